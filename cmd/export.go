@@ -0,0 +1,94 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"github.com/lazylex/watch-store-store/internal/adapters/export"
+	"github.com/lazylex/watch-store-store/internal/config"
+	"github.com/lazylex/watch-store-store/internal/domain/value_objects/report"
+	"github.com/lazylex/watch-store-store/internal/dto"
+	"github.com/lazylex/watch-store-store/internal/helpers/constants/various"
+	"github.com/lazylex/watch-store-store/internal/repository/mysql"
+	"github.com/lazylex/watch-store-store/internal/service"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+var (
+	exportReport = flag.String("export", "",
+		"выгрузить отчёт о продажах указанного вида (sold, articles или days) в файл и завершить работу")
+	exportFrom   = flag.String("export-from", "", "начальная дата периода отчёта (ГГГГ-ММ-ДД)")
+	exportTo     = flag.String("export-to", "", "конечная дата периода отчёта (ГГГГ-ММ-ДД), по умолчанию - текущее время")
+	exportFormat = flag.String("export-format", "", "формат отчёта (csv или xlsx), по умолчанию - по расширению файла")
+	exportOut    = flag.String("export-out", "", "путь к файлу отчёта")
+)
+
+// mustExportSales выгружает отчёт о продажах, заданный флагами командной строки, в файл. Сервер, брокер сообщений и
+// метрики при этом не запускаются, поэтому выгрузку можно выполнять рядом с работающим экземпляром приложения. При
+// ошибке файл удаляется, а работа приложения завершается с ненулевым кодом.
+func mustExportSales(cfg *config.Config) {
+	domainService := service.New(mysql.WithRepository(&cfg.Storage), service.WithMetrics(nil))
+	err := exportSales(domainService)
+	_ = domainService.SQLRepository.Close()
+
+	if err != nil {
+		slog.Error(fmt.Sprintf("export failed: %s", err))
+		os.Exit(1)
+	}
+	slog.Info(fmt.Sprintf("%s report saved to %s", *exportReport, *exportOut))
+}
+
+// exportSales выгружает отчёт о продажах, заданный флагами командной строки, в файл.
+func exportSales(domainService *service.Service) (err error) {
+	var format report.Format
+	transferObject := dto.FromToReport{Report: report.Kind(*exportReport), FromTo: dto.FromTo{To: time.Now()}}
+
+	if *exportOut == "" {
+		return fmt.Errorf("no output file, use -export-out")
+	}
+	if transferObject.From, err = time.Parse(various.DateLayout, *exportFrom); err != nil {
+		return fmt.Errorf("invalid -export-from date: %w", err)
+	}
+	if *exportTo != "" {
+		if transferObject.To, err = time.Parse(various.DateLayout, *exportTo); err != nil {
+			return fmt.Errorf("invalid -export-to date: %w", err)
+		}
+	}
+	if err = transferObject.Validate(); err != nil {
+		return err
+	}
+
+	formatName := *exportFormat
+	if formatName == "" {
+		formatName = filepath.Ext(*exportOut)
+	}
+	if format, err = export.FormatByName(formatName); err != nil {
+		return fmt.Errorf("%w %q", err, formatName)
+	}
+
+	file, err := os.Create(*exportOut)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if closeErr := file.Close(); err == nil {
+			err = closeErr
+		}
+		if err != nil {
+			_ = os.Remove(*exportOut)
+		}
+	}()
+
+	writer, err := export.New(format, file, string(transferObject.Report))
+	if err != nil {
+		return err
+	}
+	if err = domainService.ExportSales(context.Background(), transferObject, writer); err != nil {
+		return err
+	}
+
+	return writer.Close()
+}
@@ -0,0 +1,75 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"github.com/lazylex/watch-store-store/internal/adapters/stock_import"
+	"github.com/lazylex/watch-store-store/internal/config"
+	"github.com/lazylex/watch-store-store/internal/domain/value_objects/conflict"
+	"github.com/lazylex/watch-store-store/internal/dto"
+	"github.com/lazylex/watch-store-store/internal/repository/mysql"
+	"github.com/lazylex/watch-store-store/internal/service"
+	"log/slog"
+	"os"
+)
+
+var (
+	importStock = flag.String("import-stock", "",
+		"загрузить товары из CSV-файла (article, name, price, amount) и завершить работу")
+	importDryRun    = flag.Bool("import-dry-run", false, "только проверить файл загрузки, не изменяя данных")
+	importChunkSize = flag.Uint("import-chunk-size", 0,
+		"размер частей, применяемых в отдельных транзакциях, 0 - целиком")
+	importOnConflict = flag.String("import-on-conflict", string(conflict.Reject),
+		"действие для товаров, уже имеющихся на складе (reject, skip или update)")
+)
+
+// mustImportStock загружает товары из файла, заданного флагами командной строки, и выводит отчёт о загрузке в формате
+// JSON в стандартный вывод. Сервер, брокер сообщений и метрики при этом не запускаются. Если загрузка не применена или
+// часть строк не загружена, работа приложения завершается с ненулевым кодом.
+func mustImportStock(cfg *config.Config) {
+	domainService := service.New(mysql.WithRepository(&cfg.Storage), service.WithMetrics(nil))
+	report, err := importStockFromFile(domainService)
+	_ = domainService.SQLRepository.Close()
+
+	if err != nil {
+		slog.Error(fmt.Sprintf("import failed: %s", err))
+		os.Exit(1)
+	}
+
+	encoder := json.NewEncoder(os.Stdout)
+	encoder.SetIndent("", "  ")
+	_ = encoder.Encode(report)
+
+	if report.Failed > 0 || (!report.DryRun && !report.Applied) {
+		slog.Error(fmt.Sprintf("import of %s finished with %d failed rows (applied: %t)",
+			*importStock, report.Failed, report.Applied))
+		os.Exit(1)
+	}
+	slog.Info(fmt.Sprintf("%d rows of %s processed (dry run: %t)", report.Total, *importStock, report.DryRun))
+}
+
+// importStockFromFile загружает товары из файла, заданного флагами командной строки.
+func importStockFromFile(domainService *service.Service) (dto.StockImportReport, error) {
+	transferObject := dto.StockImport{
+		Conflict:  conflict.Policy(*importOnConflict),
+		ChunkSize: *importChunkSize,
+		DryRun:    *importDryRun,
+	}
+
+	file, err := os.Open(*importStock)
+	if err != nil {
+		return dto.StockImportReport{}, err
+	}
+	defer func() { _ = file.Close() }()
+
+	if transferObject.Rows, err = stock_import.ParseCSV(file); err != nil {
+		return dto.StockImportReport{}, err
+	}
+	if err = transferObject.Validate(); err != nil {
+		return dto.StockImportReport{}, err
+	}
+
+	return domainService.ImportStock(context.Background(), transferObject)
+}
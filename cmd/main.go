@@ -22,6 +22,15 @@ import (
 func main() {
 	cfg := config.MustLoad()
 	slog.SetDefault(logger.MustCreate(cfg.Env, cfg.Instance))
+	if *exportReport != "" {
+		mustExportSales(cfg)
+		return
+	}
+	if *importStock != "" {
+		mustImportStock(cfg)
+		return
+	}
+
 	if err := clearScreen(); err != nil {
 		slog.Error(err.Error())
 	}
@@ -34,8 +43,16 @@ func main() {
 	}
 
 	metrics := prometheusMetrics.MustCreate(&cfg.Prometheus)
-	domainService := service.New(mysql.WithRepository(&cfg.Storage),
-		service.WithMetrics(metrics))
+	options := []service.Option{mysql.WithRepository(&cfg.Storage),
+		service.WithMetrics(metrics), service.WithAdjustmentReasons(cfg.AdjustmentReasons),
+		service.WithReplenishment(service.ReplenishmentDefaults(cfg.Replenishment)), service.WithInstance(cfg.Instance),
+		service.WithStockLevelMetrics(cfg.Prometheus.StockLevelMetrics),
+		service.WithOrderNumberPrefix(cfg.OrderNumberPrefix)}
+	if cfg.UseKafka {
+		options = append(options, service.WithEvents(kafka.NewEventPublisher(&cfg.Kafka, cfg.Instance)))
+	}
+	domainService := service.New(options...)
+	go domainService.WatchGauges(cfg.Prometheus.GaugesInterval)
 
 	if cfg.UseKafka {
 		kafka.MustRun(domainService, &cfg.Kafka, cfg.Instance)
@@ -0,0 +1,31 @@
+package export
+
+import (
+	"encoding/csv"
+	"io"
+)
+
+// csvWriter запись отчёта в формате CSV с разделителем-запятой.
+type csvWriter struct {
+	w *csv.Writer
+}
+
+// newCSV возвращает Writer, записывающий отчёт в формате CSV.
+func newCSV(w io.Writer) *csvWriter {
+	return &csvWriter{w: csv.NewWriter(w)}
+}
+
+// WriteRow записывает строку отчёта.
+func (c *csvWriter) WriteRow(cells ...any) error {
+	record := make([]string, len(cells))
+	for i, v := range cells {
+		record[i], _ = cell(v)
+	}
+	return c.w.Write(record)
+}
+
+// Close дописывает буферизованные данные.
+func (c *csvWriter) Close() error {
+	c.w.Flush()
+	return c.w.Error()
+}
@@ -0,0 +1,126 @@
+package export
+
+import (
+	"errors"
+	"fmt"
+	"github.com/lazylex/watch-store-store/internal/domain/value_objects/report"
+	"github.com/lazylex/watch-store-store/internal/ports/export"
+	"io"
+	"mime"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const timeLayout = "2006-01-02 15:04:05"
+
+var ErrUnknownFormat = errors.New("unknown report format")
+
+// Writer запись отчёта в файл. После записи всех строк необходимо вызвать Close, чтобы дописать в файл буферизованные
+// данные и завершающие структуры формата. Close не закрывает переданный в New io.Writer.
+type Writer interface {
+	export.Interface
+	Close() error
+}
+
+// contentTypes MIME-типы поддерживаемых форматов отчётов.
+var contentTypes = map[report.Format]string{
+	report.CSV:  "text/csv",
+	report.XLSX: "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet",
+}
+
+// New возвращает Writer, записывающий отчёт в формате format в w. Название листа sheet используется только в XLSX.
+func New(format report.Format, w io.Writer, sheet string) (Writer, error) {
+	switch format {
+	case report.CSV:
+		return newCSV(w), nil
+	case report.XLSX:
+		return newXLSX(w, sheet)
+	}
+	return nil, ErrUnknownFormat
+}
+
+// ContentType возвращает MIME-тип формата отчёта.
+func ContentType(format report.Format) string {
+	return contentTypes[format]
+}
+
+// FormatByName возвращает формат отчёта по его названию или расширению файла (с точкой или без).
+func FormatByName(name string) (report.Format, error) {
+	name = strings.ToLower(strings.TrimPrefix(name, "."))
+	for _, f := range report.Formats() {
+		if string(f) == name {
+			return f, nil
+		}
+	}
+	return "", ErrUnknownFormat
+}
+
+// Negotiate выбирает формат отчёта по значению заголовка Accept. Типы перебираются в порядке убывания их веса q, при
+// равных весах - в порядке перечисления. Пустой заголовок и */* соответствуют CSV. Если ни один из перечисленных типов
+// не поддерживается, возвращается false.
+func Negotiate(accept string) (report.Format, bool) {
+	if strings.TrimSpace(accept) == "" {
+		return report.CSV, true
+	}
+
+	var best report.Format
+	bestQ := 0.0
+	for _, part := range strings.Split(accept, ",") {
+		mediaType, params, err := mime.ParseMediaType(strings.TrimSpace(part))
+		if err != nil {
+			continue
+		}
+		q := 1.0
+		if v, ok := params["q"]; ok {
+			if q, err = strconv.ParseFloat(v, 64); err != nil {
+				continue
+			}
+		}
+		if q <= bestQ {
+			continue
+		}
+		if f, ok := formatByMediaType(mediaType); ok {
+			best, bestQ = f, q
+		}
+	}
+
+	return best, bestQ > 0
+}
+
+// formatByMediaType возвращает формат отчёта, соответствующий MIME-типу, в том числе шаблонам */* и text/*.
+func formatByMediaType(mediaType string) (report.Format, bool) {
+	switch mediaType {
+	case "*/*", "text/*":
+		return report.CSV, true
+	}
+	for _, f := range report.Formats() {
+		if contentTypes[f] == mediaType {
+			return f, true
+		}
+	}
+	return "", false
+}
+
+// cell возвращает строковое представление значения ячейки и признак того, что значение является числом.
+func cell(value any) (string, bool) {
+	switch v := value.(type) {
+	case string:
+		return v, false
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64), true
+	case int:
+		return strconv.Itoa(v), true
+	case int64:
+		return strconv.FormatInt(v, 10), true
+	case uint:
+		return strconv.FormatUint(uint64(v), 10), true
+	case uint64:
+		return strconv.FormatUint(v, 10), true
+	case time.Time:
+		return v.Format(timeLayout), false
+	case fmt.Stringer:
+		return v.String(), false
+	}
+	return fmt.Sprint(value), false
+}
@@ -0,0 +1,112 @@
+package export
+
+import (
+	"archive/zip"
+	"bytes"
+	"github.com/lazylex/watch-store-store/internal/domain/value_objects/report"
+	"io"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestNegotiate(t *testing.T) {
+	testCases := []struct {
+		accept   string
+		format   report.Format
+		accepted bool
+	}{
+		{"", report.CSV, true},
+		{"*/*", report.CSV, true},
+		{"text/csv", report.CSV, true},
+		{"application/vnd.openxmlformats-officedocument.spreadsheetml.sheet", report.XLSX, true},
+		{"text/csv;q=0.5, application/vnd.openxmlformats-officedocument.spreadsheetml.sheet", report.XLSX, true},
+		{"application/json, */*;q=0.1", report.CSV, true},
+		{"application/json", "", false},
+		{"text/csv;q=0", "", false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.accept, func(t *testing.T) {
+			format, accepted := Negotiate(tc.accept)
+			if format != tc.format || accepted != tc.accepted {
+				t.Fail()
+			}
+		})
+	}
+}
+
+func TestFormatByName(t *testing.T) {
+	if f, err := FormatByName(".XLSX"); err != nil || f != report.XLSX {
+		t.Fail()
+	}
+	if _, err := FormatByName("pdf"); err == nil {
+		t.Fail()
+	}
+}
+
+func TestCSVWriter(t *testing.T) {
+	var buf bytes.Buffer
+	w, _ := New(report.CSV, &buf, "")
+
+	_ = w.WriteRow("article", "price", "date")
+	_ = w.WriteRow("CA-F91W, black", 1330.5, time.Date(2024, 6, 1, 10, 30, 0, 0, time.UTC))
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	expected := "article,price,date\n\"CA-F91W, black\",1330.5,2024-06-01 10:30:00\n"
+	if buf.String() != expected {
+		t.Errorf("got %q", buf.String())
+	}
+}
+
+func TestXLSXWriter(t *testing.T) {
+	var buf bytes.Buffer
+	w, err := New(report.XLSX, &buf, "sold")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_ = w.WriteRow("article", "amount")
+	_ = w.WriteRow("<A&B>", uint(3))
+	if err = w.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	archive, err := zip.NewReader(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	parts := make(map[string]string)
+	for _, f := range archive.File {
+		r, _ := f.Open()
+		content, _ := io.ReadAll(r)
+		parts[f.Name] = string(content)
+	}
+
+	for _, name := range []string{"[Content_Types].xml", "_rels/.rels", "xl/workbook.xml",
+		"xl/_rels/workbook.xml.rels", "xl/worksheets/sheet1.xml"} {
+		if _, ok := parts[name]; !ok {
+			t.Errorf("no %s in archive", name)
+		}
+	}
+	if !strings.Contains(parts["xl/workbook.xml"], `name="sold"`) {
+		t.Error("incorrect sheet name")
+	}
+
+	sheet := parts["xl/worksheets/sheet1.xml"]
+	if !strings.Contains(sheet, `<c r="A2" t="inlineStr"><is><t xml:space="preserve">&lt;A&amp;B&gt;</t></is></c>`) ||
+		!strings.Contains(sheet, `<c r="B2"><v>3</v></c>`) || !strings.HasSuffix(sheet, `</sheetData></worksheet>`) {
+		t.Errorf("incorrect sheet %s", sheet)
+	}
+}
+
+func TestColumn(t *testing.T) {
+	for index, expected := range map[int]string{0: "A", 25: "Z", 26: "AA", 701: "ZZ", 702: "AAA"} {
+		if column(index) != expected {
+			t.Errorf("column(%d) = %s, expected %s", index, column(index), expected)
+		}
+	}
+}
@@ -0,0 +1,87 @@
+package goods_receipt
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/lazylex/watch-store-store/internal/dto"
+	"github.com/lazylex/watch-store-store/internal/helpers/constants/prefixes"
+	"github.com/lazylex/watch-store-store/internal/logger"
+	"github.com/lazylex/watch-store-store/internal/ports/service"
+	"github.com/segmentio/kafka-go"
+	"log/slog"
+	"strings"
+	"time"
+)
+
+const attemptsUntilAlarm = 6
+
+// ReceiveGoods принимает поставки товаров, считываемые из топика в формате JSON (формат совпадает с телом запроса
+// REST-обработчика ReceiveGoods). Autocommit не выполняется. Приёмка идемпотентна по номеру документа, поэтому повторно
+// доставленное сообщение не изменяет остатки. Сообщения с некорректными данными пропускаются. При ошибке приёмки
+// смещение в Кафке не сохраняется, а производятся новые попытки приёмки. Каждая последующая попытка производится через
+// период, на десять секунд дольше предыдущего. Через attemptsUntilAlarm попыток, в лог выводится ошибка, а не
+// предупреждение.
+func ReceiveGoods(service service.Interface, brokers []string, topic, instance string) {
+	var err error
+	var m kafka.Message
+	var attempts int
+	var received bool
+	ctx := context.Background()
+
+	r := kafka.NewReader(kafka.ReaderConfig{
+		Brokers:  brokers,
+		Topic:    topic,
+		MaxBytes: 10e6,
+		GroupID:  instance,
+	})
+
+	log := slog.With(slog.String(logger.OPLabel, "kafka.consumer.ReceiveGoods"))
+	canFetchMessage := true
+	for {
+		if canFetchMessage {
+			m, err = r.FetchMessage(ctx)
+			if err != nil {
+				break
+			}
+			attempts = 0
+		}
+		canFetchMessage = true
+
+		var data dto.GoodsReceipt
+		err = json.Unmarshal(m.Value, &data)
+
+		if err != nil {
+			log.Warn("error unmarshal JSON")
+		} else if err = data.Validate(); err != nil {
+			log.Warn(err.Error())
+		} else {
+			log.Info(fmt.Sprintf("reading goods receipt %s", data.DocumentNumber))
+			if received, err = service.ReceiveGoods(ctx, data); err != nil {
+				if attempts < attemptsUntilAlarm {
+					log.Warn(err.Error())
+				} else {
+					log.Error(err.Error())
+				}
+
+				if !strings.HasPrefix(err.Error(), prefixes.DTOErrorsPrefix) {
+					canFetchMessage = false
+					attempts++
+					time.Sleep(time.Second * time.Duration(10*attempts))
+				}
+			} else if !received {
+				log.Info(fmt.Sprintf("document %s already received", data.DocumentNumber))
+			}
+		}
+
+		if canFetchMessage {
+			if err = r.CommitMessages(ctx, m); err != nil {
+				log.Warn(err.Error())
+			}
+		}
+	}
+
+	if err = r.Close(); err != nil {
+		log.Error("failed to close reader: " + err.Error())
+	}
+}
@@ -81,6 +81,6 @@ func ListenTopic(service service.Interface, brokers []string, topic, instance st
 	}
 
 	if err = r.Close(); err != nil {
-		log.Error("failed to close reader:", err)
+		log.Error("failed to close reader: " + err.Error())
 	}
 }
@@ -0,0 +1,115 @@
+package stock_transfer
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	transferMessage "github.com/lazylex/watch-store-store/internal/adapters/message_broker/kafka/producer/stock_transfer"
+	"github.com/lazylex/watch-store-store/internal/dto"
+	"github.com/lazylex/watch-store-store/internal/helpers/constants/prefixes"
+	"github.com/lazylex/watch-store-store/internal/logger"
+	"github.com/lazylex/watch-store-store/internal/ports/repository"
+	"github.com/lazylex/watch-store-store/internal/ports/service"
+	"github.com/segmentio/kafka-go"
+	"log/slog"
+	"strings"
+	"time"
+)
+
+const attemptsUntilAlarm = 6
+
+var errUnknownKind = errors.New(prefixes.DTOErrorsPrefix + "unknown transfer message kind")
+
+// ReceiveTransfers читает из топика экземпляра приложения instance сообщения о перемещениях товара между магазинами
+// в формате transferMessage.Message. Сообщение об отправке товара сохраняется как входящее перемещение, сообщение о
+// приёмке товара - как результат приёмки исходящего перемещения. Обработка обоих видов сообщений идемпотентна.
+// Autocommit не выполняется. Сообщения с некорректными данными, адресованные другому экземпляру или противоречащие
+// состоянию перемещения, пропускаются. При прочих ошибках смещение в Кафке не сохраняется, а производятся новые попытки
+// обработки сообщения. Каждая последующая попытка производится через период, на десять секунд дольше предыдущего.
+// Через attemptsUntilAlarm попыток, в лог выводится ошибка, а не предупреждение.
+func ReceiveTransfers(service service.Interface, brokers []string, topic, instance string) {
+	var err error
+	var m kafka.Message
+	var attempts int
+	ctx := context.Background()
+
+	r := kafka.NewReader(kafka.ReaderConfig{
+		Brokers:  brokers,
+		Topic:    topic,
+		MaxBytes: 10e6,
+		GroupID:  instance,
+	})
+
+	log := slog.With(slog.String(logger.OPLabel, "kafka.consumer.ReceiveTransfers"))
+	canFetchMessage := true
+	for {
+		if canFetchMessage {
+			m, err = r.FetchMessage(ctx)
+			if err != nil {
+				break
+			}
+			attempts = 0
+		}
+		canFetchMessage = true
+
+		var data transferMessage.Message
+		err = json.Unmarshal(m.Value, &data)
+
+		switch {
+		case err != nil:
+			log.Warn("error unmarshal JSON")
+		case data.Recipient != instance:
+			log.Warn(fmt.Sprintf("transfer message for %s skipped", data.Recipient))
+		default:
+			log.Info(fmt.Sprintf("reading %s of transfer %d from %s", data.Kind, data.TransferID, data.Sender))
+			if err = handle(ctx, service, data); err != nil {
+				if attempts < attemptsUntilAlarm {
+					log.Warn(err.Error())
+				} else {
+					log.Error(err.Error())
+				}
+
+				if !isPermanent(err) {
+					canFetchMessage = false
+					attempts++
+					time.Sleep(time.Second * time.Duration(10*attempts))
+				}
+			}
+		}
+
+		if canFetchMessage {
+			if err = r.CommitMessages(ctx, m); err != nil {
+				log.Warn(err.Error())
+			}
+		}
+	}
+
+	if err = r.Close(); err != nil {
+		log.Error("failed to close reader: " + err.Error())
+	}
+}
+
+// handle передаёт сервисному слою данные сообщения о перемещении в соответствии с его видом.
+func handle(ctx context.Context, service service.Interface, data transferMessage.Message) error {
+	var err error
+	switch data.Kind {
+	case transferMessage.Shipment:
+		_, err = service.RegisterInboundTransfer(ctx,
+			dto.Transfer{Counterpart: data.Sender, SourceID: data.TransferID, Items: data.Items})
+	case transferMessage.Confirmation:
+		_, err = service.CompleteOutboundTransfer(ctx,
+			dto.Transfer{ID: data.TransferID, Counterpart: data.Sender, Items: data.Items})
+	default:
+		err = errUnknownKind
+	}
+	return err
+}
+
+// isPermanent возвращает true, если повторная обработка сообщения приведёт к той же ошибке (некорректные данные,
+// отсутствующее перемещение или несоответствие его состоянию).
+func isPermanent(err error) bool {
+	return strings.HasPrefix(err.Error(), prefixes.DTOErrorsPrefix) ||
+		strings.HasPrefix(err.Error(), prefixes.ServicePrefix) ||
+		errors.Is(err, repository.ErrNoRecord)
+}
@@ -17,8 +17,8 @@ import (
 const attemptsUntilAlarm = 6
 
 // UpdatePrice обновляет цену товара, находящегося в продаже, если считывает в топике store.update-price новую цену.
-// Autocommit не выполняется. При ошибке обновления цены смещение в Кафке не сохраняется, а производятся новые попытки
-// обновления. Каждая последующая попытка производится через период, на десять секунд дольше предыдущего. Через
+// Сообщение может содержать и групповое изменение цен (см. changePrices). Autocommit не выполняется. При ошибке
+// обновления цены смещение в Кафке не сохраняется, а производятся новые попытки обновления. Каждая последующая попытка производится через период, на десять секунд дольше предыдущего. Через
 // attemptsUntilAlarm попыток, в лог выводится ошибка, а не предупреждение.
 func UpdatePrice(service service.Interface, brokers []string, topic, instance string) {
 	var err error
@@ -45,44 +45,78 @@ func UpdatePrice(service service.Interface, brokers []string, topic, instance st
 		}
 		canFetchMessage = true
 
-		var data dto.ArticlePrice
-		err = json.Unmarshal(m.Value, &data)
-
-		if err != nil {
-			log.Warn("error unmarshal JSON")
-		} else {
-			err = data.Validate()
-			if err != nil {
+		var valid bool
+		if valid, err = changePrices(ctx, service, m.Value, log); err != nil {
+			if attempts < attemptsUntilAlarm {
 				log.Warn(err.Error())
 			} else {
-				log.Info(fmt.Sprintf("reading updating price to %.2f (article %s)", data.Price, data.Article))
-				if err = service.ChangePriceInStock(ctx, data); err != nil {
-					if attempts < attemptsUntilAlarm {
-						log.Warn(err.Error())
-					} else {
-						log.Error(err.Error())
-					}
+				log.Error(err.Error())
+			}
 
-					if errors.Is(err, repository.ErrNoRecord) {
-						canFetchMessage = true
-					} else {
-						canFetchMessage = false
-						attempts++
-						time.Sleep(time.Second * time.Duration(10*attempts))
-					}
-				}
+			if errors.Is(err, repository.ErrNoRecord) {
+				canFetchMessage = true
+			} else {
+				canFetchMessage = false
+				attempts++
+				time.Sleep(time.Second * time.Duration(10*attempts))
+			}
+		}
 
-				if canFetchMessage {
-					err = r.CommitMessages(ctx, m)
-					if err != nil {
-						log.Warn(err.Error())
-					}
-				}
+		if valid && canFetchMessage {
+			err = r.CommitMessages(ctx, m)
+			if err != nil {
+				log.Warn(err.Error())
 			}
 		}
 	}
 
 	if err = r.Close(); err != nil {
-		log.Error("failed to close reader:", err)
+		log.Error("failed to close reader: " + err.Error())
 	}
 }
+
+// changePrices изменяет цены по сообщению и возвращает false, если сообщение некорректно. Сообщение содержит новую цену
+// товара или групповое изменение цен в формате dto.BulkPriceUpdate - список новых цен или артикул товара без дефектов и
+// процент изменения цены. Примеры сообщений:
+//
+//	{"article": "CA-F91W", "price": 3590}
+//
+//	{"prices": [{"article": "CA-F91W", "price": 3590}, {"article": "CA-F91W.1000", "price": 2990}]}
+//
+//	{"article": "CA-F91W", "percent": -10}
+//
+// Групповое изменение, которое невозможно применить целиком, не применяется и повторно не выполняется.
+func changePrices(ctx context.Context, service service.Interface, value []byte, log *slog.Logger) (bool, error) {
+	var data dto.ArticlePrice
+	var batch dto.BulkPriceUpdate
+
+	if json.Unmarshal(value, &batch) != nil || json.Unmarshal(value, &data) != nil {
+		log.Warn("error unmarshal JSON")
+		return false, nil
+	}
+
+	if len(batch.Prices) == 0 && batch.Percent == 0 {
+		if err := data.Validate(); err != nil {
+			log.Warn(err.Error())
+			return false, nil
+		}
+		log.Info(fmt.Sprintf("reading updating price to %.2f (article %s)", data.Price, data.Article))
+		return true, service.ChangePriceInStock(ctx, data)
+	}
+
+	if err := batch.Validate(); err != nil {
+		log.Warn(err.Error())
+		return false, nil
+	}
+	log.Info("reading bulk price update")
+	report, err := service.UpdatePrices(ctx, batch)
+	if err == nil && !report.Applied {
+		for _, item := range report.Items {
+			if item.Status == dto.PriceFailed {
+				log.Warn(fmt.Sprintf("bulk price update not applied: article %s: %s", item.Article, item.Error))
+			}
+		}
+	}
+
+	return true, err
+}
@@ -2,12 +2,18 @@ package kafka
 
 import (
 	"fmt"
+	"github.com/lazylex/watch-store-store/internal/adapters/message_broker/kafka/consumer/goods_receipt"
 	"github.com/lazylex/watch-store-store/internal/adapters/message_broker/kafka/consumer/request_count"
+	transferConsumer "github.com/lazylex/watch-store-store/internal/adapters/message_broker/kafka/consumer/stock_transfer"
 	"github.com/lazylex/watch-store-store/internal/adapters/message_broker/kafka/consumer/update_price"
+	eventsProducer "github.com/lazylex/watch-store-store/internal/adapters/message_broker/kafka/producer/events"
+	"github.com/lazylex/watch-store-store/internal/adapters/message_broker/kafka/producer/reorder_suggestions"
 	"github.com/lazylex/watch-store-store/internal/adapters/message_broker/kafka/producer/response_count"
+	transferProducer "github.com/lazylex/watch-store-store/internal/adapters/message_broker/kafka/producer/stock_transfer"
 	"github.com/lazylex/watch-store-store/internal/config"
 	"github.com/lazylex/watch-store-store/internal/dto"
 	internalLogger "github.com/lazylex/watch-store-store/internal/logger"
+	"github.com/lazylex/watch-store-store/internal/ports/events"
 	"github.com/lazylex/watch-store-store/internal/ports/service"
 	"log/slog"
 	"os"
@@ -43,6 +49,31 @@ func MustRun(service service.Interface, cfg *config.Kafka, instance string) {
 		log.Error("not configured Kafka count topics")
 	}
 
+	if len(cfg.GoodsReceiptTopic) > 0 {
+		go goods_receipt.ReceiveGoods(service, cfg.Brokers, cfg.GoodsReceiptTopic, instance)
+		topicsInService++
+	} else {
+		log.Error("not configured Kafka Goods Receipt topic")
+	}
+
+	if len(cfg.ReorderSuggestionsTopic) > 0 {
+		go reorder_suggestions.Publish(service, cfg.Brokers, cfg.ReorderSuggestionsTopic, instance,
+			cfg.ReorderSuggestionsInterval)
+		topicsInService++
+	} else {
+		log.Info("not configured Kafka Reorder Suggestions topic")
+	}
+
+	if len(cfg.StockTransferTopicPrefix) > 0 {
+		go transferConsumer.ReceiveTransfers(service, cfg.Brokers,
+			transferProducer.Topic(cfg.StockTransferTopicPrefix, instance), instance)
+		go transferProducer.Publish(service, cfg.Brokers, cfg.StockTransferTopicPrefix, instance,
+			cfg.StockTransferPollInterval)
+		topicsInService++
+	} else {
+		log.Info("not configured Kafka Stock Transfer topic prefix")
+	}
+
 	if topicsInService > 0 {
 		log.Info(fmt.Sprintf("kafka topics in service: %d", topicsInService))
 	} else {
@@ -50,3 +81,25 @@ func MustRun(service service.Interface, cfg *config.Kafka, instance string) {
 	}
 
 }
+
+// NewEventPublisher запускает публикацию доменных событий и возвращает их получателя для внедрения в сервис. Если в
+// конфигурации cfg не задан ни топик событий по умолчанию, ни топики отдельных типов событий, возвращается nil и события
+// не публикуются.
+func NewEventPublisher(cfg *config.Kafka, instance string) events.Interface {
+	log := slog.With(slog.String(internalLogger.OPLabel, "kafka.NewEventPublisher"))
+
+	if len(cfg.EventsTopic) == 0 && len(cfg.EventTopics) == 0 {
+		log.Info("not configured Kafka Events topics")
+		return nil
+	}
+
+	if len(cfg.Brokers) < 1 {
+		log.Error("empty kafka brokers list")
+		os.Exit(1)
+	}
+
+	publisher := eventsProducer.New(cfg.Brokers, cfg.EventsTopic, cfg.EventTopics, instance, cfg.EventsBufferSize)
+	go publisher.Run()
+
+	return publisher
+}
@@ -0,0 +1,163 @@
+package events
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/lazylex/watch-store-store/internal/domain/event"
+	"github.com/lazylex/watch-store-store/internal/logger"
+	"github.com/segmentio/kafka-go"
+	"log/slog"
+	"strconv"
+	"time"
+)
+
+const (
+	attemptsUntilAlarm = 6
+	maxBatchSize       = 100
+	batchTimeout       = 10 * time.Millisecond
+)
+
+// Заголовки сообщений с доменными событиями.
+const (
+	HeaderInstance     = "instance"
+	HeaderRequestID    = "request_id"
+	HeaderEventType    = "event_type"
+	HeaderEventVersion = "event_version"
+)
+
+// Message формат сообщения с доменным событием. Key - артикул товара, номер заказа или идентификатор документа, он же
+// является ключом сообщения Кафки, поэтому события с одинаковым ключом попадают в один раздел топика в порядке
+// возникновения.
+type Message struct {
+	Type       event.Type `json:"type"`
+	Version    int        `json:"version"`
+	Key        string     `json:"key"`
+	Instance   string     `json:"instance"`
+	OccurredAt time.Time  `json:"occurred_at"`
+	Payload    any        `json:"payload"`
+}
+
+// outgoing событие вместе с идентификатором запроса, в ходе обработки которого оно возникло.
+type outgoing struct {
+	event     event.Event
+	requestID string
+}
+
+// Publisher публикует доменные события в Кафку. Топик события определяется по его типу, для типов без собственного
+// топика используется топик по умолчанию. Публикация выполняется асинхронно через буфер размером bufferSize: если
+// буфер заполнен, событие отбрасывается с записью об ошибке в лог, чтобы не задерживать обработку запросов.
+type Publisher struct {
+	brokers      []string
+	defaultTopic string
+	topics       map[event.Type]string
+	instance     string
+	events       chan outgoing
+	log          *slog.Logger
+}
+
+// New возвращает публикатора доменных событий. Ключи topics - типы событий, значения - топики для них. Публикация
+// начинается после вызова Run.
+func New(brokers []string, defaultTopic string, topics map[string]string, instance string,
+	bufferSize int) *Publisher {
+	p := &Publisher{
+		brokers:      brokers,
+		defaultTopic: defaultTopic,
+		topics:       make(map[event.Type]string, len(topics)),
+		instance:     instance,
+		events:       make(chan outgoing, max(bufferSize, 1)),
+		log:          slog.With(slog.String(logger.OPLabel, "kafka.producer.events")),
+	}
+
+	for t, topic := range topics {
+		if !event.Type(t).IsKnown() {
+			p.log.Error(fmt.Sprintf("topic %s configured for unknown event type %s", topic, t))
+			continue
+		}
+		p.topics[event.Type(t)] = topic
+	}
+
+	return p
+}
+
+// Publish помещает событие в буфер публикации. Если для типа события не задан топик, событие не публикуется.
+func (p *Publisher) Publish(ctx context.Context, e event.Event) {
+	if p.topic(e.Type) == "" {
+		return
+	}
+
+	requestID, _ := ctx.Value(logger.RequestId).(string)
+	select {
+	case p.events <- outgoing{event: e, requestID: requestID}:
+	default:
+		p.log.Error(fmt.Sprintf("events buffer is full, event %s with key %s dropped", e.Type, e.Key))
+	}
+}
+
+// Run публикует события из буфера. Накопившиеся в буфере события отправляются одним пакетом.
+func (p *Publisher) Run() {
+	w := &kafka.Writer{
+		Addr:                   kafka.TCP(p.brokers...),
+		Balancer:               &kafka.Hash{},
+		MaxAttempts:            attemptsUntilAlarm,
+		BatchTimeout:           batchTimeout,
+		AllowAutoTopicCreation: true,
+	}
+
+	for {
+		batch := p.appendMessage(make([]kafka.Message, 0, maxBatchSize), <-p.events)
+	collect:
+		for len(batch) < maxBatchSize {
+			select {
+			case o := <-p.events:
+				batch = p.appendMessage(batch, o)
+			default:
+				break collect
+			}
+		}
+		if len(batch) == 0 {
+			continue
+		}
+
+		if err := w.WriteMessages(context.Background(), batch...); err != nil {
+			p.log.Error(fmt.Sprintf("failed to write %d events: %s", len(batch), err.Error()))
+		}
+	}
+}
+
+// topic возвращает топик для событий типа t.
+func (p *Publisher) topic(t event.Type) string {
+	if topic, ok := p.topics[t]; ok {
+		return topic
+	}
+	return p.defaultTopic
+}
+
+// appendMessage добавляет к batch сообщение Кафки с событием в формате JSON. Событие, которое не удалось
+// сериализовать, отбрасывается.
+func (p *Publisher) appendMessage(batch []kafka.Message, o outgoing) []kafka.Message {
+	value, err := json.Marshal(Message{
+		Type:       o.event.Type,
+		Version:    o.event.Version,
+		Key:        o.event.Key,
+		Instance:   p.instance,
+		OccurredAt: o.event.OccurredAt,
+		Payload:    o.event.Payload,
+	})
+	if err != nil {
+		p.log.Error(fmt.Sprintf("failed to marshal event %s: %s", o.event.Type, err.Error()))
+		return batch
+	}
+
+	return append(batch, kafka.Message{
+		Topic: p.topic(o.event.Type),
+		Key:   []byte(o.event.Key),
+		Value: value,
+		Headers: []kafka.Header{
+			{Key: HeaderInstance, Value: []byte(p.instance)},
+			{Key: HeaderRequestID, Value: []byte(o.requestID)},
+			{Key: HeaderEventType, Value: []byte(o.event.Type)},
+			{Key: HeaderEventVersion, Value: []byte(strconv.Itoa(o.event.Version))},
+		},
+	})
+}
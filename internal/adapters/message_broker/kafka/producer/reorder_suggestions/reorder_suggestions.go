@@ -0,0 +1,72 @@
+package reorder_suggestions
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/lazylex/watch-store-store/internal/dto"
+	"github.com/lazylex/watch-store-store/internal/logger"
+	"github.com/lazylex/watch-store-store/internal/ports/service"
+	"github.com/segmentio/kafka-go"
+	"log/slog"
+	"time"
+)
+
+const attemptsUntilAlarm = 6
+
+// message формат публикуемого в топик списка товаров, которые необходимо заказать.
+type message struct {
+	Instance    string                  `json:"instance"`
+	Date        time.Time               `json:"date"`
+	Suggestions []dto.ReorderSuggestion `json:"suggestions"`
+}
+
+// Publish при запуске и далее с периодичностью interval публикует в топик topic список товаров, которые необходимо
+// заказать, в формате JSON. Ключом сообщения является название экземпляра приложения instance. Если рассчитать список
+// не удалось, публикация пропускается до следующего периода.
+func Publish(service service.Interface, brokers []string, topic, instance string, interval time.Duration) {
+	log := slog.With(slog.String(logger.OPLabel, "kafka.producer.reorder_suggestions.Publish"))
+	if interval <= 0 {
+		log.Error("reorder suggestions publication interval must be positive")
+		return
+	}
+
+	w := &kafka.Writer{
+		Addr:                   kafka.TCP(brokers...),
+		Topic:                  topic,
+		Balancer:               &kafka.LeastBytes{},
+		MaxAttempts:            attemptsUntilAlarm,
+		AllowAutoTopicCreation: true,
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		publish(service, w, instance, log)
+		<-ticker.C
+	}
+}
+
+// publish рассчитывает и публикует список товаров, которые необходимо заказать.
+func publish(service service.Interface, w *kafka.Writer, instance string, log *slog.Logger) {
+	ctx := context.Background()
+
+	suggestions, err := service.ReorderSuggestions(ctx, dto.WindowDays{})
+	if err != nil {
+		log.Error("failed to calculate reorder suggestions: " + err.Error())
+		return
+	}
+
+	value, err := json.Marshal(message{Instance: instance, Date: time.Now(), Suggestions: suggestions})
+	if err != nil {
+		log.Error("failed to marshal reorder suggestions: " + err.Error())
+		return
+	}
+
+	if err = w.WriteMessages(ctx, kafka.Message{Key: []byte(instance), Value: value}); err != nil {
+		log.Error("failed to write messages:" + err.Error())
+	} else {
+		log.Info(fmt.Sprintf("%d reorder suggestions were successfully sent", len(suggestions)))
+	}
+}
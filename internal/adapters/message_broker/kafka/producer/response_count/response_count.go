@@ -28,7 +28,7 @@ func Serve(brokers []string, topic, instance string, countChan <-chan dto.Articl
 
 		if !ok {
 			if err := w.Close(); err != nil {
-				log.Error("failed to close writer:", err)
+				log.Error("failed to close writer: " + err.Error())
 			}
 		}
 
@@ -0,0 +1,111 @@
+package stock_transfer
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/lazylex/watch-store-store/internal/domain/aggregates/transfer"
+	"github.com/lazylex/watch-store-store/internal/dto"
+	"github.com/lazylex/watch-store-store/internal/logger"
+	"github.com/lazylex/watch-store-store/internal/ports/service"
+	"github.com/segmentio/kafka-go"
+	"log/slog"
+	"strconv"
+	"time"
+)
+
+const attemptsUntilAlarm = 6
+
+// Kind вид сообщения о перемещении товара между магазинами.
+type Kind string
+
+const (
+	Shipment     Kind = "shipment"     // Отправитель отправил товар
+	Confirmation Kind = "confirmation" // Получатель принял товар
+)
+
+// Message формат сообщения о перемещении товара между магазинами. TransferID - идентификатор перемещения у
+// отправителя товара. В сообщении о приёмке поле received_amount товаров содержит принятое получателем количество.
+type Message struct {
+	Kind       Kind               `json:"kind"`
+	Sender     string             `json:"sender"`
+	Recipient  string             `json:"recipient"`
+	TransferID transfer.ID        `json:"transfer_id"`
+	Items      []dto.TransferItem `json:"items"`
+}
+
+// Topic возвращает название топика, из которого читает сообщения о перемещениях экземпляр приложения instance.
+func Topic(prefix, instance string) string {
+	return prefix + instance
+}
+
+// Publish при запуске и далее с периодичностью interval отправляет второй стороне перемещений сообщения о созданных
+// исходящих и принятых входящих перемещениях в формате JSON. Сообщение публикуется в топик получателя сообщения (см.
+// Topic). После успешной публикации перемещение отмечается, как отправленное, поэтому при сбое сообщение будет
+// отправлено повторно - получатель обрабатывает повторные сообщения идемпотентно.
+func Publish(service service.Interface, brokers []string, prefix, instance string, interval time.Duration) {
+	log := slog.With(slog.String(logger.OPLabel, "kafka.producer.stock_transfer.Publish"))
+	if interval <= 0 {
+		log.Error("stock transfer poll interval must be positive")
+		return
+	}
+
+	w := &kafka.Writer{
+		Addr:                   kafka.TCP(brokers...),
+		Balancer:               &kafka.LeastBytes{},
+		MaxAttempts:            attemptsUntilAlarm,
+		AllowAutoTopicCreation: true,
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		publish(service, w, prefix, instance, log)
+		<-ticker.C
+	}
+}
+
+// publish отправляет сообщения обо всех перемещениях, о которых ещё не сообщено второй стороне.
+func publish(service service.Interface, w *kafka.Writer, prefix, instance string, log *slog.Logger) {
+	ctx := context.Background()
+
+	transfers, err := service.TransfersToNotify(ctx)
+	if err != nil {
+		log.Error("failed to read transfers to notify: " + err.Error())
+		return
+	}
+
+	for _, t := range transfers {
+		m := newMessage(t, instance)
+		value, err := json.Marshal(m)
+		if err != nil {
+			log.Error("failed to marshal transfer message: " + err.Error())
+			continue
+		}
+
+		if err = w.WriteMessages(ctx, kafka.Message{
+			Topic: Topic(prefix, m.Recipient),
+			Key:   []byte(strconv.FormatInt(int64(m.TransferID), 10)),
+			Value: value,
+		}); err != nil {
+			log.Error("failed to write messages:" + err.Error())
+			return
+		}
+
+		if err = service.MarkTransferNotified(ctx, dto.TransferID{ID: t.ID}); err != nil {
+			log.Error(err.Error())
+			continue
+		}
+		log.Info(fmt.Sprintf("%s of transfer %d was successfully sent to %s", m.Kind, m.TransferID, m.Recipient))
+	}
+}
+
+// newMessage возвращает сообщение о перемещении для второй стороны перемещения.
+func newMessage(t dto.Transfer, instance string) Message {
+	m := Message{Kind: Shipment, Sender: instance, Recipient: t.Counterpart, TransferID: t.ID, Items: t.Items}
+	if t.Direction == transfer.Inbound {
+		m.Kind, m.TransferID = Confirmation, t.SourceID
+	}
+	return m
+}
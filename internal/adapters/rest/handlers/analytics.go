@@ -0,0 +1,219 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"github.com/go-chi/render"
+	"github.com/lazylex/watch-store-store/internal/adapters/rest/request"
+	"github.com/lazylex/watch-store-store/internal/adapters/rest/response"
+	"github.com/lazylex/watch-store-store/internal/domain/value_objects/article"
+	"github.com/lazylex/watch-store-store/internal/domain/value_objects/bucket"
+	"github.com/lazylex/watch-store-store/internal/domain/value_objects/measure"
+	"github.com/lazylex/watch-store-store/internal/dto"
+	"github.com/lazylex/watch-store-store/internal/helpers/constants/various"
+	"github.com/lazylex/watch-store-store/internal/logger"
+	"log/slog"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+const defaultTopLimit = 10
+
+// period считывает из параметров запроса период from - to. Параметр from обязателен, при отсутствии параметра to им
+// становится текущее время (как и в SoldAmount).
+func period(r *http.Request) (dto.FromTo, error) {
+	var err error
+	var result dto.FromTo
+
+	fromParam := r.FormValue(request.From)
+	toParam := r.FormValue(request.To)
+
+	if len(fromParam) == 0 {
+		return result, request.ErrEmptyFromDate
+	}
+	if result.From, err = time.Parse(various.DateLayout, fromParam); err != nil {
+		return result, request.ErrIncorrectDate
+	}
+
+	if len(toParam) == 0 {
+		result.To = time.Now()
+	} else if result.To, err = time.Parse(various.DateLayout, toParam); err != nil {
+		return result, request.ErrIncorrectDate
+	}
+
+	return result, nil
+}
+
+// SalesByPeriod возвращает количество проданных единиц, выручку, выручку без НДС (net) и сумму НДС (tax) за вычетом
+// возвратов, а также возвращённые единицы (refunded_units) и сумму возвратов (refunded) за период с разбивкой по
+// интервалам. Параметрами запроса передаются даты from и to (to необязателен) и размер интервала bucket (day, week или
+// month, по умолчанию - day). Интервалы без продаж и возвратов не возвращаются. Пример возвращаемых данных:
+//
+//	[
+//		{"period": "2024-06-03T00:00:00Z", "units": 12, "revenue": 41880, "net": 34900, "tax": 6980,
+//			"refunded_units": 0, "refunded": 0},
+//		{"period": "2024-06-10T00:00:00Z", "units": 7, "revenue": 24430, "net": 20358.33, "tax": 4071.67,
+//			"refunded_units": 1, "refunded": 3490}
+//	]
+func (h *Handler) SalesByPeriod(w http.ResponseWriter, r *http.Request) {
+	var err error
+	var result []dto.PeriodSales
+	log := logger.AddPlaceAndRequestId(slog.Default(), "rest.handlers.SalesByPeriod", r)
+
+	ctx, cancel := context.WithTimeout(r.Context(), h.queryTimeout)
+	defer cancel()
+
+	transferObject := dto.FromToBucket{Bucket: bucket.Bucket(r.FormValue(request.Bucket))}
+	if transferObject.FromTo, err = period(r); err != nil {
+		response.WriteHeaderAndLogAboutBadRequest(w, log, err)
+		return
+	}
+	if transferObject.Bucket == "" {
+		transferObject.Bucket = bucket.Day
+	}
+
+	err = transferObject.Validate()
+	if response.WriteHeaderAndLogAboutErr(w, log, err); err != nil {
+		return
+	}
+
+	result, err = h.service.SalesByPeriod(injectRequestIDToCtx(ctx, r), transferObject)
+	if response.WriteHeaderAndLogAboutErr(w, log, err); err != nil {
+		return
+	}
+
+	log.Info(fmt.Sprintf("requested sales by %s", transferObject.Bucket))
+
+	render.JSON(w, r, result)
+}
+
+// TopArticles возвращает самые продаваемые за период товары (с учётом возвратов). Параметрами запроса передаются даты
+// from и to (to необязателен), показатель by (units или revenue, по умолчанию - units) и количество товаров limit (по
+// умолчанию - 10). Пример возвращаемых данных:
+//
+//	[
+//		{"article": "CA-F91W", "units": 40, "revenue": 139600, "refunded_units": 1, "refunded": 3490,
+//			"average_price": 3490}
+//	]
+func (h *Handler) TopArticles(w http.ResponseWriter, r *http.Request) {
+	var err error
+	var result []dto.ArticleSales
+	log := logger.AddPlaceAndRequestId(slog.Default(), "rest.handlers.TopArticles", r)
+
+	ctx, cancel := context.WithTimeout(r.Context(), h.queryTimeout)
+	defer cancel()
+
+	transferObject := dto.FromToTop{By: measure.Measure(r.FormValue(request.By)), Limit: defaultTopLimit}
+	if transferObject.FromTo, err = period(r); err != nil {
+		response.WriteHeaderAndLogAboutBadRequest(w, log, err)
+		return
+	}
+	if transferObject.By == "" {
+		transferObject.By = measure.Units
+	}
+	if limitParam := r.FormValue(request.Limit); len(limitParam) > 0 {
+		if transferObject.Limit, err = strconv.Atoi(limitParam); err != nil {
+			response.WriteHeaderAndLogAboutBadRequest(w, log, request.ErrIncorrectLimit)
+			return
+		}
+	}
+
+	err = transferObject.Validate()
+	if response.WriteHeaderAndLogAboutErr(w, log, err); err != nil {
+		return
+	}
+
+	result, err = h.service.TopArticles(injectRequestIDToCtx(ctx, r), transferObject)
+	if response.WriteHeaderAndLogAboutErr(w, log, err); err != nil {
+		return
+	}
+
+	log.Info(fmt.Sprintf("requested top %d articles by %s", transferObject.Limit, transferObject.By))
+
+	render.JSON(w, r, result)
+}
+
+// AverageSalePrice возвращает среднюю цену продажи товаров за период. Параметрами запроса передаются даты from и to
+// (to необязателен) и, необязательно, артикул article. Если артикул передан, возвращается одна запись, иначе - записи
+// по всем проданным за период товарам. Формат записи совпадает с возвращаемым в TopArticles.
+func (h *Handler) AverageSalePrice(w http.ResponseWriter, r *http.Request) {
+	var err error
+	var fromTo dto.FromTo
+	var result any
+	log := logger.AddPlaceAndRequestId(slog.Default(), "rest.handlers.AverageSalePrice", r)
+
+	ctx, cancel := context.WithTimeout(r.Context(), h.queryTimeout)
+	defer cancel()
+
+	if fromTo, err = period(r); err != nil {
+		response.WriteHeaderAndLogAboutBadRequest(w, log, err)
+		return
+	}
+
+	if art := article.Article(r.FormValue(request.Article)); len(art) > 0 {
+		err = h.resolveBarcodes(injectRequestIDToCtx(ctx, r), &art)
+		if response.WriteHeaderAndLogAboutErr(w, log, err); err != nil {
+			return
+		}
+
+		transferObject := dto.ArticleFromTo{Article: art, From: fromTo.From, To: fromTo.To}
+		err = transferObject.Validate()
+		if response.WriteHeaderAndLogAboutErr(w, log, err); err != nil {
+			return
+		}
+		result, err = h.service.AverageSalePrice(injectRequestIDToCtx(ctx, r), transferObject)
+	} else {
+		err = fromTo.Validate()
+		if response.WriteHeaderAndLogAboutErr(w, log, err); err != nil {
+			return
+		}
+		result, err = h.service.AverageSalePrices(injectRequestIDToCtx(ctx, r), fromTo)
+	}
+
+	if response.WriteHeaderAndLogAboutErr(w, log, err); err != nil {
+		return
+	}
+
+	log.Info("requested average sale price")
+
+	render.JSON(w, r, result)
+}
+
+// SalesByChannel возвращает продажи за период по каналам продаж: register - продажи на кассе, internet - выполненные
+// заказы, unknown - продажи, записанные до появления чеков. Параметрами запроса передаются даты from и to (to
+// необязателен). Пример возвращаемых данных:
+//
+//	[
+//		{"channel": "internet", "receipts": 3, "units": 4, "revenue": 20460, "refunded_units": 0, "refunded": 0},
+//		{"channel": "register", "receipts": 25, "units": 31, "revenue": 108190, "refunded_units": 1,
+//			"refunded": 3490}
+//	]
+func (h *Handler) SalesByChannel(w http.ResponseWriter, r *http.Request) {
+	var err error
+	var transferObject dto.FromTo
+	var result []dto.ChannelSales
+	log := logger.AddPlaceAndRequestId(slog.Default(), "rest.handlers.SalesByChannel", r)
+
+	ctx, cancel := context.WithTimeout(r.Context(), h.queryTimeout)
+	defer cancel()
+
+	if transferObject, err = period(r); err != nil {
+		response.WriteHeaderAndLogAboutBadRequest(w, log, err)
+		return
+	}
+
+	err = transferObject.Validate()
+	if response.WriteHeaderAndLogAboutErr(w, log, err); err != nil {
+		return
+	}
+
+	result, err = h.service.SalesByChannel(injectRequestIDToCtx(ctx, r), transferObject)
+	if response.WriteHeaderAndLogAboutErr(w, log, err); err != nil {
+		return
+	}
+
+	log.Info("requested sales by channel")
+
+	render.JSON(w, r, result)
+}
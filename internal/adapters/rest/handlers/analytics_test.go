@@ -0,0 +1,134 @@
+package handlers
+
+import (
+	"github.com/go-chi/chi"
+	"github.com/golang/mock/gomock"
+	"github.com/lazylex/watch-store-store/internal/domain/value_objects/bucket"
+	"github.com/lazylex/watch-store-store/internal/domain/value_objects/measure"
+	"github.com/lazylex/watch-store-store/internal/dto"
+	mockService "github.com/lazylex/watch-store-store/internal/ports/service/mocks"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+)
+
+func TestHandler_SalesByPeriodDefaultBucket(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	mux := chi.NewRouter()
+	mock := mockService.NewMockInterface(ctrl)
+	mux.HandleFunc("/api/api_v1/analytics/sales/", New(mock, time.Second).SalesByPeriod)
+
+	response := httptest.NewRecorder()
+	request := httptest.NewRequest(http.MethodGet, "/api/api_v1/analytics/sales/", nil)
+	request.Form = url.Values{}
+	request.Form.Set("from", "2024-01-01")
+	request.Form.Set("to", "2024-02-01")
+
+	mock.EXPECT().SalesByPeriod(gomock.Any(), gomock.Any()).Times(1).DoAndReturn(
+		func(_ any, data dto.FromToBucket) ([]dto.PeriodSales, error) {
+			if data.Bucket != bucket.Day {
+				t.Fail()
+			}
+			return []dto.PeriodSales{}, nil
+		})
+
+	mux.ServeHTTP(response, request)
+	if response.Code != http.StatusOK {
+		t.Fail()
+	}
+}
+
+func TestHandler_SalesByPeriodWithoutFrom(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	mux := chi.NewRouter()
+	mock := mockService.NewMockInterface(ctrl)
+	mux.HandleFunc("/api/api_v1/analytics/sales/", New(mock, time.Second).SalesByPeriod)
+
+	response := httptest.NewRecorder()
+	request := httptest.NewRequest(http.MethodGet, "/api/api_v1/analytics/sales/", nil)
+	request.Form = url.Values{}
+	request.Form.Set("bucket", "week")
+
+	mock.EXPECT().SalesByPeriod(gomock.Any(), gomock.Any()).Times(0)
+
+	mux.ServeHTTP(response, request)
+	if response.Code != http.StatusBadRequest {
+		t.Fail()
+	}
+}
+
+func TestHandler_TopArticles(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	mux := chi.NewRouter()
+	mock := mockService.NewMockInterface(ctrl)
+	mux.HandleFunc("/api/api_v1/analytics/top/", New(mock, time.Second).TopArticles)
+
+	response := httptest.NewRecorder()
+	request := httptest.NewRequest(http.MethodGet, "/api/api_v1/analytics/top/", nil)
+	request.Form = url.Values{}
+	request.Form.Set("from", "2024-01-01")
+	request.Form.Set("to", "2024-02-01")
+	request.Form.Set("by", "revenue")
+	request.Form.Set("limit", "3")
+
+	mock.EXPECT().TopArticles(gomock.Any(), gomock.Any()).Times(1).DoAndReturn(
+		func(_ any, data dto.FromToTop) ([]dto.ArticleSales, error) {
+			if data.By != measure.Revenue || data.Limit != 3 {
+				t.Fail()
+			}
+			return []dto.ArticleSales{}, nil
+		})
+
+	mux.ServeHTTP(response, request)
+	if response.Code != http.StatusOK {
+		t.Fail()
+	}
+}
+
+func TestHandler_TopArticlesIncorrectLimit(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	mux := chi.NewRouter()
+	mock := mockService.NewMockInterface(ctrl)
+	mux.HandleFunc("/api/api_v1/analytics/top/", New(mock, time.Second).TopArticles)
+
+	response := httptest.NewRecorder()
+	request := httptest.NewRequest(http.MethodGet, "/api/api_v1/analytics/top/", nil)
+	request.Form = url.Values{}
+	request.Form.Set("from", "2024-01-01")
+	request.Form.Set("limit", "ten")
+
+	mock.EXPECT().TopArticles(gomock.Any(), gomock.Any()).Times(0)
+
+	mux.ServeHTTP(response, request)
+	if response.Code != http.StatusBadRequest {
+		t.Fail()
+	}
+}
+
+func TestHandler_AverageSalePriceForArticle(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	mux := chi.NewRouter()
+	mock := mockService.NewMockInterface(ctrl)
+	mux.HandleFunc("/api/api_v1/analytics/average-price/", New(mock, time.Second).AverageSalePrice)
+
+	response := httptest.NewRecorder()
+	request := httptest.NewRequest(http.MethodGet, "/api/api_v1/analytics/average-price/", nil)
+	request.Form = url.Values{}
+	request.Form.Set("from", "2024-01-01")
+	request.Form.Set("article", "test-1")
+
+	mock.EXPECT().AverageSalePrice(gomock.Any(), gomock.Any()).Times(1).Return(dto.ArticleSales{}, nil)
+	mock.EXPECT().AverageSalePrices(gomock.Any(), gomock.Any()).Times(0)
+
+	mux.ServeHTTP(response, request)
+	if response.Code != http.StatusOK {
+		t.Fail()
+	}
+}
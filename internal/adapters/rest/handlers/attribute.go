@@ -0,0 +1,265 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/go-chi/render"
+	"github.com/lazylex/watch-store-store/internal/adapters/rest/request"
+	"github.com/lazylex/watch-store-store/internal/adapters/rest/response"
+	"github.com/lazylex/watch-store-store/internal/domain/value_objects/article"
+	"github.com/lazylex/watch-store-store/internal/domain/value_objects/attribute"
+	"github.com/lazylex/watch-store-store/internal/dto"
+	"github.com/lazylex/watch-store-store/internal/logger"
+	"log/slog"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// defaultStockListLimit количество товаров, возвращаемых при поиске по атрибутам, если параметр limit не передан.
+const defaultStockListLimit = 100
+
+// SetAttributeDefinition сохраняет определение атрибута товаров. Тип атрибута может принимать значения string,
+// number, boolean и enum. Для перечислимого типа обязателен список допустимых значений. В теле запроса передаются
+// данные в формате JSON. Пример передаваемых данных:
+//
+//	{"code": "case_material", "name": "Материал корпуса", "type": "enum", "values": ["steel", "titanium", "resin"]}
+func (h *Handler) SetAttributeDefinition(w http.ResponseWriter, r *http.Request) {
+	var err error
+	var transferObject dto.AttributeDefinition
+	log := logger.AddPlaceAndRequestId(slog.Default(), "rest.handlers.SetAttributeDefinition", r)
+
+	ctx, cancel := context.WithTimeout(r.Context(), h.queryTimeout)
+	defer cancel()
+
+	if err = json.NewDecoder(r.Body).Decode(&transferObject); err != nil {
+		response.WriteHeaderAndLogAboutBadRequest(w, log, err)
+		return
+	}
+
+	err = transferObject.Validate()
+	if response.WriteHeaderAndLogAboutErr(w, log, err); err != nil {
+		return
+	}
+
+	err = h.service.SetAttributeDefinition(injectRequestIDToCtx(ctx, r), transferObject)
+	if response.WriteHeaderAndLogAboutErr(w, log, err); err != nil {
+		return
+	}
+
+	log.Info(fmt.Sprintf("attribute definition %s saved", transferObject.Code))
+}
+
+// AttributeDefinitions возвращает определения всех атрибутов товаров. Пример возвращаемых данных:
+//
+//	[
+//	 {"code": "case_material", "name": "Материал корпуса", "type": "enum", "values": ["steel", "titanium", "resin"]},
+//	 {"code": "water_resistance", "name": "Водозащита, м", "type": "number"}
+//	]
+func (h *Handler) AttributeDefinitions(w http.ResponseWriter, r *http.Request) {
+	var err error
+	var result []dto.AttributeDefinition
+	log := logger.AddPlaceAndRequestId(slog.Default(), "rest.handlers.AttributeDefinitions", r)
+
+	ctx, cancel := context.WithTimeout(r.Context(), h.queryTimeout)
+	defer cancel()
+
+	result, err = h.service.AttributeDefinitions(injectRequestIDToCtx(ctx, r))
+	if response.WriteHeaderAndLogAboutErr(w, log, err); err != nil {
+		return
+	}
+
+	log.Info(fmt.Sprintf("requested %d attribute definitions", len(result)))
+
+	render.JSON(w, r, result)
+}
+
+// SetArticleAttributes сохраняет значения атрибутов товара. Значения не переданных атрибутов не изменяются. В теле
+// запроса передаются данные в формате JSON. Пример передаваемых данных:
+//
+//	{"article": "CA-F91W", "attributes": {"case_material": "resin", "water_resistance": "30"}}
+func (h *Handler) SetArticleAttributes(w http.ResponseWriter, r *http.Request) {
+	var err error
+	var transferObject dto.ArticleAttributes
+	log := logger.AddPlaceAndRequestId(slog.Default(), "rest.handlers.SetArticleAttributes", r)
+
+	ctx, cancel := context.WithTimeout(r.Context(), h.queryTimeout)
+	defer cancel()
+
+	if err = json.NewDecoder(r.Body).Decode(&transferObject); err != nil {
+		response.WriteHeaderAndLogAboutBadRequest(w, log, err)
+		return
+	}
+
+	err = h.resolveBarcodes(injectRequestIDToCtx(ctx, r), &transferObject.Article)
+	if response.WriteHeaderAndLogAboutErr(w, log, err); err != nil {
+		return
+	}
+
+	err = transferObject.Validate()
+	if response.WriteHeaderAndLogAboutErr(w, log, err); err != nil {
+		return
+	}
+
+	err = h.service.SetArticleAttributes(injectRequestIDToCtx(ctx, r), transferObject)
+	if response.WriteHeaderAndLogAboutErr(w, log, err); err != nil {
+		return
+	}
+
+	log.Info(fmt.Sprintf("attributes of article %s saved", transferObject.Article))
+}
+
+// DeleteArticleAttribute удаляет значение атрибута с переданным параметром запроса (code) кодом у товара с переданным
+// параметром запроса (article) артикулом.
+func (h *Handler) DeleteArticleAttribute(w http.ResponseWriter, r *http.Request) {
+	var err error
+	log := logger.AddPlaceAndRequestId(slog.Default(), "rest.handlers.DeleteArticleAttribute", r)
+
+	ctx, cancel := context.WithTimeout(r.Context(), h.queryTimeout)
+	defer cancel()
+
+	transferObject := dto.ArticleAttributeCode{
+		Article: article.Article(r.FormValue(request.Article)),
+		Code:    attribute.Code(r.FormValue(request.Code)),
+	}
+
+	err = h.resolveBarcodes(injectRequestIDToCtx(ctx, r), &transferObject.Article)
+	if response.WriteHeaderAndLogAboutErr(w, log, err); err != nil {
+		return
+	}
+
+	err = transferObject.Validate()
+	if response.WriteHeaderAndLogAboutErr(w, log, err); err != nil {
+		return
+	}
+
+	err = h.service.DeleteArticleAttribute(injectRequestIDToCtx(ctx, r), transferObject)
+	if response.WriteHeaderAndLogAboutErr(w, log, err); err != nil {
+		return
+	}
+
+	log.Info(fmt.Sprintf("attribute %s of article %s deleted", transferObject.Code, transferObject.Article))
+}
+
+// FindStock возвращает товары в продаже, отобранные по значениям атрибутов. Условия передаются параметрами запроса
+// вида attr.<код>=<значение> для равенства и attr.<код>.min, attr.<код>.max для диапазона числовых значений. Товары
+// упорядочены по артикулу, страница задаётся параметрами limit (по умолчанию 100) и offset. При attributes=true к
+// товарам добавляются значения их атрибутов. Пример запроса и возвращаемых данных:
+//
+//	/api/api_v1/stock/list/?attr.case_material=resin&attr.water_resistance.min=30&attributes=true
+//
+//	[
+//	 {"article": "CA-F91W", "price": 1500, "name": "Casio F-91W", "amount": 12,
+//	  "attributes": {"case_material": "resin", "water_resistance": "30"}}
+//	]
+func (h *Handler) FindStock(w http.ResponseWriter, r *http.Request) {
+	var err error
+	var result []dto.StockAttributes
+	var transferObject dto.StockFilter
+	log := logger.AddPlaceAndRequestId(slog.Default(), "rest.handlers.FindStock", r)
+
+	ctx, cancel := context.WithTimeout(r.Context(), h.queryTimeout)
+	defer cancel()
+
+	transferObject, err = stockFilter(r)
+	if response.WriteHeaderAndLogAboutErr(w, log, err); err != nil {
+		return
+	}
+
+	err = transferObject.Validate()
+	if response.WriteHeaderAndLogAboutErr(w, log, err); err != nil {
+		return
+	}
+
+	result, err = h.service.FindStock(injectRequestIDToCtx(ctx, r), transferObject)
+	if response.WriteHeaderAndLogAboutErr(w, log, err); err != nil {
+		return
+	}
+
+	log.Info(fmt.Sprintf("found %d articles by %d attribute filters", len(result), len(transferObject.Filters)))
+
+	render.JSON(w, r, result)
+}
+
+// stockFilter формирует условия поиска товаров по атрибутам из параметров запроса.
+func stockFilter(r *http.Request) (dto.StockFilter, error) {
+	var err error
+	result := dto.StockFilter{Limit: defaultStockListLimit}
+
+	if err = r.ParseForm(); err != nil {
+		return result, err
+	}
+
+	if value := r.Form.Get(request.Limit); value != "" {
+		if result.Limit, err = strconv.Atoi(value); err != nil {
+			return result, request.ErrIncorrectLimit
+		}
+	}
+	if value := r.Form.Get(request.Offset); value != "" {
+		if result.Offset, err = strconv.Atoi(value); err != nil {
+			return result, request.ErrIncorrectOffset
+		}
+	}
+	if result.WithAttributes, err = withAttributes(r); err != nil {
+		return result, err
+	}
+
+	filters := make(map[attribute.Code]*dto.AttributeFilter)
+	filter := func(code string) *dto.AttributeFilter {
+		f, ok := filters[attribute.Code(code)]
+		if !ok {
+			f = &dto.AttributeFilter{Code: attribute.Code(code)}
+			filters[f.Code] = f
+		}
+		return f
+	}
+
+	for key, values := range r.Form {
+		if !strings.HasPrefix(key, request.AttributePrefix) || len(values) == 0 {
+			continue
+		}
+		name := strings.TrimPrefix(key, request.AttributePrefix)
+		switch {
+		case strings.HasSuffix(name, request.AttributeMin):
+			var bound float64
+			if bound, err = strconv.ParseFloat(values[0], 64); err != nil {
+				return result, request.ErrIncorrectAttributeFilter
+			}
+			filter(strings.TrimSuffix(name, request.AttributeMin)).Min = &bound
+		case strings.HasSuffix(name, request.AttributeMax):
+			var bound float64
+			if bound, err = strconv.ParseFloat(values[0], 64); err != nil {
+				return result, request.ErrIncorrectAttributeFilter
+			}
+			filter(strings.TrimSuffix(name, request.AttributeMax)).Max = &bound
+		default:
+			value := values[0]
+			filter(name).Value = &value
+		}
+	}
+
+	for _, f := range filters {
+		result.Filters = append(result.Filters, *f)
+	}
+	sort.Slice(result.Filters, func(i, j int) bool { return result.Filters[i].Code < result.Filters[j].Code })
+
+	return result, nil
+}
+
+// withAttributes возвращает значение параметра запроса attributes, указывающего на необходимость добавить к данным о
+// товаре значения его атрибутов.
+func withAttributes(r *http.Request) (bool, error) {
+	value := r.FormValue(request.Attributes)
+	if value == "" {
+		return false, nil
+	}
+
+	result, err := strconv.ParseBool(value)
+	if err != nil {
+		return false, request.ErrIncorrectAttributesFlag
+	}
+
+	return result, nil
+}
@@ -0,0 +1,171 @@
+package handlers
+
+import (
+	"github.com/go-chi/chi"
+	"github.com/golang/mock/gomock"
+	"github.com/lazylex/watch-store-store/internal/domain/value_objects/attribute"
+	"github.com/lazylex/watch-store-store/internal/dto"
+	"github.com/lazylex/watch-store-store/internal/ports/repository"
+	"github.com/lazylex/watch-store-store/internal/ports/service"
+	mockService "github.com/lazylex/watch-store-store/internal/ports/service/mocks"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestHandler_SetAttributeDefinition(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	mux := chi.NewRouter()
+	mock := mockService.NewMockInterface(ctrl)
+	mux.HandleFunc("/api/api_v1/attributes/definition", New(mock, time.Second).SetAttributeDefinition)
+
+	response := httptest.NewRecorder()
+	request := httptest.NewRequest(http.MethodPut, "/api/api_v1/attributes/definition",
+		strings.NewReader("{\"code\":\"case_material\",\"name\":\"Материал\",\"type\":\"enum\",\"values\":[\"resin\"]}"))
+
+	mock.EXPECT().SetAttributeDefinition(gomock.Any(), dto.AttributeDefinition{Code: "case_material",
+		Name: "Материал", Type: attribute.Enum, Values: []string{"resin"}}).Times(1).Return(nil)
+
+	mux.ServeHTTP(response, request)
+	if response.Code != http.StatusOK {
+		t.Fail()
+	}
+}
+
+func TestHandler_SetAttributeDefinitionTypeChange(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	mux := chi.NewRouter()
+	mock := mockService.NewMockInterface(ctrl)
+	mux.HandleFunc("/api/api_v1/attributes/definition", New(mock, time.Second).SetAttributeDefinition)
+
+	response := httptest.NewRecorder()
+	request := httptest.NewRequest(http.MethodPut, "/api/api_v1/attributes/definition",
+		strings.NewReader("{\"code\":\"water_resistance\",\"name\":\"Водозащита\",\"type\":\"string\"}"))
+
+	mock.EXPECT().SetAttributeDefinition(gomock.Any(), gomock.Any()).Times(1).Return(
+		service.ErrAttributeTypeChange)
+
+	mux.ServeHTTP(response, request)
+	if response.Code != http.StatusConflict {
+		t.Fail()
+	}
+}
+
+func TestHandler_SetArticleAttributesWithoutAttributes(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	mux := chi.NewRouter()
+	mock := mockService.NewMockInterface(ctrl)
+	mux.HandleFunc("/api/api_v1/stock/attributes", New(mock, time.Second).SetArticleAttributes)
+
+	response := httptest.NewRecorder()
+	request := httptest.NewRequest(http.MethodPut, "/api/api_v1/stock/attributes",
+		strings.NewReader("{\"article\":\"CA-F91W\",\"attributes\":{}}"))
+
+	mock.EXPECT().SetArticleAttributes(gomock.Any(), gomock.Any()).Times(0)
+
+	mux.ServeHTTP(response, request)
+	if response.Code != http.StatusBadRequest {
+		t.Fail()
+	}
+}
+
+func TestHandler_DeleteArticleAttributeNotFound(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	mux := chi.NewRouter()
+	mock := mockService.NewMockInterface(ctrl)
+	mux.HandleFunc("/api/api_v1/stock/attribute/", New(mock, time.Second).DeleteArticleAttribute)
+
+	response := httptest.NewRecorder()
+	request := httptest.NewRequest(http.MethodDelete, "/api/api_v1/stock/attribute/", nil)
+	request.Form = url.Values{}
+	request.Form.Set("article", "CA-F91W")
+	request.Form.Set("code", "case_material")
+
+	mock.EXPECT().DeleteArticleAttribute(gomock.Any(),
+		dto.ArticleAttributeCode{Article: "CA-F91W", Code: "case_material"}).Times(1).Return(repository.ErrNoRecord)
+
+	mux.ServeHTTP(response, request)
+	if response.Code != http.StatusNotFound {
+		t.Fail()
+	}
+}
+
+func TestHandler_FindStock(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	mux := chi.NewRouter()
+	mock := mockService.NewMockInterface(ctrl)
+	mux.HandleFunc("/api/api_v1/stock/list/", New(mock, time.Second).FindStock)
+
+	response := httptest.NewRecorder()
+	request := httptest.NewRequest(http.MethodGet, "/api/api_v1/stock/list/", nil)
+	request.Form = url.Values{}
+	request.Form.Set("attr.case_material", "resin")
+	request.Form.Set("attr.water_resistance.min", "30")
+	request.Form.Set("attr.water_resistance.max", "100")
+	request.Form.Set("attributes", "true")
+	request.Form.Set("offset", "20")
+
+	value, low, high := "resin", 30.0, 100.0
+	mock.EXPECT().FindStock(gomock.Any(), dto.StockFilter{Limit: defaultStockListLimit, Offset: 20,
+		WithAttributes: true, Filters: []dto.AttributeFilter{{Code: "case_material", Value: &value},
+			{Code: "water_resistance", Min: &low, Max: &high}}}).Times(1).Return(
+		[]dto.StockAttributes{{ArticlePriceNameAmount: dto.ArticlePriceNameAmount{Article: "CA-F91W"},
+			Attributes: map[attribute.Code]string{"case_material": "resin"}}}, nil)
+
+	mux.ServeHTTP(response, request)
+	if response.Code != http.StatusOK || !strings.Contains(response.Body.String(), "\"case_material\":\"resin\"") {
+		t.Fail()
+	}
+}
+
+func TestHandler_FindStockIncorrectRange(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	mux := chi.NewRouter()
+	mock := mockService.NewMockInterface(ctrl)
+	mux.HandleFunc("/api/api_v1/stock/list/", New(mock, time.Second).FindStock)
+
+	response := httptest.NewRecorder()
+	request := httptest.NewRequest(http.MethodGet, "/api/api_v1/stock/list/", nil)
+	request.Form = url.Values{}
+	request.Form.Set("attr.water_resistance.min", "deep")
+
+	mock.EXPECT().FindStock(gomock.Any(), gomock.Any()).Times(0)
+
+	mux.ServeHTTP(response, request)
+	if response.Code != http.StatusBadRequest {
+		t.Fail()
+	}
+}
+
+func TestHandler_StockRecordWithAttributes(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	mux := chi.NewRouter()
+	mock := mockService.NewMockInterface(ctrl)
+	mux.HandleFunc("/api/api_v1/stock/", New(mock, time.Second).StockRecord)
+
+	response := httptest.NewRecorder()
+	request := httptest.NewRequest(http.MethodGet, "/api/api_v1/stock/", nil)
+	request.Form = url.Values{}
+	request.Form.Set("article", "CA-F91W")
+	request.Form.Set("attributes", "true")
+
+	mock.EXPECT().Stock(gomock.Any(), gomock.Any()).Times(0)
+	mock.EXPECT().StockWithAttributes(gomock.Any(), dto.Article{Article: "CA-F91W"}).Times(1).Return(
+		dto.StockAttributes{ArticlePriceNameAmount: dto.ArticlePriceNameAmount{Article: "CA-F91W"},
+			Attributes: map[attribute.Code]string{"water_resistance": "30"}}, nil)
+
+	mux.ServeHTTP(response, request)
+	if response.Code != http.StatusOK || !strings.Contains(response.Body.String(), "\"water_resistance\":\"30\"") {
+		t.Fail()
+	}
+}
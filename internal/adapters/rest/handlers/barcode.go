@@ -0,0 +1,181 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/go-chi/render"
+	"github.com/lazylex/watch-store-store/internal/adapters/rest/request"
+	"github.com/lazylex/watch-store-store/internal/adapters/rest/response"
+	"github.com/lazylex/watch-store-store/internal/domain/value_objects/article"
+	"github.com/lazylex/watch-store-store/internal/domain/value_objects/barcode"
+	"github.com/lazylex/watch-store-store/internal/dto"
+	"github.com/lazylex/watch-store-store/internal/logger"
+	"log/slog"
+	"net/http"
+)
+
+// ImportBarcodes назначает товарам штрихкоды EAN-13 или UPC-A. Загрузка выполняется целиком: если хотя бы один штрихкод
+// назначен другому товару, возвращается код 409 и ни один штрихкод не сохраняется. В теле запроса передаются данные в
+// формате JSON. Пример передаваемых данных:
+//
+//	{"barcodes": [
+//	  {"article": "CA-F91W", "barcode": "4971850436713"},
+//	  {"article": "CA-F91W", "barcode": "079767436713"}
+//	]}
+func (h *Handler) ImportBarcodes(w http.ResponseWriter, r *http.Request) {
+	var err error
+	var transferObject dto.BarcodeImport
+	log := logger.AddPlaceAndRequestId(slog.Default(), "rest.handlers.ImportBarcodes", r)
+
+	ctx, cancel := context.WithTimeout(r.Context(), h.queryTimeout)
+	defer cancel()
+
+	if err = json.NewDecoder(r.Body).Decode(&transferObject); err != nil {
+		response.WriteHeaderAndLogAboutBadRequest(w, log, err)
+		return
+	}
+
+	err = transferObject.Validate()
+	if response.WriteHeaderAndLogAboutErr(w, log, err); err != nil {
+		return
+	}
+
+	err = h.service.ImportBarcodes(injectRequestIDToCtx(ctx, r), transferObject)
+	if response.WriteHeaderAndLogAboutErr(w, log, err); err != nil {
+		return
+	}
+
+	log.Info(fmt.Sprintf("%d barcodes imported", len(transferObject.Barcodes)))
+}
+
+// StockByBarcode возвращает информацию о товаре, которому назначен переданный параметром запроса (barcode) штрихкод.
+// Пример возвращаемых данных:
+//
+//	{"article": "CA-F91W", "name": "CASIO F-91W-1YEG", "price": 3490, "amount": 60}
+func (h *Handler) StockByBarcode(w http.ResponseWriter, r *http.Request) {
+	var err error
+	var stock dto.ArticlePriceNameAmount
+	log := logger.AddPlaceAndRequestId(slog.Default(), "rest.handlers.StockByBarcode", r)
+
+	ctx, cancel := context.WithTimeout(r.Context(), h.queryTimeout)
+	defer cancel()
+
+	transferObject := dto.Barcode{Barcode: barcode.Barcode(r.FormValue(request.Barcode))}
+	err = transferObject.Validate()
+	if response.WriteHeaderAndLogAboutErr(w, log, err); err != nil {
+		return
+	}
+
+	stock, err = h.service.StockByBarcode(injectRequestIDToCtx(ctx, r), transferObject)
+	if response.WriteHeaderAndLogAboutErr(w, log, err); err != nil {
+		return
+	}
+
+	log.Info(fmt.Sprintf("requested stock record with barcode %s", transferObject.Barcode))
+
+	render.JSON(w, r, stock)
+}
+
+// ArticleBarcodes возвращает штрихкоды товара с переданным параметром запроса (article) артикулом. Коды UPC-A
+// возвращаются в формате EAN-13. Пример возвращаемых данных:
+//
+//	["0079767436713", "4971850436713"]
+func (h *Handler) ArticleBarcodes(w http.ResponseWriter, r *http.Request) {
+	var err error
+	var result []barcode.Barcode
+	log := logger.AddPlaceAndRequestId(slog.Default(), "rest.handlers.ArticleBarcodes", r)
+
+	ctx, cancel := context.WithTimeout(r.Context(), h.queryTimeout)
+	defer cancel()
+
+	transferObject := dto.Article{Article: article.Article(r.FormValue(request.Article))}
+	err = transferObject.Validate()
+	if response.WriteHeaderAndLogAboutErr(w, log, err); err != nil {
+		return
+	}
+
+	result, err = h.service.ArticleBarcodes(injectRequestIDToCtx(ctx, r), transferObject)
+	if response.WriteHeaderAndLogAboutErr(w, log, err); err != nil {
+		return
+	}
+
+	log.Info(fmt.Sprintf("requested barcodes of article %s", transferObject.Article))
+
+	render.JSON(w, r, result)
+}
+
+// DeleteBarcode удаляет переданный параметром запроса (barcode) штрихкод.
+func (h *Handler) DeleteBarcode(w http.ResponseWriter, r *http.Request) {
+	var err error
+	log := logger.AddPlaceAndRequestId(slog.Default(), "rest.handlers.DeleteBarcode", r)
+
+	ctx, cancel := context.WithTimeout(r.Context(), h.queryTimeout)
+	defer cancel()
+
+	transferObject := dto.Barcode{Barcode: barcode.Barcode(r.FormValue(request.Barcode))}
+	err = transferObject.Validate()
+	if response.WriteHeaderAndLogAboutErr(w, log, err); err != nil {
+		return
+	}
+
+	err = h.service.DeleteBarcode(injectRequestIDToCtx(ctx, r), transferObject)
+	if response.WriteHeaderAndLogAboutErr(w, log, err); err != nil {
+		return
+	}
+
+	log.Info(fmt.Sprintf("barcode %s deleted", transferObject.Barcode))
+}
+
+// resolveBarcodes заменяет переданные вместо артикулов штрихкоды артикулами товаров, которым они назначены. Сервис
+// вызывается только для значений, являющихся корректными штрихкодами EAN-13 или UPC-A.
+func (h *Handler) resolveBarcodes(ctx context.Context, articles ...*article.Article) error {
+	for _, art := range articles {
+		if !barcode.Barcode(*art).IsValid() {
+			continue
+		}
+		resolved, err := h.service.ResolveArticle(ctx, *art)
+		if err != nil {
+			return err
+		}
+		*art = resolved
+	}
+
+	return nil
+}
+
+// articlesOfProducts возвращает указатели на артикулы товаров для замены штрихкодов.
+func articlesOfProducts(products []dto.ArticlePriceAmount) []*article.Article {
+	result := make([]*article.Article, len(products))
+	for i := range products {
+		result[i] = &products[i].Article
+	}
+	return result
+}
+
+// articlesOfAmounts возвращает указатели на артикулы товаров для замены штрихкодов.
+func articlesOfAmounts(products []dto.ArticleAmount) []*article.Article {
+	result := make([]*article.Article, len(products))
+	for i := range products {
+		result[i] = &products[i].Article
+	}
+	return result
+}
+
+// articlesOfGoodsReceipt возвращает указатели на артикулы строк поставки для замены штрихкодов.
+func articlesOfGoodsReceipt(lines []dto.GoodsReceiptLine) []*article.Article {
+	result := make([]*article.Article, len(lines))
+	for i := range lines {
+		result[i] = &lines[i].Article
+	}
+	return result
+}
+
+// articlesOf возвращает указатели на элементы списка артикулов для замены штрихкодов.
+func articlesOf(articles []article.Article) []*article.Article {
+	result := make([]*article.Article, len(articles))
+	for i := range articles {
+		result[i] = &articles[i]
+	}
+	return result
+}
@@ -0,0 +1,148 @@
+package handlers
+
+import (
+	"github.com/go-chi/chi"
+	"github.com/golang/mock/gomock"
+	"github.com/lazylex/watch-store-store/internal/domain/aggregates/receipt"
+	"github.com/lazylex/watch-store-store/internal/domain/value_objects/article"
+	"github.com/lazylex/watch-store-store/internal/dto"
+	"github.com/lazylex/watch-store-store/internal/ports/repository"
+	"github.com/lazylex/watch-store-store/internal/ports/service"
+	mockService "github.com/lazylex/watch-store-store/internal/ports/service/mocks"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestHandler_ImportBarcodes(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	mux := chi.NewRouter()
+	mock := mockService.NewMockInterface(ctrl)
+	mux.HandleFunc("/api/api_v1/barcode/import", New(mock, time.Second).ImportBarcodes)
+
+	response := httptest.NewRecorder()
+	request := httptest.NewRequest(http.MethodPost, "/api/api_v1/barcode/import",
+		strings.NewReader("{\"barcodes\":[{\"article\":\"CA-F91W\",\"barcode\":\"036000291452\"}]}"))
+
+	mock.EXPECT().ImportBarcodes(gomock.Any(), dto.BarcodeImport{Barcodes: []dto.ArticleBarcode{
+		{Article: "CA-F91W", Barcode: "036000291452"}}}).Times(1).Return(nil)
+
+	mux.ServeHTTP(response, request)
+	if response.Code != http.StatusOK {
+		t.Fail()
+	}
+}
+
+func TestHandler_ImportBarcodesTaken(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	mux := chi.NewRouter()
+	mock := mockService.NewMockInterface(ctrl)
+	mux.HandleFunc("/api/api_v1/barcode/import", New(mock, time.Second).ImportBarcodes)
+
+	response := httptest.NewRecorder()
+	request := httptest.NewRequest(http.MethodPost, "/api/api_v1/barcode/import",
+		strings.NewReader("{\"barcodes\":[{\"article\":\"CA-F91W\",\"barcode\":\"036000291452\"}]}"))
+
+	mock.EXPECT().ImportBarcodes(gomock.Any(), gomock.Any()).Times(1).Return(service.ErrBarcodeTaken)
+
+	mux.ServeHTTP(response, request)
+	if response.Code != http.StatusConflict {
+		t.Fail()
+	}
+}
+
+func TestHandler_ImportBarcodesIncorrectChecksum(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	mux := chi.NewRouter()
+	mock := mockService.NewMockInterface(ctrl)
+	mux.HandleFunc("/api/api_v1/barcode/import", New(mock, time.Second).ImportBarcodes)
+
+	response := httptest.NewRecorder()
+	request := httptest.NewRequest(http.MethodPost, "/api/api_v1/barcode/import",
+		strings.NewReader("{\"barcodes\":[{\"article\":\"CA-F91W\",\"barcode\":\"036000291453\"}]}"))
+
+	mock.EXPECT().ImportBarcodes(gomock.Any(), gomock.Any()).Times(0)
+
+	mux.ServeHTTP(response, request)
+	if response.Code != http.StatusBadRequest {
+		t.Fail()
+	}
+}
+
+func TestHandler_StockByBarcodeNotFound(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	mux := chi.NewRouter()
+	mock := mockService.NewMockInterface(ctrl)
+	mux.HandleFunc("/api/api_v1/barcode/", New(mock, time.Second).StockByBarcode)
+
+	response := httptest.NewRecorder()
+	request := httptest.NewRequest(http.MethodGet, "/api/api_v1/barcode/", nil)
+	request.Form = url.Values{}
+	request.Form.Set("barcode", "4006381333931")
+
+	mock.EXPECT().StockByBarcode(gomock.Any(), dto.Barcode{Barcode: "4006381333931"}).Times(1).Return(
+		dto.ArticlePriceNameAmount{}, repository.ErrNoRecord)
+
+	mux.ServeHTTP(response, request)
+	if response.Code != http.StatusNotFound {
+		t.Fail()
+	}
+}
+
+func TestHandler_StockRecordByBarcode(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	mux := chi.NewRouter()
+	mock := mockService.NewMockInterface(ctrl)
+	mux.HandleFunc("/api/api_v1/stock/", New(mock, time.Second).StockRecord)
+
+	response := httptest.NewRecorder()
+	request := httptest.NewRequest(http.MethodGet, "/api/api_v1/stock/", nil)
+	request.Form = url.Values{}
+	request.Form.Set("article", "4006381333931")
+
+	mock.EXPECT().ResolveArticle(gomock.Any(), article.Article("4006381333931")).Times(1).Return(
+		article.Article("CA-F91W"), nil)
+	mock.EXPECT().Stock(gomock.Any(), dto.Article{Article: "CA-F91W"}).Times(1).Return(
+		dto.ArticlePriceNameAmount{Article: "CA-F91W"}, nil)
+
+	mux.ServeHTTP(response, request)
+	if response.Code != http.StatusOK {
+		t.Fail()
+	}
+}
+
+func TestHandler_MakeLocalSaleByBarcode(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	mux := chi.NewRouter()
+	mock := mockService.NewMockInterface(ctrl)
+	mux.HandleFunc("/api/api_v1/sale/make", New(mock, time.Second).MakeLocalSale)
+
+	response := httptest.NewRecorder()
+	request := httptest.NewRequest(http.MethodPost, "/api/api_v1/sale/make",
+		strings.NewReader("{\"cash_register\":1,\"payment_method\":\"cash\",\"products\":["+
+			"{\"article\":\"036000291452\",\"price\":100,\"amount\":1},{\"article\":\"CA-W800H\",\"price\":50,\"amount\":2}]}"))
+
+	mock.EXPECT().ResolveArticle(gomock.Any(), article.Article("036000291452")).Times(1).Return(
+		article.Article("CA-F91W"), nil)
+	mock.EXPECT().MakeSale(gomock.Any(), gomock.Any()).Times(1).DoAndReturn(
+		func(_ any, data dto.CashRegisterProducts) (receipt.ID, error) {
+			if data.Products[0].Article != "CA-F91W" || data.Products[1].Article != "CA-W800H" {
+				t.Error(data.Products)
+			}
+			return 1, nil
+		})
+
+	mux.ServeHTTP(response, request)
+	if response.Code != http.StatusCreated || !strings.Contains(response.Body.String(), "\"receipt_id\":1") {
+		t.Fail()
+	}
+}
@@ -0,0 +1,83 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/go-chi/render"
+	"github.com/lazylex/watch-store-store/internal/adapters/rest/response"
+	"github.com/lazylex/watch-store-store/internal/domain/value_objects/article"
+	"github.com/lazylex/watch-store-store/internal/dto"
+	"github.com/lazylex/watch-store-store/internal/logger"
+	"log/slog"
+	"net/http"
+)
+
+// UpdatePrices изменяет цены группы товаров в одной транзакции. В теле запроса в формате JSON передаётся список новых
+// цен или артикул товара без дефектов и процент изменения цены, применяемый к нему и ко всем его вариантам с
+// дефектами. Примеры передаваемых данных:
+//
+//	{"prices": [{"article": "CA-F91W", "price": 3590}, {"article": "CA-F91W.1000", "price": 2990}]}
+//
+//	{"article": "CA-F91W", "percent": -10}
+//
+// Возвращается отчёт с результатом для каждого товара. Если цену хотя бы одного товара изменить невозможно, ни одна
+// цена не изменяется и отчёт возвращается с кодом 422, а товары, цены которых можно было изменить, отмечаются в нём как
+// not_applied. Пример возвращаемых данных:
+//
+//	{
+//		"applied": true, "updated": 2, "not_applied": 0, "failed": 0,
+//		"items": [
+//			{"article": "CA-F91W", "old_price": 3490, "new_price": 3141, "status": "updated"},
+//			{"article": "CA-F91W.1000", "old_price": 2990, "new_price": 2691, "status": "updated"}
+//		]
+//	}
+func (h *Handler) UpdatePrices(w http.ResponseWriter, r *http.Request) {
+	var err error
+	var report dto.BulkPriceReport
+	var transferObject dto.BulkPriceUpdate
+	log := logger.AddPlaceAndRequestId(slog.Default(), "rest.handlers.UpdatePrices", r)
+
+	ctx, cancel := context.WithTimeout(r.Context(), h.queryTimeout)
+	defer cancel()
+
+	if err = json.NewDecoder(r.Body).Decode(&transferObject); err != nil {
+		response.WriteHeaderAndLogAboutBadRequest(w, log, err)
+		return
+	}
+
+	articles := articlesOfPrices(transferObject.Prices)
+	if transferObject.Article != "" {
+		articles = append(articles, &transferObject.Article)
+	}
+	err = h.resolveBarcodes(injectRequestIDToCtx(ctx, r), articles...)
+	if response.WriteHeaderAndLogAboutErr(w, log, err); err != nil {
+		return
+	}
+
+	err = transferObject.Validate()
+	if response.WriteHeaderAndLogAboutErr(w, log, err); err != nil {
+		return
+	}
+
+	report, err = h.service.UpdatePrices(injectRequestIDToCtx(ctx, r), transferObject)
+	if response.WriteHeaderAndLogAboutErr(w, log, err); err != nil {
+		return
+	}
+
+	if !report.Applied {
+		render.Status(r, http.StatusUnprocessableEntity)
+	}
+	render.JSON(w, r, report)
+	log.Info(fmt.Sprintf("bulk price update of %d articles processed (applied: %t)", len(report.Items),
+		report.Applied))
+}
+
+// articlesOfPrices возвращает указатели на артикулы товаров для замены штрихкодов.
+func articlesOfPrices(prices []dto.ArticlePrice) []*article.Article {
+	result := make([]*article.Article, len(prices))
+	for i := range prices {
+		result[i] = &prices[i].Article
+	}
+	return result
+}
@@ -0,0 +1,92 @@
+package handlers
+
+import (
+	"github.com/go-chi/chi"
+	"github.com/golang/mock/gomock"
+	"github.com/lazylex/watch-store-store/internal/dto"
+	"github.com/lazylex/watch-store-store/internal/ports/repository"
+	mockService "github.com/lazylex/watch-store-store/internal/ports/service/mocks"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestHandler_UpdatePrices(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	mux := chi.NewRouter()
+	mock := mockService.NewMockInterface(ctrl)
+	mux.HandleFunc("/api/api_v1/stock/prices", New(mock, time.Second).UpdatePrices)
+
+	response := httptest.NewRecorder()
+	request := httptest.NewRequest(http.MethodPut, "/api/api_v1/stock/prices",
+		strings.NewReader("{\"prices\":[{\"article\":\"CA-F91W\",\"price\":3590}]}"))
+
+	mock.EXPECT().UpdatePrices(gomock.Any(), dto.BulkPriceUpdate{Prices: []dto.ArticlePrice{
+		{Article: "CA-F91W", Price: 3590}}}).Times(1).Return(dto.BulkPriceReport{Applied: true, Updated: 1}, nil)
+
+	mux.ServeHTTP(response, request)
+	if response.Code != http.StatusOK {
+		t.Fail()
+	}
+}
+
+func TestHandler_UpdatePricesNotApplied(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	mux := chi.NewRouter()
+	mock := mockService.NewMockInterface(ctrl)
+	mux.HandleFunc("/api/api_v1/stock/prices", New(mock, time.Second).UpdatePrices)
+
+	response := httptest.NewRecorder()
+	request := httptest.NewRequest(http.MethodPut, "/api/api_v1/stock/prices",
+		strings.NewReader("{\"article\":\"CA-F91W\",\"percent\":-10}"))
+
+	mock.EXPECT().UpdatePrices(gomock.Any(), dto.BulkPriceUpdate{Article: "CA-F91W", Percent: -10}).Times(1).
+		Return(dto.BulkPriceReport{Failed: 1}, nil)
+
+	mux.ServeHTTP(response, request)
+	if response.Code != http.StatusUnprocessableEntity {
+		t.Fail()
+	}
+}
+
+func TestHandler_UpdatePricesNoArticle(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	mux := chi.NewRouter()
+	mock := mockService.NewMockInterface(ctrl)
+	mux.HandleFunc("/api/api_v1/stock/prices", New(mock, time.Second).UpdatePrices)
+
+	response := httptest.NewRecorder()
+	request := httptest.NewRequest(http.MethodPut, "/api/api_v1/stock/prices",
+		strings.NewReader("{\"article\":\"CA-F91W\",\"percent\":5}"))
+
+	mock.EXPECT().UpdatePrices(gomock.Any(), gomock.Any()).Times(1).Return(dto.BulkPriceReport{}, repository.ErrNoRecord)
+
+	mux.ServeHTTP(response, request)
+	if response.Code != http.StatusNotFound {
+		t.Fail()
+	}
+}
+
+func TestHandler_UpdatePricesDefectArticleAsBase(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	mux := chi.NewRouter()
+	mock := mockService.NewMockInterface(ctrl)
+	mux.HandleFunc("/api/api_v1/stock/prices", New(mock, time.Second).UpdatePrices)
+
+	response := httptest.NewRecorder()
+	request := httptest.NewRequest(http.MethodPut, "/api/api_v1/stock/prices",
+		strings.NewReader("{\"article\":\"CA-F91W.1000\",\"percent\":5}"))
+
+	mock.EXPECT().UpdatePrices(gomock.Any(), gomock.Any()).Times(0)
+
+	mux.ServeHTTP(response, request)
+	if response.Code != http.StatusBadRequest {
+		t.Fail()
+	}
+}
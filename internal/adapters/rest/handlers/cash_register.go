@@ -0,0 +1,135 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/go-chi/render"
+	"github.com/lazylex/watch-store-store/internal/adapters/rest/request"
+	"github.com/lazylex/watch-store-store/internal/adapters/rest/response"
+	"github.com/lazylex/watch-store-store/internal/domain/aggregates/reservation"
+	"github.com/lazylex/watch-store-store/internal/dto"
+	"github.com/lazylex/watch-store-store/internal/logger"
+	"log/slog"
+	"net/http"
+	"strconv"
+)
+
+// CreateCashRegister регистрирует кассу в реестре касс. В теле запроса передаются данные в формате JSON. Номер кассы не
+// должен совпадать с номером существующего заказа. В случае успеха возвращается http.StatusCreated. Пример передаваемых
+// данных:
+//
+//	{"cash_register": 11, "name": "Касса у входа", "location": "back_room", "enabled": true}
+func (h *Handler) CreateCashRegister(w http.ResponseWriter, r *http.Request) {
+	var err error
+	var transferObject dto.CashRegisterRecord
+	log := logger.AddPlaceAndRequestId(slog.Default(), "rest.handlers.CreateCashRegister", r)
+
+	ctx, cancel := context.WithTimeout(r.Context(), h.queryTimeout)
+	defer cancel()
+
+	if err = json.NewDecoder(r.Body).Decode(&transferObject); err != nil {
+		response.WriteHeaderAndLogAboutBadRequest(w, log, err)
+		return
+	}
+
+	err = transferObject.Validate()
+	if response.WriteHeaderAndLogAboutErr(w, log, err); err != nil {
+		return
+	}
+
+	err = h.service.CreateCashRegister(injectRequestIDToCtx(ctx, r), transferObject)
+	if response.WriteHeaderAndLogAboutErr(w, log, err); err != nil {
+		return
+	}
+
+	w.WriteHeader(http.StatusCreated)
+	log.Info(fmt.Sprintf("cash register %d registered", transferObject.CashRegister))
+}
+
+// UpdateCashRegister изменяет название, место хранения и признак включения зарегистрированной кассы. Формат
+// передаваемых данных совпадает с CreateCashRegister. Если касса не зарегистрирована, возвращается код 404.
+func (h *Handler) UpdateCashRegister(w http.ResponseWriter, r *http.Request) {
+	var err error
+	var transferObject dto.CashRegisterRecord
+	log := logger.AddPlaceAndRequestId(slog.Default(), "rest.handlers.UpdateCashRegister", r)
+
+	ctx, cancel := context.WithTimeout(r.Context(), h.queryTimeout)
+	defer cancel()
+
+	if err = json.NewDecoder(r.Body).Decode(&transferObject); err != nil {
+		response.WriteHeaderAndLogAboutBadRequest(w, log, err)
+		return
+	}
+
+	err = transferObject.Validate()
+	if response.WriteHeaderAndLogAboutErr(w, log, err); err != nil {
+		return
+	}
+
+	err = h.service.UpdateCashRegister(injectRequestIDToCtx(ctx, r), transferObject)
+	if response.WriteHeaderAndLogAboutErr(w, log, err); err != nil {
+		return
+	}
+
+	log.Info(fmt.Sprintf("cash register %d updated", transferObject.CashRegister))
+}
+
+// CashRegister возвращает в формате JSON кассу с переданным в параметре запроса (cash_register) номером. Если касса не
+// зарегистрирована, возвращается код 404. Пример возвращаемых данных:
+//
+//	{"cash_register": 1, "name": "Касса 1", "enabled": true, "last_seen_at": "2024-06-14T09:00:00Z"}
+func (h *Handler) CashRegister(w http.ResponseWriter, r *http.Request) {
+	var err error
+	var number int64
+	var result dto.CashRegisterRecord
+	log := logger.AddPlaceAndRequestId(slog.Default(), "rest.handlers.CashRegister", r)
+
+	ctx, cancel := context.WithTimeout(r.Context(), h.queryTimeout)
+	defer cancel()
+
+	if number, err = strconv.ParseInt(r.FormValue(request.Register), 10, 64); err != nil {
+		response.WriteHeaderAndLogAboutBadRequest(w, log, request.ErrIncorrectCashRegister)
+		return
+	}
+
+	transferObject := dto.CashRegister{CashRegister: reservation.OrderNumber(number)}
+	err = transferObject.Validate()
+	if response.WriteHeaderAndLogAboutErr(w, log, err); err != nil {
+		return
+	}
+
+	result, err = h.service.CashRegister(injectRequestIDToCtx(ctx, r), transferObject)
+	if response.WriteHeaderAndLogAboutErr(w, log, err); err != nil {
+		return
+	}
+
+	log.Info(fmt.Sprintf("requested cash register %d", number))
+
+	render.JSON(w, r, result)
+}
+
+// CashRegisters возвращает в формате JSON все зарегистрированные кассы, упорядоченные по номеру. Пример возвращаемых
+// данных:
+//
+//	[
+//		{"cash_register": 1, "name": "Касса 1", "enabled": true, "last_seen_at": "2024-06-14T09:00:00Z"},
+//		{"cash_register": 11, "name": "Касса у входа", "location": "back_room", "enabled": false}
+//	]
+func (h *Handler) CashRegisters(w http.ResponseWriter, r *http.Request) {
+	var err error
+	var result []dto.CashRegisterRecord
+	log := logger.AddPlaceAndRequestId(slog.Default(), "rest.handlers.CashRegisters", r)
+
+	ctx, cancel := context.WithTimeout(r.Context(), h.queryTimeout)
+	defer cancel()
+
+	result, err = h.service.CashRegisters(injectRequestIDToCtx(ctx, r))
+	if response.WriteHeaderAndLogAboutErr(w, log, err); err != nil {
+		return
+	}
+
+	log.Info(fmt.Sprintf("requested cash registers, %d found", len(result)))
+
+	render.JSON(w, r, result)
+}
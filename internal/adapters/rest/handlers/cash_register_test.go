@@ -0,0 +1,131 @@
+package handlers
+
+import (
+	"github.com/go-chi/chi"
+	"github.com/golang/mock/gomock"
+	"github.com/lazylex/watch-store-store/internal/dto"
+	"github.com/lazylex/watch-store-store/internal/ports/repository"
+	"github.com/lazylex/watch-store-store/internal/ports/service"
+	mockService "github.com/lazylex/watch-store-store/internal/ports/service/mocks"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestHandler_CreateCashRegister(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	mux := chi.NewRouter()
+	mock := mockService.NewMockInterface(ctrl)
+	mux.HandleFunc("/api/api_v1/cash-register", New(mock, time.Second).CreateCashRegister)
+
+	response := httptest.NewRecorder()
+	request := httptest.NewRequest(http.MethodPost, "/api/api_v1/cash-register",
+		strings.NewReader("{\"cash_register\":11,\"name\":\"Касса у входа\",\"location\":\"back_room\",\"enabled\":true}"))
+
+	mock.EXPECT().CreateCashRegister(gomock.Any(), dto.CashRegisterRecord{CashRegister: 11, Name: "Касса у входа",
+		Location: "back_room", Enabled: true}).Times(1).Return(nil)
+
+	mux.ServeHTTP(response, request)
+	if response.Code != http.StatusCreated {
+		t.Fail()
+	}
+}
+
+func TestHandler_CreateCashRegisterExists(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	mux := chi.NewRouter()
+	mock := mockService.NewMockInterface(ctrl)
+	mux.HandleFunc("/api/api_v1/cash-register", New(mock, time.Second).CreateCashRegister)
+
+	response := httptest.NewRecorder()
+	request := httptest.NewRequest(http.MethodPost, "/api/api_v1/cash-register",
+		strings.NewReader("{\"cash_register\":1,\"name\":\"Касса 1\",\"enabled\":true}"))
+
+	mock.EXPECT().CreateCashRegister(gomock.Any(), gomock.Any()).Times(1).Return(service.ErrCashRegisterExists)
+
+	mux.ServeHTTP(response, request)
+	if response.Code != http.StatusConflict {
+		t.Fail()
+	}
+}
+
+func TestHandler_UpdateCashRegisterEmptyName(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	mux := chi.NewRouter()
+	mock := mockService.NewMockInterface(ctrl)
+	mux.HandleFunc("/api/api_v1/cash-register", New(mock, time.Second).UpdateCashRegister)
+
+	response := httptest.NewRecorder()
+	request := httptest.NewRequest(http.MethodPut, "/api/api_v1/cash-register",
+		strings.NewReader("{\"cash_register\":1,\"enabled\":false}"))
+
+	mock.EXPECT().UpdateCashRegister(gomock.Any(), gomock.Any()).Times(0)
+
+	mux.ServeHTTP(response, request)
+	if response.Code != http.StatusBadRequest {
+		t.Fail()
+	}
+}
+
+func TestHandler_CashRegisterNotFound(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	mux := chi.NewRouter()
+	mock := mockService.NewMockInterface(ctrl)
+	mux.HandleFunc("/api/api_v1/cash-register/", New(mock, time.Second).CashRegister)
+
+	response := httptest.NewRecorder()
+	request := httptest.NewRequest(http.MethodGet, "/api/api_v1/cash-register/?"+url.Values{
+		"cash_register": []string{"42"}}.Encode(), nil)
+
+	mock.EXPECT().CashRegister(gomock.Any(), dto.CashRegister{CashRegister: 42}).Times(1).Return(
+		dto.CashRegisterRecord{}, repository.ErrNoRecord)
+
+	mux.ServeHTTP(response, request)
+	if response.Code != http.StatusNotFound {
+		t.Fail()
+	}
+}
+
+func TestHandler_CashRegisterIncorrectNumber(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	mux := chi.NewRouter()
+	mock := mockService.NewMockInterface(ctrl)
+	mux.HandleFunc("/api/api_v1/cash-register/", New(mock, time.Second).CashRegister)
+
+	response := httptest.NewRecorder()
+	request := httptest.NewRequest(http.MethodGet, "/api/api_v1/cash-register/?cash_register=first", nil)
+
+	mock.EXPECT().CashRegister(gomock.Any(), gomock.Any()).Times(0)
+
+	mux.ServeHTTP(response, request)
+	if response.Code != http.StatusBadRequest {
+		t.Fail()
+	}
+}
+
+func TestHandler_CashRegisters(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	mux := chi.NewRouter()
+	mock := mockService.NewMockInterface(ctrl)
+	mux.HandleFunc("/api/api_v1/cash-register/list/", New(mock, time.Second).CashRegisters)
+
+	response := httptest.NewRecorder()
+	request := httptest.NewRequest(http.MethodGet, "/api/api_v1/cash-register/list/", nil)
+
+	mock.EXPECT().CashRegisters(gomock.Any()).Times(1).Return(
+		[]dto.CashRegisterRecord{{CashRegister: 1, Name: "Касса 1", Enabled: true}}, nil)
+
+	mux.ServeHTTP(response, request)
+	if response.Code != http.StatusOK || !strings.Contains(response.Body.String(), "\"name\":\"Касса 1\"") {
+		t.Fail()
+	}
+}
@@ -0,0 +1,100 @@
+package handlers
+
+import (
+	"fmt"
+	"github.com/lazylex/watch-store-store/internal/adapters/export"
+	"github.com/lazylex/watch-store-store/internal/adapters/rest/request"
+	"github.com/lazylex/watch-store-store/internal/adapters/rest/response"
+	"github.com/lazylex/watch-store-store/internal/domain/value_objects/report"
+	"github.com/lazylex/watch-store-store/internal/dto"
+	"github.com/lazylex/watch-store-store/internal/helpers/constants/various"
+	"github.com/lazylex/watch-store-store/internal/logger"
+	"log/slog"
+	"net/http"
+)
+
+// exportResponseWriter откладывает запись заголовков ответа до записи первых байт отчёта. Пока ничего не записано,
+// ошибку формирования отчёта можно вернуть клиенту кодом ответа.
+type exportResponseWriter struct {
+	http.ResponseWriter
+	contentType string
+	fileName    string
+	started     bool
+}
+
+// Write записывает заголовки ответа (при первом вызове) и данные отчёта.
+func (e *exportResponseWriter) Write(p []byte) (int, error) {
+	if !e.started {
+		e.started = true
+		e.Header().Set("Content-Type", e.contentType)
+		e.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", e.fileName))
+		e.WriteHeader(http.StatusOK)
+	}
+	return e.ResponseWriter.Write(p)
+}
+
+// ExportSales выгружает отчёт о продажах за период в виде файла. Параметрами запроса передаются даты from и to (to
+// необязателен) и вид отчёта report: sold - записи о проданных товарах (по умолчанию), articles - продажи по товарам,
+// days - продажи по дням. Формат файла (CSV или XLSX) определяется заголовком Accept или параметром format, имеющим
+// приоритет над заголовком. Если заголовок Accept не содержит поддерживаемых типов, возвращается код 406.
+//
+// Отчёт передаётся клиенту по мере чтения из хранилища, поэтому таймаут запросов к хранилищу к нему не применяется.
+// Если ошибка произошла после начала передачи, ответ обрывается и файл остаётся неполным.
+func (h *Handler) ExportSales(w http.ResponseWriter, r *http.Request) {
+	var err error
+	var format report.Format
+	var writer export.Writer
+	log := logger.AddPlaceAndRequestId(slog.Default(), "rest.handlers.ExportSales", r)
+
+	transferObject := dto.FromToReport{Report: report.Kind(r.FormValue(request.Report))}
+	if transferObject.FromTo, err = period(r); err != nil {
+		response.WriteHeaderAndLogAboutBadRequest(w, log, err)
+		return
+	}
+	if transferObject.Report == "" {
+		transferObject.Report = report.Sold
+	}
+
+	err = transferObject.Validate()
+	if response.WriteHeaderAndLogAboutErr(w, log, err); err != nil {
+		return
+	}
+
+	if formatParam := r.FormValue(request.Format); len(formatParam) > 0 {
+		if format, err = export.FormatByName(formatParam); err != nil {
+			response.WriteHeaderAndLogAboutBadRequest(w, log, request.ErrIncorrectFormat)
+			return
+		}
+	} else {
+		var ok bool
+		if format, ok = export.Negotiate(r.Header.Get("Accept")); !ok {
+			w.WriteHeader(http.StatusNotAcceptable)
+			log.Warn(fmt.Sprintf("no acceptable report format in %q", r.Header.Get("Accept")))
+			return
+		}
+	}
+
+	out := &exportResponseWriter{
+		ResponseWriter: w,
+		contentType:    export.ContentType(format),
+		fileName: fmt.Sprintf("sales_%s_%s_%s.%s", transferObject.Report,
+			transferObject.From.Format(various.DateLayout), transferObject.To.Format(various.DateLayout), format),
+	}
+
+	if writer, err = export.New(format, out, string(transferObject.Report)); err == nil {
+		if err = h.service.ExportSales(injectRequestIDToCtx(r.Context(), r), transferObject, writer); err == nil {
+			err = writer.Close()
+		}
+	}
+
+	if err != nil {
+		if !out.started {
+			response.WriteHeaderAndLogAboutErr(w, log, err)
+			return
+		}
+		log.Error(fmt.Sprintf("report export interrupted: %s", err.Error()))
+		return
+	}
+
+	log.Info(fmt.Sprintf("exported %s report in %s", transferObject.Report, format))
+}
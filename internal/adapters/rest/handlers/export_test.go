@@ -0,0 +1,86 @@
+package handlers
+
+import (
+	"github.com/go-chi/chi"
+	"github.com/golang/mock/gomock"
+	"github.com/lazylex/watch-store-store/internal/dto"
+	"github.com/lazylex/watch-store-store/internal/ports/export"
+	"github.com/lazylex/watch-store-store/internal/ports/repository"
+	mockService "github.com/lazylex/watch-store-store/internal/ports/service/mocks"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestHandler_ExportSalesCSV(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	mux := chi.NewRouter()
+	mock := mockService.NewMockInterface(ctrl)
+	mux.HandleFunc("/api/api_v1/export/sales/", New(mock, time.Second).ExportSales)
+
+	response := httptest.NewRecorder()
+	request := httptest.NewRequest(http.MethodGet, "/api/api_v1/export/sales/", nil)
+	request.Form = url.Values{}
+	request.Form.Set("from", "2024-06-01")
+	request.Form.Set("to", "2024-06-30")
+	request.Header.Set("Accept", "text/csv")
+
+	mock.EXPECT().ExportSales(gomock.Any(), gomock.Any(), gomock.Any()).Times(1).DoAndReturn(
+		func(_ any, _ dto.FromToReport, w export.Interface) error {
+			return w.WriteRow("article", "amount")
+		})
+
+	mux.ServeHTTP(response, request)
+	if response.Code != http.StatusOK || response.Header().Get("Content-Type") != "text/csv" ||
+		!strings.Contains(response.Header().Get("Content-Disposition"), "sales_sold_2024-06-01_2024-06-30.csv") ||
+		response.Body.String() != "article,amount\n" {
+		t.Fail()
+	}
+}
+
+func TestHandler_ExportSalesNotAcceptable(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	mux := chi.NewRouter()
+	mock := mockService.NewMockInterface(ctrl)
+	mux.HandleFunc("/api/api_v1/export/sales/", New(mock, time.Second).ExportSales)
+
+	response := httptest.NewRecorder()
+	request := httptest.NewRequest(http.MethodGet, "/api/api_v1/export/sales/", nil)
+	request.Form = url.Values{}
+	request.Form.Set("from", "2024-06-01")
+	request.Header.Set("Accept", "application/json")
+
+	mock.EXPECT().ExportSales(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+	mux.ServeHTTP(response, request)
+	if response.Code != http.StatusNotAcceptable {
+		t.Fail()
+	}
+}
+
+func TestHandler_ExportSalesErrorBeforeData(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	mux := chi.NewRouter()
+	mock := mockService.NewMockInterface(ctrl)
+	mux.HandleFunc("/api/api_v1/export/sales/", New(mock, time.Second).ExportSales)
+
+	response := httptest.NewRecorder()
+	request := httptest.NewRequest(http.MethodGet, "/api/api_v1/export/sales/", nil)
+	request.Form = url.Values{}
+	request.Form.Set("from", "2024-06-01")
+	request.Form.Set("format", "xlsx")
+	request.Header.Set("Accept", "text/csv")
+
+	mock.EXPECT().ExportSales(gomock.Any(), gomock.Any(), gomock.Any()).Times(1).Return(repository.ErrTimeout)
+
+	mux.ServeHTTP(response, request)
+	if response.Code != http.StatusRequestTimeout {
+		t.Fail()
+	}
+}
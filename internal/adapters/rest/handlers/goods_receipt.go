@@ -0,0 +1,95 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/go-chi/render"
+	"github.com/lazylex/watch-store-store/internal/adapters/rest/request"
+	"github.com/lazylex/watch-store-store/internal/adapters/rest/response"
+	"github.com/lazylex/watch-store-store/internal/dto"
+	"github.com/lazylex/watch-store-store/internal/logger"
+	"log/slog"
+	"net/http"
+)
+
+// ReceiveGoods принимает поставку товаров. В теле запроса в формате JSON передаются номер сопроводительного документа,
+// поставщик и строки поставки. Название и цена продажи указываются для товаров, отсутствующих в ассортименте, серийные
+// номера (serials) - для товаров, учитываемых по серийным номерам. Пример передаваемых данных:
+//
+//	{
+//		"document_number": "TN-2024-0117",
+//		"supplier": "Casio Europe",
+//		"lines": [
+//			{"article": "CA-F91W", "cost": 900, "amount": 20},
+//			{"article": "CA-GW-M5610U", "cost": 9800, "amount": 2, "serials": ["3A9C0412", "3A9C0413"]},
+//			{"article": "CA-A168WG-9EF", "name": "CASIO A168WG-9EF", "price": 6990, "cost": 4100, "amount": 5}
+//		]
+//	}
+//
+// При первой приёмке документа возвращается http.StatusCreated. Повторная передача того же документа не изменяет
+// остатки, в этом случае возвращается http.StatusOK.
+func (h *Handler) ReceiveGoods(w http.ResponseWriter, r *http.Request) {
+	var err error
+	var received bool
+	var transferObject dto.GoodsReceipt
+	log := logger.AddPlaceAndRequestId(slog.Default(), "rest.handlers.ReceiveGoods", r)
+
+	ctx, cancel := context.WithTimeout(r.Context(), h.queryTimeout)
+	defer cancel()
+
+	err = json.NewDecoder(r.Body).Decode(&transferObject)
+	if err != nil {
+		response.WriteHeaderAndLogAboutBadRequest(w, log, err)
+		return
+	}
+
+	err = h.resolveBarcodes(injectRequestIDToCtx(ctx, r), articlesOfGoodsReceipt(transferObject.Lines)...)
+	if response.WriteHeaderAndLogAboutErr(w, log, err); err != nil {
+		return
+	}
+
+	err = transferObject.Validate()
+	if response.WriteHeaderAndLogAboutErr(w, log, err); err != nil {
+		return
+	}
+
+	received, err = h.service.ReceiveGoods(injectRequestIDToCtx(ctx, r), transferObject)
+	if response.WriteHeaderAndLogAboutErr(w, log, err); err != nil {
+		return
+	}
+
+	if received {
+		w.WriteHeader(http.StatusCreated)
+		log.Info(fmt.Sprintf("goods received by document %s", transferObject.DocumentNumber))
+	} else {
+		log.Info(fmt.Sprintf("document %s already received", transferObject.DocumentNumber))
+	}
+}
+
+// GoodsReceipt возвращает в формате JSON принятую поставку с переданным в параметре запроса (document_number) номером
+// документа. Формат возвращаемых данных совпадает с форматом, передаваемым в ReceiveGoods, с добавлением времени
+// приёмки (received_at).
+func (h *Handler) GoodsReceipt(w http.ResponseWriter, r *http.Request) {
+	var err error
+	var result dto.GoodsReceipt
+	log := logger.AddPlaceAndRequestId(slog.Default(), "rest.handlers.GoodsReceipt", r)
+
+	ctx, cancel := context.WithTimeout(r.Context(), h.queryTimeout)
+	defer cancel()
+
+	transferObject := dto.DocumentNumber{DocumentNumber: r.FormValue(request.Document)}
+	err = transferObject.Validate()
+	if response.WriteHeaderAndLogAboutErr(w, log, err); err != nil {
+		return
+	}
+
+	result, err = h.service.GoodsReceipt(injectRequestIDToCtx(ctx, r), transferObject)
+	if response.WriteHeaderAndLogAboutErr(w, log, err); err != nil {
+		return
+	}
+
+	log.Info(fmt.Sprintf("requested goods receipt %s", transferObject.DocumentNumber))
+
+	render.JSON(w, r, result)
+}
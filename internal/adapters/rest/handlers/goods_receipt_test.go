@@ -0,0 +1,92 @@
+package handlers
+
+import (
+	"github.com/go-chi/chi"
+	"github.com/golang/mock/gomock"
+	"github.com/lazylex/watch-store-store/internal/dto"
+	"github.com/lazylex/watch-store-store/internal/ports/repository"
+	mockService "github.com/lazylex/watch-store-store/internal/ports/service/mocks"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+)
+
+const goodsReceiptBody = "{\"document_number\":\"TN-1\",\"supplier\":\"casio\"," +
+	"\"lines\":[{\"article\":\"CA-F91W\",\"cost\":900,\"amount\":20}]}"
+
+func TestHandler_ReceiveGoodsCreated(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	mux := chi.NewRouter()
+	mock := mockService.NewMockInterface(ctrl)
+	mux.HandleFunc("/api/api_v1/goods-receipt", New(mock, time.Second).ReceiveGoods)
+
+	response := httptest.NewRecorder()
+	request := httptest.NewRequest(http.MethodPost, "/api/api_v1/goods-receipt", strings.NewReader(goodsReceiptBody))
+
+	mock.EXPECT().ReceiveGoods(gomock.Any(), dto.GoodsReceipt{DocumentNumber: "TN-1", Supplier: "casio",
+		Lines: []dto.GoodsReceiptLine{{Article: "CA-F91W", Cost: 900, Amount: 20}}}).Times(1).Return(true, nil)
+
+	mux.ServeHTTP(response, request)
+	if response.Code != http.StatusCreated {
+		t.Fail()
+	}
+}
+
+func TestHandler_ReceiveGoodsAlreadyReceived(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	mux := chi.NewRouter()
+	mock := mockService.NewMockInterface(ctrl)
+	mux.HandleFunc("/api/api_v1/goods-receipt", New(mock, time.Second).ReceiveGoods)
+
+	response := httptest.NewRecorder()
+	request := httptest.NewRequest(http.MethodPost, "/api/api_v1/goods-receipt", strings.NewReader(goodsReceiptBody))
+
+	mock.EXPECT().ReceiveGoods(gomock.Any(), gomock.Any()).Times(1).Return(false, nil)
+
+	mux.ServeHTTP(response, request)
+	if response.Code != http.StatusOK {
+		t.Fail()
+	}
+}
+
+func TestHandler_ReceiveGoodsNoSupplier(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	mux := chi.NewRouter()
+	mock := mockService.NewMockInterface(ctrl)
+	mux.HandleFunc("/api/api_v1/goods-receipt", New(mock, time.Second).ReceiveGoods)
+
+	response := httptest.NewRecorder()
+	request := httptest.NewRequest(http.MethodPost, "/api/api_v1/goods-receipt",
+		strings.NewReader("{\"document_number\":\"TN-1\",\"lines\":[{\"article\":\"CA-F91W\",\"amount\":20}]}"))
+
+	mux.ServeHTTP(response, request)
+	if response.Code != http.StatusBadRequest {
+		t.Fail()
+	}
+}
+
+func TestHandler_GoodsReceiptNoRecord(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	mux := chi.NewRouter()
+	mock := mockService.NewMockInterface(ctrl)
+	mux.HandleFunc("/api/api_v1/goods-receipt/", New(mock, time.Second).GoodsReceipt)
+	mock.EXPECT().GoodsReceipt(gomock.Any(), dto.DocumentNumber{DocumentNumber: "TN-1"}).Times(1).Return(
+		dto.GoodsReceipt{}, repository.ErrNoRecord)
+
+	response := httptest.NewRecorder()
+	request := httptest.NewRequest(http.MethodGet, "/api/api_v1/goods-receipt/", nil)
+	request.Form = url.Values{}
+	request.Form.Set("document_number", "TN-1")
+
+	mux.ServeHTTP(response, request)
+	if response.Code != http.StatusNotFound {
+		t.Fail()
+	}
+}
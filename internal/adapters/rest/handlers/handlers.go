@@ -8,13 +8,17 @@ import (
 	"github.com/go-chi/render"
 	"github.com/lazylex/watch-store-store/internal/adapters/rest/request"
 	"github.com/lazylex/watch-store-store/internal/adapters/rest/response"
+	"github.com/lazylex/watch-store-store/internal/domain/aggregates/receipt"
+	"github.com/lazylex/watch-store-store/internal/domain/aggregates/reservation"
 	"github.com/lazylex/watch-store-store/internal/domain/value_objects/article"
+	"github.com/lazylex/watch-store-store/internal/domain/value_objects/payment"
 	"github.com/lazylex/watch-store-store/internal/dto"
 	"github.com/lazylex/watch-store-store/internal/helpers/constants/various"
 	"github.com/lazylex/watch-store-store/internal/logger"
 	"github.com/lazylex/watch-store-store/internal/ports/service"
 	"log/slog"
 	"net/http"
+	"strconv"
 	"time"
 )
 
@@ -42,9 +46,12 @@ func injectRequestIDToCtx(ctx context.Context, r *http.Request) context.Context
 //	   "price": 3490,
 //	   "amount": 60
 //	}
+//
+// При переданном параметре запроса attributes=true к данным добавляются значения атрибутов товара в поле attributes.
 func (h *Handler) StockRecord(w http.ResponseWriter, r *http.Request) {
 	var err error
 	var art article.Article
+	var withAttr bool
 	var stock dto.ArticlePriceNameAmount
 	log := logger.AddPlaceAndRequestId(slog.Default(), "rest.handlers.StockRecord", r)
 
@@ -53,12 +60,35 @@ func (h *Handler) StockRecord(w http.ResponseWriter, r *http.Request) {
 
 	art = article.Article(r.FormValue(request.Article))
 
+	err = h.resolveBarcodes(injectRequestIDToCtx(ctx, r), &art)
+	if response.WriteHeaderAndLogAboutErr(w, log, err); err != nil {
+		return
+	}
+
 	transferObject := dto.Article{Article: art}
 	err = transferObject.Validate()
 	if response.WriteHeaderAndLogAboutErr(w, log, err); err != nil {
 		return
 	}
 
+	withAttr, err = withAttributes(r)
+	if response.WriteHeaderAndLogAboutErr(w, log, err); err != nil {
+		return
+	}
+
+	if withAttr {
+		var result dto.StockAttributes
+		result, err = h.service.StockWithAttributes(injectRequestIDToCtx(ctx, r), transferObject)
+		if response.WriteHeaderAndLogAboutErr(w, log, err); err != nil {
+			return
+		}
+
+		log.Info(fmt.Sprintf("requested stock record with attributes with article %s", art))
+
+		render.JSON(w, r, result)
+		return
+	}
+
 	stock, err = h.service.Stock(injectRequestIDToCtx(ctx, r), transferObject)
 	if response.WriteHeaderAndLogAboutErr(w, log, err); err != nil {
 		return
@@ -74,10 +104,13 @@ func (h *Handler) StockRecord(w http.ResponseWriter, r *http.Request) {
 // {
 // "amount": 13
 // }
+// При переданном параметре запроса locations=true дополнительно возвращается распределение количества по местам
+// хранения.
 func (h *Handler) AmountInStock(w http.ResponseWriter, r *http.Request) {
 	var err error
 	var art article.Article
 	var amount uint
+	var withLocations bool
 	log := logger.AddPlaceAndRequestId(slog.Default(), "rest.handlers.AmountInStock", r)
 
 	ctx, cancel := context.WithTimeout(r.Context(), h.queryTimeout)
@@ -85,12 +118,35 @@ func (h *Handler) AmountInStock(w http.ResponseWriter, r *http.Request) {
 
 	art = article.Article(r.FormValue(request.Article))
 
+	err = h.resolveBarcodes(injectRequestIDToCtx(ctx, r), &art)
+	if response.WriteHeaderAndLogAboutErr(w, log, err); err != nil {
+		return
+	}
+
 	transferObject := dto.Article{Article: art}
 	err = transferObject.Validate()
 	if response.WriteHeaderAndLogAboutErr(w, log, err); err != nil {
 		return
 	}
 
+	withLocations, err = withLocationsBreakdown(r)
+	if response.WriteHeaderAndLogAboutErr(w, log, err); err != nil {
+		return
+	}
+
+	if withLocations {
+		var result dto.AmountLocations
+		result, err = h.service.AmountByLocation(injectRequestIDToCtx(ctx, r), transferObject)
+		if response.WriteHeaderAndLogAboutErr(w, log, err); err != nil {
+			return
+		}
+
+		log.Info(fmt.Sprintf("requested amount by locations in stock record with article %s", art))
+
+		render.JSON(w, r, result)
+		return
+	}
+
 	amount, err = h.service.AmountInStock(injectRequestIDToCtx(ctx, r), transferObject)
 	if response.WriteHeaderAndLogAboutErr(w, log, err); err != nil {
 		return
@@ -120,6 +176,11 @@ func (h *Handler) UpdatePriceInStock(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	err = h.resolveBarcodes(injectRequestIDToCtx(ctx, r), &transferObject.Article)
+	if response.WriteHeaderAndLogAboutErr(w, log, err); err != nil {
+		return
+	}
+
 	err = transferObject.Validate()
 	if response.WriteHeaderAndLogAboutErr(w, log, err); err != nil {
 		return
@@ -152,6 +213,11 @@ func (h *Handler) UpdateAmountInStock(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	err = h.resolveBarcodes(injectRequestIDToCtx(ctx, r), &transferObject.Article)
+	if response.WriteHeaderAndLogAboutErr(w, log, err); err != nil {
+		return
+	}
+
 	err = transferObject.Validate()
 	if response.WriteHeaderAndLogAboutErr(w, log, err); err != nil {
 		return
@@ -216,6 +282,11 @@ func (h *Handler) SoldAmount(w http.ResponseWriter, r *http.Request) {
 
 	art = article.Article(r.FormValue(request.Article))
 
+	err = h.resolveBarcodes(injectRequestIDToCtx(ctx, r), &art)
+	if response.WriteHeaderAndLogAboutErr(w, log, err); err != nil {
+		return
+	}
+
 	fromParam := r.FormValue(request.From)
 	toParam := r.FormValue(request.To)
 
@@ -268,12 +339,18 @@ func (h *Handler) SoldAmount(w http.ResponseWriter, r *http.Request) {
 
 // MakeReservation резервирует группу товаров под переданным номером заказа. В теле запроса передается номер заказа,
 // статус резервирования (описание в internal/domain/aggregates/reservation/number_date_state_products.go) и массив резервируемых
-// продуктов в формате JSON. В случае удачного резервирования возвращается http.StatusCreated и производится запись в
-// лог. Пример передаваемых данных:
+// продуктов в формате JSON. Номер заказа через интернет или для покупателя в магазине можно не передавать - тогда он
+// выделяется сервисом. Для заказа, оформленного не на кассе, можно передать данные покупателя (customer). В случае
+// удачного резервирования возвращается http.StatusCreated и номер заказа ({"order_number": 13}) и производится запись
+// в лог. Для заказа покупателя в магазине в ответе также возвращается код получения заказа, который сообщается
+// покупателю и не сохраняется в открытом виде ({"order_number": 13, "pickup_code": "402913"}). Такой ответ не
+// кэшируется. Для товаров, учитываемых по серийным номерам, в массиве serials передаются номера резервируемых
+// экземпляров. Пример передаваемых данных:
 //
 //	{
 //		"order_number":13,
 //		"state":1,
+//		"customer":{"name":"Иван Петров","phone":"+7 (912) 345-67-89","email":"ivan@example.com"},
 //		"products":[
 //			{
 //				"article" : "9",
@@ -290,6 +367,7 @@ func (h *Handler) SoldAmount(w http.ResponseWriter, r *http.Request) {
 func (h *Handler) MakeReservation(w http.ResponseWriter, r *http.Request) {
 	var err error
 	var transferObject dto.NumberDateStateProducts
+	var result dto.NumberPickupCode
 	log := logger.AddPlaceAndRequestId(slog.Default(), "rest.handlers.MakeReservation", r)
 
 	ctx, cancel := context.WithTimeout(r.Context(), h.queryTimeout)
@@ -301,16 +379,25 @@ func (h *Handler) MakeReservation(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	err = h.resolveBarcodes(injectRequestIDToCtx(ctx, r), articlesOfProducts(transferObject.Products)...)
+	if response.WriteHeaderAndLogAboutErr(w, log, err); err != nil {
+		return
+	}
+
 	transferObject.Date = time.Now()
 	err = transferObject.Validate()
 	if response.WriteHeaderAndLogAboutErr(w, log, err); err != nil {
 		return
 	}
 
-	err = h.service.MakeReservation(injectRequestIDToCtx(ctx, r), transferObject)
+	result, err = h.service.MakeReservation(injectRequestIDToCtx(ctx, r), transferObject)
 	if response.WriteHeaderAndLogAboutErr(w, log, err); err == nil {
-		w.WriteHeader(http.StatusCreated)
-		log.Info(fmt.Sprintf("succesfully saved order %d", transferObject.OrderNumber))
+		if result.PickupCode != "" {
+			response.NoStore(w, dto.NumberPickupCode{OrderNumber: result.OrderNumber})
+		}
+		render.Status(r, http.StatusCreated)
+		render.JSON(w, r, result)
+		log.Info(fmt.Sprintf("succesfully saved order %d", result.OrderNumber))
 	}
 }
 
@@ -344,55 +431,133 @@ func (h *Handler) CancelReservation(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-// MakeLocalSale товар из доступного для продажи переносится в историю продаж. В случае удачного выполнения операции
-// возвращается http.StatusOK и производится запись в лог. В теле запроса передается массив резервируемых продуктов
-// в формате JSON. Пример передаваемых данных:
+// MakeLocalSale товар из доступного для продажи переносится в историю продаж. Проданные товары объединяются в чек,
+// относящийся к открытой на кассе смене. В случае удачного выполнения операции возвращается http.StatusCreated с
+// идентификатором чека и производится запись в лог. В теле запроса передается номер кассы, способ оплаты (cash, card,
+// online, voucher или bank_transfer) и массив продаваемых продуктов в формате JSON. Пример передаваемых данных:
 //
-//	[
-//		{
-//			"article" : "9",
-//			"price" : 1330,
-//			"amount":6
-//		},
-//		{
-//			"article":"1",
-//			"price":3530,
-//			"amount":5
-//		}
-//	]
+//	{
+//		"cash_register": 1,
+//		"payment_method": "cash",
+//		"products":[
+//			{
+//				"article" : "9",
+//				"price" : 1330,
+//				"amount":6
+//			},
+//			{
+//				"article":"1",
+//				"price":3530,
+//				"amount":5
+//			}
+//		]
+//	}
+//
+// Вместо способа оплаты можно передать массив платежей (payments), сумма которых покрывает сумму чека. Сдача выдаётся
+// только с наличных, поэтому сумма безналичных платежей не может превышать сумму чека:
+//
+//	"payments": [{"method": "voucher", "amount": 5000}, {"method": "cash", "amount": 30000}]
+//
+// Для товаров, учитываемых по серийным номерам, передаются номера продаваемых экземпляров (по одному на каждую единицу
+// товара):
+//
+//	"serials": ["3A9C0412", "3A9C0413"]
+//
+// На товары с гарантийным сроком выдаются гарантии, по которым их можно найти по номеру телефона покупателя, если он
+// передан:
+//
+//	"phone": "+7 (912) 345-67-89"
+//
+// Для совместимости с кассами, использующими прежний формат запроса, в теле может передаваться только массив
+// продаваемых продуктов. Тогда номер кассы передаётся параметром запроса cash_register, а способ оплаты - параметром
+// payment_method (если не передан, продажа оплачивается наличными):
+//
+//	POST /api/api_v1/sale/make?cash_register=1
+//	[{"article": "9", "price": 1330, "amount": 6}]
+//
+// Пример возвращаемого значения:
+//
+// {"receipt_id": 1517}
 func (h *Handler) MakeLocalSale(w http.ResponseWriter, r *http.Request) {
 	var err error
-	var products []dto.ArticlePriceAmount
+	var id receipt.ID
+	var body json.RawMessage
+	var transferObject dto.CashRegisterProducts
 	log := logger.AddPlaceAndRequestId(slog.Default(), "rest.handlers.MakeLocalSale", r)
 
 	ctx, cancel := context.WithTimeout(r.Context(), h.queryTimeout)
 	defer cancel()
 
-	err = json.NewDecoder(r.Body).Decode(&products)
+	err = json.NewDecoder(r.Body).Decode(&body)
+	if err == nil {
+		if body[0] == '[' {
+			transferObject, err = legacySale(r, body)
+		} else {
+			err = json.Unmarshal(body, &transferObject)
+		}
+	}
 	if err != nil {
 		response.WriteHeaderAndLogAboutBadRequest(w, log, err)
 		return
 	}
 
-	err = h.service.MakeSale(injectRequestIDToCtx(ctx, r), products)
+	err = h.resolveBarcodes(injectRequestIDToCtx(ctx, r), articlesOfProducts(transferObject.Products)...)
+	if response.WriteHeaderAndLogAboutErr(w, log, err); err != nil {
+		return
+	}
+
+	id, err = h.service.MakeSale(injectRequestIDToCtx(ctx, r), transferObject)
 	if response.WriteHeaderAndLogAboutErr(w, log, err); err == nil {
 		var logString string
-		for _, p := range products {
+		for _, p := range transferObject.Products {
 			logString += fmt.Sprintf("sold article: %s, amount: %d, price %.2f. ", p.Article, p.Amount, p.Price)
 		}
-		w.WriteHeader(http.StatusCreated)
-		log.Info(logString)
+		render.Status(r, http.StatusCreated)
+		render.JSON(w, r, dto.ReceiptID{ID: id})
+		log.Info(logString + fmt.Sprintf("receipt %d", id))
 	}
 }
 
+// legacySale возвращает продажу на кассе, переданную в прежнем формате - массивом продаваемых продуктов body. Номер
+// кассы и способ оплаты (по умолчанию - наличные) считываются из параметров запроса.
+func legacySale(r *http.Request, body json.RawMessage) (dto.CashRegisterProducts, error) {
+	result := dto.CashRegisterProducts{PaymentMethod: payment.Cash}
+
+	number, err := strconv.ParseInt(r.URL.Query().Get(request.Register), 10, 64)
+	if err != nil {
+		return result, request.ErrIncorrectCashRegister
+	}
+	result.CashRegister = reservation.OrderNumber(number)
+
+	if method := r.URL.Query().Get(request.Payment); method != "" {
+		result.PaymentMethod = payment.Method(method)
+	}
+
+	return result, json.Unmarshal(body, &result.Products)
+}
+
 // FinishOrder отмечает заказ выполненным (отданным локальному покупателю или отправленным интернет-покупателю)
 // и заносит зарезервированные продукты в историю проданных товаров. Данные в запросе передаются в теле в виде JSON.
-// Например:
+// Для заказа, оформленного на кассе, обязательно указывается способ оплаты. Например:
 //
-// {"order_number": 9}
+// {"order_number": 9, "payment_method": "card"}
+//
+// Заказ покупателя в магазине выдаётся только при указании кода получения заказа (pickup_code), выданного при
+// резервировании. При неверном коде возвращается http.StatusForbidden:
+//
+// {"order_number": 13, "payment_method": "cash", "pickup_code": "402913"}
+//
+// Заказ может быть оплачен несколькими способами, тогда вместо способа оплаты передаются платежи:
+//
+// {"order_number": 9, "payments": [{"method": "card", "amount": 1000}, {"method": "cash", "amount": 500}]}
+//
+// В ответе возвращается идентификатор созданного чека:
+//
+// {"receipt_id": 1518}
 func (h *Handler) FinishOrder(w http.ResponseWriter, r *http.Request) {
 	var err error
-	var transferObject dto.Number
+	var id receipt.ID
+	var transferObject dto.NumberPaymentMethod
 	log := logger.AddPlaceAndRequestId(slog.Default(), "rest.handlers.FinishOrder", r)
 
 	ctx, cancel := context.WithTimeout(r.Context(), h.queryTimeout)
@@ -409,8 +574,67 @@ func (h *Handler) FinishOrder(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	err = h.service.FinishOrder(injectRequestIDToCtx(ctx, r), transferObject)
+	id, err = h.service.FinishOrder(injectRequestIDToCtx(ctx, r), transferObject)
 	if response.WriteHeaderAndLogAboutErr(w, log, err); err == nil {
-		log.Info(fmt.Sprintf("finish order %d", transferObject.OrderNumber))
+		render.JSON(w, r, dto.ReceiptID{ID: id})
+		log.Info(fmt.Sprintf("finish order %d, receipt %d", transferObject.OrderNumber, id))
 	}
 }
+
+// Receipt возвращает в формате JSON чек с переданным в параметре запроса (id) идентификатором. Пример возвращаемых
+// данных:
+//
+//	{
+//		"id": 1517,
+//		"cash_register": 1,
+//		"shift_id": 42,
+//		"order_number": 0,
+//		"payment_method": "mixed",
+//		"total": 25630,
+//		"tax": 4271.67,
+//		"date": "2024-06-14T15:04:05Z",
+//		"products": [
+//			{"article": "9", "price": 1330, "amount": 6},
+//			{"article": "1", "price": 3530, "amount": 5}
+//		],
+//		"payments": [
+//			{"method": "voucher", "amount": 5000},
+//			{"method": "cash", "amount": 21000, "change": 370}
+//		],
+//		"taxes": [
+//			{"article": "9", "category": "standard", "rate": 20, "net": 6650, "tax": 1330},
+//			{"article": "1", "category": "standard", "rate": 20, "net": 14708.33, "tax": 2941.67}
+//		]
+//	}
+//
+// Если чек оплачен несколькими способами, в payment_method указывается mixed. В taxes в порядке строк products
+// передаётся включённый в их стоимость НДС.
+func (h *Handler) Receipt(w http.ResponseWriter, r *http.Request) {
+	var err error
+	var id int64
+	var result dto.Receipt
+	log := logger.AddPlaceAndRequestId(slog.Default(), "rest.handlers.Receipt", r)
+
+	ctx, cancel := context.WithTimeout(r.Context(), h.queryTimeout)
+	defer cancel()
+
+	if id, err = strconv.ParseInt(r.FormValue(request.ID), 10, 64); err != nil {
+		response.WriteHeaderAndLogAboutBadRequest(w, log, request.ErrIncorrectID)
+		return
+	}
+
+	transferObject := dto.ReceiptID{ID: receipt.ID(id)}
+	err = transferObject.Validate()
+	if response.WriteHeaderAndLogAboutErr(w, log, err); err != nil {
+		return
+	}
+
+	result, err = h.service.Receipt(injectRequestIDToCtx(ctx, r), transferObject)
+	if response.WriteHeaderAndLogAboutErr(w, log, err); err != nil {
+		return
+	}
+
+	log.Info(fmt.Sprintf("requested receipt %d", id))
+
+	render.JSON(w, r, result)
+}
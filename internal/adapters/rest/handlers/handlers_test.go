@@ -1,8 +1,11 @@
 package handlers
 
 import (
+	"context"
 	"github.com/go-chi/chi"
 	"github.com/golang/mock/gomock"
+	"github.com/lazylex/watch-store-store/internal/domain/aggregates/receipt"
+	"github.com/lazylex/watch-store-store/internal/domain/value_objects/payment"
 	"github.com/lazylex/watch-store-store/internal/ports/repository"
 	"net/url"
 	"strings"
@@ -649,14 +652,89 @@ func TestHandler_MakeReservationSuccess(t *testing.T) {
 	service.EXPECT().MakeReservation(
 		gomock.Any(),
 		gomock.Any(),
-	).Times(1).Return(nil)
+	).Times(1).Return(dto.NumberPickupCode{OrderNumber: 13}, nil)
 
 	mux.ServeHTTP(response, request)
-	if response.Code != http.StatusCreated {
+	if response.Code != http.StatusCreated || response.Header().Get("Cache-Control") != "" {
 		t.Fail()
 	}
 }
 
+func TestHandler_MakeReservationAllocatedNumber(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	mux := chi.NewRouter()
+	service := mockService.NewMockInterface(ctrl)
+	mux.HandleFunc("/api/api_v1/reservation/make", New(service, time.Second).MakeReservation)
+
+	response := httptest.NewRecorder()
+	request := httptest.NewRequest(
+		http.MethodPost,
+		"/api/api_v1/reservation/make",
+		strings.NewReader("{\"state\":3,\"products\":[{\"article\":\"9\",\"price\":1330,\"amount\":6}]}"))
+
+	service.EXPECT().MakeReservation(gomock.Any(), gomock.Any()).Times(1).Return(
+		dto.NumberPickupCode{OrderNumber: 7_000_000_042}, nil)
+
+	mux.ServeHTTP(response, request)
+	body := strings.TrimSpace(response.Body.String())
+	if response.Code != http.StatusCreated || body != "{\"order_number\":7000000042}" {
+		t.Errorf("unexpected response %d %s", response.Code, response.Body.String())
+	}
+}
+
+func TestHandler_MakeReservationPickupCode(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	mux := chi.NewRouter()
+	service := mockService.NewMockInterface(ctrl)
+	mux.HandleFunc("/api/api_v1/reservation/make", New(service, time.Second).MakeReservation)
+
+	response := httptest.NewRecorder()
+	request := httptest.NewRequest(
+		http.MethodPost,
+		"/api/api_v1/reservation/make",
+		strings.NewReader("{\"state\":3,\"customer\":{\"name\":\"Иван\",\"phone\":\"+7 912 345-67-89\"},"+
+			"\"products\":[{\"article\":\"9\",\"price\":1330,\"amount\":6}]}"))
+
+	service.EXPECT().MakeReservation(gomock.Any(), gomock.Any()).Times(1).DoAndReturn(
+		func(_ context.Context, data dto.NumberDateStateProducts) (dto.NumberPickupCode, error) {
+			if data.Customer == nil || data.Customer.Phone != "+7 912 345-67-89" {
+				t.Errorf("customer not passed to service: %v", data.Customer)
+			}
+			return dto.NumberPickupCode{OrderNumber: 42, PickupCode: "402913"}, nil
+		})
+
+	mux.ServeHTTP(response, request)
+	body := strings.TrimSpace(response.Body.String())
+	if response.Code != http.StatusCreated || body != "{\"order_number\":42,\"pickup_code\":\"402913\"}" ||
+		response.Header().Get("Cache-Control") != "no-store" {
+		t.Errorf("unexpected response %d %s", response.Code, response.Body.String())
+	}
+}
+
+func TestHandler_MakeReservationCustomerInCashRegisterOrder(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	mux := chi.NewRouter()
+	service := mockService.NewMockInterface(ctrl)
+	mux.HandleFunc("/api/api_v1/reservation/make", New(service, time.Second).MakeReservation)
+
+	response := httptest.NewRecorder()
+	request := httptest.NewRequest(
+		http.MethodPost,
+		"/api/api_v1/reservation/make",
+		strings.NewReader("{\"order_number\":1,\"state\":1,\"customer\":{\"name\":\"Иван\",\"phone\":\"89123456789\"},"+
+			"\"products\":[{\"article\":\"9\",\"price\":1330,\"amount\":6}]}"))
+
+	service.EXPECT().MakeReservation(gomock.Any(), gomock.Any()).Times(0)
+
+	mux.ServeHTTP(response, request)
+	if response.Code != http.StatusBadRequest {
+		t.Errorf("unexpected response %d", response.Code)
+	}
+}
+
 func TestHandler_MakeReservationNoProducts(t *testing.T) {
 	t.Parallel()
 	ctrl := gomock.NewController(t)
@@ -762,9 +840,55 @@ func TestHandler_MakeLocalSaleSuccess(t *testing.T) {
 	response := httptest.NewRecorder()
 	request := httptest.NewRequest(
 		http.MethodPost, "/api/api_v1/sale/make",
-		strings.NewReader("[{\"article\":\"9\",\"price\":1330,\"amount\":6},{\"article\":\"1\",\"price\":3530,\"amount\":5}]"))
+		strings.NewReader("{\"cash_register\":1,\"payment_method\":\"cash\","+
+			"\"products\":[{\"article\":\"9\",\"price\":1330,\"amount\":6},"+
+			"{\"article\":\"1\",\"price\":3530,\"amount\":5}]}"))
+
+	service.EXPECT().MakeSale(gomock.Any(), gomock.Any()).Times(1).Return(receipt.ID(1), nil)
+
+	mux.ServeHTTP(response, request)
+	if response.Code != http.StatusCreated {
+		t.Fail()
+	}
+}
+
+func TestHandler_MakeLocalSaleLegacyArray(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	mux := chi.NewRouter()
+	service := mockService.NewMockInterface(ctrl)
+	mux.HandleFunc("/api/api_v1/sale/make", New(service, time.Second).MakeLocalSale)
+
+	response := httptest.NewRecorder()
+	request := httptest.NewRequest(
+		http.MethodPost, "/api/api_v1/sale/make?cash_register=1",
+		strings.NewReader("[{\"article\":\"9\",\"price\":1330,\"amount\":6},"+
+			"{\"article\":\"1\",\"price\":3530,\"amount\":5}]"))
+
+	service.EXPECT().MakeSale(gomock.Any(), dto.CashRegisterProducts{CashRegister: 1, PaymentMethod: payment.Cash,
+		Products: []dto.ArticlePriceAmount{{Article: "9", Price: 1330, Amount: 6}, {Article: "1", Price: 3530,
+			Amount: 5}}}).Times(1).Return(receipt.ID(1), nil)
+
+	mux.ServeHTTP(response, request)
+	if response.Code != http.StatusCreated {
+		t.Fail()
+	}
+}
+
+func TestHandler_MakeLocalSaleLegacyArrayWithPaymentMethod(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	mux := chi.NewRouter()
+	service := mockService.NewMockInterface(ctrl)
+	mux.HandleFunc("/api/api_v1/sale/make", New(service, time.Second).MakeLocalSale)
 
-	service.EXPECT().MakeSale(gomock.Any(), gomock.Any()).Times(1).Return(nil)
+	response := httptest.NewRecorder()
+	request := httptest.NewRequest(
+		http.MethodPost, "/api/api_v1/sale/make?cash_register=2&payment_method=card",
+		strings.NewReader(" [{\"article\":\"9\",\"price\":1330,\"amount\":6}]"))
+
+	service.EXPECT().MakeSale(gomock.Any(), dto.CashRegisterProducts{CashRegister: 2, PaymentMethod: payment.Card,
+		Products: []dto.ArticlePriceAmount{{Article: "9", Price: 1330, Amount: 6}}}).Times(1).Return(receipt.ID(2), nil)
 
 	mux.ServeHTTP(response, request)
 	if response.Code != http.StatusCreated {
@@ -772,6 +896,26 @@ func TestHandler_MakeLocalSaleSuccess(t *testing.T) {
 	}
 }
 
+func TestHandler_MakeLocalSaleLegacyArrayWithoutCashRegister(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	mux := chi.NewRouter()
+	service := mockService.NewMockInterface(ctrl)
+	mux.HandleFunc("/api/api_v1/sale/make", New(service, time.Second).MakeLocalSale)
+
+	response := httptest.NewRecorder()
+	request := httptest.NewRequest(
+		http.MethodPost, "/api/api_v1/sale/make",
+		strings.NewReader("[{\"article\":\"9\",\"price\":1330,\"amount\":6}]"))
+
+	service.EXPECT().MakeSale(gomock.Any(), gomock.Any()).Times(0)
+
+	mux.ServeHTTP(response, request)
+	if response.Code != http.StatusBadRequest {
+		t.Fail()
+	}
+}
+
 func TestHandler_MakeLocalSaleNoProducts(t *testing.T) {
 	t.Parallel()
 	ctrl := gomock.NewController(t)
@@ -798,9 +942,11 @@ func TestHandler_MakeLocalSaleTimeout(t *testing.T) {
 	response := httptest.NewRecorder()
 	request := httptest.NewRequest(
 		http.MethodPost, "/api/api_v1/sale/make",
-		strings.NewReader("[{\"article\":\"9\",\"price\":1330,\"amount\":6},{\"article\":\"1\",\"price\":3530,\"amount\":5}]"))
+		strings.NewReader("{\"cash_register\":1,\"payment_method\":\"cash\","+
+			"\"products\":[{\"article\":\"9\",\"price\":1330,\"amount\":6},"+
+			"{\"article\":\"1\",\"price\":3530,\"amount\":5}]}"))
 
-	service.EXPECT().MakeSale(gomock.Any(), gomock.Any()).Times(1).Return(repository.ErrTimeout)
+	service.EXPECT().MakeSale(gomock.Any(), gomock.Any()).Times(1).Return(receipt.ID(0), repository.ErrTimeout)
 
 	mux.ServeHTTP(response, request)
 	if response.Code != http.StatusRequestTimeout {
@@ -818,7 +964,8 @@ func TestHandler_MakeLocalSaleErrorData(t *testing.T) {
 	response := httptest.NewRecorder()
 	request := httptest.NewRequest(
 		http.MethodPost, "/api/api_v1/sale/make",
-		strings.NewReader("[{\"article\":\"9,\"pri\":1330,\"amt\":6},{\"article\":\"1\",\"price\":3530,\"amount\":5}]"))
+		strings.NewReader("{\"cash_register\":1,\"payment_method\":\"cash\","+
+			"\"products\":[{\"article\":\"9,\"pri\":1330,\"amt\":6}]}"))
 
 	mux.ServeHTTP(response, request)
 	if response.Code != http.StatusBadRequest {
@@ -835,9 +982,10 @@ func TestHandler_FinishOrderSuccess(t *testing.T) {
 
 	response := httptest.NewRecorder()
 	request := httptest.NewRequest(http.MethodPut, "/api/api_v1/reservation/finish",
-		strings.NewReader("{\"order_number\": 9}"))
+		strings.NewReader("{\"order_number\": 9, \"payment_method\": \"card\"}"))
 
-	service.EXPECT().FinishOrder(gomock.Any(), dto.Number{OrderNumber: 9}).Times(1).Return(nil)
+	service.EXPECT().FinishOrder(gomock.Any(),
+		dto.NumberPaymentMethod{OrderNumber: 9, PaymentMethod: payment.Card}).Times(1).Return(receipt.ID(1), nil)
 
 	mux.ServeHTTP(response, request)
 	if response.Code != http.StatusOK {
@@ -888,12 +1036,95 @@ func TestHandler_FinishOrderTimeout(t *testing.T) {
 
 	response := httptest.NewRecorder()
 	request := httptest.NewRequest(http.MethodPut, "/api/api_v1/reservation/finish",
-		strings.NewReader("{\"order_number\": 9}"))
+		strings.NewReader("{\"order_number\": 9, \"payment_method\": \"card\"}"))
 
-	service.EXPECT().FinishOrder(gomock.Any(), dto.Number{OrderNumber: 9}).Times(1).Return(repository.ErrTimeout)
+	service.EXPECT().FinishOrder(gomock.Any(),
+		dto.NumberPaymentMethod{OrderNumber: 9, PaymentMethod: payment.Card}).Times(1).Return(receipt.ID(0),
+		repository.ErrTimeout)
 
 	mux.ServeHTTP(response, request)
 	if response.Code != http.StatusRequestTimeout {
 		t.Fail()
 	}
 }
+
+func TestHandler_MakeLocalSaleReturnsReceiptID(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	mux := chi.NewRouter()
+	service := mockService.NewMockInterface(ctrl)
+	mux.HandleFunc("/api/api_v1/sale/make", New(service, time.Second).MakeLocalSale)
+
+	response := httptest.NewRecorder()
+	request := httptest.NewRequest(
+		http.MethodPost, "/api/api_v1/sale/make",
+		strings.NewReader("{\"cash_register\":1,\"payment_method\":\"cash\","+
+			"\"products\":[{\"article\":\"9\",\"price\":1330,\"amount\":6}]}"))
+
+	service.EXPECT().MakeSale(gomock.Any(), dto.CashRegisterProducts{CashRegister: 1, PaymentMethod: payment.Cash,
+		Products: []dto.ArticlePriceAmount{{Article: "9", Price: 1330, Amount: 6}}}).Times(1).Return(receipt.ID(15), nil)
+
+	mux.ServeHTTP(response, request)
+	if response.Code != http.StatusCreated || strings.Compare(response.Body.String(), "{\"receipt_id\":15}\n") != 0 {
+		t.Fail()
+	}
+}
+
+func TestHandler_ReceiptSuccess(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	mux := chi.NewRouter()
+	service := mockService.NewMockInterface(ctrl)
+	mux.HandleFunc("/api/api_v1/receipt/", New(service, time.Second).Receipt)
+	service.EXPECT().Receipt(gomock.Any(), dto.ReceiptID{ID: 15}).Times(1).Return(
+		dto.Receipt{ID: 15, CashRegister: 1, Total: 1330,
+			Products: []dto.ArticlePriceAmount{{Article: "9", Price: 1330, Amount: 1}}}, nil)
+
+	response := httptest.NewRecorder()
+	request := httptest.NewRequest(http.MethodGet, "/api/api_v1/receipt/", nil)
+	request.Form = url.Values{}
+	request.Form.Set("id", "15")
+
+	mux.ServeHTTP(response, request)
+	if response.Code != http.StatusOK {
+		t.Fail()
+	}
+}
+
+func TestHandler_ReceiptIncorrectID(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	mux := chi.NewRouter()
+	service := mockService.NewMockInterface(ctrl)
+	mux.HandleFunc("/api/api_v1/receipt/", New(service, time.Second).Receipt)
+
+	response := httptest.NewRecorder()
+	request := httptest.NewRequest(http.MethodGet, "/api/api_v1/receipt/", nil)
+	request.Form = url.Values{}
+	request.Form.Set("id", "fifteen")
+
+	mux.ServeHTTP(response, request)
+	if response.Code != http.StatusBadRequest {
+		t.Fail()
+	}
+}
+
+func TestHandler_ReceiptNoRecord(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	mux := chi.NewRouter()
+	service := mockService.NewMockInterface(ctrl)
+	mux.HandleFunc("/api/api_v1/receipt/", New(service, time.Second).Receipt)
+	service.EXPECT().Receipt(gomock.Any(), dto.ReceiptID{ID: 15}).Times(1).Return(dto.Receipt{},
+		repository.ErrNoRecord)
+
+	response := httptest.NewRecorder()
+	request := httptest.NewRequest(http.MethodGet, "/api/api_v1/receipt/", nil)
+	request.Form = url.Values{}
+	request.Form.Set("id", "15")
+
+	mux.ServeHTTP(response, request)
+	if response.Code != http.StatusNotFound {
+		t.Fail()
+	}
+}
@@ -0,0 +1,61 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/go-chi/render"
+	"github.com/lazylex/watch-store-store/internal/adapters/rest/response"
+	"github.com/lazylex/watch-store-store/internal/domain/aggregates/refund"
+	"github.com/lazylex/watch-store-store/internal/dto"
+	"github.com/lazylex/watch-store-store/internal/logger"
+	"log/slog"
+	"net/http"
+)
+
+// ReturnSale оформляет возврат товаров, проданных по чеку. Товары возвращаются в продажу. В теле запроса передается
+// идентификатор чека, номер кассы, на которой оформляется возврат, и возвращаемые товары в формате JSON. Для товаров,
+// учитываемых по серийным номерам, в массиве serials передаются номера возвращаемых экземпляров. Пример передаваемых
+// данных:
+//
+//	{
+//		"receipt_id": 1517,
+//		"cash_register": 1,
+//		"products": [{"article": "9", "amount": 1}]
+//	}
+//
+// В случае успеха возвращается http.StatusCreated и идентификатор возврата:
+//
+// {"refund_id": 12}
+func (h *Handler) ReturnSale(w http.ResponseWriter, r *http.Request) {
+	var err error
+	var id refund.ID
+	var transferObject dto.Refund
+	log := logger.AddPlaceAndRequestId(slog.Default(), "rest.handlers.ReturnSale", r)
+
+	ctx, cancel := context.WithTimeout(r.Context(), h.queryTimeout)
+	defer cancel()
+
+	err = json.NewDecoder(r.Body).Decode(&transferObject)
+	if err != nil {
+		response.WriteHeaderAndLogAboutBadRequest(w, log, err)
+		return
+	}
+
+	err = h.resolveBarcodes(injectRequestIDToCtx(ctx, r), articlesOfAmounts(transferObject.Products)...)
+	if response.WriteHeaderAndLogAboutErr(w, log, err); err != nil {
+		return
+	}
+
+	err = transferObject.Validate()
+	if response.WriteHeaderAndLogAboutErr(w, log, err); err != nil {
+		return
+	}
+
+	id, err = h.service.ReturnSale(injectRequestIDToCtx(ctx, r), transferObject)
+	if response.WriteHeaderAndLogAboutErr(w, log, err); err == nil {
+		render.Status(r, http.StatusCreated)
+		render.JSON(w, r, dto.RefundID{ID: id})
+		log.Info(fmt.Sprintf("refund %d on receipt %d", id, transferObject.ReceiptID))
+	}
+}
@@ -0,0 +1,71 @@
+package handlers
+
+import (
+	"github.com/go-chi/chi"
+	"github.com/golang/mock/gomock"
+	"github.com/lazylex/watch-store-store/internal/domain/aggregates/refund"
+	"github.com/lazylex/watch-store-store/internal/dto"
+	"github.com/lazylex/watch-store-store/internal/ports/service"
+	mockService "github.com/lazylex/watch-store-store/internal/ports/service/mocks"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestHandler_ReturnSaleSuccess(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	mux := chi.NewRouter()
+	mock := mockService.NewMockInterface(ctrl)
+	mux.HandleFunc("/api/api_v1/sale/return", New(mock, time.Second).ReturnSale)
+
+	response := httptest.NewRecorder()
+	request := httptest.NewRequest(http.MethodPost, "/api/api_v1/sale/return",
+		strings.NewReader("{\"receipt_id\":15,\"cash_register\":1,\"products\":[{\"article\":\"9\",\"amount\":1}]}"))
+
+	mock.EXPECT().ReturnSale(gomock.Any(), dto.Refund{ReceiptID: 15, CashRegister: 1,
+		Products: []dto.ArticleAmount{{Article: "9", Amount: 1}}}).Times(1).Return(refund.ID(3), nil)
+
+	mux.ServeHTTP(response, request)
+	if response.Code != http.StatusCreated || strings.Compare(response.Body.String(), "{\"refund_id\":3}\n") != 0 {
+		t.Fail()
+	}
+}
+
+func TestHandler_ReturnSaleNoProducts(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	mux := chi.NewRouter()
+	mock := mockService.NewMockInterface(ctrl)
+	mux.HandleFunc("/api/api_v1/sale/return", New(mock, time.Second).ReturnSale)
+
+	response := httptest.NewRecorder()
+	request := httptest.NewRequest(http.MethodPost, "/api/api_v1/sale/return",
+		strings.NewReader("{\"receipt_id\":15,\"cash_register\":1,\"products\":[]}"))
+
+	mux.ServeHTTP(response, request)
+	if response.Code != http.StatusBadRequest {
+		t.Fail()
+	}
+}
+
+func TestHandler_ReturnSaleExceedsSold(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	mux := chi.NewRouter()
+	mock := mockService.NewMockInterface(ctrl)
+	mux.HandleFunc("/api/api_v1/sale/return", New(mock, time.Second).ReturnSale)
+
+	response := httptest.NewRecorder()
+	request := httptest.NewRequest(http.MethodPost, "/api/api_v1/sale/return",
+		strings.NewReader("{\"receipt_id\":15,\"cash_register\":1,\"products\":[{\"article\":\"9\",\"amount\":5}]}"))
+
+	mock.EXPECT().ReturnSale(gomock.Any(), gomock.Any()).Times(1).Return(refund.ID(0), service.ErrRefundExceedsSold)
+
+	mux.ServeHTTP(response, request)
+	if response.Code != http.StatusConflict {
+		t.Fail()
+	}
+}
@@ -0,0 +1,121 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/go-chi/render"
+	"github.com/lazylex/watch-store-store/internal/adapters/rest/request"
+	"github.com/lazylex/watch-store-store/internal/adapters/rest/response"
+	"github.com/lazylex/watch-store-store/internal/domain/value_objects/article"
+	"github.com/lazylex/watch-store-store/internal/dto"
+	"github.com/lazylex/watch-store-store/internal/logger"
+	"log/slog"
+	"net/http"
+	"strconv"
+)
+
+// SetReplenishmentSetting сохраняет срок поставки товара в днях и страховой запас. В теле запроса передаются данные в
+// формате JSON. Пример передаваемых данных:
+//
+//	{"article": "CA-F91W", "lead_time_days": 10, "safety_stock": 3}
+func (h *Handler) SetReplenishmentSetting(w http.ResponseWriter, r *http.Request) {
+	var err error
+	var transferObject dto.ReplenishmentSetting
+	log := logger.AddPlaceAndRequestId(slog.Default(), "rest.handlers.SetReplenishmentSetting", r)
+
+	ctx, cancel := context.WithTimeout(r.Context(), h.queryTimeout)
+	defer cancel()
+
+	if err = json.NewDecoder(r.Body).Decode(&transferObject); err != nil {
+		response.WriteHeaderAndLogAboutBadRequest(w, log, err)
+		return
+	}
+
+	err = h.resolveBarcodes(injectRequestIDToCtx(ctx, r), &transferObject.Article)
+	if response.WriteHeaderAndLogAboutErr(w, log, err); err != nil {
+		return
+	}
+
+	err = transferObject.Validate()
+	if response.WriteHeaderAndLogAboutErr(w, log, err); err != nil {
+		return
+	}
+
+	err = h.service.SetReplenishmentSetting(injectRequestIDToCtx(ctx, r), transferObject)
+	if response.WriteHeaderAndLogAboutErr(w, log, err); err != nil {
+		return
+	}
+
+	log.Info(fmt.Sprintf("replenishment setting of article %s saved", transferObject.Article))
+}
+
+// ReplenishmentSetting возвращает срок поставки и страховой запас товара с переданным параметром запроса (article)
+// артикулом. Если параметры пополнения для товара не сохранялись, возвращается код 404. Пример возвращаемых данных:
+//
+//	{"article": "CA-F91W", "lead_time_days": 10, "safety_stock": 3}
+func (h *Handler) ReplenishmentSetting(w http.ResponseWriter, r *http.Request) {
+	var err error
+	var result dto.ReplenishmentSetting
+	log := logger.AddPlaceAndRequestId(slog.Default(), "rest.handlers.ReplenishmentSetting", r)
+
+	ctx, cancel := context.WithTimeout(r.Context(), h.queryTimeout)
+	defer cancel()
+
+	transferObject := dto.Article{Article: article.Article(r.FormValue(request.Article))}
+
+	err = h.resolveBarcodes(injectRequestIDToCtx(ctx, r), &transferObject.Article)
+	if response.WriteHeaderAndLogAboutErr(w, log, err); err != nil {
+		return
+	}
+
+	err = transferObject.Validate()
+	if response.WriteHeaderAndLogAboutErr(w, log, err); err != nil {
+		return
+	}
+
+	result, err = h.service.ReplenishmentSetting(injectRequestIDToCtx(ctx, r), transferObject)
+	if response.WriteHeaderAndLogAboutErr(w, log, err); err != nil {
+		return
+	}
+
+	log.Info(fmt.Sprintf("requested replenishment setting of article %s", transferObject.Article))
+
+	render.JSON(w, r, result)
+}
+
+// ReorderSuggestions возвращает список товаров, которые необходимо заказать, исходя из скорости продаж за последние
+// window_days дней (необязательный параметр запроса, по умолчанию используется значение из конфигурации). Пример
+// возвращаемых данных:
+//
+//	[
+//		{"article": "CA-F91W", "in_stock": 2, "velocity": 1.5, "days_of_cover": 1.33, "lead_time_days": 7,
+//		 "safety_stock": 3, "reorder_point": 13.5, "quantity": 33}
+//	]
+func (h *Handler) ReorderSuggestions(w http.ResponseWriter, r *http.Request) {
+	var err error
+	var transferObject dto.WindowDays
+	var result []dto.ReorderSuggestion
+	log := logger.AddPlaceAndRequestId(slog.Default(), "rest.handlers.ReorderSuggestions", r)
+
+	ctx, cancel := context.WithTimeout(r.Context(), h.queryTimeout)
+	defer cancel()
+
+	if windowParam := r.FormValue(request.Window); len(windowParam) > 0 {
+		var window uint64
+		if window, err = strconv.ParseUint(windowParam, 10, 32); err != nil || window == 0 {
+			response.WriteHeaderAndLogAboutBadRequest(w, log, request.ErrIncorrectWindow)
+			return
+		}
+		transferObject.WindowDays = uint(window)
+	}
+
+	result, err = h.service.ReorderSuggestions(injectRequestIDToCtx(ctx, r), transferObject)
+	if response.WriteHeaderAndLogAboutErr(w, log, err); err != nil {
+		return
+	}
+
+	log.Info(fmt.Sprintf("requested reorder suggestions, %d articles", len(result)))
+
+	render.JSON(w, r, result)
+}
@@ -0,0 +1,116 @@
+package handlers
+
+import (
+	"github.com/go-chi/chi"
+	"github.com/golang/mock/gomock"
+	"github.com/lazylex/watch-store-store/internal/dto"
+	"github.com/lazylex/watch-store-store/internal/ports/repository"
+	mockService "github.com/lazylex/watch-store-store/internal/ports/service/mocks"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestHandler_SetReplenishmentSetting(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	mux := chi.NewRouter()
+	mock := mockService.NewMockInterface(ctrl)
+	mux.HandleFunc("/api/api_v1/replenishment/setting", New(mock, time.Second).SetReplenishmentSetting)
+
+	response := httptest.NewRecorder()
+	request := httptest.NewRequest(http.MethodPut, "/api/api_v1/replenishment/setting",
+		strings.NewReader("{\"article\":\"CA-F91W\",\"lead_time_days\":10,\"safety_stock\":3}"))
+
+	mock.EXPECT().SetReplenishmentSetting(gomock.Any(),
+		dto.ReplenishmentSetting{Article: "CA-F91W", LeadTimeDays: 10, SafetyStock: 3}).Times(1).Return(nil)
+
+	mux.ServeHTTP(response, request)
+	if response.Code != http.StatusOK {
+		t.Fail()
+	}
+}
+
+func TestHandler_SetReplenishmentSettingZeroLeadTime(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	mux := chi.NewRouter()
+	mock := mockService.NewMockInterface(ctrl)
+	mux.HandleFunc("/api/api_v1/replenishment/setting", New(mock, time.Second).SetReplenishmentSetting)
+
+	response := httptest.NewRecorder()
+	request := httptest.NewRequest(http.MethodPut, "/api/api_v1/replenishment/setting",
+		strings.NewReader("{\"article\":\"CA-F91W\",\"safety_stock\":3}"))
+
+	mock.EXPECT().SetReplenishmentSetting(gomock.Any(), gomock.Any()).Times(0)
+
+	mux.ServeHTTP(response, request)
+	if response.Code != http.StatusBadRequest {
+		t.Fail()
+	}
+}
+
+func TestHandler_ReplenishmentSettingNotFound(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	mux := chi.NewRouter()
+	mock := mockService.NewMockInterface(ctrl)
+	mux.HandleFunc("/api/api_v1/replenishment/setting/", New(mock, time.Second).ReplenishmentSetting)
+
+	response := httptest.NewRecorder()
+	request := httptest.NewRequest(http.MethodGet, "/api/api_v1/replenishment/setting/", nil)
+	request.Form = url.Values{}
+	request.Form.Set("article", "CA-F91W")
+
+	mock.EXPECT().ReplenishmentSetting(gomock.Any(), dto.Article{Article: "CA-F91W"}).Times(1).Return(
+		dto.ReplenishmentSetting{}, repository.ErrNoRecord)
+
+	mux.ServeHTTP(response, request)
+	if response.Code != http.StatusNotFound {
+		t.Fail()
+	}
+}
+
+func TestHandler_ReorderSuggestions(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	mux := chi.NewRouter()
+	mock := mockService.NewMockInterface(ctrl)
+	mux.HandleFunc("/api/api_v1/replenishment/suggestions/", New(mock, time.Second).ReorderSuggestions)
+
+	response := httptest.NewRecorder()
+	request := httptest.NewRequest(http.MethodGet, "/api/api_v1/replenishment/suggestions/", nil)
+	request.Form = url.Values{}
+	request.Form.Set("window_days", "14")
+
+	mock.EXPECT().ReorderSuggestions(gomock.Any(), dto.WindowDays{WindowDays: 14}).Times(1).Return(
+		[]dto.ReorderSuggestion{{Article: "CA-F91W", Quantity: 5}}, nil)
+
+	mux.ServeHTTP(response, request)
+	if response.Code != http.StatusOK || !strings.Contains(response.Body.String(), "\"quantity\":5") {
+		t.Fail()
+	}
+}
+
+func TestHandler_ReorderSuggestionsIncorrectWindow(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	mux := chi.NewRouter()
+	mock := mockService.NewMockInterface(ctrl)
+	mux.HandleFunc("/api/api_v1/replenishment/suggestions/", New(mock, time.Second).ReorderSuggestions)
+
+	response := httptest.NewRecorder()
+	request := httptest.NewRequest(http.MethodGet, "/api/api_v1/replenishment/suggestions/", nil)
+	request.Form = url.Values{}
+	request.Form.Set("window_days", "-1")
+
+	mock.EXPECT().ReorderSuggestions(gomock.Any(), gomock.Any()).Times(0)
+
+	mux.ServeHTTP(response, request)
+	if response.Code != http.StatusBadRequest {
+		t.Fail()
+	}
+}
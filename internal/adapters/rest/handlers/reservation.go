@@ -0,0 +1,135 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/go-chi/render"
+	"github.com/lazylex/watch-store-store/internal/adapters/rest/request"
+	"github.com/lazylex/watch-store-store/internal/adapters/rest/response"
+	"github.com/lazylex/watch-store-store/internal/domain/aggregates/reservation"
+	"github.com/lazylex/watch-store-store/internal/domain/value_objects/phone"
+	"github.com/lazylex/watch-store-store/internal/dto"
+	"github.com/lazylex/watch-store-store/internal/logger"
+	"log/slog"
+	"net/http"
+	"strconv"
+)
+
+// MarkReadyForPickup помечает заказ, как собранный и ожидающий покупателя в магазине. Номер заказа передаётся в теле
+// запроса в формате JSON:
+//
+// {"order_number": 150}
+//
+// В случае успеха возвращается http.StatusOK. Если заказ находится в состоянии, из которого переход недопустим
+// (например, уже выполнен), возвращается http.StatusConflict.
+func (h *Handler) MarkReadyForPickup(w http.ResponseWriter, r *http.Request) {
+	h.changeReservationState(w, r, "rest.handlers.MarkReadyForPickup", h.service.MarkReadyForPickup)
+}
+
+// ShipOrder помечает заказ, как переданный в доставку. Формат запроса и ответы совпадают с MarkReadyForPickup.
+func (h *Handler) ShipOrder(w http.ResponseWriter, r *http.Request) {
+	h.changeReservationState(w, r, "rest.handlers.ShipOrder", h.service.ShipOrder)
+}
+
+// changeReservationState декодирует номер заказа из тела запроса и передаёт его в функцию сервиса change, переводящую
+// заказ в новое состояние.
+func (h *Handler) changeReservationState(w http.ResponseWriter, r *http.Request, place string,
+	change func(context.Context, dto.Number) error) {
+	var err error
+	var transferObject dto.Number
+	log := logger.AddPlaceAndRequestId(slog.Default(), place, r)
+
+	ctx, cancel := context.WithTimeout(r.Context(), h.queryTimeout)
+	defer cancel()
+
+	err = json.NewDecoder(r.Body).Decode(&transferObject)
+	if err != nil {
+		response.WriteHeaderAndLogAboutBadRequest(w, log, err)
+		return
+	}
+
+	err = transferObject.Validate()
+	if response.WriteHeaderAndLogAboutErr(w, log, err); err != nil {
+		return
+	}
+
+	err = change(injectRequestIDToCtx(ctx, r), transferObject)
+	if response.WriteHeaderAndLogAboutErr(w, log, err); err == nil {
+		log.Info(fmt.Sprintf("state of order %d changed", transferObject.OrderNumber))
+	}
+}
+
+// ReservationHistory возвращает в формате JSON историю смены состояний заказа с переданным в параметре запроса
+// (order_number) номером. Для создания заказа поле from равно нулю. Пример возвращаемых данных:
+//
+//	[
+//		{"order_number": 150, "from": 0, "to": 3, "actor": "system", "date": "2024-06-05T10:12:44Z"},
+//		{"order_number": 150, "from": 3, "to": 7, "actor": "ivanova", "date": "2024-06-05T15:40:02Z"}
+//	]
+func (h *Handler) ReservationHistory(w http.ResponseWriter, r *http.Request) {
+	var err error
+	var number int64
+	var result []dto.ReservationTransition
+	log := logger.AddPlaceAndRequestId(slog.Default(), "rest.handlers.ReservationHistory", r)
+
+	ctx, cancel := context.WithTimeout(r.Context(), h.queryTimeout)
+	defer cancel()
+
+	if number, err = strconv.ParseInt(r.FormValue(request.Order), 10, 64); err != nil {
+		response.WriteHeaderAndLogAboutBadRequest(w, log, request.ErrIncorrectOrderNumber)
+		return
+	}
+
+	transferObject := dto.Number{OrderNumber: reservation.OrderNumber(number)}
+	err = transferObject.Validate()
+	if response.WriteHeaderAndLogAboutErr(w, log, err); err != nil {
+		return
+	}
+
+	result, err = h.service.ReservationHistory(injectRequestIDToCtx(ctx, r), transferObject)
+	if response.WriteHeaderAndLogAboutErr(w, log, err); err != nil {
+		return
+	}
+
+	log.Info(fmt.Sprintf("requested history of order %d", number))
+
+	render.JSON(w, r, result)
+}
+
+// OpenReservationsByPhone возвращает в формате JSON невыполненные и неотменённые заказы покупателя с переданным в
+// параметре запроса (phone) номером телефона. Номер может содержать пробелы, скобки, дефисы и ведущий '+'. Пример
+// возвращаемых данных:
+//
+//	[
+//		{
+//			"order_number": 13,
+//			"date": "2024-06-05T10:12:44Z",
+//			"state": 3,
+//			"customer": {"name": "Иван Петров", "phone": "79123456789"},
+//			"products": [{"article": "9", "price": 1330, "amount": 6}]
+//		}
+//	]
+func (h *Handler) OpenReservationsByPhone(w http.ResponseWriter, r *http.Request) {
+	var err error
+	var result []dto.NumberDateStateProducts
+	log := logger.AddPlaceAndRequestId(slog.Default(), "rest.handlers.OpenReservationsByPhone", r)
+
+	ctx, cancel := context.WithTimeout(r.Context(), h.queryTimeout)
+	defer cancel()
+
+	transferObject := dto.Phone{Phone: phone.Phone(r.FormValue(request.Phone))}
+	if err = transferObject.Validate(); err != nil {
+		response.WriteHeaderAndLogAboutBadRequest(w, log, request.ErrIncorrectPhone)
+		return
+	}
+
+	result, err = h.service.OpenReservationsByPhone(injectRequestIDToCtx(ctx, r), transferObject)
+	if response.WriteHeaderAndLogAboutErr(w, log, err); err != nil {
+		return
+	}
+
+	log.Info(fmt.Sprintf("requested open orders of phone %s, %d found", transferObject.Phone.Redacted(), len(result)))
+
+	render.JSON(w, r, result)
+}
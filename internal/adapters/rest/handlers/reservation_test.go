@@ -0,0 +1,178 @@
+package handlers
+
+import (
+	"fmt"
+	"github.com/go-chi/chi"
+	"github.com/golang/mock/gomock"
+	"github.com/lazylex/watch-store-store/internal/domain/aggregates/receipt"
+	"github.com/lazylex/watch-store-store/internal/domain/aggregates/reservation"
+	"github.com/lazylex/watch-store-store/internal/dto"
+	"github.com/lazylex/watch-store-store/internal/ports/service"
+	mockService "github.com/lazylex/watch-store-store/internal/ports/service/mocks"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestHandler_MarkReadyForPickup(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	mux := chi.NewRouter()
+	mock := mockService.NewMockInterface(ctrl)
+	mux.HandleFunc("/api/api_v1/reservation/ready", New(mock, time.Second).MarkReadyForPickup)
+
+	response := httptest.NewRecorder()
+	request := httptest.NewRequest(http.MethodPut, "/api/api_v1/reservation/ready",
+		strings.NewReader("{\"order_number\":150}"))
+
+	mock.EXPECT().MarkReadyForPickup(gomock.Any(), dto.Number{OrderNumber: 150}).Times(1).Return(nil)
+
+	mux.ServeHTTP(response, request)
+	if response.Code != http.StatusOK {
+		t.Fail()
+	}
+}
+
+func TestHandler_ShipOrderIllegalTransition(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	mux := chi.NewRouter()
+	mock := mockService.NewMockInterface(ctrl)
+	mux.HandleFunc("/api/api_v1/reservation/ship", New(mock, time.Second).ShipOrder)
+
+	response := httptest.NewRecorder()
+	request := httptest.NewRequest(http.MethodPut, "/api/api_v1/reservation/ship",
+		strings.NewReader("{\"order_number\":150}"))
+
+	mock.EXPECT().ShipOrder(gomock.Any(), dto.Number{OrderNumber: 150}).Times(1).Return(
+		&reservation.TransitionError{From: reservation.NewForLocalCustomer, To: reservation.Shipped})
+
+	mux.ServeHTTP(response, request)
+	if response.Code != http.StatusConflict {
+		t.Fail()
+	}
+}
+
+func TestHandler_ShipOrderAlreadyProcessed(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	mux := chi.NewRouter()
+	mock := mockService.NewMockInterface(ctrl)
+	mux.HandleFunc("/api/api_v1/reservation/ship", New(mock, time.Second).ShipOrder)
+
+	response := httptest.NewRecorder()
+	request := httptest.NewRequest(http.MethodPut, "/api/api_v1/reservation/ship",
+		strings.NewReader("{\"order_number\":150}"))
+
+	mock.EXPECT().ShipOrder(gomock.Any(), gomock.Any()).Times(1).Return(
+		fmt.Errorf("%w: %w", service.ErrAlreadyProcessed, reservation.ErrIllegalTransition))
+
+	mux.ServeHTTP(response, request)
+	if response.Code != http.StatusConflict {
+		t.Fail()
+	}
+}
+
+func TestHandler_ReservationHistory(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	mux := chi.NewRouter()
+	mock := mockService.NewMockInterface(ctrl)
+	mux.HandleFunc("/api/api_v1/reservation/history/", New(mock, time.Second).ReservationHistory)
+	mock.EXPECT().ReservationHistory(gomock.Any(), dto.Number{OrderNumber: 150}).Times(1).Return(
+		[]dto.ReservationTransition{{OrderNumber: 150, From: reservation.NewForInternetCustomer,
+			To: reservation.Shipped, Actor: "ivanova"}}, nil)
+
+	response := httptest.NewRecorder()
+	request := httptest.NewRequest(http.MethodGet, "/api/api_v1/reservation/history/", nil)
+	request.Form = url.Values{}
+	request.Form.Set("order_number", "150")
+
+	mux.ServeHTTP(response, request)
+	if response.Code != http.StatusOK || !strings.Contains(response.Body.String(), "\"actor\":\"ivanova\"") {
+		t.Fail()
+	}
+}
+
+func TestHandler_ReservationHistoryIncorrectNumber(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	mux := chi.NewRouter()
+	mock := mockService.NewMockInterface(ctrl)
+	mux.HandleFunc("/api/api_v1/reservation/history/", New(mock, time.Second).ReservationHistory)
+	mock.EXPECT().ReservationHistory(gomock.Any(), gomock.Any()).Times(0)
+
+	response := httptest.NewRecorder()
+	request := httptest.NewRequest(http.MethodGet, "/api/api_v1/reservation/history/", nil)
+	request.Form = url.Values{}
+	request.Form.Set("order_number", "abc")
+
+	mux.ServeHTTP(response, request)
+	if response.Code != http.StatusBadRequest {
+		t.Fail()
+	}
+}
+
+func TestHandler_OpenReservationsByPhone(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	mux := chi.NewRouter()
+	mock := mockService.NewMockInterface(ctrl)
+	mux.HandleFunc("/api/api_v1/reservation/by-phone/", New(mock, time.Second).OpenReservationsByPhone)
+	mock.EXPECT().OpenReservationsByPhone(gomock.Any(), dto.Phone{Phone: "+7 (912) 345-67-89"}).Times(1).Return(
+		[]dto.NumberDateStateProducts{{OrderNumber: 13, State: reservation.ReadyForPickup,
+			Customer: &dto.Customer{Name: "Иван", Phone: "79123456789"}}}, nil)
+
+	response := httptest.NewRecorder()
+	request := httptest.NewRequest(http.MethodGet, "/api/api_v1/reservation/by-phone/", nil)
+	request.Form = url.Values{}
+	request.Form.Set("phone", "+7 (912) 345-67-89")
+
+	mux.ServeHTTP(response, request)
+	if response.Code != http.StatusOK || !strings.Contains(response.Body.String(), "\"order_number\":13") {
+		t.Errorf("unexpected response %d %s", response.Code, response.Body.String())
+	}
+}
+
+func TestHandler_OpenReservationsByPhoneIncorrectPhone(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	mux := chi.NewRouter()
+	mock := mockService.NewMockInterface(ctrl)
+	mux.HandleFunc("/api/api_v1/reservation/by-phone/", New(mock, time.Second).OpenReservationsByPhone)
+	mock.EXPECT().OpenReservationsByPhone(gomock.Any(), gomock.Any()).Times(0)
+
+	for _, value := range []string{"", "12345", "phone-number"} {
+		response := httptest.NewRecorder()
+		request := httptest.NewRequest(http.MethodGet, "/api/api_v1/reservation/by-phone/", nil)
+		request.Form = url.Values{}
+		request.Form.Set("phone", value)
+
+		mux.ServeHTTP(response, request)
+		if response.Code != http.StatusBadRequest {
+			t.Errorf("phone %q: expected %d, got %d", value, http.StatusBadRequest, response.Code)
+		}
+	}
+}
+
+func TestHandler_FinishOrderWrongPickupCode(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	mux := chi.NewRouter()
+	mock := mockService.NewMockInterface(ctrl)
+	mux.HandleFunc("/api/api_v1/reservation/finish", New(mock, time.Second).FinishOrder)
+	mock.EXPECT().FinishOrder(gomock.Any(), dto.NumberPaymentMethod{OrderNumber: 13, PaymentMethod: "cash",
+		PickupCode: "111111"}).Times(1).Return(receipt.ID(0), service.ErrPickupCodeMismatch)
+
+	response := httptest.NewRecorder()
+	request := httptest.NewRequest(http.MethodPut, "/api/api_v1/reservation/finish",
+		strings.NewReader("{\"order_number\":13,\"payment_method\":\"cash\",\"pickup_code\":\"111111\"}"))
+
+	mux.ServeHTTP(response, request)
+	if response.Code != http.StatusForbidden {
+		t.Errorf("expected %d, got %d", http.StatusForbidden, response.Code)
+	}
+}
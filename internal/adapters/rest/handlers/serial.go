@@ -0,0 +1,129 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/go-chi/render"
+	"github.com/lazylex/watch-store-store/internal/adapters/rest/request"
+	"github.com/lazylex/watch-store-store/internal/adapters/rest/response"
+	"github.com/lazylex/watch-store-store/internal/domain/value_objects/article"
+	"github.com/lazylex/watch-store-store/internal/domain/value_objects/serial"
+	"github.com/lazylex/watch-store-store/internal/dto"
+	"github.com/lazylex/watch-store-store/internal/logger"
+	"log/slog"
+	"net/http"
+)
+
+// SetSerialTracking включает или отключает учёт товара по серийным номерам. При включении учёта передаются серийные
+// номера всех имеющихся в продаже экземпляров товара, ещё не зарегистрированных в магазине. Если после регистрации
+// количество доступных экземпляров не совпадает с количеством товара или товар зарезервирован под невыполненные заказы,
+// возвращается код 409. При отключении учёта доступные экземпляры списываются. В теле запроса передаются данные в
+// формате JSON. Пример передаваемых данных:
+//
+//	{"article": "CA-F91W", "tracked": true, "serials": ["3A9C0412", "3A9C0413"]}
+func (h *Handler) SetSerialTracking(w http.ResponseWriter, r *http.Request) {
+	var err error
+	var transferObject dto.SerialTracking
+	log := logger.AddPlaceAndRequestId(slog.Default(), "rest.handlers.SetSerialTracking", r)
+
+	ctx, cancel := context.WithTimeout(r.Context(), h.queryTimeout)
+	defer cancel()
+
+	if err = json.NewDecoder(r.Body).Decode(&transferObject); err != nil {
+		response.WriteHeaderAndLogAboutBadRequest(w, log, err)
+		return
+	}
+
+	err = h.resolveBarcodes(injectRequestIDToCtx(ctx, r), &transferObject.Article)
+	if response.WriteHeaderAndLogAboutErr(w, log, err); err != nil {
+		return
+	}
+
+	err = transferObject.Validate()
+	if response.WriteHeaderAndLogAboutErr(w, log, err); err != nil {
+		return
+	}
+
+	err = h.service.SetSerialTracking(injectRequestIDToCtx(ctx, r), transferObject)
+	if response.WriteHeaderAndLogAboutErr(w, log, err); err != nil {
+		return
+	}
+
+	log.Info(fmt.Sprintf("serial tracking of article %s set to %t", transferObject.Article, transferObject.Tracked))
+}
+
+// AvailableSerials возвращает серийные номера доступных для продажи экземпляров товара с переданным параметром запроса
+// (article) артикулом. Пример возвращаемых данных:
+//
+//	["3A9C0412", "3A9C0413"]
+func (h *Handler) AvailableSerials(w http.ResponseWriter, r *http.Request) {
+	var err error
+	var result []serial.Number
+	log := logger.AddPlaceAndRequestId(slog.Default(), "rest.handlers.AvailableSerials", r)
+
+	ctx, cancel := context.WithTimeout(r.Context(), h.queryTimeout)
+	defer cancel()
+
+	transferObject := dto.Article{Article: article.Article(r.FormValue(request.Article))}
+
+	err = h.resolveBarcodes(injectRequestIDToCtx(ctx, r), &transferObject.Article)
+	if response.WriteHeaderAndLogAboutErr(w, log, err); err != nil {
+		return
+	}
+
+	err = transferObject.Validate()
+	if response.WriteHeaderAndLogAboutErr(w, log, err); err != nil {
+		return
+	}
+
+	result, err = h.service.AvailableSerials(injectRequestIDToCtx(ctx, r), transferObject)
+	if response.WriteHeaderAndLogAboutErr(w, log, err); err != nil {
+		return
+	}
+
+	log.Info(fmt.Sprintf("requested %d available serials of article %s", len(result), transferObject.Article))
+
+	render.JSON(w, r, result)
+}
+
+// SerialHistory возвращает текущее состояние экземпляра товара с переданным параметром запроса (serial) серийным
+// номером и историю его событий в порядке их возникновения. Если номер не зарегистрирован, возвращается код 404. Пример
+// возвращаемых данных:
+//
+//	{
+//		"serial": "3A9C0412",
+//		"article": "CA-F91W",
+//		"state": "sold",
+//		"receipt_id": 118,
+//		"events": [
+//			{"serial": "3A9C0412", "article": "CA-F91W", "state": "in_stock", "document_number": "TN-2026-0042",
+//			 "date": "2026-03-02T10:15:00Z"},
+//			{"serial": "3A9C0412", "article": "CA-F91W", "state": "sold", "receipt_id": 118,
+//			 "date": "2026-03-05T17:40:12Z"}
+//		]
+//	}
+func (h *Handler) SerialHistory(w http.ResponseWriter, r *http.Request) {
+	var err error
+	var result dto.SerialHistory
+	log := logger.AddPlaceAndRequestId(slog.Default(), "rest.handlers.SerialHistory", r)
+
+	ctx, cancel := context.WithTimeout(r.Context(), h.queryTimeout)
+	defer cancel()
+
+	transferObject := dto.Serial{Serial: serial.Number(r.FormValue(request.Serial))}
+
+	err = transferObject.Validate()
+	if response.WriteHeaderAndLogAboutErr(w, log, err); err != nil {
+		return
+	}
+
+	result, err = h.service.SerialHistory(injectRequestIDToCtx(ctx, r), transferObject)
+	if response.WriteHeaderAndLogAboutErr(w, log, err); err != nil {
+		return
+	}
+
+	log.Info(fmt.Sprintf("requested history of serial %s", transferObject.Serial))
+
+	render.JSON(w, r, result)
+}
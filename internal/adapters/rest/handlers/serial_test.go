@@ -0,0 +1,98 @@
+package handlers
+
+import (
+	"github.com/go-chi/chi"
+	"github.com/golang/mock/gomock"
+	"github.com/lazylex/watch-store-store/internal/domain/value_objects/serial"
+	"github.com/lazylex/watch-store-store/internal/dto"
+	"github.com/lazylex/watch-store-store/internal/ports/repository"
+	"github.com/lazylex/watch-store-store/internal/ports/service"
+	mockService "github.com/lazylex/watch-store-store/internal/ports/service/mocks"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestHandler_SetSerialTrackingDontMatchStock(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	mux := chi.NewRouter()
+	mock := mockService.NewMockInterface(ctrl)
+	mux.HandleFunc("/api/api_v1/serial/tracking", New(mock, time.Second).SetSerialTracking)
+
+	response := httptest.NewRecorder()
+	request := httptest.NewRequest(http.MethodPut, "/api/api_v1/serial/tracking",
+		strings.NewReader("{\"article\":\"CA-F91W\",\"tracked\":true,\"serials\":[\"3A9C0412\"]}"))
+
+	mock.EXPECT().SetSerialTracking(gomock.Any(), dto.SerialTracking{Article: "CA-F91W", Tracked: true,
+		Serials: []serial.Number{"3A9C0412"}}).Times(1).Return(service.ErrSerialsDontMatchStock)
+
+	mux.ServeHTTP(response, request)
+	if response.Code != http.StatusConflict {
+		t.Fail()
+	}
+}
+
+func TestHandler_SetSerialTrackingIncorrectSerial(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	mux := chi.NewRouter()
+	mock := mockService.NewMockInterface(ctrl)
+	mux.HandleFunc("/api/api_v1/serial/tracking", New(mock, time.Second).SetSerialTracking)
+
+	response := httptest.NewRecorder()
+	request := httptest.NewRequest(http.MethodPut, "/api/api_v1/serial/tracking",
+		strings.NewReader("{\"article\":\"CA-F91W\",\"tracked\":true,\"serials\":[\"3A 9C\"]}"))
+
+	mock.EXPECT().SetSerialTracking(gomock.Any(), gomock.Any()).Times(0)
+
+	mux.ServeHTTP(response, request)
+	if response.Code != http.StatusBadRequest {
+		t.Fail()
+	}
+}
+
+func TestHandler_AvailableSerials(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	mux := chi.NewRouter()
+	mock := mockService.NewMockInterface(ctrl)
+	mux.HandleFunc("/api/api_v1/serial/available/", New(mock, time.Second).AvailableSerials)
+
+	response := httptest.NewRecorder()
+	request := httptest.NewRequest(http.MethodGet, "/api/api_v1/serial/available/", nil)
+	request.Form = url.Values{}
+	request.Form.Set("article", "CA-F91W")
+
+	mock.EXPECT().AvailableSerials(gomock.Any(), dto.Article{Article: "CA-F91W"}).Times(1).Return(
+		[]serial.Number{"3A9C0412", "3A9C0413"}, nil)
+
+	mux.ServeHTTP(response, request)
+	if response.Code != http.StatusOK || !strings.Contains(response.Body.String(), "[\"3A9C0412\",\"3A9C0413\"]") {
+		t.Fail()
+	}
+}
+
+func TestHandler_SerialHistoryNotFound(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	mux := chi.NewRouter()
+	mock := mockService.NewMockInterface(ctrl)
+	mux.HandleFunc("/api/api_v1/serial/history/", New(mock, time.Second).SerialHistory)
+
+	response := httptest.NewRecorder()
+	request := httptest.NewRequest(http.MethodGet, "/api/api_v1/serial/history/", nil)
+	request.Form = url.Values{}
+	request.Form.Set("serial", "3A9C0412")
+
+	mock.EXPECT().SerialHistory(gomock.Any(), dto.Serial{Serial: "3A9C0412"}).Times(1).Return(
+		dto.SerialHistory{}, repository.ErrNoRecord)
+
+	mux.ServeHTTP(response, request)
+	if response.Code != http.StatusNotFound {
+		t.Fail()
+	}
+}
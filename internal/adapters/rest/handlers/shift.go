@@ -0,0 +1,136 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/go-chi/render"
+	"github.com/lazylex/watch-store-store/internal/adapters/rest/request"
+	"github.com/lazylex/watch-store-store/internal/adapters/rest/response"
+	"github.com/lazylex/watch-store-store/internal/domain/aggregates/shift"
+	"github.com/lazylex/watch-store-store/internal/dto"
+	"github.com/lazylex/watch-store-store/internal/logger"
+	"log/slog"
+	"net/http"
+	"strconv"
+)
+
+// OpenShift открывает кассовую смену. В теле запроса передается номер кассы, идентификатор кассира и сумма размена в
+// кассе на момент открытия в формате JSON. Пример передаваемых данных:
+//
+// {"cash_register": 1, "cashier_id": "ivanova", "opening_float": 5000}
+//
+// В случае успеха возвращается http.StatusCreated и идентификатор смены:
+//
+// {"shift_id": 42}
+func (h *Handler) OpenShift(w http.ResponseWriter, r *http.Request) {
+	var err error
+	var id shift.ID
+	var transferObject dto.Shift
+	log := logger.AddPlaceAndRequestId(slog.Default(), "rest.handlers.OpenShift", r)
+
+	ctx, cancel := context.WithTimeout(r.Context(), h.queryTimeout)
+	defer cancel()
+
+	err = json.NewDecoder(r.Body).Decode(&transferObject)
+	if err != nil {
+		response.WriteHeaderAndLogAboutBadRequest(w, log, err)
+		return
+	}
+
+	err = transferObject.Validate()
+	if response.WriteHeaderAndLogAboutErr(w, log, err); err != nil {
+		return
+	}
+
+	id, err = h.service.OpenShift(injectRequestIDToCtx(ctx, r), transferObject)
+	if response.WriteHeaderAndLogAboutErr(w, log, err); err == nil {
+		render.Status(r, http.StatusCreated)
+		render.JSON(w, r, dto.ShiftID{ID: id})
+		log.Info(fmt.Sprintf("shift %d opened on cash register %d", id, transferObject.CashRegister))
+	}
+}
+
+// CloseShift закрывает открытую на кассе смену и возвращает Z-отчёт. В теле запроса передается номер кассы в формате
+// JSON. Пример передаваемых данных:
+//
+// {"cash_register": 1}
+//
+// Пример возвращаемых данных:
+//
+//	{
+//		"shift_id": 42,
+//		"cash_register": 1,
+//		"cashier_id": "ivanova",
+//		"opened_at": "2024-06-14T09:00:00Z",
+//		"closed_at": "2024-06-14T21:00:00Z",
+//		"opening_float": 5000,
+//		"sales_count": 3,
+//		"sales_total": 12000,
+//		"refunds_count": 1,
+//		"refunds_total": 1000,
+//		"cash_in_drawer": 7000,
+//		"sales": [{"method": "cash", "count": 1, "total": 3000}, {"method": "card", "count": 2, "total": 9000}],
+//		"refunds": [{"method": "cash", "count": 1, "total": 1000}]
+//	}
+func (h *Handler) CloseShift(w http.ResponseWriter, r *http.Request) {
+	var err error
+	var report dto.ZReport
+	var transferObject dto.CashRegister
+	log := logger.AddPlaceAndRequestId(slog.Default(), "rest.handlers.CloseShift", r)
+
+	ctx, cancel := context.WithTimeout(r.Context(), h.queryTimeout)
+	defer cancel()
+
+	err = json.NewDecoder(r.Body).Decode(&transferObject)
+	if err != nil {
+		response.WriteHeaderAndLogAboutBadRequest(w, log, err)
+		return
+	}
+
+	err = transferObject.Validate()
+	if response.WriteHeaderAndLogAboutErr(w, log, err); err != nil {
+		return
+	}
+
+	report, err = h.service.CloseShift(injectRequestIDToCtx(ctx, r), transferObject)
+	if response.WriteHeaderAndLogAboutErr(w, log, err); err != nil {
+		return
+	}
+
+	log.Info(fmt.Sprintf("shift %d closed on cash register %d", report.ShiftID, report.CashRegister))
+
+	render.JSON(w, r, report)
+}
+
+// ZReport возвращает в формате JSON Z-отчёт смены с переданным в параметре запроса (id) идентификатором. Формат
+// возвращаемых данных совпадает с ответом CloseShift.
+func (h *Handler) ZReport(w http.ResponseWriter, r *http.Request) {
+	var err error
+	var id int64
+	var report dto.ZReport
+	log := logger.AddPlaceAndRequestId(slog.Default(), "rest.handlers.ZReport", r)
+
+	ctx, cancel := context.WithTimeout(r.Context(), h.queryTimeout)
+	defer cancel()
+
+	if id, err = strconv.ParseInt(r.FormValue(request.ID), 10, 64); err != nil {
+		response.WriteHeaderAndLogAboutBadRequest(w, log, request.ErrIncorrectID)
+		return
+	}
+
+	transferObject := dto.ShiftID{ID: shift.ID(id)}
+	err = transferObject.Validate()
+	if response.WriteHeaderAndLogAboutErr(w, log, err); err != nil {
+		return
+	}
+
+	report, err = h.service.ZReport(injectRequestIDToCtx(ctx, r), transferObject)
+	if response.WriteHeaderAndLogAboutErr(w, log, err); err != nil {
+		return
+	}
+
+	log.Info(fmt.Sprintf("requested z-report of shift %d", id))
+
+	render.JSON(w, r, report)
+}
@@ -0,0 +1,150 @@
+package handlers
+
+import (
+	"github.com/go-chi/chi"
+	"github.com/golang/mock/gomock"
+	"github.com/lazylex/watch-store-store/internal/domain/aggregates/shift"
+	"github.com/lazylex/watch-store-store/internal/dto"
+	"github.com/lazylex/watch-store-store/internal/ports/repository"
+	"github.com/lazylex/watch-store-store/internal/ports/service"
+	mockService "github.com/lazylex/watch-store-store/internal/ports/service/mocks"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestHandler_OpenShiftSuccess(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	mux := chi.NewRouter()
+	mock := mockService.NewMockInterface(ctrl)
+	mux.HandleFunc("/api/api_v1/shift/open", New(mock, time.Second).OpenShift)
+
+	response := httptest.NewRecorder()
+	request := httptest.NewRequest(http.MethodPost, "/api/api_v1/shift/open",
+		strings.NewReader("{\"cash_register\":1,\"cashier_id\":\"ivanova\",\"opening_float\":5000}"))
+
+	mock.EXPECT().OpenShift(gomock.Any(), dto.Shift{CashRegister: 1, CashierID: "ivanova",
+		OpeningFloat: 5000}).Times(1).Return(shift.ID(42), nil)
+
+	mux.ServeHTTP(response, request)
+	if response.Code != http.StatusCreated || strings.Compare(response.Body.String(), "{\"shift_id\":42}\n") != 0 {
+		t.Fail()
+	}
+}
+
+func TestHandler_OpenShiftNoCashier(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	mux := chi.NewRouter()
+	mock := mockService.NewMockInterface(ctrl)
+	mux.HandleFunc("/api/api_v1/shift/open", New(mock, time.Second).OpenShift)
+
+	response := httptest.NewRecorder()
+	request := httptest.NewRequest(http.MethodPost, "/api/api_v1/shift/open",
+		strings.NewReader("{\"cash_register\":1,\"opening_float\":5000}"))
+
+	mux.ServeHTTP(response, request)
+	if response.Code != http.StatusBadRequest {
+		t.Fail()
+	}
+}
+
+func TestHandler_OpenShiftAlreadyOpen(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	mux := chi.NewRouter()
+	mock := mockService.NewMockInterface(ctrl)
+	mux.HandleFunc("/api/api_v1/shift/open", New(mock, time.Second).OpenShift)
+
+	response := httptest.NewRecorder()
+	request := httptest.NewRequest(http.MethodPost, "/api/api_v1/shift/open",
+		strings.NewReader("{\"cash_register\":1,\"cashier_id\":\"ivanova\"}"))
+
+	mock.EXPECT().OpenShift(gomock.Any(), gomock.Any()).Times(1).Return(shift.ID(0), service.ErrShiftAlreadyOpen)
+
+	mux.ServeHTTP(response, request)
+	if response.Code != http.StatusConflict {
+		t.Fail()
+	}
+}
+
+func TestHandler_CloseShiftSuccess(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	mux := chi.NewRouter()
+	mock := mockService.NewMockInterface(ctrl)
+	mux.HandleFunc("/api/api_v1/shift/close", New(mock, time.Second).CloseShift)
+
+	response := httptest.NewRecorder()
+	request := httptest.NewRequest(http.MethodPut, "/api/api_v1/shift/close",
+		strings.NewReader("{\"cash_register\":1}"))
+
+	mock.EXPECT().CloseShift(gomock.Any(), dto.CashRegister{CashRegister: 1}).Times(1).Return(
+		dto.ZReport{ShiftID: 42, CashRegister: 1}, nil)
+
+	mux.ServeHTTP(response, request)
+	if response.Code != http.StatusOK {
+		t.Fail()
+	}
+}
+
+func TestHandler_CloseShiftNoOpenShift(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	mux := chi.NewRouter()
+	mock := mockService.NewMockInterface(ctrl)
+	mux.HandleFunc("/api/api_v1/shift/close", New(mock, time.Second).CloseShift)
+
+	response := httptest.NewRecorder()
+	request := httptest.NewRequest(http.MethodPut, "/api/api_v1/shift/close",
+		strings.NewReader("{\"cash_register\":1}"))
+
+	mock.EXPECT().CloseShift(gomock.Any(), gomock.Any()).Times(1).Return(dto.ZReport{}, service.ErrNoOpenShift)
+
+	mux.ServeHTTP(response, request)
+	if response.Code != http.StatusConflict {
+		t.Fail()
+	}
+}
+
+func TestHandler_ZReportSuccess(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	mux := chi.NewRouter()
+	mock := mockService.NewMockInterface(ctrl)
+	mux.HandleFunc("/api/api_v1/shift/z-report/", New(mock, time.Second).ZReport)
+	mock.EXPECT().ZReport(gomock.Any(), dto.ShiftID{ID: 42}).Times(1).Return(dto.ZReport{ShiftID: 42}, nil)
+
+	response := httptest.NewRecorder()
+	request := httptest.NewRequest(http.MethodGet, "/api/api_v1/shift/z-report/", nil)
+	request.Form = url.Values{}
+	request.Form.Set("id", "42")
+
+	mux.ServeHTTP(response, request)
+	if response.Code != http.StatusOK {
+		t.Fail()
+	}
+}
+
+func TestHandler_ZReportNoRecord(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	mux := chi.NewRouter()
+	mock := mockService.NewMockInterface(ctrl)
+	mux.HandleFunc("/api/api_v1/shift/z-report/", New(mock, time.Second).ZReport)
+	mock.EXPECT().ZReport(gomock.Any(), dto.ShiftID{ID: 42}).Times(1).Return(dto.ZReport{}, repository.ErrNoRecord)
+
+	response := httptest.NewRecorder()
+	request := httptest.NewRequest(http.MethodGet, "/api/api_v1/shift/z-report/", nil)
+	request.Form = url.Values{}
+	request.Form.Set("id", "42")
+
+	mux.ServeHTTP(response, request)
+	if response.Code != http.StatusNotFound {
+		t.Fail()
+	}
+}
@@ -0,0 +1,56 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/go-chi/render"
+	"github.com/lazylex/watch-store-store/internal/adapters/rest/response"
+	"github.com/lazylex/watch-store-store/internal/dto"
+	"github.com/lazylex/watch-store-store/internal/logger"
+	"log/slog"
+	"net/http"
+)
+
+// AdjustAmountInStock изменяет количество товара на переданную величину с указанием кода причины корректировки.
+// Отрицательная величина списывает товар, положительная - оприходует. Пример передаваемых в формате JSON данных:
+//
+// {"article": "CA-F91W", "delta": -1, "reason": "damaged", "comment": "разбито стекло на витрине"}
+//
+// В случае успеха возвращается http.StatusCreated и новое количество товара:
+//
+// {"article": "CA-F91W", "amount": 19}
+//
+// Если после корректировки количество станет отрицательным, возвращается http.StatusConflict.
+func (h *Handler) AdjustAmountInStock(w http.ResponseWriter, r *http.Request) {
+	var err error
+	var amount uint
+	var transferObject dto.ArticleDeltaReason
+	log := logger.AddPlaceAndRequestId(slog.Default(), "rest.handlers.AdjustAmountInStock", r)
+
+	ctx, cancel := context.WithTimeout(r.Context(), h.queryTimeout)
+	defer cancel()
+
+	err = json.NewDecoder(r.Body).Decode(&transferObject)
+	if err != nil {
+		response.WriteHeaderAndLogAboutBadRequest(w, log, err)
+		return
+	}
+
+	err = h.resolveBarcodes(injectRequestIDToCtx(ctx, r), &transferObject.Article)
+	if response.WriteHeaderAndLogAboutErr(w, log, err); err != nil {
+		return
+	}
+
+	err = transferObject.Validate()
+	if response.WriteHeaderAndLogAboutErr(w, log, err); err != nil {
+		return
+	}
+
+	amount, err = h.service.AdjustAmountInStock(injectRequestIDToCtx(ctx, r), transferObject)
+	if response.WriteHeaderAndLogAboutErr(w, log, err); err == nil {
+		render.Status(r, http.StatusCreated)
+		render.JSON(w, r, dto.ArticleAmount{Article: transferObject.Article, Amount: amount})
+		log.Info(fmt.Sprintf("amount of article %s adjusted by %d", transferObject.Article, transferObject.Delta))
+	}
+}
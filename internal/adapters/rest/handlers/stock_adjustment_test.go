@@ -0,0 +1,73 @@
+package handlers
+
+import (
+	"github.com/go-chi/chi"
+	"github.com/golang/mock/gomock"
+	"github.com/lazylex/watch-store-store/internal/dto"
+	"github.com/lazylex/watch-store-store/internal/ports/service"
+	mockService "github.com/lazylex/watch-store-store/internal/ports/service/mocks"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestHandler_AdjustAmountInStockCreated(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	mux := chi.NewRouter()
+	mock := mockService.NewMockInterface(ctrl)
+	mux.HandleFunc("/api/api_v1/stock/adjustment", New(mock, time.Second).AdjustAmountInStock)
+
+	response := httptest.NewRecorder()
+	request := httptest.NewRequest(http.MethodPost, "/api/api_v1/stock/adjustment",
+		strings.NewReader("{\"article\":\"CA-F91W\",\"delta\":-1,\"reason\":\"damaged\"}"))
+
+	mock.EXPECT().AdjustAmountInStock(gomock.Any(),
+		dto.ArticleDeltaReason{Article: "CA-F91W", Delta: -1, Reason: "damaged"}).Times(1).Return(uint(19), nil)
+
+	mux.ServeHTTP(response, request)
+	if response.Code != http.StatusCreated || !strings.Contains(response.Body.String(), "\"amount\":19") {
+		t.Fail()
+	}
+}
+
+func TestHandler_AdjustAmountInStockZeroDelta(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	mux := chi.NewRouter()
+	mock := mockService.NewMockInterface(ctrl)
+	mux.HandleFunc("/api/api_v1/stock/adjustment", New(mock, time.Second).AdjustAmountInStock)
+
+	response := httptest.NewRecorder()
+	request := httptest.NewRequest(http.MethodPost, "/api/api_v1/stock/adjustment",
+		strings.NewReader("{\"article\":\"CA-F91W\",\"delta\":0,\"reason\":\"damaged\"}"))
+
+	mock.EXPECT().AdjustAmountInStock(gomock.Any(), gomock.Any()).Times(0)
+
+	mux.ServeHTTP(response, request)
+	if response.Code != http.StatusBadRequest {
+		t.Fail()
+	}
+}
+
+func TestHandler_AdjustAmountInStockBelowZero(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	mux := chi.NewRouter()
+	mock := mockService.NewMockInterface(ctrl)
+	mux.HandleFunc("/api/api_v1/stock/adjustment", New(mock, time.Second).AdjustAmountInStock)
+
+	response := httptest.NewRecorder()
+	request := httptest.NewRequest(http.MethodPost, "/api/api_v1/stock/adjustment",
+		strings.NewReader("{\"article\":\"CA-F91W\",\"delta\":-5,\"reason\":\"lost\"}"))
+
+	mock.EXPECT().AdjustAmountInStock(gomock.Any(), gomock.Any()).Times(1).Return(uint(0),
+		service.ErrAdjustmentBelowZero)
+
+	mux.ServeHTTP(response, request)
+	if response.Code != http.StatusConflict {
+		t.Fail()
+	}
+}
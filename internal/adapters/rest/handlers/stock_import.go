@@ -0,0 +1,96 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"github.com/go-chi/render"
+	"github.com/lazylex/watch-store-store/internal/adapters/rest/request"
+	"github.com/lazylex/watch-store-store/internal/adapters/rest/response"
+	"github.com/lazylex/watch-store-store/internal/adapters/stock_import"
+	"github.com/lazylex/watch-store-store/internal/domain/value_objects/conflict"
+	"github.com/lazylex/watch-store-store/internal/dto"
+	"github.com/lazylex/watch-store-store/internal/logger"
+	"log/slog"
+	"net/http"
+	"strconv"
+)
+
+// maxImportFileSize максимальный размер загружаемого файла с товарами.
+const maxImportFileSize = 10 << 20
+
+// ImportStock загружает товары из CSV-файла, переданного в поле file формы multipart/form-data. Файл содержит столбцы
+// article, name, price и amount (строка заголовка необязательна). Параметрами запроса передаются:
+//
+//   - dry_run - только проверить файл, не изменяя данных (по умолчанию false);
+//   - on_conflict - действие для товаров, уже имеющихся на складе: reject - считать строку ошибочной (по умолчанию),
+//     skip - пропустить, update - заменить название, цену и количество;
+//   - chunk_size - размер частей, каждая из которых применяется в отдельной транзакции. По умолчанию (0) файл
+//     применяется целиком и только при отсутствии ошибочных строк.
+//
+// Возвращается отчёт о загрузке с результатом для каждой строки. Если загрузка целиком не была применена из-за
+// ошибочных строк, отчёт возвращается с кодом 422. Пример возвращаемых данных:
+//
+//	{
+//		"dry_run": false, "applied": true, "total": 2, "created": 1, "updated": 0, "skipped": 0, "failed": 1,
+//		"rows": [
+//			{"line": 2, "article": "CA-F91W", "status": "created"},
+//			{"line": 3, "article": "CA-F92W", "status": "failed", "error": "article already in stock"}
+//		]
+//	}
+func (h *Handler) ImportStock(w http.ResponseWriter, r *http.Request) {
+	var err error
+	var report dto.StockImportReport
+	log := logger.AddPlaceAndRequestId(slog.Default(), "rest.handlers.ImportStock", r)
+
+	ctx, cancel := context.WithTimeout(r.Context(), h.queryTimeout)
+	defer cancel()
+
+	r.Body = http.MaxBytesReader(w, r.Body, maxImportFileSize)
+	transferObject := dto.StockImport{Conflict: conflict.Policy(r.FormValue(request.Conflict))}
+	if transferObject.Conflict == "" {
+		transferObject.Conflict = conflict.Reject
+	}
+	if value := r.FormValue(request.DryRun); value != "" {
+		if transferObject.DryRun, err = strconv.ParseBool(value); err != nil {
+			response.WriteHeaderAndLogAboutBadRequest(w, log, request.ErrIncorrectDryRunFlag)
+			return
+		}
+	}
+	if value := r.FormValue(request.Chunk); value != "" {
+		var size uint64
+		if size, err = strconv.ParseUint(value, 10, 0); err != nil {
+			response.WriteHeaderAndLogAboutBadRequest(w, log, request.ErrIncorrectChunkSize)
+			return
+		}
+		transferObject.ChunkSize = uint(size)
+	}
+
+	file, _, err := r.FormFile(request.File)
+	if err != nil {
+		response.WriteHeaderAndLogAboutBadRequest(w, log, fmt.Errorf("%w: %w", request.ErrNoImportFile, err))
+		return
+	}
+	defer func() { _ = file.Close() }()
+
+	if transferObject.Rows, err = stock_import.ParseCSV(file); err != nil {
+		response.WriteHeaderAndLogAboutBadRequest(w, log, err)
+		return
+	}
+
+	err = transferObject.Validate()
+	if response.WriteHeaderAndLogAboutErr(w, log, err); err != nil {
+		return
+	}
+
+	report, err = h.service.ImportStock(injectRequestIDToCtx(ctx, r), transferObject)
+	if response.WriteHeaderAndLogAboutErr(w, log, err); err != nil {
+		return
+	}
+
+	if !report.DryRun && !report.Applied {
+		render.Status(r, http.StatusUnprocessableEntity)
+	}
+	render.JSON(w, r, report)
+	log.Info(fmt.Sprintf("stock import of %d rows processed (dry run: %t, applied: %t)",
+		report.Total, report.DryRun, report.Applied))
+}
@@ -0,0 +1,131 @@
+package handlers
+
+import (
+	"bytes"
+	"github.com/go-chi/chi"
+	"github.com/golang/mock/gomock"
+	"github.com/lazylex/watch-store-store/internal/domain/value_objects/conflict"
+	"github.com/lazylex/watch-store-store/internal/dto"
+	mockService "github.com/lazylex/watch-store-store/internal/ports/service/mocks"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+// importRequest формирует запрос на загрузку товаров с переданным содержимым CSV-файла.
+func importRequest(t *testing.T, query, content string) *http.Request {
+	var body bytes.Buffer
+	writer := multipart.NewWriter(&body)
+	part, err := writer.CreateFormFile("file", "stock.csv")
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, _ = part.Write([]byte(content))
+	_ = writer.Close()
+
+	request := httptest.NewRequest(http.MethodPost, "/api/api_v1/stock/import"+query, &body)
+	request.Header.Set("Content-Type", writer.FormDataContentType())
+	return request
+}
+
+func TestHandler_ImportStock(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	mux := chi.NewRouter()
+	mock := mockService.NewMockInterface(ctrl)
+	mux.HandleFunc("/api/api_v1/stock/import", New(mock, time.Second).ImportStock)
+
+	response := httptest.NewRecorder()
+	request := importRequest(t, "?on_conflict=update&chunk_size=100",
+		"article,name,price,amount\nCA-F91W,CASIO F-91W-1YEG,3490,5\n")
+
+	mock.EXPECT().ImportStock(gomock.Any(), dto.StockImport{
+		Rows: []dto.StockImportRow{{Line: 2, Record: dto.ArticlePriceNameAmount{
+			Article: "CA-F91W", Name: "CASIO F-91W-1YEG", Price: 3490, Amount: 5}}},
+		Conflict:  conflict.Update,
+		ChunkSize: 100,
+	}).Times(1).Return(dto.StockImportReport{Applied: true, Total: 1, Updated: 1}, nil)
+
+	mux.ServeHTTP(response, request)
+	if response.Code != http.StatusOK {
+		t.Fail()
+	}
+}
+
+func TestHandler_ImportStockNotApplied(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	mux := chi.NewRouter()
+	mock := mockService.NewMockInterface(ctrl)
+	mux.HandleFunc("/api/api_v1/stock/import", New(mock, time.Second).ImportStock)
+
+	response := httptest.NewRecorder()
+	request := importRequest(t, "", "CA-F91W,CASIO F-91W-1YEG,3490,5\n")
+
+	mock.EXPECT().ImportStock(gomock.Any(), gomock.Any()).Times(1).
+		Return(dto.StockImportReport{Total: 1, Failed: 1}, nil)
+
+	mux.ServeHTTP(response, request)
+	if response.Code != http.StatusUnprocessableEntity {
+		t.Fail()
+	}
+}
+
+func TestHandler_ImportStockDryRun(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	mux := chi.NewRouter()
+	mock := mockService.NewMockInterface(ctrl)
+	mux.HandleFunc("/api/api_v1/stock/import", New(mock, time.Second).ImportStock)
+
+	response := httptest.NewRecorder()
+	request := importRequest(t, "?dry_run=true", "CA-F91W,CASIO F-91W-1YEG,3490,5\n")
+
+	mock.EXPECT().ImportStock(gomock.Any(), gomock.Any()).Times(1).
+		Return(dto.StockImportReport{DryRun: true, Total: 1, Failed: 1}, nil)
+
+	mux.ServeHTTP(response, request)
+	if response.Code != http.StatusOK {
+		t.Fail()
+	}
+}
+
+func TestHandler_ImportStockIncorrectParams(t *testing.T) {
+	t.Parallel()
+	for _, query := range []string{"?dry_run=maybe", "?chunk_size=-1", "?on_conflict=merge"} {
+		ctrl := gomock.NewController(t)
+		mux := chi.NewRouter()
+		mock := mockService.NewMockInterface(ctrl)
+		mux.HandleFunc("/api/api_v1/stock/import", New(mock, time.Second).ImportStock)
+
+		response := httptest.NewRecorder()
+		request := importRequest(t, query, "CA-F91W,CASIO F-91W-1YEG,3490,5\n")
+
+		mock.EXPECT().ImportStock(gomock.Any(), gomock.Any()).Times(0)
+
+		mux.ServeHTTP(response, request)
+		if response.Code != http.StatusBadRequest {
+			t.Errorf("%s: got %d", query, response.Code)
+		}
+	}
+}
+
+func TestHandler_ImportStockNoFile(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	mux := chi.NewRouter()
+	mock := mockService.NewMockInterface(ctrl)
+	mux.HandleFunc("/api/api_v1/stock/import", New(mock, time.Second).ImportStock)
+
+	response := httptest.NewRecorder()
+	request := httptest.NewRequest(http.MethodPost, "/api/api_v1/stock/import", nil)
+
+	mock.EXPECT().ImportStock(gomock.Any(), gomock.Any()).Times(0)
+
+	mux.ServeHTTP(response, request)
+	if response.Code != http.StatusBadRequest {
+		t.Fail()
+	}
+}
@@ -0,0 +1,68 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/lazylex/watch-store-store/internal/adapters/rest/request"
+	"github.com/lazylex/watch-store-store/internal/adapters/rest/response"
+	"github.com/lazylex/watch-store-store/internal/dto"
+	"github.com/lazylex/watch-store-store/internal/logger"
+	"log/slog"
+	"net/http"
+	"strconv"
+)
+
+// TransferStock перемещает товар между местами хранения магазина. Общее количество товара не изменяется. Пример
+// передаваемых в формате JSON данных:
+//
+// {"article": "CA-F91W", "from": "back_room", "to": "showcase", "amount": 3}
+//
+// Если на месте хранения from недостаточно товара, возвращается http.StatusConflict.
+func (h *Handler) TransferStock(w http.ResponseWriter, r *http.Request) {
+	var err error
+	var transferObject dto.StockTransfer
+	log := logger.AddPlaceAndRequestId(slog.Default(), "rest.handlers.TransferStock", r)
+
+	ctx, cancel := context.WithTimeout(r.Context(), h.queryTimeout)
+	defer cancel()
+
+	if err = json.NewDecoder(r.Body).Decode(&transferObject); err != nil {
+		response.WriteHeaderAndLogAboutBadRequest(w, log, err)
+		return
+	}
+
+	err = h.resolveBarcodes(injectRequestIDToCtx(ctx, r), &transferObject.Article)
+	if response.WriteHeaderAndLogAboutErr(w, log, err); err != nil {
+		return
+	}
+
+	err = transferObject.Validate()
+	if response.WriteHeaderAndLogAboutErr(w, log, err); err != nil {
+		return
+	}
+
+	err = h.service.TransferStock(injectRequestIDToCtx(ctx, r), transferObject)
+	if response.WriteHeaderAndLogAboutErr(w, log, err); err != nil {
+		return
+	}
+
+	log.Info(fmt.Sprintf("%d items of article %s transferred from %s to %s", transferObject.Amount,
+		transferObject.Article, transferObject.From, transferObject.To))
+}
+
+// withLocationsBreakdown возвращает значение параметра запроса locations, указывающего на необходимость вернуть
+// распределение количества товара по местам хранения.
+func withLocationsBreakdown(r *http.Request) (bool, error) {
+	value := r.FormValue(request.Locations)
+	if value == "" {
+		return false, nil
+	}
+
+	result, err := strconv.ParseBool(value)
+	if err != nil {
+		return false, request.ErrIncorrectLocationsFlag
+	}
+
+	return result, nil
+}
@@ -0,0 +1,118 @@
+package handlers
+
+import (
+	"github.com/go-chi/chi"
+	"github.com/golang/mock/gomock"
+	"github.com/lazylex/watch-store-store/internal/domain/value_objects/location"
+	"github.com/lazylex/watch-store-store/internal/dto"
+	"github.com/lazylex/watch-store-store/internal/ports/service"
+	mockService "github.com/lazylex/watch-store-store/internal/ports/service/mocks"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestHandler_TransferStockSuccess(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	mux := chi.NewRouter()
+	mock := mockService.NewMockInterface(ctrl)
+	mux.HandleFunc("/api/api_v1/stock/transfer", New(mock, time.Second).TransferStock)
+
+	response := httptest.NewRecorder()
+	request := httptest.NewRequest(http.MethodPost, "/api/api_v1/stock/transfer",
+		strings.NewReader("{\"article\":\"CA-F91W\",\"from\":\"back_room\",\"to\":\"showcase\",\"amount\":3}"))
+
+	mock.EXPECT().TransferStock(gomock.Any(), dto.StockTransfer{Article: "CA-F91W", From: location.BackRoom,
+		To: location.Showcase, Amount: 3}).Times(1).Return(nil)
+
+	mux.ServeHTTP(response, request)
+	if response.Code != http.StatusOK {
+		t.Fail()
+	}
+}
+
+func TestHandler_TransferStockSameLocation(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	mux := chi.NewRouter()
+	mock := mockService.NewMockInterface(ctrl)
+	mux.HandleFunc("/api/api_v1/stock/transfer", New(mock, time.Second).TransferStock)
+
+	response := httptest.NewRecorder()
+	request := httptest.NewRequest(http.MethodPost, "/api/api_v1/stock/transfer",
+		strings.NewReader("{\"article\":\"CA-F91W\",\"from\":\"showcase\",\"to\":\"showcase\",\"amount\":3}"))
+
+	mock.EXPECT().TransferStock(gomock.Any(), gomock.Any()).Times(0)
+
+	mux.ServeHTTP(response, request)
+	if response.Code != http.StatusBadRequest {
+		t.Fail()
+	}
+}
+
+func TestHandler_TransferStockNoEnoughItems(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	mux := chi.NewRouter()
+	mock := mockService.NewMockInterface(ctrl)
+	mux.HandleFunc("/api/api_v1/stock/transfer", New(mock, time.Second).TransferStock)
+
+	response := httptest.NewRecorder()
+	request := httptest.NewRequest(http.MethodPost, "/api/api_v1/stock/transfer",
+		strings.NewReader("{\"article\":\"CA-F91W\",\"from\":\"back_room\",\"to\":\"repair_desk\",\"amount\":30}"))
+
+	mock.EXPECT().TransferStock(gomock.Any(), gomock.Any()).Times(1).Return(service.ErrNoEnoughItemsInPlace)
+
+	mux.ServeHTTP(response, request)
+	if response.Code != http.StatusConflict {
+		t.Fail()
+	}
+}
+
+func TestHandler_GetAmountInStockByLocations(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	mux := chi.NewRouter()
+	mock := mockService.NewMockInterface(ctrl)
+	mux.HandleFunc("/api/api_v1/stock/amount/", New(mock, time.Second).AmountInStock)
+	mock.EXPECT().AmountInStock(gomock.Any(), gomock.Any()).Times(0)
+	mock.EXPECT().AmountByLocation(gomock.Any(), dto.Article{Article: "CA-F91W"}).Times(1).Return(
+		dto.AmountLocations{Amount: 5, Locations: []dto.LocationAmount{{Location: location.Showcase, Amount: 2},
+			{Location: location.BackRoom, Amount: 3}}}, nil)
+
+	response := httptest.NewRecorder()
+	request := httptest.NewRequest(http.MethodGet, "/api/api_v1/stock/amount/", nil)
+	request.Form = url.Values{}
+	request.Form.Set("article", "CA-F91W")
+	request.Form.Set("locations", "true")
+
+	mux.ServeHTTP(response, request)
+	if response.Code != http.StatusOK || !strings.Contains(response.Body.String(),
+		"{\"location\":\"back_room\",\"amount\":3}") {
+		t.Fail()
+	}
+}
+
+func TestHandler_GetAmountInStockIncorrectLocationsFlag(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	mux := chi.NewRouter()
+	mock := mockService.NewMockInterface(ctrl)
+	mux.HandleFunc("/api/api_v1/stock/amount/", New(mock, time.Second).AmountInStock)
+	mock.EXPECT().AmountByLocation(gomock.Any(), gomock.Any()).Times(0)
+
+	response := httptest.NewRecorder()
+	request := httptest.NewRequest(http.MethodGet, "/api/api_v1/stock/amount/", nil)
+	request.Form = url.Values{}
+	request.Form.Set("article", "CA-F91W")
+	request.Form.Set("locations", "maybe")
+
+	mux.ServeHTTP(response, request)
+	if response.Code != http.StatusBadRequest {
+		t.Fail()
+	}
+}
@@ -0,0 +1,205 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/go-chi/render"
+	"github.com/lazylex/watch-store-store/internal/adapters/rest/request"
+	"github.com/lazylex/watch-store-store/internal/adapters/rest/response"
+	"github.com/lazylex/watch-store-store/internal/domain/aggregates/transfer"
+	"github.com/lazylex/watch-store-store/internal/dto"
+	"github.com/lazylex/watch-store-store/internal/logger"
+	"log/slog"
+	"net/http"
+	"strconv"
+)
+
+// CreateOutboundTransfer отправляет товар в другой магазин. Количество отправляемых товаров уменьшается сразу, а
+// получатель получает сообщение о перемещении через Кафку. Пример передаваемых в формате JSON данных:
+//
+// {"target": "store-2", "items": [{"article": "CA-F91W", "amount": 3}]}
+//
+// В случае успеха возвращается http.StatusCreated и идентификатор перемещения:
+//
+// {"transfer_id": 12}
+func (h *Handler) CreateOutboundTransfer(w http.ResponseWriter, r *http.Request) {
+	var err error
+	var id transfer.ID
+	var transferObject dto.OutboundTransfer
+	log := logger.AddPlaceAndRequestId(slog.Default(), "rest.handlers.CreateOutboundTransfer", r)
+
+	ctx, cancel := context.WithTimeout(r.Context(), h.queryTimeout)
+	defer cancel()
+
+	if err = json.NewDecoder(r.Body).Decode(&transferObject); err != nil {
+		response.WriteHeaderAndLogAboutBadRequest(w, log, err)
+		return
+	}
+
+	err = h.resolveBarcodes(injectRequestIDToCtx(ctx, r), articlesOfAmounts(transferObject.Items)...)
+	if response.WriteHeaderAndLogAboutErr(w, log, err); err != nil {
+		return
+	}
+
+	err = transferObject.Validate()
+	if response.WriteHeaderAndLogAboutErr(w, log, err); err != nil {
+		return
+	}
+
+	id, err = h.service.CreateOutboundTransfer(injectRequestIDToCtx(ctx, r), transferObject)
+	if response.WriteHeaderAndLogAboutErr(w, log, err); err == nil {
+		render.Status(r, http.StatusCreated)
+		render.JSON(w, r, dto.TransferID{ID: id})
+		log.Info(fmt.Sprintf("transfer %d to %s created", id, transferObject.Target))
+	}
+}
+
+// ConfirmInboundTransfer подтверждает приёмку входящего перемещения с фактически принятым количеством товаров. Товары
+// перемещения, не переданные в запросе, считаются не поступившими. Пример передаваемых в формате JSON данных:
+//
+// {"transfer_id": 5, "items": [{"article": "CA-F91W", "amount": 2}]}
+//
+// Возвращается перемещение в формате ответа Transfer.
+func (h *Handler) ConfirmInboundTransfer(w http.ResponseWriter, r *http.Request) {
+	var err error
+	var result dto.Transfer
+	var transferObject dto.TransferConfirmation
+	log := logger.AddPlaceAndRequestId(slog.Default(), "rest.handlers.ConfirmInboundTransfer", r)
+
+	ctx, cancel := context.WithTimeout(r.Context(), h.queryTimeout)
+	defer cancel()
+
+	if err = json.NewDecoder(r.Body).Decode(&transferObject); err != nil {
+		response.WriteHeaderAndLogAboutBadRequest(w, log, err)
+		return
+	}
+
+	err = h.resolveBarcodes(injectRequestIDToCtx(ctx, r), articlesOfAmounts(transferObject.Items)...)
+	if response.WriteHeaderAndLogAboutErr(w, log, err); err != nil {
+		return
+	}
+
+	err = transferObject.Validate()
+	if response.WriteHeaderAndLogAboutErr(w, log, err); err != nil {
+		return
+	}
+
+	result, err = h.service.ConfirmInboundTransfer(injectRequestIDToCtx(ctx, r), transferObject)
+	if response.WriteHeaderAndLogAboutErr(w, log, err); err != nil {
+		return
+	}
+
+	log.Info(fmt.Sprintf("transfer %d received", transferObject.ID))
+
+	render.JSON(w, r, result)
+}
+
+// ResolveTransfer урегулирует расхождение исходящего перемещения. Если restock равен true, недостача возвращается в
+// остатки, иначе считается списанной. Пример передаваемых в формате JSON данных:
+//
+// {"transfer_id": 12, "restock": true}
+//
+// Возвращается перемещение в формате ответа Transfer.
+func (h *Handler) ResolveTransfer(w http.ResponseWriter, r *http.Request) {
+	var err error
+	var result dto.Transfer
+	var transferObject dto.TransferResolution
+	log := logger.AddPlaceAndRequestId(slog.Default(), "rest.handlers.ResolveTransfer", r)
+
+	ctx, cancel := context.WithTimeout(r.Context(), h.queryTimeout)
+	defer cancel()
+
+	if err = json.NewDecoder(r.Body).Decode(&transferObject); err != nil {
+		response.WriteHeaderAndLogAboutBadRequest(w, log, err)
+		return
+	}
+
+	err = transferObject.Validate()
+	if response.WriteHeaderAndLogAboutErr(w, log, err); err != nil {
+		return
+	}
+
+	result, err = h.service.ResolveTransfer(injectRequestIDToCtx(ctx, r), transferObject)
+	if response.WriteHeaderAndLogAboutErr(w, log, err); err != nil {
+		return
+	}
+
+	log.Info(fmt.Sprintf("discrepancy of transfer %d resolved", transferObject.ID))
+
+	render.JSON(w, r, result)
+}
+
+// Transfer возвращает в формате JSON перемещение товара между магазинами с переданным параметром запроса (id)
+// идентификатором. Пример возвращаемого значения:
+//
+//	{
+//	 "transfer_id": 12, "direction": "outbound", "counterpart": "store-2", "state": 3,
+//	 "created_at": "2024-05-02T10:00:00Z", "updated_at": "2024-05-03T12:00:00Z",
+//	 "items": [{"article": "CA-F91W", "name": "Casio F-91W", "price": 1500, "amount": 3, "received_amount": 2}]
+//	}
+func (h *Handler) Transfer(w http.ResponseWriter, r *http.Request) {
+	var err error
+	var id int64
+	var result dto.Transfer
+	log := logger.AddPlaceAndRequestId(slog.Default(), "rest.handlers.Transfer", r)
+
+	ctx, cancel := context.WithTimeout(r.Context(), h.queryTimeout)
+	defer cancel()
+
+	if id, err = strconv.ParseInt(r.FormValue(request.ID), 10, 64); err != nil {
+		response.WriteHeaderAndLogAboutBadRequest(w, log, request.ErrIncorrectID)
+		return
+	}
+
+	transferObject := dto.TransferID{ID: transfer.ID(id)}
+	err = transferObject.Validate()
+	if response.WriteHeaderAndLogAboutErr(w, log, err); err != nil {
+		return
+	}
+
+	result, err = h.service.Transfer(injectRequestIDToCtx(ctx, r), transferObject)
+	if response.WriteHeaderAndLogAboutErr(w, log, err); err != nil {
+		return
+	}
+
+	log.Info(fmt.Sprintf("requested transfer %d", id))
+
+	render.JSON(w, r, result)
+}
+
+// Transfers возвращает в формате JSON перемещения товара между магазинами без перемещаемых товаров. Перемещения можно
+// отобрать по направлению (параметр запроса direction: outbound или inbound) и состоянию (параметр запроса state).
+func (h *Handler) Transfers(w http.ResponseWriter, r *http.Request) {
+	var err error
+	var result []dto.Transfer
+	var transferObject dto.TransferFilter
+	log := logger.AddPlaceAndRequestId(slog.Default(), "rest.handlers.Transfers", r)
+
+	ctx, cancel := context.WithTimeout(r.Context(), h.queryTimeout)
+	defer cancel()
+
+	transferObject.Direction = transfer.Direction(r.FormValue(request.Direction))
+	if value := r.FormValue(request.State); value != "" {
+		var state int
+		if state, err = strconv.Atoi(value); err != nil {
+			response.WriteHeaderAndLogAboutBadRequest(w, log, request.ErrIncorrectState)
+			return
+		}
+		transferObject.State = transfer.State(state)
+	}
+
+	err = transferObject.Validate()
+	if response.WriteHeaderAndLogAboutErr(w, log, err); err != nil {
+		return
+	}
+
+	result, err = h.service.Transfers(injectRequestIDToCtx(ctx, r), transferObject)
+	if response.WriteHeaderAndLogAboutErr(w, log, err); err != nil {
+		return
+	}
+
+	log.Info(fmt.Sprintf("requested %d transfers", len(result)))
+
+	render.JSON(w, r, result)
+}
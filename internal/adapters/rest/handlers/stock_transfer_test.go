@@ -0,0 +1,180 @@
+package handlers
+
+import (
+	"github.com/go-chi/chi"
+	"github.com/golang/mock/gomock"
+	"github.com/lazylex/watch-store-store/internal/domain/aggregates/transfer"
+	"github.com/lazylex/watch-store-store/internal/dto"
+	"github.com/lazylex/watch-store-store/internal/ports/service"
+	mockService "github.com/lazylex/watch-store-store/internal/ports/service/mocks"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestHandler_CreateOutboundTransferCreated(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	mux := chi.NewRouter()
+	mock := mockService.NewMockInterface(ctrl)
+	mux.HandleFunc("/api/api_v1/transfer/outbound", New(mock, time.Second).CreateOutboundTransfer)
+
+	response := httptest.NewRecorder()
+	request := httptest.NewRequest(http.MethodPost, "/api/api_v1/transfer/outbound",
+		strings.NewReader("{\"target\":\"store-2\",\"items\":[{\"article\":\"CA-F91W\",\"amount\":3}]}"))
+
+	mock.EXPECT().CreateOutboundTransfer(gomock.Any(), dto.OutboundTransfer{Target: "store-2",
+		Items: []dto.ArticleAmount{{Article: "CA-F91W", Amount: 3}}}).Times(1).Return(transfer.ID(12), nil)
+
+	mux.ServeHTTP(response, request)
+	if response.Code != http.StatusCreated || strings.Compare(response.Body.String(), "{\"transfer_id\":12}\n") != 0 {
+		t.Fail()
+	}
+}
+
+func TestHandler_CreateOutboundTransferNoItems(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	mux := chi.NewRouter()
+	mock := mockService.NewMockInterface(ctrl)
+	mux.HandleFunc("/api/api_v1/transfer/outbound", New(mock, time.Second).CreateOutboundTransfer)
+
+	response := httptest.NewRecorder()
+	request := httptest.NewRequest(http.MethodPost, "/api/api_v1/transfer/outbound",
+		strings.NewReader("{\"target\":\"store-2\",\"items\":[]}"))
+
+	mock.EXPECT().CreateOutboundTransfer(gomock.Any(), gomock.Any()).Times(0)
+
+	mux.ServeHTTP(response, request)
+	if response.Code != http.StatusBadRequest {
+		t.Fail()
+	}
+}
+
+func TestHandler_CreateOutboundTransferNoEnoughItems(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	mux := chi.NewRouter()
+	mock := mockService.NewMockInterface(ctrl)
+	mux.HandleFunc("/api/api_v1/transfer/outbound", New(mock, time.Second).CreateOutboundTransfer)
+
+	response := httptest.NewRecorder()
+	request := httptest.NewRequest(http.MethodPost, "/api/api_v1/transfer/outbound",
+		strings.NewReader("{\"target\":\"store-2\",\"items\":[{\"article\":\"CA-F91W\",\"amount\":30}]}"))
+
+	mock.EXPECT().CreateOutboundTransfer(gomock.Any(), gomock.Any()).Times(1).Return(transfer.ID(0),
+		service.ErrNoEnoughItemsToSend)
+
+	mux.ServeHTTP(response, request)
+	if response.Code != http.StatusConflict {
+		t.Fail()
+	}
+}
+
+func TestHandler_ConfirmInboundTransferNotInTransit(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	mux := chi.NewRouter()
+	mock := mockService.NewMockInterface(ctrl)
+	mux.HandleFunc("/api/api_v1/transfer/inbound/confirm", New(mock, time.Second).ConfirmInboundTransfer)
+
+	response := httptest.NewRecorder()
+	request := httptest.NewRequest(http.MethodPut, "/api/api_v1/transfer/inbound/confirm",
+		strings.NewReader("{\"transfer_id\":5,\"items\":[{\"article\":\"CA-F91W\",\"amount\":2}]}"))
+
+	mock.EXPECT().ConfirmInboundTransfer(gomock.Any(), dto.TransferConfirmation{ID: 5,
+		Items: []dto.ArticleAmount{{Article: "CA-F91W", Amount: 2}}}).Times(1).Return(dto.Transfer{},
+		service.ErrTransferNotInTransit)
+
+	mux.ServeHTTP(response, request)
+	if response.Code != http.StatusConflict {
+		t.Fail()
+	}
+}
+
+func TestHandler_ResolveTransferSuccess(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	mux := chi.NewRouter()
+	mock := mockService.NewMockInterface(ctrl)
+	mux.HandleFunc("/api/api_v1/transfer/resolve", New(mock, time.Second).ResolveTransfer)
+
+	response := httptest.NewRecorder()
+	request := httptest.NewRequest(http.MethodPut, "/api/api_v1/transfer/resolve",
+		strings.NewReader("{\"transfer_id\":12,\"restock\":true}"))
+
+	mock.EXPECT().ResolveTransfer(gomock.Any(), dto.TransferResolution{ID: 12, Restock: true}).Times(1).Return(
+		dto.Transfer{ID: 12, Direction: transfer.Outbound, State: transfer.Resolved}, nil)
+
+	mux.ServeHTTP(response, request)
+	if response.Code != http.StatusOK || !strings.Contains(response.Body.String(), "\"state\":4") {
+		t.Fail()
+	}
+}
+
+func TestHandler_TransferSuccess(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	mux := chi.NewRouter()
+	mock := mockService.NewMockInterface(ctrl)
+	mux.HandleFunc("/api/api_v1/transfer/", New(mock, time.Second).Transfer)
+
+	mock.EXPECT().Transfer(gomock.Any(), dto.TransferID{ID: 5}).Times(1).Return(dto.Transfer{ID: 5,
+		Direction: transfer.Inbound, Counterpart: "store-1", SourceID: 12, State: transfer.InTransit,
+		Items: []dto.TransferItem{{Article: "CA-F91W", Amount: 3}}}, nil)
+
+	response := httptest.NewRecorder()
+	request := httptest.NewRequest(http.MethodGet, "/api/api_v1/transfer/", nil)
+	request.Form = url.Values{}
+	request.Form.Set("id", "5")
+
+	mux.ServeHTTP(response, request)
+	if response.Code != http.StatusOK || !strings.Contains(response.Body.String(), "\"source_transfer_id\":12") {
+		t.Fail()
+	}
+}
+
+func TestHandler_TransfersIncorrectDirection(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	mux := chi.NewRouter()
+	mock := mockService.NewMockInterface(ctrl)
+	mux.HandleFunc("/api/api_v1/transfer/list/", New(mock, time.Second).Transfers)
+
+	mock.EXPECT().Transfers(gomock.Any(), gomock.Any()).Times(0)
+
+	response := httptest.NewRecorder()
+	request := httptest.NewRequest(http.MethodGet, "/api/api_v1/transfer/list/", nil)
+	request.Form = url.Values{}
+	request.Form.Set("direction", "sideways")
+
+	mux.ServeHTTP(response, request)
+	if response.Code != http.StatusBadRequest {
+		t.Fail()
+	}
+}
+
+func TestHandler_TransfersByState(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	mux := chi.NewRouter()
+	mock := mockService.NewMockInterface(ctrl)
+	mux.HandleFunc("/api/api_v1/transfer/list/", New(mock, time.Second).Transfers)
+
+	mock.EXPECT().Transfers(gomock.Any(), dto.TransferFilter{Direction: transfer.Outbound,
+		State: transfer.Discrepancy}).Times(1).Return([]dto.Transfer{{ID: 12}}, nil)
+
+	response := httptest.NewRecorder()
+	request := httptest.NewRequest(http.MethodGet, "/api/api_v1/transfer/list/", nil)
+	request.Form = url.Values{}
+	request.Form.Set("direction", "outbound")
+	request.Form.Set("state", "3")
+
+	mux.ServeHTTP(response, request)
+	if response.Code != http.StatusOK || !strings.Contains(response.Body.String(), "\"transfer_id\":12") {
+		t.Fail()
+	}
+}
@@ -0,0 +1,184 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/go-chi/render"
+	"github.com/lazylex/watch-store-store/internal/adapters/rest/request"
+	"github.com/lazylex/watch-store-store/internal/adapters/rest/response"
+	"github.com/lazylex/watch-store-store/internal/domain/aggregates/stocktake"
+	"github.com/lazylex/watch-store-store/internal/dto"
+	"github.com/lazylex/watch-store-store/internal/logger"
+	"log/slog"
+	"net/http"
+	"strconv"
+)
+
+// StartStocktake начинает инвентаризацию. В теле запроса в формате JSON передаются артикулы подсчитываемых товаров.
+// Если список артикулов пуст, инвентаризация проводится по всему ассортименту. Пример передаваемых данных:
+//
+// {"articles": ["CA-F91W", "CA-A168WG-9EF"]}
+//
+// В случае успеха возвращается http.StatusCreated и идентификатор инвентаризации:
+//
+// {"stocktake_id": 7}
+func (h *Handler) StartStocktake(w http.ResponseWriter, r *http.Request) {
+	var err error
+	var id stocktake.ID
+	var transferObject dto.StocktakeArticles
+	log := logger.AddPlaceAndRequestId(slog.Default(), "rest.handlers.StartStocktake", r)
+
+	ctx, cancel := context.WithTimeout(r.Context(), h.queryTimeout)
+	defer cancel()
+
+	err = json.NewDecoder(r.Body).Decode(&transferObject)
+	if err != nil {
+		response.WriteHeaderAndLogAboutBadRequest(w, log, err)
+		return
+	}
+
+	err = h.resolveBarcodes(injectRequestIDToCtx(ctx, r), articlesOf(transferObject.Articles)...)
+	if response.WriteHeaderAndLogAboutErr(w, log, err); err != nil {
+		return
+	}
+
+	err = transferObject.Validate()
+	if response.WriteHeaderAndLogAboutErr(w, log, err); err != nil {
+		return
+	}
+
+	id, err = h.service.StartStocktake(injectRequestIDToCtx(ctx, r), transferObject)
+	if response.WriteHeaderAndLogAboutErr(w, log, err); err == nil {
+		render.Status(r, http.StatusCreated)
+		render.JSON(w, r, dto.StocktakeID{ID: id})
+		log.Info(fmt.Sprintf("stocktake %d started", id))
+	}
+}
+
+// CountStocktake добавляет к инвентаризации подсчитанное количество товаров. Количество прибавляется к подсчитанному
+// ранее, поэтому подсчёт можно передавать частями. В теле запроса передаются данные в формате JSON. Пример:
+//
+//	{
+//		"stocktake_id": 7,
+//		"counts": [{"article": "CA-F91W", "amount": 12}, {"article": "CA-A168WG-9EF", "amount": 0}]
+//	}
+func (h *Handler) CountStocktake(w http.ResponseWriter, r *http.Request) {
+	var err error
+	var transferObject dto.StocktakeCounts
+	log := logger.AddPlaceAndRequestId(slog.Default(), "rest.handlers.CountStocktake", r)
+
+	ctx, cancel := context.WithTimeout(r.Context(), h.queryTimeout)
+	defer cancel()
+
+	err = json.NewDecoder(r.Body).Decode(&transferObject)
+	if err != nil {
+		response.WriteHeaderAndLogAboutBadRequest(w, log, err)
+		return
+	}
+
+	err = h.resolveBarcodes(injectRequestIDToCtx(ctx, r), articlesOfAmounts(transferObject.Counts)...)
+	if response.WriteHeaderAndLogAboutErr(w, log, err); err != nil {
+		return
+	}
+
+	err = transferObject.Validate()
+	if response.WriteHeaderAndLogAboutErr(w, log, err); err != nil {
+		return
+	}
+
+	err = h.service.CountStocktake(injectRequestIDToCtx(ctx, r), transferObject)
+	if response.WriteHeaderAndLogAboutErr(w, log, err); err != nil {
+		return
+	}
+
+	log.Info(fmt.Sprintf("added counts of %d articles to stocktake %d", len(transferObject.Counts),
+		transferObject.ID))
+}
+
+// StocktakeDiscrepancies возвращает в формате JSON расхождения инвентаризации с переданным в параметре запроса (id)
+// идентификатором. Пример возвращаемых данных:
+//
+//	{
+//		"stocktake_id": 7,
+//		"state": 1,
+//		"started_at": "2024-06-14T09:00:00Z",
+//		"applied_at": "0001-01-01T00:00:00Z",
+//		"items": [
+//			{
+//				"article": "CA-F91W",
+//				"system_amount": 15,
+//				"counted": 12,
+//				"is_counted": true,
+//				"sold": 2,
+//				"refunded": 0,
+//				"expected": 13,
+//				"difference": -1
+//			}
+//		]
+//	}
+func (h *Handler) StocktakeDiscrepancies(w http.ResponseWriter, r *http.Request) {
+	var err error
+	var id int64
+	var result dto.Stocktake
+	log := logger.AddPlaceAndRequestId(slog.Default(), "rest.handlers.StocktakeDiscrepancies", r)
+
+	ctx, cancel := context.WithTimeout(r.Context(), h.queryTimeout)
+	defer cancel()
+
+	if id, err = strconv.ParseInt(r.FormValue(request.ID), 10, 64); err != nil {
+		response.WriteHeaderAndLogAboutBadRequest(w, log, request.ErrIncorrectID)
+		return
+	}
+
+	transferObject := dto.StocktakeID{ID: stocktake.ID(id)}
+	err = transferObject.Validate()
+	if response.WriteHeaderAndLogAboutErr(w, log, err); err != nil {
+		return
+	}
+
+	result, err = h.service.StocktakeDiscrepancies(injectRequestIDToCtx(ctx, r), transferObject)
+	if response.WriteHeaderAndLogAboutErr(w, log, err); err != nil {
+		return
+	}
+
+	log.Info(fmt.Sprintf("requested discrepancies of stocktake %d", id))
+
+	render.JSON(w, r, result)
+}
+
+// ApplyStocktake вносит расхождения инвентаризации в остатки товаров. В теле запроса передается идентификатор
+// инвентаризации в формате JSON:
+//
+// {"stocktake_id": 7}
+//
+// Возвращается отчёт о внесённых расхождениях в формате ответа StocktakeDiscrepancies.
+func (h *Handler) ApplyStocktake(w http.ResponseWriter, r *http.Request) {
+	var err error
+	var result dto.Stocktake
+	var transferObject dto.StocktakeID
+	log := logger.AddPlaceAndRequestId(slog.Default(), "rest.handlers.ApplyStocktake", r)
+
+	ctx, cancel := context.WithTimeout(r.Context(), h.queryTimeout)
+	defer cancel()
+
+	err = json.NewDecoder(r.Body).Decode(&transferObject)
+	if err != nil {
+		response.WriteHeaderAndLogAboutBadRequest(w, log, err)
+		return
+	}
+
+	err = transferObject.Validate()
+	if response.WriteHeaderAndLogAboutErr(w, log, err); err != nil {
+		return
+	}
+
+	result, err = h.service.ApplyStocktake(injectRequestIDToCtx(ctx, r), transferObject)
+	if response.WriteHeaderAndLogAboutErr(w, log, err); err != nil {
+		return
+	}
+
+	log.Info(fmt.Sprintf("stocktake %d applied", transferObject.ID))
+
+	render.JSON(w, r, result)
+}
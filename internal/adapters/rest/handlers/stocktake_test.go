@@ -0,0 +1,113 @@
+package handlers
+
+import (
+	"github.com/go-chi/chi"
+	"github.com/golang/mock/gomock"
+	"github.com/lazylex/watch-store-store/internal/domain/aggregates/stocktake"
+	"github.com/lazylex/watch-store-store/internal/domain/value_objects/article"
+	"github.com/lazylex/watch-store-store/internal/dto"
+	"github.com/lazylex/watch-store-store/internal/ports/service"
+	mockService "github.com/lazylex/watch-store-store/internal/ports/service/mocks"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestHandler_StartStocktakeSuccess(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	mux := chi.NewRouter()
+	mock := mockService.NewMockInterface(ctrl)
+	mux.HandleFunc("/api/api_v1/stocktake/start", New(mock, time.Second).StartStocktake)
+
+	response := httptest.NewRecorder()
+	request := httptest.NewRequest(http.MethodPost, "/api/api_v1/stocktake/start",
+		strings.NewReader("{\"articles\":[\"CA-F91W\"]}"))
+
+	mock.EXPECT().StartStocktake(gomock.Any(), dto.StocktakeArticles{Articles: []article.Article{"CA-F91W"}}).Times(
+		1).Return(stocktake.ID(7), nil)
+
+	mux.ServeHTTP(response, request)
+	if response.Code != http.StatusCreated || strings.Compare(response.Body.String(), "{\"stocktake_id\":7}\n") != 0 {
+		t.Fail()
+	}
+}
+
+func TestHandler_CountStocktakeNoCounts(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	mux := chi.NewRouter()
+	mock := mockService.NewMockInterface(ctrl)
+	mux.HandleFunc("/api/api_v1/stocktake/count", New(mock, time.Second).CountStocktake)
+
+	response := httptest.NewRecorder()
+	request := httptest.NewRequest(http.MethodPut, "/api/api_v1/stocktake/count",
+		strings.NewReader("{\"stocktake_id\":7,\"counts\":[]}"))
+
+	mux.ServeHTTP(response, request)
+	if response.Code != http.StatusBadRequest {
+		t.Fail()
+	}
+}
+
+func TestHandler_CountStocktakeNotIncluded(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	mux := chi.NewRouter()
+	mock := mockService.NewMockInterface(ctrl)
+	mux.HandleFunc("/api/api_v1/stocktake/count", New(mock, time.Second).CountStocktake)
+
+	response := httptest.NewRecorder()
+	request := httptest.NewRequest(http.MethodPut, "/api/api_v1/stocktake/count",
+		strings.NewReader("{\"stocktake_id\":7,\"counts\":[{\"article\":\"CA-F91W\",\"amount\":3}]}"))
+
+	mock.EXPECT().CountStocktake(gomock.Any(), gomock.Any()).Times(1).Return(service.ErrArticleNotInStocktake)
+
+	mux.ServeHTTP(response, request)
+	if response.Code != http.StatusConflict {
+		t.Fail()
+	}
+}
+
+func TestHandler_StocktakeDiscrepanciesSuccess(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	mux := chi.NewRouter()
+	mock := mockService.NewMockInterface(ctrl)
+	mux.HandleFunc("/api/api_v1/stocktake/discrepancies/", New(mock, time.Second).StocktakeDiscrepancies)
+	mock.EXPECT().StocktakeDiscrepancies(gomock.Any(), dto.StocktakeID{ID: 7}).Times(1).Return(
+		dto.Stocktake{ID: 7, State: stocktake.InProgress}, nil)
+
+	response := httptest.NewRecorder()
+	request := httptest.NewRequest(http.MethodGet, "/api/api_v1/stocktake/discrepancies/", nil)
+	request.Form = url.Values{}
+	request.Form.Set("id", "7")
+
+	mux.ServeHTTP(response, request)
+	if response.Code != http.StatusOK {
+		t.Fail()
+	}
+}
+
+func TestHandler_ApplyStocktakeAlreadyApplied(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	mux := chi.NewRouter()
+	mock := mockService.NewMockInterface(ctrl)
+	mux.HandleFunc("/api/api_v1/stocktake/apply", New(mock, time.Second).ApplyStocktake)
+
+	response := httptest.NewRecorder()
+	request := httptest.NewRequest(http.MethodPut, "/api/api_v1/stocktake/apply",
+		strings.NewReader("{\"stocktake_id\":7}"))
+
+	mock.EXPECT().ApplyStocktake(gomock.Any(), dto.StocktakeID{ID: 7}).Times(1).Return(dto.Stocktake{},
+		service.ErrStocktakeApplied)
+
+	mux.ServeHTTP(response, request)
+	if response.Code != http.StatusConflict {
+		t.Fail()
+	}
+}
@@ -0,0 +1,140 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/go-chi/render"
+	"github.com/lazylex/watch-store-store/internal/adapters/rest/request"
+	"github.com/lazylex/watch-store-store/internal/adapters/rest/response"
+	"github.com/lazylex/watch-store-store/internal/domain/value_objects/article"
+	"github.com/lazylex/watch-store-store/internal/dto"
+	"github.com/lazylex/watch-store-store/internal/logger"
+	"log/slog"
+	"net/http"
+)
+
+// SetTaxRate сохраняет ставку НДС в процентах для налоговой категории товаров, действующую с указанного момента. В теле
+// запроса передаются данные в формате JSON. Пример передаваемых данных:
+//
+//	{"category": "standard", "rate": 20, "effective_from": "2026-01-01T00:00:00+03:00"}
+func (h *Handler) SetTaxRate(w http.ResponseWriter, r *http.Request) {
+	var err error
+	var transferObject dto.TaxRate
+	log := logger.AddPlaceAndRequestId(slog.Default(), "rest.handlers.SetTaxRate", r)
+
+	ctx, cancel := context.WithTimeout(r.Context(), h.queryTimeout)
+	defer cancel()
+
+	if err = json.NewDecoder(r.Body).Decode(&transferObject); err != nil {
+		response.WriteHeaderAndLogAboutBadRequest(w, log, err)
+		return
+	}
+
+	err = transferObject.Validate()
+	if response.WriteHeaderAndLogAboutErr(w, log, err); err != nil {
+		return
+	}
+
+	err = h.service.SetTaxRate(injectRequestIDToCtx(ctx, r), transferObject)
+	if response.WriteHeaderAndLogAboutErr(w, log, err); err != nil {
+		return
+	}
+
+	log.Info(fmt.Sprintf("tax rate of category %s saved", transferObject.Category))
+}
+
+// TaxRates возвращает все ставки НДС, упорядоченные по налоговой категории и дате начала действия. Пример возвращаемых
+// данных:
+//
+//	[
+//		{"category": "reduced", "rate": 10, "effective_from": "2019-01-01T00:00:00Z"},
+//		{"category": "standard", "rate": 20, "effective_from": "2019-01-01T00:00:00Z"}
+//	]
+func (h *Handler) TaxRates(w http.ResponseWriter, r *http.Request) {
+	var err error
+	var result []dto.TaxRate
+	log := logger.AddPlaceAndRequestId(slog.Default(), "rest.handlers.TaxRates", r)
+
+	ctx, cancel := context.WithTimeout(r.Context(), h.queryTimeout)
+	defer cancel()
+
+	result, err = h.service.TaxRates(injectRequestIDToCtx(ctx, r))
+	if response.WriteHeaderAndLogAboutErr(w, log, err); err != nil {
+		return
+	}
+
+	log.Info(fmt.Sprintf("requested %d tax rates", len(result)))
+
+	render.JSON(w, r, result)
+}
+
+// SetArticleTaxCategory сохраняет налоговую категорию товара. Для категории, отличной от default, должна быть сохранена
+// хотя бы одна ставка, иначе возвращается код 409. В теле запроса передаются данные в формате JSON. Пример передаваемых
+// данных:
+//
+//	{"article": "CA-F91W", "category": "reduced"}
+func (h *Handler) SetArticleTaxCategory(w http.ResponseWriter, r *http.Request) {
+	var err error
+	var transferObject dto.ArticleTaxCategory
+	log := logger.AddPlaceAndRequestId(slog.Default(), "rest.handlers.SetArticleTaxCategory", r)
+
+	ctx, cancel := context.WithTimeout(r.Context(), h.queryTimeout)
+	defer cancel()
+
+	if err = json.NewDecoder(r.Body).Decode(&transferObject); err != nil {
+		response.WriteHeaderAndLogAboutBadRequest(w, log, err)
+		return
+	}
+
+	err = h.resolveBarcodes(injectRequestIDToCtx(ctx, r), &transferObject.Article)
+	if response.WriteHeaderAndLogAboutErr(w, log, err); err != nil {
+		return
+	}
+
+	err = transferObject.Validate()
+	if response.WriteHeaderAndLogAboutErr(w, log, err); err != nil {
+		return
+	}
+
+	err = h.service.SetArticleTaxCategory(injectRequestIDToCtx(ctx, r), transferObject)
+	if response.WriteHeaderAndLogAboutErr(w, log, err); err != nil {
+		return
+	}
+
+	log.Info(fmt.Sprintf("tax category of article %s saved", transferObject.Article))
+}
+
+// ArticleTaxRate возвращает налоговую категорию товара с переданным параметром запроса (article) артикулом и
+// действующую в текущий момент ставку НДС этой категории. Пример возвращаемых данных:
+//
+//	{"article": "CA-F91W", "category": "standard", "rate": 20}
+func (h *Handler) ArticleTaxRate(w http.ResponseWriter, r *http.Request) {
+	var err error
+	var result dto.ArticleTaxRate
+	log := logger.AddPlaceAndRequestId(slog.Default(), "rest.handlers.ArticleTaxRate", r)
+
+	ctx, cancel := context.WithTimeout(r.Context(), h.queryTimeout)
+	defer cancel()
+
+	transferObject := dto.Article{Article: article.Article(r.FormValue(request.Article))}
+
+	err = h.resolveBarcodes(injectRequestIDToCtx(ctx, r), &transferObject.Article)
+	if response.WriteHeaderAndLogAboutErr(w, log, err); err != nil {
+		return
+	}
+
+	err = transferObject.Validate()
+	if response.WriteHeaderAndLogAboutErr(w, log, err); err != nil {
+		return
+	}
+
+	result, err = h.service.ArticleTaxRate(injectRequestIDToCtx(ctx, r), transferObject)
+	if response.WriteHeaderAndLogAboutErr(w, log, err); err != nil {
+		return
+	}
+
+	log.Info(fmt.Sprintf("requested tax rate of article %s", transferObject.Article))
+
+	render.JSON(w, r, result)
+}
@@ -0,0 +1,95 @@
+package handlers
+
+import (
+	"github.com/go-chi/chi"
+	"github.com/golang/mock/gomock"
+	"github.com/lazylex/watch-store-store/internal/dto"
+	"github.com/lazylex/watch-store-store/internal/ports/service"
+	mockService "github.com/lazylex/watch-store-store/internal/ports/service/mocks"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestHandler_SetTaxRate(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	mux := chi.NewRouter()
+	mock := mockService.NewMockInterface(ctrl)
+	mux.HandleFunc("/api/api_v1/tax/rate", New(mock, time.Second).SetTaxRate)
+
+	response := httptest.NewRecorder()
+	request := httptest.NewRequest(http.MethodPut, "/api/api_v1/tax/rate",
+		strings.NewReader("{\"category\":\"standard\",\"rate\":20,\"effective_from\":\"2026-01-01T00:00:00Z\"}"))
+
+	mock.EXPECT().SetTaxRate(gomock.Any(), dto.TaxRate{Category: "standard", Rate: 20,
+		EffectiveFrom: time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)}).Times(1).Return(nil)
+
+	mux.ServeHTTP(response, request)
+	if response.Code != http.StatusOK {
+		t.Fail()
+	}
+}
+
+func TestHandler_SetTaxRateIncorrectRate(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	mux := chi.NewRouter()
+	mock := mockService.NewMockInterface(ctrl)
+	mux.HandleFunc("/api/api_v1/tax/rate", New(mock, time.Second).SetTaxRate)
+
+	response := httptest.NewRecorder()
+	request := httptest.NewRequest(http.MethodPut, "/api/api_v1/tax/rate",
+		strings.NewReader("{\"category\":\"standard\",\"rate\":120,\"effective_from\":\"2026-01-01T00:00:00Z\"}"))
+
+	mock.EXPECT().SetTaxRate(gomock.Any(), gomock.Any()).Times(0)
+
+	mux.ServeHTTP(response, request)
+	if response.Code != http.StatusBadRequest {
+		t.Fail()
+	}
+}
+
+func TestHandler_SetArticleTaxCategoryUnknownCategory(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	mux := chi.NewRouter()
+	mock := mockService.NewMockInterface(ctrl)
+	mux.HandleFunc("/api/api_v1/tax/category", New(mock, time.Second).SetArticleTaxCategory)
+
+	response := httptest.NewRecorder()
+	request := httptest.NewRequest(http.MethodPut, "/api/api_v1/tax/category",
+		strings.NewReader("{\"article\":\"CA-F91W\",\"category\":\"reduced\"}"))
+
+	mock.EXPECT().SetArticleTaxCategory(gomock.Any(), dto.ArticleTaxCategory{Article: "CA-F91W",
+		Category: "reduced"}).Times(1).Return(service.ErrUnknownTaxCategory)
+
+	mux.ServeHTTP(response, request)
+	if response.Code != http.StatusConflict {
+		t.Fail()
+	}
+}
+
+func TestHandler_ArticleTaxRate(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	mux := chi.NewRouter()
+	mock := mockService.NewMockInterface(ctrl)
+	mux.HandleFunc("/api/api_v1/tax/category/", New(mock, time.Second).ArticleTaxRate)
+
+	response := httptest.NewRecorder()
+	request := httptest.NewRequest(http.MethodGet, "/api/api_v1/tax/category/", nil)
+	request.Form = url.Values{}
+	request.Form.Set("article", "CA-F91W")
+
+	mock.EXPECT().ArticleTaxRate(gomock.Any(), dto.Article{Article: "CA-F91W"}).Times(1).Return(
+		dto.ArticleTaxRate{Article: "CA-F91W", Category: "standard", Rate: 20}, nil)
+
+	mux.ServeHTTP(response, request)
+	if response.Code != http.StatusOK || !strings.Contains(response.Body.String(), "\"rate\":20") {
+		t.Fail()
+	}
+}
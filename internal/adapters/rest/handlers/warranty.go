@@ -0,0 +1,302 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/go-chi/render"
+	"github.com/lazylex/watch-store-store/internal/adapters/rest/request"
+	"github.com/lazylex/watch-store-store/internal/adapters/rest/response"
+	"github.com/lazylex/watch-store-store/internal/domain/aggregates/receipt"
+	"github.com/lazylex/watch-store-store/internal/domain/aggregates/warranty"
+	"github.com/lazylex/watch-store-store/internal/domain/value_objects/article"
+	"github.com/lazylex/watch-store-store/internal/domain/value_objects/phone"
+	"github.com/lazylex/watch-store-store/internal/domain/value_objects/serial"
+	"github.com/lazylex/watch-store-store/internal/dto"
+	"github.com/lazylex/watch-store-store/internal/logger"
+	"log/slog"
+	"net/http"
+	"strconv"
+)
+
+// SetWarrantyTerm сохраняет гарантийный срок товара в месяцах. При нулевом сроке гарантия на товар больше не
+// выдаётся. Изменение срока не затрагивает уже выданные гарантии. В теле запроса передаются данные в формате JSON.
+// Пример передаваемых данных:
+//
+//	{"article": "CA-F91W", "months": 24}
+func (h *Handler) SetWarrantyTerm(w http.ResponseWriter, r *http.Request) {
+	var err error
+	var transferObject dto.WarrantyTerm
+	log := logger.AddPlaceAndRequestId(slog.Default(), "rest.handlers.SetWarrantyTerm", r)
+
+	ctx, cancel := context.WithTimeout(r.Context(), h.queryTimeout)
+	defer cancel()
+
+	if err = json.NewDecoder(r.Body).Decode(&transferObject); err != nil {
+		response.WriteHeaderAndLogAboutBadRequest(w, log, err)
+		return
+	}
+
+	err = h.resolveBarcodes(injectRequestIDToCtx(ctx, r), &transferObject.Article)
+	if response.WriteHeaderAndLogAboutErr(w, log, err); err != nil {
+		return
+	}
+
+	err = transferObject.Validate()
+	if response.WriteHeaderAndLogAboutErr(w, log, err); err != nil {
+		return
+	}
+
+	err = h.service.SetWarrantyTerm(injectRequestIDToCtx(ctx, r), transferObject)
+	if response.WriteHeaderAndLogAboutErr(w, log, err); err != nil {
+		return
+	}
+
+	log.Info(fmt.Sprintf("warranty term of article %s saved", transferObject.Article))
+}
+
+// WarrantyTerm возвращает гарантийный срок в месяцах товара с переданным параметром запроса (article) артикулом. Для
+// товара без гарантии срок равен нулю. Пример возвращаемых данных:
+//
+//	{"article": "CA-F91W", "months": 24}
+func (h *Handler) WarrantyTerm(w http.ResponseWriter, r *http.Request) {
+	var err error
+	var result dto.WarrantyTerm
+	log := logger.AddPlaceAndRequestId(slog.Default(), "rest.handlers.WarrantyTerm", r)
+
+	ctx, cancel := context.WithTimeout(r.Context(), h.queryTimeout)
+	defer cancel()
+
+	transferObject := dto.Article{Article: article.Article(r.FormValue(request.Article))}
+
+	err = h.resolveBarcodes(injectRequestIDToCtx(ctx, r), &transferObject.Article)
+	if response.WriteHeaderAndLogAboutErr(w, log, err); err != nil {
+		return
+	}
+
+	err = transferObject.Validate()
+	if response.WriteHeaderAndLogAboutErr(w, log, err); err != nil {
+		return
+	}
+
+	result, err = h.service.WarrantyTerm(injectRequestIDToCtx(ctx, r), transferObject)
+	if response.WriteHeaderAndLogAboutErr(w, log, err); err != nil {
+		return
+	}
+
+	log.Info(fmt.Sprintf("requested warranty term of article %s", transferObject.Article))
+
+	render.JSON(w, r, result)
+}
+
+// WarrantiesByReceipt возвращает гарантии, выданные по чеку с переданным параметром запроса (receipt_id)
+// идентификатором. Гарантия на экземпляр товара с серийным номером выдаётся на одну единицу товара, на остальные
+// товары - на всё количество строки чека. Пример возвращаемых данных:
+//
+//	[
+//		{"id": 31, "article": "CA-F91W", "serial": "3A9C0412", "amount": 1, "receipt_id": 118,
+//		 "phone": "79123456789", "sold_at": "2026-03-05T17:40:12Z", "expires_at": "2028-03-05T17:40:12Z"},
+//		{"id": 32, "article": "CA-STRAP-18", "amount": 2, "receipt_id": 118, "phone": "79123456789",
+//		 "sold_at": "2026-03-05T17:40:12Z", "expires_at": "2026-09-05T17:40:12Z"}
+//	]
+func (h *Handler) WarrantiesByReceipt(w http.ResponseWriter, r *http.Request) {
+	var err error
+	var id int64
+	var result []dto.Warranty
+	log := logger.AddPlaceAndRequestId(slog.Default(), "rest.handlers.WarrantiesByReceipt", r)
+
+	ctx, cancel := context.WithTimeout(r.Context(), h.queryTimeout)
+	defer cancel()
+
+	if id, err = strconv.ParseInt(r.FormValue(request.Receipt), 10, 64); err != nil {
+		response.WriteHeaderAndLogAboutBadRequest(w, log, request.ErrIncorrectID)
+		return
+	}
+
+	transferObject := dto.ReceiptID{ID: receipt.ID(id)}
+	err = transferObject.Validate()
+	if response.WriteHeaderAndLogAboutErr(w, log, err); err != nil {
+		return
+	}
+
+	result, err = h.service.WarrantiesByReceipt(injectRequestIDToCtx(ctx, r), transferObject)
+	if response.WriteHeaderAndLogAboutErr(w, log, err); err != nil {
+		return
+	}
+
+	log.Info(fmt.Sprintf("requested warranties of receipt %d, %d found", id, len(result)))
+
+	render.JSON(w, r, result)
+}
+
+// WarrantiesBySerial возвращает в хронологическом порядке гарантии на экземпляр товара с переданным параметром запроса
+// (serial) серийным номером. Если экземпляр продавался повторно после возврата, гарантий несколько. Формат
+// возвращаемых данных совпадает с форматом данных WarrantiesByReceipt.
+func (h *Handler) WarrantiesBySerial(w http.ResponseWriter, r *http.Request) {
+	var err error
+	var result []dto.Warranty
+	log := logger.AddPlaceAndRequestId(slog.Default(), "rest.handlers.WarrantiesBySerial", r)
+
+	ctx, cancel := context.WithTimeout(r.Context(), h.queryTimeout)
+	defer cancel()
+
+	transferObject := dto.Serial{Serial: serial.Number(r.FormValue(request.Serial))}
+
+	err = transferObject.Validate()
+	if response.WriteHeaderAndLogAboutErr(w, log, err); err != nil {
+		return
+	}
+
+	result, err = h.service.WarrantiesBySerial(injectRequestIDToCtx(ctx, r), transferObject)
+	if response.WriteHeaderAndLogAboutErr(w, log, err); err != nil {
+		return
+	}
+
+	log.Info(fmt.Sprintf("requested warranties of serial %s, %d found", transferObject.Serial, len(result)))
+
+	render.JSON(w, r, result)
+}
+
+// WarrantiesByPhone возвращает в хронологическом порядке гарантии, выданные покупателю с переданным в параметре
+// запроса (phone) номером телефона. Номер может содержать пробелы, скобки, дефисы и ведущий '+'. Формат возвращаемых
+// данных совпадает с форматом данных WarrantiesByReceipt.
+func (h *Handler) WarrantiesByPhone(w http.ResponseWriter, r *http.Request) {
+	var err error
+	var result []dto.Warranty
+	log := logger.AddPlaceAndRequestId(slog.Default(), "rest.handlers.WarrantiesByPhone", r)
+
+	ctx, cancel := context.WithTimeout(r.Context(), h.queryTimeout)
+	defer cancel()
+
+	transferObject := dto.Phone{Phone: phone.Phone(r.FormValue(request.Phone))}
+	if err = transferObject.Validate(); err != nil {
+		response.WriteHeaderAndLogAboutBadRequest(w, log, request.ErrIncorrectPhone)
+		return
+	}
+
+	result, err = h.service.WarrantiesByPhone(injectRequestIDToCtx(ctx, r), transferObject)
+	if response.WriteHeaderAndLogAboutErr(w, log, err); err != nil {
+		return
+	}
+
+	log.Info(fmt.Sprintf("requested warranties of phone %s, %d found", transferObject.Phone.Redacted(), len(result)))
+
+	render.JSON(w, r, result)
+}
+
+// RegisterWarrantyClaim регистрирует гарантийное обращение покупателя и возвращает присвоенный ему идентификатор. Если
+// гарантии нет, возвращается код 404, если её срок истёк или по ней есть незакрытое обращение - код 409. В теле
+// запроса передаются данные в формате JSON. Пример передаваемых данных:
+//
+//	{"warranty_id": 31, "description": "Отстают на 20 секунд в сутки"}
+//
+// Пример возвращаемых данных:
+//
+//	{"claim_id": 7}
+func (h *Handler) RegisterWarrantyClaim(w http.ResponseWriter, r *http.Request) {
+	var err error
+	var id warranty.ClaimID
+	var transferObject dto.WarrantyIDDescription
+	log := logger.AddPlaceAndRequestId(slog.Default(), "rest.handlers.RegisterWarrantyClaim", r)
+
+	ctx, cancel := context.WithTimeout(r.Context(), h.queryTimeout)
+	defer cancel()
+
+	if err = json.NewDecoder(r.Body).Decode(&transferObject); err != nil {
+		response.WriteHeaderAndLogAboutBadRequest(w, log, err)
+		return
+	}
+
+	err = transferObject.Validate()
+	if response.WriteHeaderAndLogAboutErr(w, log, err); err != nil {
+		return
+	}
+
+	id, err = h.service.RegisterWarrantyClaim(injectRequestIDToCtx(ctx, r), transferObject)
+	if response.WriteHeaderAndLogAboutErr(w, log, err); err == nil {
+		render.Status(r, http.StatusCreated)
+		render.JSON(w, r, dto.WarrantyClaimID{ID: id})
+		log.Info(fmt.Sprintf("claim %d registered for warranty %d", id, transferObject.WarrantyID))
+	}
+}
+
+// ChangeWarrantyClaimStatus переводит гарантийное обращение в новое состояние. Зарегистрированное обращение передаётся
+// в ремонт (in_repair), либо по нему сразу принимается решение о замене (replaced) или отказе (rejected). Товар из
+// ремонта возвращается отремонтированным (repaired) или заменяется, либо в ремонте отказывают. Обращение, по которому
+// принято решение, закрывается (closed) при возврате товара покупателю. При недопустимом переходе возвращается код 409.
+// В теле запроса передаются данные в формате JSON. Пример передаваемых данных:
+//
+//	{"claim_id": 7, "status": "in_repair"}
+func (h *Handler) ChangeWarrantyClaimStatus(w http.ResponseWriter, r *http.Request) {
+	var err error
+	var transferObject dto.ClaimIDStatus
+	log := logger.AddPlaceAndRequestId(slog.Default(), "rest.handlers.ChangeWarrantyClaimStatus", r)
+
+	ctx, cancel := context.WithTimeout(r.Context(), h.queryTimeout)
+	defer cancel()
+
+	if err = json.NewDecoder(r.Body).Decode(&transferObject); err != nil {
+		response.WriteHeaderAndLogAboutBadRequest(w, log, err)
+		return
+	}
+
+	err = transferObject.Validate()
+	if response.WriteHeaderAndLogAboutErr(w, log, err); err != nil {
+		return
+	}
+
+	err = h.service.ChangeWarrantyClaimStatus(injectRequestIDToCtx(ctx, r), transferObject)
+	if response.WriteHeaderAndLogAboutErr(w, log, err); err != nil {
+		return
+	}
+
+	log.Info(fmt.Sprintf("claim %d moved to %s", transferObject.ClaimID, transferObject.Status))
+}
+
+// WarrantyClaims возвращает в хронологическом порядке гарантийные обращения по гарантии с переданным параметром
+// запроса (warranty_id) идентификатором вместе с историей смены их состояний. Если гарантии нет, возвращается код 404.
+// Пример возвращаемых данных:
+//
+//	[
+//		{
+//			"id": 7,
+//			"warranty_id": 31,
+//			"description": "Отстают на 20 секунд в сутки",
+//			"status": "in_repair",
+//			"created_at": "2026-06-11T12:03:44Z",
+//			"history": [
+//				{"claim_id": 7, "to": "registered", "actor": "seller", "date": "2026-06-11T12:03:44Z"},
+//				{"claim_id": 7, "from": "registered", "to": "in_repair", "actor": "seller",
+//				 "date": "2026-06-12T09:30:00Z"}
+//			]
+//		}
+//	]
+func (h *Handler) WarrantyClaims(w http.ResponseWriter, r *http.Request) {
+	var err error
+	var id int64
+	var result []dto.WarrantyClaim
+	log := logger.AddPlaceAndRequestId(slog.Default(), "rest.handlers.WarrantyClaims", r)
+
+	ctx, cancel := context.WithTimeout(r.Context(), h.queryTimeout)
+	defer cancel()
+
+	if id, err = strconv.ParseInt(r.FormValue(request.Warranty), 10, 64); err != nil {
+		response.WriteHeaderAndLogAboutBadRequest(w, log, request.ErrIncorrectID)
+		return
+	}
+
+	transferObject := dto.WarrantyID{ID: warranty.ID(id)}
+	err = transferObject.Validate()
+	if response.WriteHeaderAndLogAboutErr(w, log, err); err != nil {
+		return
+	}
+
+	result, err = h.service.WarrantyClaims(injectRequestIDToCtx(ctx, r), transferObject)
+	if response.WriteHeaderAndLogAboutErr(w, log, err); err != nil {
+		return
+	}
+
+	log.Info(fmt.Sprintf("requested claims of warranty %d, %d found", id, len(result)))
+
+	render.JSON(w, r, result)
+}
@@ -0,0 +1,117 @@
+package handlers
+
+import (
+	"github.com/go-chi/chi"
+	"github.com/golang/mock/gomock"
+	"github.com/lazylex/watch-store-store/internal/domain/aggregates/warranty"
+	"github.com/lazylex/watch-store-store/internal/dto"
+	"github.com/lazylex/watch-store-store/internal/ports/repository"
+	"github.com/lazylex/watch-store-store/internal/ports/service"
+	mockService "github.com/lazylex/watch-store-store/internal/ports/service/mocks"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestHandler_RegisterWarrantyClaim(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	mux := chi.NewRouter()
+	mock := mockService.NewMockInterface(ctrl)
+	mux.HandleFunc("/api/api_v1/warranty/claim", New(mock, time.Second).RegisterWarrantyClaim)
+
+	response := httptest.NewRecorder()
+	request := httptest.NewRequest(http.MethodPost, "/api/api_v1/warranty/claim",
+		strings.NewReader("{\"warranty_id\":31,\"description\":\"Отстают на 20 секунд в сутки\"}"))
+
+	mock.EXPECT().RegisterWarrantyClaim(gomock.Any(), dto.WarrantyIDDescription{WarrantyID: 31,
+		Description: "Отстают на 20 секунд в сутки"}).Times(1).Return(warranty.ClaimID(7), nil)
+
+	mux.ServeHTTP(response, request)
+	if response.Code != http.StatusCreated || !strings.Contains(response.Body.String(), "{\"claim_id\":7}") {
+		t.Fail()
+	}
+}
+
+func TestHandler_RegisterWarrantyClaimExpired(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	mux := chi.NewRouter()
+	mock := mockService.NewMockInterface(ctrl)
+	mux.HandleFunc("/api/api_v1/warranty/claim", New(mock, time.Second).RegisterWarrantyClaim)
+
+	response := httptest.NewRecorder()
+	request := httptest.NewRequest(http.MethodPost, "/api/api_v1/warranty/claim",
+		strings.NewReader("{\"warranty_id\":31,\"description\":\"Не заводится\"}"))
+
+	mock.EXPECT().RegisterWarrantyClaim(gomock.Any(), gomock.Any()).Times(1).Return(warranty.ClaimID(0),
+		service.ErrWarrantyExpired)
+
+	mux.ServeHTTP(response, request)
+	if response.Code != http.StatusConflict {
+		t.Fail()
+	}
+}
+
+func TestHandler_ChangeWarrantyClaimStatusIllegalTransition(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	mux := chi.NewRouter()
+	mock := mockService.NewMockInterface(ctrl)
+	mux.HandleFunc("/api/api_v1/warranty/claim/status", New(mock, time.Second).ChangeWarrantyClaimStatus)
+
+	response := httptest.NewRecorder()
+	request := httptest.NewRequest(http.MethodPut, "/api/api_v1/warranty/claim/status",
+		strings.NewReader("{\"claim_id\":7,\"status\":\"closed\"}"))
+
+	mock.EXPECT().ChangeWarrantyClaimStatus(gomock.Any(), dto.ClaimIDStatus{ClaimID: 7, Status: warranty.Closed}).
+		Times(1).Return(&warranty.TransitionError{From: warranty.InRepair, To: warranty.Closed})
+
+	mux.ServeHTTP(response, request)
+	if response.Code != http.StatusConflict {
+		t.Fail()
+	}
+}
+
+func TestHandler_WarrantiesByPhoneIncorrectPhone(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	mux := chi.NewRouter()
+	mock := mockService.NewMockInterface(ctrl)
+	mux.HandleFunc("/api/api_v1/warranty/by-phone/", New(mock, time.Second).WarrantiesByPhone)
+
+	response := httptest.NewRecorder()
+	request := httptest.NewRequest(http.MethodGet, "/api/api_v1/warranty/by-phone/", nil)
+	request.Form = url.Values{}
+	request.Form.Set("phone", "12-34")
+
+	mock.EXPECT().WarrantiesByPhone(gomock.Any(), gomock.Any()).Times(0)
+
+	mux.ServeHTTP(response, request)
+	if response.Code != http.StatusBadRequest {
+		t.Fail()
+	}
+}
+
+func TestHandler_WarrantyClaimsNotFound(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	mux := chi.NewRouter()
+	mock := mockService.NewMockInterface(ctrl)
+	mux.HandleFunc("/api/api_v1/warranty/claims/", New(mock, time.Second).WarrantyClaims)
+
+	response := httptest.NewRecorder()
+	request := httptest.NewRequest(http.MethodGet, "/api/api_v1/warranty/claims/", nil)
+	request.Form = url.Values{}
+	request.Form.Set("warranty_id", "31")
+
+	mock.EXPECT().WarrantyClaims(gomock.Any(), dto.WarrantyID{ID: 31}).Times(1).Return(nil, repository.ErrNoRecord)
+
+	mux.ServeHTTP(response, request)
+	if response.Code != http.StatusNotFound {
+		t.Fail()
+	}
+}
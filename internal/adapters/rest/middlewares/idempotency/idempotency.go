@@ -0,0 +1,160 @@
+package idempotency
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"github.com/go-chi/chi/middleware"
+	"github.com/lazylex/watch-store-store/internal/adapters/rest/response"
+	"github.com/lazylex/watch-store-store/internal/adapters/rest/router"
+	"github.com/lazylex/watch-store-store/internal/dto"
+	"github.com/lazylex/watch-store-store/internal/logger"
+	"github.com/lazylex/watch-store-store/internal/ports/service"
+	"io"
+	"log/slog"
+	"net/http"
+	"strings"
+	"time"
+)
+
+const (
+	header         = "Idempotency-Key"
+	replayedHeader = "Idempotent-Replayed"
+)
+
+type MiddlewareIdempotency struct {
+	service service.Interface
+	ttl     time.Duration // Срок хранения результата запроса
+}
+
+// New конструктор прослойки, обеспечивающей идемпотентность POST и PUT запросов с заголовком Idempotency-Key.
+func New(service service.Interface, ttl time.Duration) *MiddlewareIdempotency {
+	return &MiddlewareIdempotency{service: service, ttl: ttl}
+}
+
+// recorder передаёт ответ обработчика клиенту, одновременно запоминая код ответа и тело. В replay сохраняется тело для
+// повторных запросов, переданное обработчиком ответа, который запрещено сохранять (см. response.NoStore).
+type recorder struct {
+	http.ResponseWriter
+	status int
+	body   bytes.Buffer
+	replay []byte
+}
+
+func (rec *recorder) SetReplayBody(body []byte) {
+	rec.replay = body
+}
+
+func (rec *recorder) WriteHeader(status int) {
+	if rec.status == 0 {
+		rec.status = status
+	}
+	rec.ResponseWriter.WriteHeader(status)
+}
+
+func (rec *recorder) Write(b []byte) (int, error) {
+	if rec.status == 0 {
+		rec.status = http.StatusOK
+	}
+	rec.body.Write(b)
+	return rec.ResponseWriter.Write(b)
+}
+
+// Handle обрабатывает POST и PUT запросы к зарегистрированным путям, содержащие заголовок Idempotency-Key. Ключи
+// хранятся отдельно для каждого субъекта JWT-токена. Первый запрос с ключом выполняется, а его код ответа и тело
+// сохраняются на время ttl. На повторные запросы того же субъекта с тем же ключом возвращается сохранённый результат с
+// заголовком Idempotent-Replayed. Пока первый запрос выполняется, на повторные возвращается http.StatusConflict. Если
+// запрос завершился таймаутом или внутренней ошибкой сервера, результат не сохраняется и запрос может быть повторён с
+// тем же ключом. Вместо ответов с заголовком Cache-Control: no-store (например, содержащих код получения заказа)
+// сохраняется переданная обработчиком часть ответа без секретных данных, а если она не передана - http.StatusConflict.
+// Запросы без заголовка обрабатываются как обычно.
+func (m *MiddlewareIdempotency) Handle(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		key := r.Header.Get(header)
+		if key == "" || (r.Method != http.MethodPost && r.Method != http.MethodPut) || !router.IsExistPath(r.URL.Path) {
+			next.ServeHTTP(rw, r)
+			return
+		}
+
+		log := logger.AddPlaceAndRequestId(slog.Default(), "adapters.rest.middlewares.idempotency.Handle", r)
+		ctx := context.WithValue(r.Context(), logger.RequestId, middleware.GetReqID(r.Context()))
+
+		body, err := io.ReadAll(r.Body)
+		if err != nil {
+			response.WriteHeaderAndLogAboutBadRequest(rw, log, err)
+			return
+		}
+		r.Body = io.NopCloser(bytes.NewReader(body))
+
+		subject, _ := r.Context().Value(logger.Actor).(string)
+		record := dto.IdempotencyRecord{
+			IdempotencyKey: dto.IdempotencyKey{Subject: subject, Key: key},
+			Fingerprint:    fingerprint(r.Method, r.URL.Path, body),
+			ExpiresAt:      time.Now().Add(m.ttl),
+		}
+
+		stored, replay, err := m.service.BeginIdempotentRequest(ctx, record)
+		if response.WriteHeaderAndLogAboutErr(rw, log, err); err != nil {
+			return
+		}
+
+		if replay {
+			if stored.ContentType != "" {
+				rw.Header().Set("Content-Type", stored.ContentType)
+			}
+			rw.Header().Set(replayedHeader, "true")
+			rw.WriteHeader(stored.Status)
+			if _, err = rw.Write(stored.Body); err != nil {
+				log.Warn(err.Error())
+			}
+			return
+		}
+
+		rec := &recorder{ResponseWriter: rw}
+		next.ServeHTTP(rec, r)
+
+		if rec.status == 0 {
+			rec.status = http.StatusOK
+		}
+
+		if rec.status >= http.StatusInternalServerError || rec.status == http.StatusRequestTimeout {
+			err = m.service.AbortIdempotentRequest(ctx, record.IdempotencyKey)
+		} else {
+			record.Status = rec.status
+			switch {
+			case !noStore(rec.Header()):
+				record.ContentType = rec.Header().Get("Content-Type")
+				record.Body = rec.body.Bytes()
+			case rec.replay != nil:
+				record.ContentType = rec.Header().Get("Content-Type")
+				record.Body = rec.replay
+			default:
+				record.Status = http.StatusConflict
+			}
+			err = m.service.CompleteIdempotentRequest(ctx, record)
+		}
+
+		if err != nil {
+			log.Error("failed to save result of request with idempotency key: " + err.Error())
+		}
+	})
+}
+
+// fingerprint возвращает отпечаток запроса, составленный из метода, пути и тела запроса.
+func fingerprint(method, path string, body []byte) string {
+	h := sha256.New()
+	h.Write([]byte(method + " " + path + "\n"))
+	h.Write(body)
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// noStore возвращает true, если ответ запрещено сохранять.
+func noStore(h http.Header) bool {
+	for _, directive := range strings.Split(h.Get("Cache-Control"), ",") {
+		if strings.EqualFold(strings.TrimSpace(directive), "no-store") {
+			return true
+		}
+	}
+	return false
+}
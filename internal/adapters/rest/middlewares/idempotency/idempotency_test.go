@@ -0,0 +1,254 @@
+package idempotency
+
+import (
+	"context"
+	"github.com/go-chi/chi"
+	"github.com/golang/mock/gomock"
+	restResponse "github.com/lazylex/watch-store-store/internal/adapters/rest/response"
+	"github.com/lazylex/watch-store-store/internal/dto"
+	"github.com/lazylex/watch-store-store/internal/logger"
+	"github.com/lazylex/watch-store-store/internal/ports/service"
+	mockService "github.com/lazylex/watch-store-store/internal/ports/service/mocks"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+const path = "/api/api_v1/sale/make"
+
+func newMux(mock service.Interface, calls *int) *chi.Mux {
+	mux := chi.NewRouter()
+	mux.Use(New(mock, time.Hour).Handle)
+	mux.Post(path, func(w http.ResponseWriter, r *http.Request) {
+		*calls++
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		_, _ = w.Write([]byte("{\"receipt_id\":5}"))
+	})
+	return mux
+}
+
+func TestHandle_WithoutKey(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	mock := mockService.NewMockInterface(ctrl)
+	calls := 0
+	mux := newMux(mock, &calls)
+
+	mock.EXPECT().BeginIdempotentRequest(gomock.Any(), gomock.Any()).Times(0)
+
+	response := httptest.NewRecorder()
+	mux.ServeHTTP(response, httptest.NewRequest(http.MethodPost, path, strings.NewReader("{}")))
+	if response.Code != http.StatusCreated || calls != 1 {
+		t.Fail()
+	}
+}
+
+func TestHandle_FirstRequest(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	mock := mockService.NewMockInterface(ctrl)
+	calls := 0
+	mux := newMux(mock, &calls)
+
+	mock.EXPECT().BeginIdempotentRequest(gomock.Any(), gomock.Any()).Times(1).Return(dto.IdempotencyRecord{}, false,
+		nil)
+	mock.EXPECT().CompleteIdempotentRequest(gomock.Any(), gomock.Any()).Times(1).DoAndReturn(
+		func(_ any, record dto.IdempotencyRecord) error {
+			if record.Key != "key-1" || record.Status != http.StatusCreated ||
+				string(record.Body) != "{\"receipt_id\":5}" || record.ContentType != "application/json" {
+				t.Fail()
+			}
+			return nil
+		})
+
+	response := httptest.NewRecorder()
+	request := httptest.NewRequest(http.MethodPost, path, strings.NewReader("{}"))
+	request.Header.Set(header, "key-1")
+	mux.ServeHTTP(response, request)
+	if response.Code != http.StatusCreated || calls != 1 {
+		t.Fail()
+	}
+}
+
+func TestHandle_Replay(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	mock := mockService.NewMockInterface(ctrl)
+	calls := 0
+	mux := newMux(mock, &calls)
+
+	mock.EXPECT().BeginIdempotentRequest(gomock.Any(), gomock.Any()).Times(1).Return(dto.IdempotencyRecord{
+		Completed: true, Status: http.StatusCreated, ContentType: "application/json",
+		Body: []byte("{\"receipt_id\":5}")}, true, nil)
+
+	response := httptest.NewRecorder()
+	request := httptest.NewRequest(http.MethodPost, path, strings.NewReader("{}"))
+	request.Header.Set(header, "key-1")
+	mux.ServeHTTP(response, request)
+	if response.Code != http.StatusCreated || calls != 0 || response.Header().Get(replayedHeader) != "true" ||
+		response.Body.String() != "{\"receipt_id\":5}" {
+		t.Fail()
+	}
+}
+
+func TestHandle_InProgress(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	mock := mockService.NewMockInterface(ctrl)
+	calls := 0
+	mux := newMux(mock, &calls)
+
+	mock.EXPECT().BeginIdempotentRequest(gomock.Any(), gomock.Any()).Times(1).Return(dto.IdempotencyRecord{}, false,
+		service.ErrRequestInProgress)
+
+	response := httptest.NewRecorder()
+	request := httptest.NewRequest(http.MethodPost, path, strings.NewReader("{}"))
+	request.Header.Set(header, "key-1")
+	mux.ServeHTTP(response, request)
+	if response.Code != http.StatusConflict || calls != 0 {
+		t.Fail()
+	}
+}
+
+func TestHandle_AbortOnServerError(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	mock := mockService.NewMockInterface(ctrl)
+	mux := chi.NewRouter()
+	mux.Use(New(mock, time.Hour).Handle)
+	mux.Post(path, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	})
+
+	mock.EXPECT().BeginIdempotentRequest(gomock.Any(), gomock.Any()).Times(1).Return(dto.IdempotencyRecord{}, false,
+		nil)
+	mock.EXPECT().AbortIdempotentRequest(gomock.Any(), dto.IdempotencyKey{Key: "key-1"}).Times(1).Return(nil)
+	mock.EXPECT().CompleteIdempotentRequest(gomock.Any(), gomock.Any()).Times(0)
+
+	response := httptest.NewRecorder()
+	request := httptest.NewRequest(http.MethodPost, path, strings.NewReader("{}"))
+	request.Header.Set(header, "key-1")
+	mux.ServeHTTP(response, request)
+	if response.Code != http.StatusInternalServerError {
+		t.Fail()
+	}
+}
+
+func TestHandle_NoStoreWithoutReplayBody(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	mock := mockService.NewMockInterface(ctrl)
+	mux := chi.NewRouter()
+	mux.Use(New(mock, time.Hour).Handle)
+	mux.Post(path, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("Cache-Control", "private, no-store")
+		w.WriteHeader(http.StatusCreated)
+		_, _ = w.Write([]byte("{\"order_number\":13,\"pickup_code\":\"402913\"}"))
+	})
+
+	mock.EXPECT().BeginIdempotentRequest(gomock.Any(), gomock.Any()).Times(1).Return(dto.IdempotencyRecord{}, false,
+		nil)
+	mock.EXPECT().CompleteIdempotentRequest(gomock.Any(), gomock.Any()).Times(1).DoAndReturn(
+		func(_ any, record dto.IdempotencyRecord) error {
+			if record.Status != http.StatusConflict || len(record.Body) != 0 || record.ContentType != "" {
+				t.Fail()
+			}
+			return nil
+		})
+
+	response := httptest.NewRecorder()
+	request := httptest.NewRequest(http.MethodPost, path, strings.NewReader("{}"))
+	request.Header.Set(header, "key-1")
+	mux.ServeHTTP(response, request)
+	if response.Code != http.StatusCreated || !strings.Contains(response.Body.String(), "402913") {
+		t.Fail()
+	}
+}
+
+func TestHandle_NoStoreWithReplayBody(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	mock := mockService.NewMockInterface(ctrl)
+	mux := chi.NewRouter()
+	mux.Use(New(mock, time.Hour).Handle)
+	mux.Post(path, func(w http.ResponseWriter, r *http.Request) {
+		restResponse.NoStore(w, dto.NumberPickupCode{OrderNumber: 13})
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		_, _ = w.Write([]byte("{\"order_number\":13,\"pickup_code\":\"402913\"}"))
+	})
+
+	mock.EXPECT().BeginIdempotentRequest(gomock.Any(), gomock.Any()).Times(1).Return(dto.IdempotencyRecord{}, false,
+		nil)
+	mock.EXPECT().CompleteIdempotentRequest(gomock.Any(), gomock.Any()).Times(1).DoAndReturn(
+		func(_ any, record dto.IdempotencyRecord) error {
+			if record.Status != http.StatusCreated || string(record.Body) != "{\"order_number\":13}" ||
+				record.ContentType != "application/json" {
+				t.Fail()
+			}
+			return nil
+		})
+
+	response := httptest.NewRecorder()
+	request := httptest.NewRequest(http.MethodPost, path, strings.NewReader("{}"))
+	request.Header.Set(header, "key-1")
+	mux.ServeHTTP(response, request)
+	if response.Code != http.StatusCreated || response.Header().Get("Cache-Control") != "no-store" ||
+		!strings.Contains(response.Body.String(), "402913") {
+		t.Fail()
+	}
+}
+
+func TestHandle_KeyScopedBySubject(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	mock := mockService.NewMockInterface(ctrl)
+	calls := 0
+	mux := chi.NewRouter()
+	mux.Use(func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			next.ServeHTTP(w, r.WithContext(context.WithValue(r.Context(), logger.Actor, "cashier-7")))
+		})
+	})
+	mux.Use(New(mock, time.Hour).Handle)
+	mux.Post(path, func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.WriteHeader(http.StatusCreated)
+	})
+
+	key := dto.IdempotencyKey{Subject: "cashier-7", Key: "key-1"}
+	mock.EXPECT().BeginIdempotentRequest(gomock.Any(), gomock.Any()).Times(1).DoAndReturn(
+		func(_ any, record dto.IdempotencyRecord) (dto.IdempotencyRecord, bool, error) {
+			if record.IdempotencyKey != key {
+				t.Fail()
+			}
+			return dto.IdempotencyRecord{}, false, nil
+		})
+	mock.EXPECT().CompleteIdempotentRequest(gomock.Any(), gomock.Any()).Times(1).DoAndReturn(
+		func(_ any, record dto.IdempotencyRecord) error {
+			if record.IdempotencyKey != key {
+				t.Fail()
+			}
+			return nil
+		})
+
+	response := httptest.NewRecorder()
+	request := httptest.NewRequest(http.MethodPost, path, strings.NewReader("{}"))
+	request.Header.Set(header, "key-1")
+	mux.ServeHTTP(response, request)
+	if response.Code != http.StatusCreated || calls != 1 {
+		t.Fail()
+	}
+}
+
+func TestFingerprint(t *testing.T) {
+	t.Parallel()
+	if fingerprint(http.MethodPost, path, []byte("{}")) == fingerprint(http.MethodPost, path, []byte("{ }")) ||
+		fingerprint(http.MethodPost, path, []byte("{}")) != fingerprint(http.MethodPost, path, []byte("{}")) {
+		t.Fail()
+	}
+}
@@ -1,6 +1,7 @@
 package jwt
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"github.com/golang-jwt/jwt/v5"
@@ -32,7 +33,8 @@ func New(secret []byte, permissions map[string]int) *MiddlewareJWT {
 }
 
 // CheckJWT проверяет JWT токен в запросе. В случае, если токен не валидный, функция прекращает дальнейшую обработку
-// запроса сервисом. Ошибка заносится в лог, отправителю возвращается ответ с кодом http.StatusUnauthorized.
+// запроса сервисом. Ошибка заносится в лог, отправителю возвращается ответ с кодом http.StatusUnauthorized. Субъект
+// токена (claim sub), при его наличии, сохраняется в контексте запроса как инициатор операции (logger.Actor).
 func (m *MiddlewareJWT) CheckJWT(next http.Handler) http.Handler {
 	return http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
 		uri := r.URL.RequestURI()
@@ -72,7 +74,7 @@ func (m *MiddlewareJWT) CheckJWT(next http.Handler) http.Handler {
 			} else if errors.Is(err, jwt.ErrTokenNotValidYet) {
 				log.Warn("token not valid yet")
 			} else {
-				log.Warn("couldn't handle this token:", err)
+				log.Warn("couldn't handle this token: " + err.Error())
 			}
 
 			rw.WriteHeader(http.StatusUnauthorized)
@@ -85,6 +87,10 @@ func (m *MiddlewareJWT) CheckJWT(next http.Handler) http.Handler {
 			return
 		}
 
+		if subject, subjectErr := token.Claims.GetSubject(); subjectErr == nil && subject != "" {
+			r = r.WithContext(context.WithValue(r.Context(), logger.Actor, subject))
+		}
+
 		next.ServeHTTP(rw, r)
 	})
 }
@@ -6,10 +6,40 @@ import (
 )
 
 const (
-	Article = "article"
-	Amount  = "amount"
-	From    = "from"
-	To      = "to"
+	Article   = "article"
+	Amount    = "amount"
+	From      = "from"
+	To        = "to"
+	ID        = "id"
+	Document  = "document_number"
+	Order     = "order_number"
+	Bucket    = "bucket"
+	By        = "by"
+	Limit     = "limit"
+	Report    = "report"
+	Format    = "format"
+	Window    = "window_days"
+	Code      = "code"
+	Offset    = "offset"
+	Barcode   = "barcode"
+	Locations = "locations"
+	Direction = "direction"
+	State     = "state"
+	DryRun    = "dry_run"
+	Chunk     = "chunk_size"
+	Conflict  = "on_conflict"
+	File      = "file"
+	Register  = "cash_register"
+	Payment   = "payment_method"
+	Phone     = "phone"
+	Serial    = "serial"
+	Receipt   = "receipt_id"
+	Warranty  = "warranty_id"
+
+	Attributes      = "attributes"
+	AttributePrefix = "attr."
+	AttributeMin    = ".min"
+	AttributeMax    = ".max"
 )
 
 // requestErr добавляет к тексту ошибки префикс, указывающий на её принадлежность к запросу.
@@ -19,3 +49,18 @@ func requestErr(text string) error {
 
 var ErrIncorrectDate = requestErr("invalid date passed")
 var ErrEmptyFromDate = requestErr("no 'from' date in request")
+var ErrIncorrectID = requestErr("invalid id passed")
+var ErrIncorrectOrderNumber = requestErr("invalid order number passed")
+var ErrIncorrectCashRegister = requestErr("invalid cash register number passed")
+var ErrIncorrectPhone = requestErr("invalid phone number passed")
+var ErrIncorrectLimit = requestErr("invalid limit passed")
+var ErrIncorrectFormat = requestErr("invalid report format passed")
+var ErrIncorrectWindow = requestErr("invalid sales window passed")
+var ErrIncorrectOffset = requestErr("invalid offset passed")
+var ErrIncorrectAttributeFilter = requestErr("invalid attribute filter passed")
+var ErrIncorrectAttributesFlag = requestErr("invalid attributes flag passed")
+var ErrIncorrectLocationsFlag = requestErr("invalid locations flag passed")
+var ErrIncorrectState = requestErr("invalid state passed")
+var ErrIncorrectDryRunFlag = requestErr("invalid dry run flag passed")
+var ErrIncorrectChunkSize = requestErr("invalid chunk size passed")
+var ErrNoImportFile = requestErr("no import file in request")
@@ -1,9 +1,13 @@
 package response
 
 import (
+	"encoding/json"
 	"errors"
+	"github.com/lazylex/watch-store-store/internal/domain/aggregates/reservation"
+	"github.com/lazylex/watch-store-store/internal/domain/aggregates/warranty"
 	"github.com/lazylex/watch-store-store/internal/helpers/constants/prefixes"
 	"github.com/lazylex/watch-store-store/internal/ports/repository"
+	"github.com/lazylex/watch-store-store/internal/ports/service"
 	"log/slog"
 	"net/http"
 	"strings"
@@ -26,12 +30,33 @@ func WriteHeaderAndLogAboutErr(w http.ResponseWriter, logger *slog.Logger, err e
 		w.WriteHeader(http.StatusNotFound)
 	case errors.Is(err, repository.ErrTimeout):
 		w.WriteHeader(http.StatusRequestTimeout)
+	case errors.Is(err, service.ErrPickupCodeMismatch):
+		w.WriteHeader(http.StatusForbidden)
+	case isConflict(err):
+		w.WriteHeader(http.StatusConflict)
 	default:
 		w.WriteHeader(http.StatusInternalServerError)
 	}
 	logger.Warn(err.Error())
 }
 
+// replayBodySetter реализуется обёрткой ответа прослойки идемпотентности, сохраняющей ответы для повторных запросов.
+type replayBodySetter interface {
+	SetReplayBody(body []byte)
+}
+
+// NoStore запрещает сохранять ответ, содержащий секретные данные (записывает заголовок Cache-Control: no-store). Для
+// повторных запросов с тем же ключом идемпотентности вместо ответа сохраняется replayable - часть ответа без секретных
+// данных, закодированная в JSON. Должна вызываться до записи кода ответа.
+func NoStore(w http.ResponseWriter, replayable any) {
+	w.Header().Set("Cache-Control", "no-store")
+	if setter, ok := w.(replayBodySetter); ok {
+		if body, err := json.Marshal(replayable); err == nil {
+			setter.SetReplayBody(body)
+		}
+	}
+}
+
 // WriteHeaderAndLogAboutBadRequest записывает заголовок ответа http.StatusBadRequest и переданную ошибку в лог.
 func WriteHeaderAndLogAboutBadRequest(w http.ResponseWriter, logger *slog.Logger, err error) {
 	if err == nil {
@@ -41,3 +66,51 @@ func WriteHeaderAndLogAboutBadRequest(w http.ResponseWriter, logger *slog.Logger
 	w.WriteHeader(http.StatusBadRequest)
 	logger.Warn(err.Error())
 }
+
+// isConflict возвращает true, если ошибка вызвана несоответствием запроса текущему состоянию данных (например, попыткой
+// открыть уже открытую смену).
+func isConflict(err error) bool {
+	for _, e := range []error{
+		service.ErrShiftAlreadyOpen,
+		service.ErrNoOpenShift,
+		service.ErrProductNotInReceipt,
+		service.ErrRefundExceedsSold,
+		service.ErrStocktakeApplied,
+		service.ErrArticleNotInStocktake,
+		service.ErrAdjustmentBelowZero,
+		service.ErrAlreadyProcessed,
+		service.ErrRequestInProgress,
+		service.ErrIdempotencyKeyReused,
+		service.ErrAttributeTypeChange,
+		service.ErrBarcodeTaken,
+		service.ErrNoEnoughItemsInPlace,
+		service.ErrTransferToItself,
+		service.ErrNoEnoughItemsToSend,
+		service.ErrWrongTransferDirection,
+		service.ErrTransferNotInTransit,
+		service.ErrNoTransferDiscrepancy,
+		service.ErrArticleNotInTransfer,
+		service.ErrTransferCounterpart,
+		service.ErrOrderNumberTaken,
+		service.ErrUnknownCashRegister,
+		service.ErrCashRegisterDisabled,
+		service.ErrCashRegisterExists,
+		service.ErrUnknownTaxCategory,
+		service.ErrSerialTracked,
+		service.ErrSerialsDontMatch,
+		service.ErrSerialUnavailable,
+		service.ErrSerialRegistered,
+		service.ErrSerialsDontMatchStock,
+		service.ErrArticleReserved,
+		service.ErrWarrantyExpired,
+		service.ErrWarrantyClaimOpen,
+		service.ErrWarrantyVoid,
+		reservation.ErrIllegalTransition,
+		warranty.ErrIllegalTransition,
+	} {
+		if errors.Is(err, e) {
+			return true
+		}
+	}
+	return false
+}
@@ -23,6 +23,64 @@ const (
 	apiApiV1ReservationMake   = "/api/api_v1/reservation/make"
 	apiApiV1ReservationCancel = "/api/api_v1/reservation/cancel"
 	apiApiV1ReservationFinish = "/api/api_v1/reservation/finish"
+	apiApiV1Receipt           = "/api/api_v1/receipt/"
+	apiApiV1SaleReturn        = "/api/api_v1/sale/return"
+	apiApiV1ShiftOpen         = "/api/api_v1/shift/open"
+	apiApiV1ShiftClose        = "/api/api_v1/shift/close"
+	apiApiV1ShiftZReport      = "/api/api_v1/shift/z-report/"
+	apiApiV1StocktakeStart    = "/api/api_v1/stocktake/start"
+	apiApiV1StocktakeCount    = "/api/api_v1/stocktake/count"
+	apiApiV1StocktakeDiff     = "/api/api_v1/stocktake/discrepancies/"
+	apiApiV1StocktakeApply    = "/api/api_v1/stocktake/apply"
+	apiApiV1GoodsReceive      = "/api/api_v1/goods-receipt"
+	apiApiV1GoodsReceipt      = "/api/api_v1/goods-receipt/"
+	apiApiV1StockAdjustment   = "/api/api_v1/stock/adjustment"
+	apiApiV1ReservationReady  = "/api/api_v1/reservation/ready"
+	apiApiV1ReservationShip   = "/api/api_v1/reservation/ship"
+	apiApiV1ReservationHist   = "/api/api_v1/reservation/history/"
+	apiApiV1ReservationPhone  = "/api/api_v1/reservation/by-phone/"
+	apiApiV1AnalyticsSales    = "/api/api_v1/analytics/sales/"
+	apiApiV1AnalyticsTop      = "/api/api_v1/analytics/top/"
+	apiApiV1AnalyticsAvgPrice = "/api/api_v1/analytics/average-price/"
+	apiApiV1AnalyticsChannels = "/api/api_v1/analytics/channels/"
+	apiApiV1ExportSales       = "/api/api_v1/export/sales/"
+	apiApiV1ReplenishmentSet  = "/api/api_v1/replenishment/setting"
+	apiApiV1ReplenishmentGet  = "/api/api_v1/replenishment/setting/"
+	apiApiV1ReorderSuggestion = "/api/api_v1/replenishment/suggestions/"
+	apiApiV1AttributeDefine   = "/api/api_v1/attributes/definition"
+	apiApiV1AttributeDefs     = "/api/api_v1/attributes/definitions/"
+	apiApiV1StockAttributes   = "/api/api_v1/stock/attributes"
+	apiApiV1StockAttribute    = "/api/api_v1/stock/attribute/"
+	apiApiV1StockList         = "/api/api_v1/stock/list/"
+	apiApiV1BarcodeImport     = "/api/api_v1/barcode/import"
+	apiApiV1Barcode           = "/api/api_v1/barcode/"
+	apiApiV1ArticleBarcodes   = "/api/api_v1/barcode/article/"
+	apiApiV1StockTransfer     = "/api/api_v1/stock/transfer"
+	apiApiV1TransferOutbound  = "/api/api_v1/transfer/outbound"
+	apiApiV1TransferConfirm   = "/api/api_v1/transfer/inbound/confirm"
+	apiApiV1TransferResolve   = "/api/api_v1/transfer/resolve"
+	apiApiV1Transfer          = "/api/api_v1/transfer/"
+	apiApiV1Transfers         = "/api/api_v1/transfer/list/"
+	apiApiV1StockImport       = "/api/api_v1/stock/import"
+	apiApiV1StockPrices       = "/api/api_v1/stock/prices"
+	apiApiV1CashRegisterSave  = "/api/api_v1/cash-register"
+	apiApiV1CashRegister      = "/api/api_v1/cash-register/"
+	apiApiV1CashRegisters     = "/api/api_v1/cash-register/list/"
+	apiApiV1TaxRateSet        = "/api/api_v1/tax/rate"
+	apiApiV1TaxRates          = "/api/api_v1/tax/rates/"
+	apiApiV1TaxCategorySet    = "/api/api_v1/tax/category"
+	apiApiV1TaxCategory       = "/api/api_v1/tax/category/"
+	apiApiV1SerialTracking    = "/api/api_v1/serial/tracking"
+	apiApiV1SerialsAvailable  = "/api/api_v1/serial/available/"
+	apiApiV1SerialHistory     = "/api/api_v1/serial/history/"
+	apiApiV1WarrantyTermSet   = "/api/api_v1/warranty/term"
+	apiApiV1WarrantyTerm      = "/api/api_v1/warranty/term/"
+	apiApiV1WarrantyReceipt   = "/api/api_v1/warranty/by-receipt/"
+	apiApiV1WarrantySerial    = "/api/api_v1/warranty/by-serial/"
+	apiApiV1WarrantyPhone     = "/api/api_v1/warranty/by-phone/"
+	apiApiV1WarrantyClaim     = "/api/api_v1/warranty/claim"
+	apiApiV1WarrantyClaimStat = "/api/api_v1/warranty/claim/status"
+	apiApiV1WarrantyClaims    = "/api/api_v1/warranty/claims/"
 )
 
 const (
@@ -36,6 +94,51 @@ const (
 	reserveGoods                       = "резервировать товар"
 	cancelReservation                  = "отменять резервирование"
 	completeSaleOrShipment             = "завершать продажу/отправку"
+	receiveReceiptData                 = "получать данные о чеке"
+	returnGoods                        = "оформлять возврат товара"
+	openShift                          = "открывать кассовую смену"
+	closeShift                         = "закрывать кассовую смену"
+	receiveZReport                     = "получать Z-отчёт"
+	conductStocktake                   = "проводить инвентаризацию"
+	receiveStocktakeDiscrepancies      = "получать расхождения инвентаризации"
+	applyStocktake                     = "вносить результаты инвентаризации в остатки"
+	receiveGoods                       = "принимать поставку товара"
+	receiveGoodsReceiptData            = "получать данные о принятой поставке"
+	adjustProductQuantity              = "корректировать количество товара с указанием причины"
+	markOrderReadyForPickup            = "отмечать готовность заказа к выдаче"
+	shipOrder                          = "отмечать передачу заказа в доставку"
+	receiveOrderHistory                = "получать историю состояний заказа"
+	findOrdersByCustomerPhone          = "искать заказы по телефону покупателя"
+	receiveSalesByPeriod               = "получать выручку и количество продаж по периодам"
+	receiveTopArticles                 = "получать рейтинг продаваемых товаров"
+	receiveAverageSalePrice            = "получать среднюю цену продажи товара"
+	receiveSalesByChannel              = "получать продажи по каналам"
+	exportSalesReport                  = "выгружать отчёты о продажах"
+	setReplenishmentSetting            = "изменять сроки поставки и страховые запасы товаров"
+	receiveReplenishmentSetting        = "получать сроки поставки и страховые запасы товаров"
+	receiveReorderSuggestions          = "получать предложения о заказе товаров"
+	defineProductAttributes            = "изменять определения атрибутов товаров"
+	receiveProductAttributeDefinitions = "получать определения атрибутов товаров"
+	updateProductAttributes            = "изменять значения атрибутов товара"
+	findProductsByAttributes           = "искать товары по атрибутам"
+	updateProductBarcodes              = "изменять штрихкоды товаров"
+	receiveProductBarcodes             = "получать штрихкоды товаров"
+	transferProductsBetweenLocations   = "перемещать товар между местами хранения"
+	sendProductsToStore                = "отправлять товар в другой магазин"
+	receiveProductsFromStore           = "принимать товар из другого магазина"
+	receiveTransfers                   = "получать данные о перемещениях товара между магазинами"
+	importProductsFromFile             = "загружать товары из файла"
+	manageCashRegisters                = "управлять реестром касс"
+	receiveCashRegisters               = "получать данные о кассах"
+	manageTaxRates                     = "изменять ставки НДС"
+	receiveTaxRates                    = "получать ставки НДС"
+	setArticleTaxCategory              = "изменять налоговые категории товаров"
+	manageSerialTracking               = "управлять учётом товаров по серийным номерам"
+	receiveSerials                     = "получать данные о серийных номерах товаров"
+	setWarrantyTerms                   = "изменять гарантийные сроки товаров"
+	receiveWarranties                  = "получать данные о гарантиях"
+	registerWarrantyClaims             = "регистрировать гарантийные обращения"
+	processWarrantyClaims              = "изменять состояние гарантийных обращений"
 )
 
 func init() {
@@ -50,6 +153,64 @@ func init() {
 		apiApiV1ReservationMake,
 		apiApiV1ReservationCancel,
 		apiApiV1ReservationFinish,
+		apiApiV1Receipt,
+		apiApiV1SaleReturn,
+		apiApiV1ShiftOpen,
+		apiApiV1ShiftClose,
+		apiApiV1ShiftZReport,
+		apiApiV1StocktakeStart,
+		apiApiV1StocktakeCount,
+		apiApiV1StocktakeDiff,
+		apiApiV1StocktakeApply,
+		apiApiV1GoodsReceive,
+		apiApiV1GoodsReceipt,
+		apiApiV1StockAdjustment,
+		apiApiV1ReservationReady,
+		apiApiV1ReservationShip,
+		apiApiV1ReservationHist,
+		apiApiV1ReservationPhone,
+		apiApiV1AnalyticsSales,
+		apiApiV1AnalyticsTop,
+		apiApiV1AnalyticsAvgPrice,
+		apiApiV1AnalyticsChannels,
+		apiApiV1ExportSales,
+		apiApiV1ReplenishmentSet,
+		apiApiV1ReplenishmentGet,
+		apiApiV1ReorderSuggestion,
+		apiApiV1AttributeDefine,
+		apiApiV1AttributeDefs,
+		apiApiV1StockAttributes,
+		apiApiV1StockAttribute,
+		apiApiV1StockList,
+		apiApiV1BarcodeImport,
+		apiApiV1Barcode,
+		apiApiV1ArticleBarcodes,
+		apiApiV1StockTransfer,
+		apiApiV1TransferOutbound,
+		apiApiV1TransferConfirm,
+		apiApiV1TransferResolve,
+		apiApiV1Transfer,
+		apiApiV1Transfers,
+		apiApiV1StockImport,
+		apiApiV1StockPrices,
+		apiApiV1CashRegisterSave,
+		apiApiV1CashRegister,
+		apiApiV1CashRegisters,
+		apiApiV1TaxRateSet,
+		apiApiV1TaxRates,
+		apiApiV1TaxCategorySet,
+		apiApiV1TaxCategory,
+		apiApiV1SerialTracking,
+		apiApiV1SerialsAvailable,
+		apiApiV1SerialHistory,
+		apiApiV1WarrantyTermSet,
+		apiApiV1WarrantyTerm,
+		apiApiV1WarrantyReceipt,
+		apiApiV1WarrantySerial,
+		apiApiV1WarrantyPhone,
+		apiApiV1WarrantyClaim,
+		apiApiV1WarrantyClaimStat,
+		apiApiV1WarrantyClaims,
 	}
 }
 
@@ -149,6 +310,366 @@ func (r *Router) registerRoutes() {
 			Permission: completeSaleOrShipment,
 			Handler:    r.handlers.FinishOrder,
 		},
+		{
+			Path:       apiApiV1Receipt,
+			Method:     http.MethodGet,
+			Permission: receiveReceiptData,
+			Handler:    r.handlers.Receipt,
+		},
+		{
+			Path:       apiApiV1SaleReturn,
+			Method:     http.MethodPost,
+			Permission: returnGoods,
+			Handler:    r.handlers.ReturnSale,
+		},
+		{
+			Path:       apiApiV1ShiftOpen,
+			Method:     http.MethodPost,
+			Permission: openShift,
+			Handler:    r.handlers.OpenShift,
+		},
+		{
+			Path:       apiApiV1ShiftClose,
+			Method:     http.MethodPut,
+			Permission: closeShift,
+			Handler:    r.handlers.CloseShift,
+		},
+		{
+			Path:       apiApiV1ShiftZReport,
+			Method:     http.MethodGet,
+			Permission: receiveZReport,
+			Handler:    r.handlers.ZReport,
+		},
+		{
+			Path:       apiApiV1StocktakeStart,
+			Method:     http.MethodPost,
+			Permission: conductStocktake,
+			Handler:    r.handlers.StartStocktake,
+		},
+		{
+			Path:       apiApiV1StocktakeCount,
+			Method:     http.MethodPut,
+			Permission: conductStocktake,
+			Handler:    r.handlers.CountStocktake,
+		},
+		{
+			Path:       apiApiV1StocktakeDiff,
+			Method:     http.MethodGet,
+			Permission: receiveStocktakeDiscrepancies,
+			Handler:    r.handlers.StocktakeDiscrepancies,
+		},
+		{
+			Path:       apiApiV1StocktakeApply,
+			Method:     http.MethodPut,
+			Permission: applyStocktake,
+			Handler:    r.handlers.ApplyStocktake,
+		},
+		{
+			Path:       apiApiV1GoodsReceive,
+			Method:     http.MethodPost,
+			Permission: receiveGoods,
+			Handler:    r.handlers.ReceiveGoods,
+		},
+		{
+			Path:       apiApiV1GoodsReceipt,
+			Method:     http.MethodGet,
+			Permission: receiveGoodsReceiptData,
+			Handler:    r.handlers.GoodsReceipt,
+		},
+		{
+			Path:       apiApiV1StockAdjustment,
+			Method:     http.MethodPost,
+			Permission: adjustProductQuantity,
+			Handler:    r.handlers.AdjustAmountInStock,
+		},
+		{
+			Path:       apiApiV1ReservationReady,
+			Method:     http.MethodPut,
+			Permission: markOrderReadyForPickup,
+			Handler:    r.handlers.MarkReadyForPickup,
+		},
+		{
+			Path:       apiApiV1ReservationShip,
+			Method:     http.MethodPut,
+			Permission: shipOrder,
+			Handler:    r.handlers.ShipOrder,
+		},
+		{
+			Path:       apiApiV1ReservationHist,
+			Method:     http.MethodGet,
+			Permission: receiveOrderHistory,
+			Handler:    r.handlers.ReservationHistory,
+		},
+		{
+			Path:       apiApiV1ReservationPhone,
+			Method:     http.MethodGet,
+			Permission: findOrdersByCustomerPhone,
+			Handler:    r.handlers.OpenReservationsByPhone,
+		},
+		{
+			Path:       apiApiV1AnalyticsSales,
+			Method:     http.MethodGet,
+			Permission: receiveSalesByPeriod,
+			Handler:    r.handlers.SalesByPeriod,
+		},
+		{
+			Path:       apiApiV1AnalyticsTop,
+			Method:     http.MethodGet,
+			Permission: receiveTopArticles,
+			Handler:    r.handlers.TopArticles,
+		},
+		{
+			Path:       apiApiV1AnalyticsAvgPrice,
+			Method:     http.MethodGet,
+			Permission: receiveAverageSalePrice,
+			Handler:    r.handlers.AverageSalePrice,
+		},
+		{
+			Path:       apiApiV1AnalyticsChannels,
+			Method:     http.MethodGet,
+			Permission: receiveSalesByChannel,
+			Handler:    r.handlers.SalesByChannel,
+		},
+		{
+			Path:       apiApiV1ExportSales,
+			Method:     http.MethodGet,
+			Permission: exportSalesReport,
+			Handler:    r.handlers.ExportSales,
+		},
+		{
+			Path:       apiApiV1ReplenishmentSet,
+			Method:     http.MethodPut,
+			Permission: setReplenishmentSetting,
+			Handler:    r.handlers.SetReplenishmentSetting,
+		},
+		{
+			Path:       apiApiV1ReplenishmentGet,
+			Method:     http.MethodGet,
+			Permission: receiveReplenishmentSetting,
+			Handler:    r.handlers.ReplenishmentSetting,
+		},
+		{
+			Path:       apiApiV1ReorderSuggestion,
+			Method:     http.MethodGet,
+			Permission: receiveReorderSuggestions,
+			Handler:    r.handlers.ReorderSuggestions,
+		},
+		{
+			Path:       apiApiV1AttributeDefine,
+			Method:     http.MethodPut,
+			Permission: defineProductAttributes,
+			Handler:    r.handlers.SetAttributeDefinition,
+		},
+		{
+			Path:       apiApiV1AttributeDefs,
+			Method:     http.MethodGet,
+			Permission: receiveProductAttributeDefinitions,
+			Handler:    r.handlers.AttributeDefinitions,
+		},
+		{
+			Path:       apiApiV1StockAttributes,
+			Method:     http.MethodPut,
+			Permission: updateProductAttributes,
+			Handler:    r.handlers.SetArticleAttributes,
+		},
+		{
+			Path:       apiApiV1StockAttribute,
+			Method:     http.MethodDelete,
+			Permission: updateProductAttributes,
+			Handler:    r.handlers.DeleteArticleAttribute,
+		},
+		{
+			Path:       apiApiV1StockList,
+			Method:     http.MethodGet,
+			Permission: findProductsByAttributes,
+			Handler:    r.handlers.FindStock,
+		},
+		{
+			Path:       apiApiV1BarcodeImport,
+			Method:     http.MethodPost,
+			Permission: updateProductBarcodes,
+			Handler:    r.handlers.ImportBarcodes,
+		},
+		{
+			Path:       apiApiV1Barcode,
+			Method:     http.MethodGet,
+			Permission: receiveProductData,
+			Handler:    r.handlers.StockByBarcode,
+		},
+		{
+			Path:       apiApiV1Barcode,
+			Method:     http.MethodDelete,
+			Permission: updateProductBarcodes,
+			Handler:    r.handlers.DeleteBarcode,
+		},
+		{
+			Path:       apiApiV1ArticleBarcodes,
+			Method:     http.MethodGet,
+			Permission: receiveProductBarcodes,
+			Handler:    r.handlers.ArticleBarcodes,
+		},
+		{
+			Path:       apiApiV1StockTransfer,
+			Method:     http.MethodPost,
+			Permission: transferProductsBetweenLocations,
+			Handler:    r.handlers.TransferStock,
+		},
+		{
+			Path:       apiApiV1TransferOutbound,
+			Method:     http.MethodPost,
+			Permission: sendProductsToStore,
+			Handler:    r.handlers.CreateOutboundTransfer,
+		},
+		{
+			Path:       apiApiV1TransferConfirm,
+			Method:     http.MethodPut,
+			Permission: receiveProductsFromStore,
+			Handler:    r.handlers.ConfirmInboundTransfer,
+		},
+		{
+			Path:       apiApiV1TransferResolve,
+			Method:     http.MethodPut,
+			Permission: sendProductsToStore,
+			Handler:    r.handlers.ResolveTransfer,
+		},
+		{
+			Path:       apiApiV1Transfer,
+			Method:     http.MethodGet,
+			Permission: receiveTransfers,
+			Handler:    r.handlers.Transfer,
+		},
+		{
+			Path:       apiApiV1Transfers,
+			Method:     http.MethodGet,
+			Permission: receiveTransfers,
+			Handler:    r.handlers.Transfers,
+		},
+		{
+			Path:       apiApiV1StockImport,
+			Method:     http.MethodPost,
+			Permission: importProductsFromFile,
+			Handler:    r.handlers.ImportStock,
+		},
+		{
+			Path:       apiApiV1StockPrices,
+			Method:     http.MethodPut,
+			Permission: updateProductPrice,
+			Handler:    r.handlers.UpdatePrices,
+		},
+		{
+			Path:       apiApiV1CashRegisterSave,
+			Method:     http.MethodPost,
+			Permission: manageCashRegisters,
+			Handler:    r.handlers.CreateCashRegister,
+		},
+		{
+			Path:       apiApiV1CashRegisterSave,
+			Method:     http.MethodPut,
+			Permission: manageCashRegisters,
+			Handler:    r.handlers.UpdateCashRegister,
+		},
+		{
+			Path:       apiApiV1CashRegister,
+			Method:     http.MethodGet,
+			Permission: receiveCashRegisters,
+			Handler:    r.handlers.CashRegister,
+		},
+		{
+			Path:       apiApiV1CashRegisters,
+			Method:     http.MethodGet,
+			Permission: receiveCashRegisters,
+			Handler:    r.handlers.CashRegisters,
+		},
+		{
+			Path:       apiApiV1TaxRateSet,
+			Method:     http.MethodPut,
+			Permission: manageTaxRates,
+			Handler:    r.handlers.SetTaxRate,
+		},
+		{
+			Path:       apiApiV1TaxRates,
+			Method:     http.MethodGet,
+			Permission: receiveTaxRates,
+			Handler:    r.handlers.TaxRates,
+		},
+		{
+			Path:       apiApiV1TaxCategorySet,
+			Method:     http.MethodPut,
+			Permission: setArticleTaxCategory,
+			Handler:    r.handlers.SetArticleTaxCategory,
+		},
+		{
+			Path:       apiApiV1TaxCategory,
+			Method:     http.MethodGet,
+			Permission: receiveTaxRates,
+			Handler:    r.handlers.ArticleTaxRate,
+		},
+		{
+			Path:       apiApiV1SerialTracking,
+			Method:     http.MethodPut,
+			Permission: manageSerialTracking,
+			Handler:    r.handlers.SetSerialTracking,
+		},
+		{
+			Path:       apiApiV1SerialsAvailable,
+			Method:     http.MethodGet,
+			Permission: receiveSerials,
+			Handler:    r.handlers.AvailableSerials,
+		},
+		{
+			Path:       apiApiV1SerialHistory,
+			Method:     http.MethodGet,
+			Permission: receiveSerials,
+			Handler:    r.handlers.SerialHistory,
+		},
+		{
+			Path:       apiApiV1WarrantyTermSet,
+			Method:     http.MethodPut,
+			Permission: setWarrantyTerms,
+			Handler:    r.handlers.SetWarrantyTerm,
+		},
+		{
+			Path:       apiApiV1WarrantyTerm,
+			Method:     http.MethodGet,
+			Permission: receiveWarranties,
+			Handler:    r.handlers.WarrantyTerm,
+		},
+		{
+			Path:       apiApiV1WarrantyReceipt,
+			Method:     http.MethodGet,
+			Permission: receiveWarranties,
+			Handler:    r.handlers.WarrantiesByReceipt,
+		},
+		{
+			Path:       apiApiV1WarrantySerial,
+			Method:     http.MethodGet,
+			Permission: receiveWarranties,
+			Handler:    r.handlers.WarrantiesBySerial,
+		},
+		{
+			Path:       apiApiV1WarrantyPhone,
+			Method:     http.MethodGet,
+			Permission: receiveWarranties,
+			Handler:    r.handlers.WarrantiesByPhone,
+		},
+		{
+			Path:       apiApiV1WarrantyClaim,
+			Method:     http.MethodPost,
+			Permission: registerWarrantyClaims,
+			Handler:    r.handlers.RegisterWarrantyClaim,
+		},
+		{
+			Path:       apiApiV1WarrantyClaimStat,
+			Method:     http.MethodPut,
+			Permission: processWarrantyClaims,
+			Handler:    r.handlers.ChangeWarrantyClaimStatus,
+		},
+		{
+			Path:       apiApiV1WarrantyClaims,
+			Method:     http.MethodGet,
+			Permission: receiveWarranties,
+			Handler:    r.handlers.WarrantyClaims,
+		},
 	}
 }
 
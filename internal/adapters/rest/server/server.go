@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"github.com/go-chi/chi/middleware"
 	restHandlers "github.com/lazylex/watch-store-store/internal/adapters/rest/handlers"
+	"github.com/lazylex/watch-store-store/internal/adapters/rest/middlewares/idempotency"
 	"github.com/lazylex/watch-store-store/internal/adapters/rest/middlewares/jwt"
 	requestMetrics "github.com/lazylex/watch-store-store/internal/adapters/rest/middlewares/request_metrics"
 	restRouter "github.com/lazylex/watch-store-store/internal/adapters/rest/router"
@@ -82,6 +83,8 @@ func MustCreate(cfg *config.HttpServer, queryTimeout time.Duration,
 		mux.Use(jwt.New([]byte(signature), permissions).CheckJWT)
 	}
 
+	mux.Use(idempotency.New(domainService, cfg.IdempotencyKeyTTL).Handle)
+
 	return &Server{
 		srv: &http.Server{
 			Handler:      mux,
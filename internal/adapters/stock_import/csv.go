@@ -0,0 +1,150 @@
+package stock_import
+
+import (
+	"bytes"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"github.com/lazylex/watch-store-store/internal/domain/value_objects/article"
+	"github.com/lazylex/watch-store-store/internal/dto"
+	"github.com/lazylex/watch-store-store/internal/dto/validators"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// Названия столбцов файла загрузки товаров.
+const (
+	ColumnArticle = "article"
+	ColumnName    = "name"
+	ColumnPrice   = "price"
+	ColumnAmount  = "amount"
+)
+
+var (
+	ErrMissingColumn   = errors.New("missing column in header")
+	ErrFieldsCount     = errors.New("wrong number of fields")
+	ErrIncorrectPrice  = errors.New("price is not a number")
+	ErrIncorrectAmount = errors.New("amount is not a non-negative integer")
+)
+
+// columns порядок столбцов в файле без строки заголовка.
+var columns = []string{ColumnArticle, ColumnName, ColumnPrice, ColumnAmount}
+
+// utf8BOM метка порядка байтов, которую добавляют в начало CSV-файлов некоторые табличные редакторы.
+var utf8BOM = []byte{0xEF, 0xBB, 0xBF}
+
+// ParseCSV разбирает файл загрузки товаров в формате CSV. Разделителем полей служит запятая или, если в первой
+// строке точек с запятой больше, чем запятых, точка с запятой (в этом случае дробная часть цены может отделяться
+// запятой). Если первая строка
+// содержит название столбца article, она считается заголовком и столбцы определяются по названиям (article, name,
+// price, amount), иначе столбцы должны следовать в этом порядке. Ошибки разбора отдельных строк сохраняются в самих
+// строках, ошибка возвращается, только если файл не удалось прочитать. Разбирается не более
+// validators.MaxImportRows + 1 строк, чтобы превышение количества строк было обнаружено при валидации загрузки.
+func ParseCSV(r io.Reader) ([]dto.StockImportRow, error) {
+	content, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	content = bytes.TrimPrefix(content, utf8BOM)
+
+	reader := csv.NewReader(bytes.NewReader(content))
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+	firstLine, _, _ := bytes.Cut(content, []byte("\n"))
+	decimalComma := false
+	if bytes.Count(firstLine, []byte(";")) > bytes.Count(firstLine, []byte(",")) {
+		reader.Comma, decimalComma = ';', true
+	}
+
+	var rows []dto.StockImportRow
+	index := map[string]int{}
+	for i, c := range columns {
+		index[c] = i
+	}
+
+	for first := true; len(rows) <= validators.MaxImportRows; first = false {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		if first && isHeader(record) {
+			if index, err = headerIndex(record); err != nil {
+				return nil, err
+			}
+			continue
+		}
+
+		line, _ := reader.FieldPos(0)
+		rows = append(rows, parseRecord(line, record, index, decimalComma))
+	}
+
+	return rows, nil
+}
+
+// isHeader проверяет, является ли строка файла строкой заголовка.
+func isHeader(record []string) bool {
+	for _, field := range record {
+		if strings.EqualFold(strings.TrimSpace(field), ColumnArticle) {
+			return true
+		}
+	}
+	return false
+}
+
+// headerIndex возвращает номера столбцов файла по их названиям из строки заголовка.
+func headerIndex(header []string) (map[string]int, error) {
+	index := make(map[string]int, len(columns))
+	for i, name := range header {
+		index[strings.ToLower(strings.TrimSpace(name))] = i
+	}
+	for _, c := range columns {
+		if _, ok := index[c]; !ok {
+			return nil, fmt.Errorf("%w: %s", ErrMissingColumn, c)
+		}
+	}
+	return index, nil
+}
+
+// parseRecord преобразует строку файла в строку загрузки.
+func parseRecord(line int, record []string, index map[string]int, decimalComma bool) dto.StockImportRow {
+	row := dto.StockImportRow{Line: line}
+	field := func(column string) string {
+		if i := index[column]; i < len(record) {
+			return strings.TrimSpace(record[i])
+		}
+		return ""
+	}
+
+	row.Record.Article = article.Article(field(ColumnArticle))
+	row.Record.Name = field(ColumnName)
+	for _, c := range columns {
+		if index[c] >= len(record) {
+			row.Err = ErrFieldsCount
+			return row
+		}
+	}
+
+	price := field(ColumnPrice)
+	if decimalComma {
+		price = strings.Replace(price, ",", ".", 1)
+	}
+	var err error
+	if row.Record.Price, err = strconv.ParseFloat(price, 64); err != nil {
+		row.Err = fmt.Errorf("%w: %q", ErrIncorrectPrice, field(ColumnPrice))
+		return row
+	}
+
+	amount, err := strconv.ParseUint(field(ColumnAmount), 10, 0)
+	if err != nil {
+		row.Err = fmt.Errorf("%w: %q", ErrIncorrectAmount, field(ColumnAmount))
+		return row
+	}
+	row.Record.Amount = uint(amount)
+
+	return row
+}
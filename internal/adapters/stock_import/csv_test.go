@@ -0,0 +1,40 @@
+package stock_import
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestParseCSVWithHeader(t *testing.T) {
+	rows, err := ParseCSV(strings.NewReader("\xEF\xBB\xBFamount,price,Article,name\n5,3490.5,ca-f91w,Casio\n\n1,x,ca-f92w,Casio\n"))
+	if err != nil || len(rows) != 2 {
+		t.Fatalf("rows %v, err %v", rows, err)
+	}
+	if r := rows[0]; r.Err != nil || r.Line != 2 || r.Record.Article != "ca-f91w" || r.Record.Amount != 5 ||
+		r.Record.Price != 3490.5 || r.Record.Name != "Casio" {
+		t.Errorf("unexpected row %+v", r)
+	}
+	if r := rows[1]; !errors.Is(r.Err, ErrIncorrectPrice) || r.Line != 4 || r.Record.Article != "ca-f92w" {
+		t.Errorf("unexpected row %+v", r)
+	}
+}
+
+func TestParseCSVWithoutHeader(t *testing.T) {
+	rows, err := ParseCSV(strings.NewReader("ca-f91w;Casio;3490,5;5\nca-f92w;Casio;100\nca-f93w;Casio;100;-1\n"))
+	if err != nil || len(rows) != 3 {
+		t.Fatalf("rows %v, err %v", rows, err)
+	}
+	if r := rows[0]; r.Err != nil || r.Record.Price != 3490.5 || r.Record.Amount != 5 {
+		t.Errorf("unexpected row %+v", r)
+	}
+	if !errors.Is(rows[1].Err, ErrFieldsCount) || !errors.Is(rows[2].Err, ErrIncorrectAmount) {
+		t.Errorf("unexpected errors %v, %v", rows[1].Err, rows[2].Err)
+	}
+}
+
+func TestParseCSVMissingColumn(t *testing.T) {
+	if _, err := ParseCSV(strings.NewReader("article,name,price\nca-f91w,Casio,100\n")); !errors.Is(err, ErrMissingColumn) {
+		t.Errorf("expected %v, got %v", ErrMissingColumn, err)
+	}
+}
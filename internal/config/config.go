@@ -15,14 +15,17 @@ const (
 )
 
 type Config struct {
-	Instance   string `yaml:"instance" env:"INSTANCE" env-required:"true"`
-	Env        string `yaml:"env" env:"ENV" env-required:"true"`
-	UseKafka   bool   `yaml:"use_kafka" env:"USE_KAFKA"`
-	HttpServer `yaml:"http_server"`
-	Storage    `yaml:"storage"`
-	Secure     `yaml:"secure"`
-	Kafka      `yaml:"kafka"`
-	Prometheus `yaml:"prometheus"`
+	Instance          string   `yaml:"instance" env:"INSTANCE" env-required:"true"`
+	OrderNumberPrefix uint     `yaml:"order_number_prefix" env:"ORDER_NUMBER_PREFIX"` // Префикс выделяемых сервером номеров заказов
+	Env               string   `yaml:"env" env:"ENV" env-required:"true"`
+	UseKafka          bool     `yaml:"use_kafka" env:"USE_KAFKA"`
+	AdjustmentReasons []string `yaml:"adjustment_reasons" env:"ADJUSTMENT_REASONS" env-separator:","` // Если не заданы, используются коды причин корректировки по умолчанию
+	HttpServer        `yaml:"http_server"`
+	Storage           `yaml:"storage"`
+	Secure            `yaml:"secure"`
+	Kafka             `yaml:"kafka"`
+	Prometheus        `yaml:"prometheus"`
+	Replenishment     `yaml:"replenishment"`
 }
 
 type Secure struct {
@@ -38,12 +41,13 @@ type Secure struct {
 }
 
 type HttpServer struct {
-	Address         string        `yaml:"address" env:"ADDRESS" env-required:"true"`
-	ReadTimeout     time.Duration `yaml:"read_timeout" env:"READ_TIMEOUT" env-required:"true"`
-	WriteTimeout    time.Duration `yaml:"write_timeout" env:"WRITE_TIMEOUT" env-required:"true"`
-	IdleTimeout     time.Duration `yaml:"idle_timeout" env:"IDLE_TIMEOUT" env-required:"true"`
-	ShutdownTimeout time.Duration `yaml:"shutdown_timeout" env:"SHUTDOWN_TIMEOUT" env-required:"true"`
-	EnableProfiler  bool          `yaml:"enable_profiler" env:"ENABLE_PROFILER"`
+	Address           string        `yaml:"address" env:"ADDRESS" env-required:"true"`
+	ReadTimeout       time.Duration `yaml:"read_timeout" env:"READ_TIMEOUT" env-required:"true"`
+	WriteTimeout      time.Duration `yaml:"write_timeout" env:"WRITE_TIMEOUT" env-required:"true"`
+	IdleTimeout       time.Duration `yaml:"idle_timeout" env:"IDLE_TIMEOUT" env-required:"true"`
+	ShutdownTimeout   time.Duration `yaml:"shutdown_timeout" env:"SHUTDOWN_TIMEOUT" env-required:"true"`
+	EnableProfiler    bool          `yaml:"enable_profiler" env:"ENABLE_PROFILER"`
+	IdempotencyKeyTTL time.Duration `yaml:"idempotency_key_ttl" env:"IDEMPOTENCY_KEY_TTL" env-default:"24h"` // Срок хранения результатов запросов с заголовком Idempotency-Key
 }
 
 type Storage struct {
@@ -63,11 +67,33 @@ type Kafka struct {
 	UpdatePriceTopic   string   `yaml:"kafka_topic_update_price" env:"KAFKA_TOPIC_UPDATE_PRICE"`
 	RequestCountTopic  string   `yaml:"kafka_request_count_topic" env:"KAFKA_TOPIC_REQUEST_COUNT"`
 	ResponseCountTopic string   `yaml:"kafka_response_count_topic" env:"KAFKA_TOPIC_RESPONSE_COUNT"`
+	GoodsReceiptTopic  string   `yaml:"kafka_topic_goods_receipt" env:"KAFKA_TOPIC_GOODS_RECEIPT"`
+
+	ReorderSuggestionsTopic    string        `yaml:"kafka_topic_reorder_suggestions" env:"KAFKA_TOPIC_REORDER_SUGGESTIONS"`
+	ReorderSuggestionsInterval time.Duration `yaml:"kafka_reorder_suggestions_interval" env:"KAFKA_REORDER_SUGGESTIONS_INTERVAL" env-default:"24h"`
+
+	StockTransferTopicPrefix  string        `yaml:"kafka_topic_prefix_stock_transfer" env:"KAFKA_TOPIC_PREFIX_STOCK_TRANSFER"` // Топик магазина - префикс и название экземпляра
+	StockTransferPollInterval time.Duration `yaml:"kafka_stock_transfer_poll_interval" env:"KAFKA_STOCK_TRANSFER_POLL_INTERVAL" env-default:"10s"`
+
+	EventsTopic      string            `yaml:"kafka_topic_events" env:"KAFKA_TOPIC_EVENTS"` // Топик доменных событий по умолчанию
+	EventTopics      map[string]string `yaml:"kafka_event_topics" env:"KAFKA_EVENT_TOPICS"` // Топики для отдельных типов событий
+	EventsBufferSize int               `yaml:"kafka_events_buffer_size" env:"KAFKA_EVENTS_BUFFER_SIZE" env-default:"1000"`
 }
 
 type Prometheus struct {
-	PrometheusPort       string `yaml:"prometheus_port" env:"PROMETHEUS_PORT"`
-	PrometheusMetricsURL string `yaml:"prometheus_metrics_url" env:"PROMETHEUS_METRICS_URL"`
+	PrometheusPort       string        `yaml:"prometheus_port" env:"PROMETHEUS_PORT"`
+	PrometheusMetricsURL string        `yaml:"prometheus_metrics_url" env:"PROMETHEUS_METRICS_URL"`
+	GaugesInterval       time.Duration `yaml:"prometheus_gauges_interval" env:"PROMETHEUS_GAUGES_INTERVAL" env-default:"1m"` // Периодичность обновления метрик по данным БД
+	StockLevelMetrics    bool          `yaml:"prometheus_stock_level_metrics" env:"PROMETHEUS_STOCK_LEVEL_METRICS"`          // Собирать ли количество каждого товара
+}
+
+// Replenishment параметры расчёта предложений о заказе товаров. Незаданные значения (кроме страхового запаса) заменяются
+// значениями по умолчанию: 28 дней продаж, 7 дней поставки и 14 дней продаж после поставки.
+type Replenishment struct {
+	WindowDays   uint `yaml:"replenishment_window_days" env:"REPLENISHMENT_WINDOW_DAYS"`       // За сколько последних дней учитываются продажи
+	LeadTimeDays uint `yaml:"replenishment_lead_time_days" env:"REPLENISHMENT_LEAD_TIME_DAYS"` // Срок поставки товаров без собственных параметров
+	SafetyStock  uint `yaml:"replenishment_safety_stock" env:"REPLENISHMENT_SAFETY_STOCK"`     // Страховой запас товаров без собственных параметров
+	CoverDays    uint `yaml:"replenishment_cover_days" env:"REPLENISHMENT_COVER_DAYS"`         // На сколько дней продаж после поставки заказывается товар
 }
 
 // MustLoad возвращает конфигурацию, считанную из файла, путь к которому передан из командной строки по флагу config или
@@ -0,0 +1,4 @@
+package receipt
+
+// ID идентификатор чека, присваиваемый при его сохранении в хранилище.
+type ID int64
@@ -0,0 +1,4 @@
+package refund
+
+// ID идентификатор возврата товаров, присваиваемый при его сохранении в хранилище.
+type ID int64
@@ -1,13 +1,150 @@
 package reservation
 
-type OrderNumber int
+import (
+	"errors"
+	"fmt"
+)
+
+// OrderNumber номер заказа. Заказы, оформленные на кассе, имеют номер кассы из реестра касс, остальные заказы - номера,
+// не совпадающие с номерами касс.
+type OrderNumber int64
+
+const (
+	// OrderNumberPrefixMultiplier множитель префикса номера заказа: номер заказа с префиксом экземпляра приложения
+	// равен префиксу, умноженному на OrderNumberPrefixMultiplier, плюс порядковый номер.
+	OrderNumberPrefixMultiplier = 1_000_000_000
+	// MaxOrderNumberPrefix максимальный префикс номера заказа. Номер с максимальным префиксом помещается в int64 и в
+	// столбцы order_number в БД (BIGINT).
+	MaxOrderNumberPrefix = 9_000_000
+)
 
-const MaxCashRegisterNumber = 10
+// State состояние бронирования.
+type State uint
 
 const (
-	NewForCashRegister = iota + 1
-	NewForLocalCustomer
-	NewForInternetCustomer
-	Finished
-	Cancel
+	NewForCashRegister     State = iota + 1 // Товар находится на кассе в ожидании оплаты
+	NewForLocalCustomer                     // Товар отложен для покупателя, находящегося в магазине
+	NewForInternetCustomer                  // Товар забронирован через интернет
+	Finished                                // Заказ выполнен
+	Cancel                                  // Заказ отменён, товар возвращён в продажу
+	ReadyForPickup                          // Заказ собран и ожидает покупателя в магазине
+	Shipped                                 // Заказ передан в доставку
+)
+
+var (
+	ErrUnknownState         = errors.New("reservation: unknown state")
+	ErrIllegalTransition    = errors.New("reservation: illegal state transition")
+	ErrOrderNumberPrefix    = errors.New("reservation: order number prefix is too big")
+	ErrOrderNumbersOverflow = errors.New("reservation: order numbers sequence overflow")
 )
+
+// NewOrderNumber возвращает номер заказа через интернет, образованный из порядкового номера sequence и префикса
+// экземпляра приложения prefix (при prefix, равном 0, номер заказа совпадает с порядковым). Порядковый номер должен
+// быть положительным, а при наличии префикса - меньше OrderNumberPrefixMultiplier.
+func NewOrderNumber(prefix uint, sequence int64) (OrderNumber, error) {
+	switch {
+	case prefix > MaxOrderNumberPrefix:
+		return 0, ErrOrderNumberPrefix
+	case sequence <= 0:
+		return 0, ErrOrderNumbersOverflow
+	case prefix > 0 && sequence >= OrderNumberPrefixMultiplier:
+		return 0, ErrOrderNumbersOverflow
+	}
+	return OrderNumber(int64(prefix)*OrderNumberPrefixMultiplier + sequence), nil
+}
+
+// TransitionError ошибка недопустимого перехода бронирования из состояния From в состояние To. Проверяется через
+// errors.Is(err, ErrIllegalTransition).
+type TransitionError struct {
+	From State
+	To   State
+}
+
+func (e *TransitionError) Error() string {
+	return fmt.Sprintf("%s from %s to %s", ErrIllegalTransition.Error(), e.From, e.To)
+}
+
+func (e *TransitionError) Is(target error) bool {
+	return target == ErrIllegalTransition
+}
+
+// transitions допустимые переходы между состояниями. Отправленный заказ может быть отменён, если покупатель отказался
+// от него и товар вернулся в магазин.
+var transitions = map[State][]State{
+	NewForCashRegister:     {Finished, Cancel},
+	NewForLocalCustomer:    {ReadyForPickup, Finished, Cancel},
+	NewForInternetCustomer: {ReadyForPickup, Shipped, Finished, Cancel},
+	ReadyForPickup:         {Finished, Cancel},
+	Shipped:                {Finished, Cancel},
+	Finished:               {},
+	Cancel:                 {},
+}
+
+var names = map[State]string{
+	NewForCashRegister:     "new for cash register",
+	NewForLocalCustomer:    "new for local customer",
+	NewForInternetCustomer: "new for internet customer",
+	Finished:               "finished",
+	Cancel:                 "cancel",
+	ReadyForPickup:         "ready for pickup",
+	Shipped:                "shipped",
+}
+
+func (s State) String() string {
+	if name, ok := names[s]; ok {
+		return name
+	}
+	return fmt.Sprintf("unknown (%d)", uint(s))
+}
+
+// IsKnown возвращает true для состояний, определённых в пакете.
+func (s State) IsKnown() bool {
+	_, ok := transitions[s]
+	return ok
+}
+
+// IsInitial возвращает true для состояний, в которых бронирование может быть создано.
+func (s State) IsInitial() bool {
+	return s == NewForCashRegister || s == NewForLocalCustomer || s == NewForInternetCustomer
+}
+
+// IsFinal возвращает true для состояний, из которых нет переходов (бронь снята).
+func (s State) IsFinal() bool {
+	return s == Finished || s == Cancel
+}
+
+// OpenStates возвращает состояния, в которых товар остаётся зарезервированным.
+func OpenStates() []State {
+	return []State{NewForCashRegister, NewForLocalCustomer, NewForInternetCustomer, ReadyForPickup, Shipped}
+}
+
+// CanTransitTo возвращает nil, если переход в состояние to допустим, иначе - ErrUnknownState или *TransitionError.
+func (s State) CanTransitTo(to State) error {
+	if !s.IsKnown() || !to.IsKnown() {
+		return ErrUnknownState
+	}
+
+	for _, allowed := range transitions[s] {
+		if allowed == to {
+			return nil
+		}
+	}
+
+	return &TransitionError{From: s, To: to}
+}
+
+// Reservation агрегат бронирования, отвечающий за смену его состояния.
+type Reservation struct {
+	OrderNumber OrderNumber
+	State       State
+}
+
+// TransitTo переводит бронирование в состояние to, если переход допустим. В противном случае состояние не изменяется
+// и возвращается ошибка.
+func (r *Reservation) TransitTo(to State) error {
+	if err := r.State.CanTransitTo(to); err != nil {
+		return err
+	}
+	r.State = to
+	return nil
+}
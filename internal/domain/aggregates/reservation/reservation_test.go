@@ -0,0 +1,131 @@
+package reservation
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestState_CanTransitTo(t *testing.T) {
+	t.Parallel()
+	testCases := []struct {
+		testName    string
+		from        State
+		to          State
+		expectedErr error
+	}{
+		{
+			testName:    "local customer order ready for pickup",
+			from:        NewForLocalCustomer,
+			to:          ReadyForPickup,
+			expectedErr: nil,
+		},
+		{
+			testName:    "internet order shipped",
+			from:        NewForInternetCustomer,
+			to:          Shipped,
+			expectedErr: nil,
+		},
+		{
+			testName:    "shipped order finished",
+			from:        Shipped,
+			to:          Finished,
+			expectedErr: nil,
+		},
+		{
+			testName:    "cash register order shipped",
+			from:        NewForCashRegister,
+			to:          Shipped,
+			expectedErr: ErrIllegalTransition,
+		},
+		{
+			testName:    "finished order cancelled",
+			from:        Finished,
+			to:          Cancel,
+			expectedErr: ErrIllegalTransition,
+		},
+		{
+			testName:    "back to initial state",
+			from:        ReadyForPickup,
+			to:          NewForLocalCustomer,
+			expectedErr: ErrIllegalTransition,
+		},
+		{
+			testName:    "unknown state",
+			from:        NewForLocalCustomer,
+			to:          State(200),
+			expectedErr: ErrUnknownState,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.testName, func(t *testing.T) {
+			if !errors.Is(tc.from.CanTransitTo(tc.to), tc.expectedErr) {
+				t.Fail()
+			}
+		})
+	}
+}
+
+func TestReservation_TransitTo(t *testing.T) {
+	t.Parallel()
+	r := Reservation{OrderNumber: 20, State: NewForInternetCustomer}
+
+	if err := r.TransitTo(Shipped); err != nil || r.State != Shipped {
+		t.Fail()
+	}
+
+	var transitionErr *TransitionError
+	if err := r.TransitTo(ReadyForPickup); !errors.As(err, &transitionErr) || r.State != Shipped ||
+		transitionErr.From != Shipped || transitionErr.To != ReadyForPickup {
+		t.Fail()
+	}
+}
+
+func TestNewOrderNumber(t *testing.T) {
+	t.Parallel()
+	testCases := []struct {
+		testName    string
+		prefix      uint
+		sequence    int64
+		expected    OrderNumber
+		expectedErr error
+	}{
+		{
+			testName: "without prefix",
+			sequence: 11,
+			expected: 11,
+		},
+		{
+			testName: "with prefix",
+			prefix:   7,
+			sequence: 123,
+			expected: 7_000_000_123,
+		},
+		{
+			testName:    "overflowed sequence",
+			sequence:    -1,
+			expectedErr: ErrOrderNumbersOverflow,
+		},
+		{
+			testName:    "sequence overflows prefix",
+			prefix:      7,
+			sequence:    OrderNumberPrefixMultiplier,
+			expectedErr: ErrOrderNumbersOverflow,
+		},
+		{
+			testName:    "too big prefix",
+			prefix:      MaxOrderNumberPrefix + 1,
+			sequence:    11,
+			expectedErr: ErrOrderNumberPrefix,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.testName, func(t *testing.T) {
+			number, err := NewOrderNumber(tc.prefix, tc.sequence)
+			if !errors.Is(err, tc.expectedErr) || number != tc.expected {
+				t.Errorf("expected %d, %v, got %d, %v", tc.expected, tc.expectedErr, number, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,4 @@
+package shift
+
+// ID идентификатор кассовой смены, присваиваемый при её открытии.
+type ID int64
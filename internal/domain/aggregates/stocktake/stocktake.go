@@ -0,0 +1,12 @@
+package stocktake
+
+// ID идентификатор инвентаризации, присваиваемый при её начале.
+type ID int64
+
+// State состояние инвентаризации.
+type State int
+
+const (
+	InProgress State = iota + 1 // Идёт подсчёт товара
+	Applied                     // Результаты подсчёта внесены в остатки
+)
@@ -0,0 +1,33 @@
+package transfer
+
+// ID идентификатор перемещения товара между магазинами, присваиваемый экземпляром приложения при создании (для
+// исходящего перемещения) или при получении (для входящего) записи о перемещении.
+type ID int64
+
+// Direction направление перемещения относительно текущего экземпляра приложения.
+type Direction string
+
+const (
+	Outbound Direction = "outbound" // Товар отправлен из этого магазина
+	Inbound  Direction = "inbound"  // Товар отправлен в этот магазин
+)
+
+// Directions возвращает все направления перемещения.
+func Directions() []Direction {
+	return []Direction{Outbound, Inbound}
+}
+
+// State состояние перемещения.
+type State int
+
+const (
+	InTransit   State = iota + 1 // Товар отправлен и ещё не принят получателем
+	Received                     // Товар принят получателем в отправленном количестве
+	Discrepancy                  // Принятое количество хотя бы одного товара отличается от отправленного
+	Resolved                     // Расхождение урегулировано отправителем
+)
+
+// IsKnown возвращает true, если состояние является одним из объявленных.
+func (s State) IsKnown() bool {
+	return s >= InTransit && s <= Resolved
+}
@@ -0,0 +1,106 @@
+package warranty
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+// ID идентификатор гарантии, присваиваемый при её сохранении в хранилище.
+type ID int64
+
+// ClaimID идентификатор гарантийного обращения, присваиваемый при его сохранении в хранилище.
+type ClaimID int64
+
+// MaxMonths максимальный гарантийный срок товара в месяцах.
+const MaxMonths = 120
+
+// Expiration возвращает момент окончания гарантии сроком months месяцев на товар, проданный в момент sold.
+func Expiration(sold time.Time, months uint) time.Time {
+	return sold.AddDate(0, int(months), 0)
+}
+
+// Status состояние гарантийного обращения.
+type Status string
+
+const (
+	Registered Status = "registered" // обращение зарегистрировано, товар принят от покупателя
+	InRepair   Status = "in_repair"  // товар передан в ремонт
+	Repaired   Status = "repaired"   // товар отремонтирован
+	Replaced   Status = "replaced"   // товар заменён
+	Rejected   Status = "rejected"   // в гарантийном обслуживании отказано
+	Closed     Status = "closed"     // товар возвращён покупателю, обращение закрыто
+)
+
+var (
+	ErrUnknownStatus     = errors.New("warranty: unknown claim status")
+	ErrIllegalTransition = errors.New("warranty: illegal claim status transition")
+)
+
+// TransitionError ошибка недопустимого перехода гарантийного обращения из состояния From в состояние To. Проверяется
+// через errors.Is(err, ErrIllegalTransition).
+type TransitionError struct {
+	From Status
+	To   Status
+}
+
+func (e *TransitionError) Error() string {
+	return fmt.Sprintf("%s from %s to %s", ErrIllegalTransition.Error(), e.From, e.To)
+}
+
+func (e *TransitionError) Is(target error) bool {
+	return target == ErrIllegalTransition
+}
+
+// transitions допустимые переходы между состояниями. Замена возможна как сразу после регистрации обращения, так и
+// после неудачной попытки ремонта.
+var transitions = map[Status][]Status{
+	Registered: {InRepair, Replaced, Rejected},
+	InRepair:   {Repaired, Replaced, Rejected},
+	Repaired:   {Closed},
+	Replaced:   {Closed},
+	Rejected:   {Closed},
+	Closed:     {},
+}
+
+// IsKnown возвращает true для состояний, определённых в пакете.
+func (s Status) IsKnown() bool {
+	_, ok := transitions[s]
+	return ok
+}
+
+// IsFinal возвращает true для состояний, из которых нет переходов (обращение закрыто).
+func (s Status) IsFinal() bool {
+	return s == Closed
+}
+
+// CanTransitTo возвращает nil, если переход в состояние to допустим, иначе - ErrUnknownStatus или *TransitionError.
+func (s Status) CanTransitTo(to Status) error {
+	if !s.IsKnown() || !to.IsKnown() {
+		return ErrUnknownStatus
+	}
+
+	for _, allowed := range transitions[s] {
+		if allowed == to {
+			return nil
+		}
+	}
+
+	return &TransitionError{From: s, To: to}
+}
+
+// Claim агрегат гарантийного обращения, отвечающий за смену его состояния.
+type Claim struct {
+	ID     ClaimID
+	Status Status
+}
+
+// TransitTo переводит обращение в состояние to, если переход допустим. В противном случае состояние не изменяется и
+// возвращается ошибка.
+func (c *Claim) TransitTo(to Status) error {
+	if err := c.Status.CanTransitTo(to); err != nil {
+		return err
+	}
+	c.Status = to
+	return nil
+}
@@ -0,0 +1,86 @@
+package warranty
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestStatus_CanTransitTo(t *testing.T) {
+	t.Parallel()
+	testCases := []struct {
+		testName    string
+		from        Status
+		to          Status
+		expectedErr error
+	}{
+		{
+			testName:    "registered claim sent to repair",
+			from:        Registered,
+			to:          InRepair,
+			expectedErr: nil,
+		},
+		{
+			testName:    "replacement after failed repair",
+			from:        InRepair,
+			to:          Replaced,
+			expectedErr: nil,
+		},
+		{
+			testName:    "rejected claim closed",
+			from:        Rejected,
+			to:          Closed,
+			expectedErr: nil,
+		},
+		{
+			testName:    "registered claim closed without decision",
+			from:        Registered,
+			to:          Closed,
+			expectedErr: ErrIllegalTransition,
+		},
+		{
+			testName:    "closed claim reopened",
+			from:        Closed,
+			to:          Registered,
+			expectedErr: ErrIllegalTransition,
+		},
+		{
+			testName:    "unknown status",
+			from:        Registered,
+			to:          Status("lost"),
+			expectedErr: ErrUnknownStatus,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.testName, func(t *testing.T) {
+			if !errors.Is(tc.from.CanTransitTo(tc.to), tc.expectedErr) {
+				t.Fail()
+			}
+		})
+	}
+}
+
+func TestClaim_TransitTo(t *testing.T) {
+	t.Parallel()
+	c := Claim{ID: 3, Status: Registered}
+
+	if err := c.TransitTo(InRepair); err != nil || c.Status != InRepair {
+		t.Fail()
+	}
+
+	var transitionErr *TransitionError
+	if err := c.TransitTo(Closed); !errors.As(err, &transitionErr) || c.Status != InRepair ||
+		transitionErr.From != InRepair || transitionErr.To != Closed {
+		t.Fail()
+	}
+}
+
+func TestExpiration(t *testing.T) {
+	t.Parallel()
+	sold := time.Date(2026, 3, 15, 12, 0, 0, 0, time.UTC)
+
+	if expiration := Expiration(sold, 24); !expiration.Equal(time.Date(2028, 3, 15, 12, 0, 0, 0, time.UTC)) {
+		t.Errorf("unexpected expiration %s", expiration)
+	}
+}
@@ -0,0 +1,117 @@
+package event
+
+import "time"
+
+// Type тип доменного события.
+type Type string
+
+const (
+	StockAdded                  Type = "stock_added"                   // Товар добавлен в ассортимент
+	StockReplaced               Type = "stock_replaced"                // Название, цена и количество товара заменены при загрузке
+	StockPriceChanged           Type = "stock_price_changed"           // Изменена цена товара
+	StockAmountChanged          Type = "stock_amount_changed"          // Количество товара установлено
+	StockAdjusted               Type = "stock_adjusted"                // Количество товара скорректировано с указанием причины
+	StockRelocated              Type = "stock_relocated"               // Товар перемещён между местами хранения
+	ReservationMade             Type = "reservation_made"              // Товар зарезервирован под заказ
+	ReservationCancelled        Type = "reservation_cancelled"         // Заказ отменён, резерв снят
+	OrderReadyForPickup         Type = "order_ready_for_pickup"        // Заказ собран и ожидает покупателя
+	OrderShipped                Type = "order_shipped"                 // Заказ передан в доставку
+	OrderFinished               Type = "order_finished"                // Заказ выдан и оплачен
+	SaleMade                    Type = "sale_made"                     // Товар продан на кассе
+	SaleReturned                Type = "sale_returned"                 // Товар возвращён покупателем
+	ShiftOpened                 Type = "shift_opened"                  // Открыта кассовая смена
+	ShiftClosed                 Type = "shift_closed"                  // Закрыта кассовая смена
+	StocktakeStarted            Type = "stocktake_started"             // Начата инвентаризация
+	StocktakeCounted            Type = "stocktake_counted"             // Передана порция подсчитанного товара
+	StocktakeApplied            Type = "stocktake_applied"             // Расхождения инвентаризации внесены в остатки
+	GoodsReceived               Type = "goods_received"                // Принята поставка товаров
+	ReplenishmentSettingChanged Type = "replenishment_setting_changed" // Изменены параметры пополнения товара
+	AttributeDefined            Type = "attribute_defined"             // Сохранено определение атрибута товаров
+	ArticleAttributesChanged    Type = "article_attributes_changed"    // Изменены значения атрибутов товара
+	ArticleAttributeDeleted     Type = "article_attribute_deleted"     // Удалено значение атрибута товара
+	BarcodeAssigned             Type = "barcode_assigned"              // Товару назначен штрихкод
+	BarcodeDeleted              Type = "barcode_deleted"               // Штрихкод удалён
+	TransferSent                Type = "transfer_sent"                 // Товар отправлен в другой магазин
+	TransferRegistered          Type = "transfer_registered"           // Получено сообщение о входящем перемещении
+	TransferReceived            Type = "transfer_received"             // Входящее перемещение принято
+	TransferCompleted           Type = "transfer_completed"            // Получатель принял исходящее перемещение
+	TransferResolved            Type = "transfer_resolved"             // Расхождение исходящего перемещения урегулировано
+	TaxRateSet                  Type = "tax_rate_set"                  // Сохранена ставка НДС налоговой категории
+	ArticleTaxCategoryChanged   Type = "article_tax_category_changed"  // Изменена налоговая категория товара
+	SerialTrackingChanged       Type = "serial_tracking_changed"       // Включён или отключён учёт по серийным номерам
+	WarrantyTermChanged         Type = "warranty_term_changed"         // Изменён гарантийный срок товара
+	WarrantyClaimRegistered     Type = "warranty_claim_registered"     // Зарегистрировано гарантийное обращение
+	WarrantyClaimStatusChanged  Type = "warranty_claim_status_changed" // Изменено состояние гарантийного обращения
+	CashRegisterCreated         Type = "cash_register_created"         // Касса зарегистрирована в реестре касс
+	CashRegisterUpdated         Type = "cash_register_updated"         // Изменены данные кассы
+)
+
+// versions текущие версии формата полезной нагрузки событий. Версия типа увеличивается при несовместимом изменении
+// формата его полезной нагрузки, что позволяет потребителям обрабатывать старые и новые события по паре тип-версия.
+var versions = map[Type]int{
+	StockAdded:                  1,
+	StockReplaced:               1,
+	StockPriceChanged:           1,
+	StockAmountChanged:          1,
+	StockAdjusted:               1,
+	StockRelocated:              1,
+	ReservationMade:             1,
+	ReservationCancelled:        1,
+	OrderReadyForPickup:         1,
+	OrderShipped:                1,
+	OrderFinished:               1,
+	SaleMade:                    1,
+	SaleReturned:                1,
+	ShiftOpened:                 1,
+	ShiftClosed:                 1,
+	StocktakeStarted:            1,
+	StocktakeCounted:            1,
+	StocktakeApplied:            1,
+	GoodsReceived:               1,
+	ReplenishmentSettingChanged: 1,
+	AttributeDefined:            1,
+	ArticleAttributesChanged:    1,
+	ArticleAttributeDeleted:     1,
+	BarcodeAssigned:             1,
+	BarcodeDeleted:              1,
+	TransferSent:                1,
+	TransferRegistered:          1,
+	TransferReceived:            1,
+	TransferCompleted:           1,
+	TransferResolved:            1,
+	TaxRateSet:                  1,
+	ArticleTaxCategoryChanged:   1,
+	SerialTrackingChanged:       1,
+	WarrantyTermChanged:         1,
+	WarrantyClaimRegistered:     1,
+	WarrantyClaimStatusChanged:  1,
+	CashRegisterCreated:         1,
+	CashRegisterUpdated:         1,
+}
+
+// Version возвращает текущую версию формата полезной нагрузки события. Для неизвестного типа возвращается 0.
+func (t Type) Version() int {
+	return versions[t]
+}
+
+// IsKnown возвращает true, если тип события является одним из объявленных.
+func (t Type) IsKnown() bool {
+	_, ok := versions[t]
+	return ok
+}
+
+// Event доменное событие. Key - артикул товара, номер заказа или номер кассы, к которому относится событие. Для
+// событий, относящихся к документу с несколькими товарами (чек, поставка, инвентаризация, перемещение), ключом является
+// идентификатор документа. События с одинаковым ключом публикуются в порядке возникновения.
+type Event struct {
+	Type       Type
+	Version    int
+	Key        string
+	OccurredAt time.Time
+	Payload    any
+}
+
+// New возвращает событие текущей версии, возникшее в текущий момент.
+func New(t Type, key string, payload any) Event {
+	return Event{Type: t, Version: t.Version(), Key: key, OccurredAt: time.Now(), Payload: payload}
+}
@@ -0,0 +1,17 @@
+package adjustment
+
+// Reason код причины корректировки количества товара.
+type Reason string
+
+const (
+	Damaged    Reason = "damaged"    // товар повреждён в магазине
+	Found      Reason = "found"      // обнаружен неучтённый товар
+	Lost       Reason = "lost"       // товар утерян
+	Theft      Reason = "theft"      // кража
+	Correction Reason = "correction" // исправление ошибки учёта
+)
+
+// DefaultReasons возвращает коды причин корректировки, используемые, если их список не задан в конфигурации.
+func DefaultReasons() []Reason {
+	return []Reason{Damaged, Found, Lost, Theft, Correction}
+}
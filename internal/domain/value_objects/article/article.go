@@ -23,3 +23,22 @@ const (
 )
 
 type Article string
+
+// defectSuffixLength длина кода дефектов в конце артикула: точка и четыре цифры.
+const defectSuffixLength = 5
+
+// Base возвращает артикул товара без дефектов, на основе которого образован артикул. Для артикула без кода дефектов
+// возвращается он сам.
+func (a Article) Base() Article {
+	r := []rune(a)
+	ln := len(r)
+	if ln <= defectSuffixLength || r[ln-defectSuffixLength] != '.' {
+		return a
+	}
+	for _, c := range r[ln-defectSuffixLength+1:] {
+		if c < '0' || c > '9' {
+			return a
+		}
+	}
+	return Article(r[:ln-defectSuffixLength])
+}
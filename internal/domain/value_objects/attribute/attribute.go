@@ -0,0 +1,19 @@
+package attribute
+
+// Code код атрибута товара (например, brand или water_resistance).
+type Code string
+
+// Type тип значения атрибута товара.
+type Type string
+
+const (
+	String  Type = "string"  // произвольная строка
+	Number  Type = "number"  // число, по которому возможен фильтр по диапазону
+	Boolean Type = "boolean" // true или false
+	Enum    Type = "enum"    // одно из перечисленных в определении атрибута значений
+)
+
+// Types возвращает все доступные типы атрибутов.
+func Types() []Type {
+	return []Type{String, Number, Boolean, Enum}
+}
@@ -0,0 +1,46 @@
+package barcode
+
+const (
+	EAN13Length = 13
+	UPCALength  = 12
+)
+
+// Barcode штрихкод товара в формате EAN-13 или UPC-A.
+type Barcode string
+
+// IsValid возвращает true, если штрихкод состоит из 13 (EAN-13) или 12 (UPC-A) цифр и его контрольная цифра верна.
+func (b Barcode) IsValid() bool {
+	if len(b) != EAN13Length && len(b) != UPCALength {
+		return false
+	}
+
+	sum := 0
+	// Веса цифр отсчитываются от контрольной цифры: для нечётных справа позиций (без учёта контрольной) вес 3, для
+	// чётных - 1. Это позволяет одинаково проверять коды EAN-13 и UPC-A.
+	for i := len(b) - 2; i >= 0; i-- {
+		if b[i] < '0' || b[i] > '9' {
+			return false
+		}
+		digit := int(b[i] - '0')
+		if (len(b)-2-i)%2 == 0 {
+			digit *= 3
+		}
+		sum += digit
+	}
+
+	last := b[len(b)-1]
+	if last < '0' || last > '9' {
+		return false
+	}
+
+	return (10-sum%10)%10 == int(last-'0')
+}
+
+// EAN13 возвращает штрихкод в формате EAN-13. Код UPC-A является кодом EAN-13 с ведущим нулём, поэтому оба формата
+// хранятся и ищутся в виде EAN-13.
+func (b Barcode) EAN13() Barcode {
+	if len(b) == UPCALength {
+		return "0" + b
+	}
+	return b
+}
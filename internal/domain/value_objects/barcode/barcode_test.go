@@ -0,0 +1,37 @@
+package barcode
+
+import "testing"
+
+func TestBarcode_IsValid(t *testing.T) {
+	testCases := []struct {
+		testName string
+		barcode  Barcode
+		expected bool
+	}{
+		{testName: "correct EAN-13", barcode: "4006381333931", expected: true},
+		{testName: "correct UPC-A", barcode: "036000291452", expected: true},
+		{testName: "wrong EAN-13 check digit", barcode: "4006381333932", expected: false},
+		{testName: "wrong UPC-A check digit", barcode: "036000291453", expected: false},
+		{testName: "letters", barcode: "40063813339A1", expected: false},
+		{testName: "letter as check digit", barcode: "400638133393X", expected: false},
+		{testName: "wrong length", barcode: "40063813339", expected: false},
+		{testName: "empty", barcode: "", expected: false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.testName, func(t *testing.T) {
+			if tc.barcode.IsValid() != tc.expected {
+				t.Fail()
+			}
+		})
+	}
+}
+
+func TestBarcode_EAN13(t *testing.T) {
+	if Barcode("036000291452").EAN13() != "0036000291452" || !Barcode("036000291452").EAN13().IsValid() {
+		t.Error("UPC-A not converted")
+	}
+	if Barcode("4006381333931").EAN13() != "4006381333931" {
+		t.Error("EAN-13 changed")
+	}
+}
@@ -0,0 +1,15 @@
+package bucket
+
+// Bucket размер интервала, по которым группируются продажи за период.
+type Bucket string
+
+const (
+	Day   Bucket = "day"   // день
+	Week  Bucket = "week"  // неделя, начинающаяся с понедельника
+	Month Bucket = "month" // календарный месяц
+)
+
+// Buckets возвращает все доступные размеры интервалов.
+func Buckets() []Bucket {
+	return []Bucket{Day, Week, Month}
+}
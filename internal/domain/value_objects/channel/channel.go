@@ -0,0 +1,16 @@
+package channel
+
+// Channel канал продаж.
+type Channel string
+
+const (
+	Register    Channel = "register"     // продажа на кассе
+	Internet    Channel = "internet"     // выполненный заказ
+	Unknown     Channel = "unknown"      // продажа, записанная до появления чеков
+	LocalPickup Channel = "local_pickup" // заказ, выданный покупателю в магазине (различается только в метриках)
+)
+
+// Channels возвращает все каналы продаж.
+func Channels() []Channel {
+	return []Channel{Register, Internet, Unknown}
+}
@@ -0,0 +1,15 @@
+package conflict
+
+// Policy действие при загрузке товара, уже имеющегося в ассортименте.
+type Policy string
+
+const (
+	Reject Policy = "reject" // строка загрузки считается ошибочной
+	Skip   Policy = "skip"   // строка загрузки пропускается, товар не изменяется
+	Update Policy = "update" // название, цена и количество товара заменяются загружаемыми
+)
+
+// Policies возвращает все доступные действия при загрузке имеющегося товара.
+func Policies() []Policy {
+	return []Policy{Reject, Skip, Update}
+}
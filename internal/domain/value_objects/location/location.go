@@ -0,0 +1,18 @@
+package location
+
+// Location место хранения товара внутри магазина.
+type Location string
+
+const (
+	Showcase   Location = "showcase"    // витрина
+	BackRoom   Location = "back_room"   // склад
+	RepairDesk Location = "repair_desk" // стойка ремонта
+)
+
+// Default место хранения, на которое поступает товар и с которого он продаётся, если не указано иное.
+const Default = Showcase
+
+// Locations возвращает все места хранения в порядке, в котором с них списывается товар.
+func Locations() []Location {
+	return []Location{Showcase, BackRoom, RepairDesk}
+}
@@ -0,0 +1,14 @@
+package measure
+
+// Measure показатель, по которому ранжируются товары.
+type Measure string
+
+const (
+	Units   Measure = "units"   // количество проданных единиц
+	Revenue Measure = "revenue" // выручка
+)
+
+// Measures возвращает все доступные показатели.
+func Measures() []Measure {
+	return []Measure{Units, Revenue}
+}
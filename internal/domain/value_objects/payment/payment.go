@@ -0,0 +1,19 @@
+package payment
+
+// Method способ оплаты покупки.
+type Method string
+
+const (
+	Cash         Method = "cash"          // наличные
+	Card         Method = "card"          // банковская карта
+	Online       Method = "online"        // оплата через интернет-магазин
+	Voucher      Method = "voucher"       // подарочный сертификат
+	BankTransfer Method = "bank_transfer" // банковский перевод
+	// Mixed указывается в чеке, оплаченном несколькими способами. Покупатель не может выбрать его как способ оплаты.
+	Mixed Method = "mixed"
+)
+
+// Methods возвращает все доступные способы оплаты.
+func Methods() []Method {
+	return []Method{Cash, Card, Online, Voucher, BankTransfer}
+}
@@ -0,0 +1,50 @@
+package phone
+
+import "strings"
+
+const (
+	MinDigits = 10
+	MaxDigits = 15
+	// shownDigits количество последних цифр номера, остающихся видимыми при его скрытии.
+	shownDigits = 4
+)
+
+// Phone номер телефона покупателя. Номер хранится и ищется в нормализованном виде - только цифры, без знака "+",
+// пробелов, скобок и дефисов.
+type Phone string
+
+// Normalized возвращает номер телефона, из которого удалены все символы, кроме цифр.
+func (p Phone) Normalized() Phone {
+	var b strings.Builder
+	for _, r := range p {
+		if r >= '0' && r <= '9' {
+			b.WriteRune(r)
+		}
+	}
+	return Phone(b.String())
+}
+
+// IsValid возвращает true, если номер содержит от MinDigits до MaxDigits цифр, а кроме цифр содержит только знак "+"
+// в начале, пробелы, скобки и дефисы.
+func (p Phone) IsValid() bool {
+	for i, r := range p {
+		switch {
+		case r >= '0' && r <= '9', r == ' ', r == '(', r == ')', r == '-':
+		case r == '+' && i == 0:
+		default:
+			return false
+		}
+	}
+
+	digits := len(p.Normalized())
+	return digits >= MinDigits && digits <= MaxDigits
+}
+
+// Redacted возвращает нормализованный номер, в котором видны только последние цифры.
+func (p Phone) Redacted() string {
+	n := p.Normalized()
+	if len(n) <= shownDigits {
+		return strings.Repeat("*", len(n))
+	}
+	return strings.Repeat("*", len(n)-shownDigits) + string(n[len(n)-shownDigits:])
+}
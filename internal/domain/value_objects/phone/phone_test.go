@@ -0,0 +1,38 @@
+package phone
+
+import "testing"
+
+func TestPhone_IsValid(t *testing.T) {
+	t.Parallel()
+	testCases := []struct {
+		phone    Phone
+		expected bool
+	}{
+		{"+7 (912) 345-67-89", true},
+		{"89123456789", true},
+		{"912345678", false},
+		{"+7912345678901234", false},
+		{"7+9123456789", false},
+		{"+7 912 345 67 89 доб. 1", false},
+	}
+
+	for _, tc := range testCases {
+		if tc.phone.IsValid() != tc.expected {
+			t.Errorf("%q: expected %t", tc.phone, tc.expected)
+		}
+	}
+}
+
+func TestPhone_Normalized(t *testing.T) {
+	t.Parallel()
+	if n := Phone("+7 (912) 345-67-89").Normalized(); n != "79123456789" {
+		t.Errorf("unexpected normalized phone %s", n)
+	}
+}
+
+func TestPhone_Redacted(t *testing.T) {
+	t.Parallel()
+	if r := Phone("+7 (912) 345-67-89").Redacted(); r != "*******6789" {
+		t.Errorf("unexpected redacted phone %s", r)
+	}
+}
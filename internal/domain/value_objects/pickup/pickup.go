@@ -0,0 +1,69 @@
+package pickup
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"log/slog"
+	"math/big"
+)
+
+// CodeLength количество цифр в коде получения заказа.
+const CodeLength = 6
+
+// redacted текст, выводимый вместо кода получения заказа.
+const redacted = "******"
+
+// Code код получения заказа, сообщаемый покупателю при резервировании товара для самовывоза. В хранилище сохраняется
+// только хэш кода. При выводе через fmt и slog код скрывается.
+type Code string
+
+// NewCode возвращает случайный код получения заказа из CodeLength цифр.
+func NewCode() (Code, error) {
+	digits := make([]byte, CodeLength)
+	for i := range digits {
+		n, err := rand.Int(rand.Reader, big.NewInt(10))
+		if err != nil {
+			return "", err
+		}
+		digits[i] = byte('0' + n.Int64())
+	}
+
+	return Code(digits), nil
+}
+
+// IsValid возвращает true, если код состоит из CodeLength цифр.
+func (c Code) IsValid() bool {
+	if len(c) != CodeLength {
+		return false
+	}
+	for i := 0; i < len(c); i++ {
+		if c[i] < '0' || c[i] > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+// Hash возвращает хэш кода, вычисленный вместе с солью salt (номером заказа), чтобы одинаковые коды разных заказов
+// имели разные хэши.
+func (c Code) Hash(salt string) string {
+	sum := sha256.Sum256([]byte(salt + ":" + string(c)))
+	return hex.EncodeToString(sum[:])
+}
+
+// Matches возвращает true, если хэш кода с солью salt совпадает с hash. Сравнение выполняется за постоянное время.
+func (c Code) Matches(hash, salt string) bool {
+	return subtle.ConstantTimeCompare([]byte(c.Hash(salt)), []byte(hash)) == 1
+}
+
+// String скрывает код при выводе через fmt.
+func (c Code) String() string {
+	return redacted
+}
+
+// LogValue скрывает код при записи в лог.
+func (c Code) LogValue() slog.Value {
+	return slog.StringValue(redacted)
+}
@@ -0,0 +1,63 @@
+package pickup
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestNewCode(t *testing.T) {
+	t.Parallel()
+	for range 100 {
+		code, err := NewCode()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !code.IsValid() {
+			t.Fatalf("invalid code %q", string(code))
+		}
+	}
+}
+
+func TestCode_IsValid(t *testing.T) {
+	t.Parallel()
+	testCases := []struct {
+		code     Code
+		expected bool
+	}{
+		{"012345", true},
+		{"12345", false},
+		{"1234567", false},
+		{"12a456", false},
+		{"", false},
+	}
+
+	for _, tc := range testCases {
+		if tc.code.IsValid() != tc.expected {
+			t.Errorf("%q: expected %t", string(tc.code), tc.expected)
+		}
+	}
+}
+
+func TestCode_Matches(t *testing.T) {
+	t.Parallel()
+	code := Code("482913")
+	hash := code.Hash("150")
+
+	if !code.Matches(hash, "150") {
+		t.Error("code doesn't match own hash")
+	}
+	if Code("482914").Matches(hash, "150") {
+		t.Error("another code matches hash")
+	}
+	if code.Matches(hash, "151") {
+		t.Error("code matches hash of another order")
+	}
+}
+
+func TestCode_Redacted(t *testing.T) {
+	t.Parallel()
+	code := Code("482913")
+	if s := fmt.Sprintf("%s %v", code, code); s != redacted+" "+redacted {
+		t.Errorf("code is not redacted: %s", s)
+	}
+}
@@ -0,0 +1,29 @@
+package report
+
+// Kind вид выгружаемого отчёта о продажах.
+type Kind string
+
+const (
+	Sold     Kind = "sold"     // записи о проданных товарах
+	Articles Kind = "articles" // продажи, сгруппированные по товарам
+	Days     Kind = "days"     // продажи, сгруппированные по дням
+	Payments Kind = "payments" // платежи, сгруппированные по дням и способам оплаты
+)
+
+// Kinds возвращает все доступные виды отчётов.
+func Kinds() []Kind {
+	return []Kind{Sold, Articles, Days, Payments}
+}
+
+// Format формат файла выгружаемого отчёта.
+type Format string
+
+const (
+	CSV  Format = "csv"
+	XLSX Format = "xlsx"
+)
+
+// Formats возвращает все доступные форматы файлов отчётов.
+func Formats() []Format {
+	return []Format{CSV, XLSX}
+}
@@ -0,0 +1,16 @@
+package serial
+
+// Number серийный номер экземпляра товара, уникальный в пределах магазина.
+type Number string
+
+// State состояние экземпляра товара с серийным номером.
+type State string
+
+const (
+	InStock  State = "in_stock" // доступен для продажи
+	Reserved State = "reserved" // зарезервирован под заказ
+	Sold     State = "sold"     // продан
+	// WrittenOff экземпляр, остававшийся в продаже при отключении учёта товара по серийным номерам. При повторном
+	// включении учёта его серийный номер может быть снова зарегистрирован.
+	WrittenOff State = "written_off"
+)
@@ -0,0 +1,7 @@
+package tax
+
+// Category налоговая категория товара (например, standard или reduced), определяющая применяемую к нему ставку НДС.
+type Category string
+
+// Default категория товаров, для которых налоговая категория не задана.
+const Default Category = "default"
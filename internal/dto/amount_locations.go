@@ -0,0 +1,8 @@
+package dto
+
+// AmountLocations общее доступное для продажи количество товара и, если она запрошена, его разбивка по местам
+// хранения.
+type AmountLocations struct {
+	Amount    uint             `json:"amount"`
+	Locations []LocationAmount `json:"locations,omitempty"`
+}
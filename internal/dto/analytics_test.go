@@ -0,0 +1,131 @@
+package dto
+
+import (
+	"errors"
+	"github.com/lazylex/watch-store-store/internal/dto/validators"
+	"testing"
+	"time"
+)
+
+func TestFromToBucketDTO(t *testing.T) {
+	from := time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2024, 7, 1, 0, 0, 0, 0, time.UTC)
+	testCases := []struct {
+		testName    string
+		data        FromToBucket
+		expectedErr error
+	}{
+		{
+			testName:    "incorrect dates order",
+			data:        FromToBucket{FromTo: FromTo{From: to, To: from}, Bucket: "day"},
+			expectedErr: validators.ErrIncorrectDatesOrder,
+		},
+		{
+			testName:    "incorrect bucket",
+			data:        FromToBucket{FromTo: FromTo{From: from, To: to}, Bucket: "year"},
+			expectedErr: validators.ErrIncorrectBucket,
+		},
+		{
+			testName:    "correct",
+			data:        FromToBucket{FromTo: FromTo{From: from, To: to}, Bucket: "week"},
+			expectedErr: nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		d := tc.data
+		t.Run(tc.testName, func(t *testing.T) {
+			if !errors.Is(d.Validate(), tc.expectedErr) {
+				t.Fail()
+			}
+		})
+	}
+}
+
+func TestFromToTopDTO(t *testing.T) {
+	period := FromTo{From: time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC), To: time.Date(2024, 7, 1, 0, 0, 0, 0, time.UTC)}
+	testCases := []struct {
+		testName    string
+		data        FromToTop
+		expectedErr error
+	}{
+		{
+			testName:    "incorrect measure",
+			data:        FromToTop{FromTo: period, By: "profit", Limit: 10},
+			expectedErr: validators.ErrIncorrectMeasure,
+		},
+		{
+			testName:    "incorrect limit",
+			data:        FromToTop{FromTo: period, By: "units", Limit: 0},
+			expectedErr: validators.ErrIncorrectLimit,
+		},
+		{
+			testName:    "correct",
+			data:        FromToTop{FromTo: period, By: "revenue", Limit: 10},
+			expectedErr: nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		d := tc.data
+		t.Run(tc.testName, func(t *testing.T) {
+			if !errors.Is(d.Validate(), tc.expectedErr) {
+				t.Fail()
+			}
+		})
+	}
+}
+
+func TestArticleSales_CalculateAveragePrice(t *testing.T) {
+	a := ArticleSales{Article: "CA-F91W", Units: 4, Revenue: 13960}
+	a.CalculateAveragePrice()
+	if a.AveragePrice != 3490 {
+		t.Fail()
+	}
+
+	empty := ArticleSales{Article: "CA-F91W"}
+	empty.CalculateAveragePrice()
+	if empty.AveragePrice != 0 {
+		t.Fail()
+	}
+
+	refunded := ArticleSales{Article: "CA-F91W", Units: -1, Revenue: -3490, RefundedUnits: 1, Refunded: 3490}
+	refunded.CalculateAveragePrice()
+	if refunded.AveragePrice != 0 {
+		t.Fail()
+	}
+}
+
+func TestFromToReportDTO(t *testing.T) {
+	period := FromTo{From: time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC), To: time.Date(2024, 7, 1, 0, 0, 0, 0, time.UTC)}
+	testCases := []struct {
+		testName    string
+		data        FromToReport
+		expectedErr error
+	}{
+		{
+			testName:    "incorrect report",
+			data:        FromToReport{FromTo: period, Report: "refunds"},
+			expectedErr: validators.ErrIncorrectReport,
+		},
+		{
+			testName:    "empty report",
+			data:        FromToReport{FromTo: period},
+			expectedErr: validators.ErrIncorrectReport,
+		},
+		{
+			testName:    "correct",
+			data:        FromToReport{FromTo: period, Report: "articles"},
+			expectedErr: nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		d := tc.data
+		t.Run(tc.testName, func(t *testing.T) {
+			if !errors.Is(d.Validate(), tc.expectedErr) {
+				t.Fail()
+			}
+		})
+	}
+}
@@ -0,0 +1,21 @@
+package dto
+
+import (
+	"github.com/lazylex/watch-store-store/internal/domain/value_objects/article"
+	"github.com/lazylex/watch-store-store/internal/domain/value_objects/attribute"
+	"github.com/lazylex/watch-store-store/internal/dto/validators"
+)
+
+// ArticleAttributeCode артикул товара и код его атрибута.
+type ArticleAttributeCode struct {
+	Article article.Article `json:"article"`
+	Code    attribute.Code  `json:"code"`
+}
+
+// Validate валидация корректности сохраненных в DTO данных.
+func (a *ArticleAttributeCode) Validate() error {
+	if err := validators.Article(a.Article); err != nil {
+		return err
+	}
+	return validators.AttributeCode(a.Code)
+}
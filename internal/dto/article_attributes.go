@@ -0,0 +1,30 @@
+package dto
+
+import (
+	"github.com/lazylex/watch-store-store/internal/domain/value_objects/article"
+	"github.com/lazylex/watch-store-store/internal/domain/value_objects/attribute"
+	"github.com/lazylex/watch-store-store/internal/dto/validators"
+)
+
+// ArticleAttributes значения атрибутов товара по их кодам. Соответствие значений типам атрибутов проверяется сервисом,
+// так как требует определений атрибутов.
+type ArticleAttributes struct {
+	Article    article.Article           `json:"article"`
+	Attributes map[attribute.Code]string `json:"attributes"`
+}
+
+// Validate валидация корректности сохраненных в DTO данных.
+func (a *ArticleAttributes) Validate() error {
+	if err := validators.Article(a.Article); err != nil {
+		return err
+	}
+	if len(a.Attributes) == 0 {
+		return validators.ErrNoAttributes
+	}
+	for code := range a.Attributes {
+		if err := validators.AttributeCode(code); err != nil {
+			return err
+		}
+	}
+	return nil
+}
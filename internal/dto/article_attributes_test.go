@@ -0,0 +1,47 @@
+package dto
+
+import (
+	"errors"
+	"github.com/lazylex/watch-store-store/internal/domain/value_objects/attribute"
+	"github.com/lazylex/watch-store-store/internal/dto/validators"
+	"testing"
+)
+
+func TestArticleAttributesDTO(t *testing.T) {
+	testCases := []struct {
+		testName    string
+		data        ArticleAttributes
+		expectedErr error
+	}{
+		{
+			testName:    "incorrect article",
+			data:        ArticleAttributes{Attributes: map[attribute.Code]string{"case_material": "resin"}},
+			expectedErr: validators.ErrIncorrectArticle,
+		},
+		{
+			testName:    "no attributes",
+			data:        ArticleAttributes{Article: "CA-F91W"},
+			expectedErr: validators.ErrNoAttributes,
+		},
+		{
+			testName:    "incorrect code",
+			data:        ArticleAttributes{Article: "CA-F91W", Attributes: map[attribute.Code]string{"1st": "resin"}},
+			expectedErr: validators.ErrIncorrectAttributeCode,
+		},
+		{
+			testName: "correct",
+			data: ArticleAttributes{Article: "CA-F91W",
+				Attributes: map[attribute.Code]string{"case_material": "resin", "water_resistance": "30"}},
+			expectedErr: nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		d := tc.data
+		t.Run(tc.testName, func(t *testing.T) {
+			if !errors.Is(d.Validate(), tc.expectedErr) {
+				t.Fail()
+			}
+		})
+	}
+}
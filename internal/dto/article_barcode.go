@@ -0,0 +1,24 @@
+package dto
+
+import (
+	"github.com/lazylex/watch-store-store/internal/domain/value_objects/article"
+	"github.com/lazylex/watch-store-store/internal/domain/value_objects/barcode"
+	"github.com/lazylex/watch-store-store/internal/dto/validators"
+)
+
+// ArticleBarcode штрихкод, назначенный товару.
+type ArticleBarcode struct {
+	Article article.Article `json:"article"`
+	Barcode barcode.Barcode `json:"barcode"`
+}
+
+// Validate валидация корректности сохраненных в DTO данных.
+func (a *ArticleBarcode) Validate() error {
+	if err := validators.Article(a.Article); err != nil {
+		return err
+	}
+	if err := validators.Barcode(a.Barcode); err != nil {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,32 @@
+package dto
+
+import (
+	"github.com/lazylex/watch-store-store/internal/domain/value_objects/adjustment"
+	"github.com/lazylex/watch-store-store/internal/domain/value_objects/article"
+	"github.com/lazylex/watch-store-store/internal/dto/validators"
+)
+
+// ArticleDeltaReason относительное изменение количества товара Delta (отрицательное - списание, положительное -
+// оприходование) с кодом причины Reason и необязательным комментарием.
+type ArticleDeltaReason struct {
+	Article article.Article   `json:"article"`
+	Delta   int               `json:"delta"`
+	Reason  adjustment.Reason `json:"reason"`
+	Comment string            `json:"comment"`
+}
+
+// Validate валидация корректности сохраненных в DTO данных. Допустимость кода причины проверяется сервисом по списку,
+// заданному в конфигурации.
+func (a *ArticleDeltaReason) Validate() error {
+	if err := validators.Article(a.Article); err != nil {
+		return err
+	}
+	if err := validators.Delta(a.Delta); err != nil {
+		return err
+	}
+	if a.Reason == "" {
+		return validators.ErrIncorrectAdjustmentReason
+	}
+
+	return nil
+}
@@ -0,0 +1,45 @@
+package dto
+
+import (
+	"errors"
+	"github.com/lazylex/watch-store-store/internal/dto/validators"
+	"testing"
+)
+
+func TestArticleDeltaReasonDTO(t *testing.T) {
+	testCases := []struct {
+		testName    string
+		data        ArticleDeltaReason
+		expectedErr error
+	}{
+		{
+			testName:    "incorrect article",
+			data:        ArticleDeltaReason{Article: "", Delta: -1, Reason: "damaged"},
+			expectedErr: validators.ErrIncorrectArticle,
+		},
+		{
+			testName:    "zero delta",
+			data:        ArticleDeltaReason{Article: "ca-09", Delta: 0, Reason: "damaged"},
+			expectedErr: validators.ErrZeroDelta,
+		},
+		{
+			testName:    "empty reason",
+			data:        ArticleDeltaReason{Article: "ca-09", Delta: 2},
+			expectedErr: validators.ErrIncorrectAdjustmentReason,
+		},
+		{
+			testName:    "correct",
+			data:        ArticleDeltaReason{Article: "ca-09", Delta: -1, Reason: "damaged"},
+			expectedErr: nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		d := tc.data
+		t.Run(tc.testName, func(t *testing.T) {
+			if !errors.Is(d.Validate(), tc.expectedErr) {
+				t.Fail()
+			}
+		})
+	}
+}
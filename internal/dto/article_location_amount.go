@@ -0,0 +1,25 @@
+package dto
+
+import (
+	"github.com/lazylex/watch-store-store/internal/domain/value_objects/article"
+	"github.com/lazylex/watch-store-store/internal/domain/value_objects/location"
+	"github.com/lazylex/watch-store-store/internal/dto/validators"
+)
+
+// ArticleLocationAmount количество товара с артикулом Article на месте хранения Location.
+type ArticleLocationAmount struct {
+	Article  article.Article   `json:"article"`
+	Location location.Location `json:"location"`
+	Amount   uint              `json:"amount"`
+}
+
+// Validate валидация корректности сохраненных в DTO данных.
+func (a *ArticleLocationAmount) Validate() error {
+	if err := validators.Article(a.Article); err != nil {
+		return err
+	}
+	if err := validators.Location(a.Location); err != nil {
+		return err
+	}
+	return nil
+}
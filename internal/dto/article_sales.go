@@ -0,0 +1,25 @@
+package dto
+
+import "github.com/lazylex/watch-store-store/internal/domain/value_objects/article"
+
+// ArticleSales продажи товара за период: количество проданных единиц, выручка и средняя цена продажи за вычетом
+// возвратов, а также количество возвращённых за период единиц и сумма возвратов. Если за период вернули больше, чем
+// продали, количество и выручка отрицательны.
+type ArticleSales struct {
+	Article       article.Article `json:"article"`
+	Units         int             `json:"units"`
+	Revenue       float64         `json:"revenue"`
+	RefundedUnits uint            `json:"refunded_units"`
+	Refunded      float64         `json:"refunded"`
+	AveragePrice  float64         `json:"average_price"`
+}
+
+// CalculateAveragePrice рассчитывает среднюю цену продажи единицы товара. Если за вычетом возвратов не продано ни одной
+// единицы, средняя цена равна нулю.
+func (a *ArticleSales) CalculateAveragePrice() {
+	if a.Units <= 0 {
+		a.AveragePrice = 0
+		return
+	}
+	a.AveragePrice = a.Revenue / float64(a.Units)
+}
@@ -0,0 +1,12 @@
+package dto
+
+import "github.com/lazylex/watch-store-store/internal/domain/value_objects/article"
+
+// ArticleStockSold количество товара в наличии и количество проданных за период единиц. Если для товара заданы
+// параметры пополнения запасов, Setting не равен nil.
+type ArticleStockSold struct {
+	Article article.Article
+	InStock uint
+	Sold    uint
+	Setting *ReplenishmentSetting
+}
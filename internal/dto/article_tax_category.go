@@ -0,0 +1,28 @@
+package dto
+
+import (
+	"github.com/lazylex/watch-store-store/internal/domain/value_objects/article"
+	"github.com/lazylex/watch-store-store/internal/domain/value_objects/tax"
+	"github.com/lazylex/watch-store-store/internal/dto/validators"
+)
+
+// ArticleTaxCategory налоговая категория товара.
+type ArticleTaxCategory struct {
+	Article  article.Article `json:"article"`
+	Category tax.Category    `json:"category"`
+}
+
+// Validate валидация корректности сохраненных в DTO данных.
+func (a *ArticleTaxCategory) Validate() error {
+	if err := validators.Article(a.Article); err != nil {
+		return err
+	}
+	return validators.TaxCategory(a.Category)
+}
+
+// ArticleTaxRate налоговая категория товара и действующая для неё на определённую дату ставка НДС в процентах.
+type ArticleTaxRate struct {
+	Article  article.Article `json:"article"`
+	Category tax.Category    `json:"category"`
+	Rate     float64         `json:"rate"`
+}
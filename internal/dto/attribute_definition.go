@@ -0,0 +1,40 @@
+package dto
+
+import (
+	"github.com/lazylex/watch-store-store/internal/domain/value_objects/attribute"
+	"github.com/lazylex/watch-store-store/internal/dto/validators"
+)
+
+// AttributeDefinition определение атрибута товара: код, название, тип значения и, для перечислимого типа, допустимые
+// значения.
+type AttributeDefinition struct {
+	Code   attribute.Code `json:"code"`
+	Name   string         `json:"name"`
+	Type   attribute.Type `json:"type"`
+	Values []string       `json:"values,omitempty"`
+}
+
+// Validate валидация корректности сохраненных в DTO данных.
+func (a *AttributeDefinition) Validate() error {
+	if err := validators.AttributeCode(a.Code); err != nil {
+		return err
+	}
+	if err := validators.Name(a.Name); err != nil {
+		return err
+	}
+	if err := validators.AttributeType(a.Type); err != nil {
+		return err
+	}
+	if a.Type != attribute.Enum {
+		return nil
+	}
+	if len(a.Values) == 0 {
+		return validators.ErrEmptyAttributeValues
+	}
+	for _, v := range a.Values {
+		if err := validators.AttributeValue(attribute.String, nil, v); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,58 @@
+package dto
+
+import (
+	"errors"
+	"github.com/lazylex/watch-store-store/internal/domain/value_objects/attribute"
+	"github.com/lazylex/watch-store-store/internal/dto/validators"
+	"testing"
+)
+
+func TestAttributeDefinitionDTO(t *testing.T) {
+	testCases := []struct {
+		testName    string
+		data        AttributeDefinition
+		expectedErr error
+	}{
+		{
+			testName:    "incorrect code",
+			data:        AttributeDefinition{Code: "Case Material", Name: "Материал корпуса", Type: attribute.String},
+			expectedErr: validators.ErrIncorrectAttributeCode,
+		},
+		{
+			testName:    "incorrect type",
+			data:        AttributeDefinition{Code: "case_material", Name: "Материал корпуса", Type: "text"},
+			expectedErr: validators.ErrIncorrectAttributeType,
+		},
+		{
+			testName:    "enum without values",
+			data:        AttributeDefinition{Code: "case_material", Name: "Материал корпуса", Type: attribute.Enum},
+			expectedErr: validators.ErrEmptyAttributeValues,
+		},
+		{
+			testName: "enum with empty value",
+			data: AttributeDefinition{Code: "case_material", Name: "Материал корпуса", Type: attribute.Enum,
+				Values: []string{"steel", ""}},
+			expectedErr: validators.ErrIncorrectAttributeValue,
+		},
+		{
+			testName: "correct enum",
+			data: AttributeDefinition{Code: "case_material", Name: "Материал корпуса", Type: attribute.Enum,
+				Values: []string{"steel", "resin"}},
+			expectedErr: nil,
+		},
+		{
+			testName:    "correct number",
+			data:        AttributeDefinition{Code: "water_resistance", Name: "Водозащита", Type: attribute.Number},
+			expectedErr: nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		d := tc.data
+		t.Run(tc.testName, func(t *testing.T) {
+			if !errors.Is(d.Validate(), tc.expectedErr) {
+				t.Fail()
+			}
+		})
+	}
+}
@@ -0,0 +1,15 @@
+package dto
+
+import (
+	"github.com/lazylex/watch-store-store/internal/domain/value_objects/barcode"
+	"github.com/lazylex/watch-store-store/internal/dto/validators"
+)
+
+type Barcode struct {
+	Barcode barcode.Barcode `json:"barcode"`
+}
+
+// Validate валидация корректности сохраненных в DTO данных.
+func (b *Barcode) Validate() error {
+	return validators.Barcode(b.Barcode)
+}
@@ -0,0 +1,35 @@
+package dto
+
+import (
+	"github.com/lazylex/watch-store-store/internal/domain/value_objects/barcode"
+	"github.com/lazylex/watch-store-store/internal/dto/validators"
+)
+
+// BarcodeImport штрихкоды товаров, загружаемые одним запросом. Товару может быть назначено несколько штрихкодов, но
+// каждый штрихкод (с учётом совпадения кодов UPC-A и EAN-13 с ведущим нулём) должен встречаться один раз.
+type BarcodeImport struct {
+	Barcodes []ArticleBarcode `json:"barcodes"`
+}
+
+// Validate валидация корректности сохраненных в DTO данных.
+func (b *BarcodeImport) Validate() error {
+	if len(b.Barcodes) == 0 {
+		return validators.ErrNoBarcodes
+	}
+	if len(b.Barcodes) > validators.MaxBarcodesInImport {
+		return validators.ErrTooManyBarcodes
+	}
+
+	seen := make(map[barcode.Barcode]struct{}, len(b.Barcodes))
+	for i := range b.Barcodes {
+		if err := b.Barcodes[i].Validate(); err != nil {
+			return err
+		}
+		code := b.Barcodes[i].Barcode.EAN13()
+		if _, ok := seen[code]; ok {
+			return validators.ErrDuplicateBarcodes
+		}
+		seen[code] = struct{}{}
+	}
+	return nil
+}
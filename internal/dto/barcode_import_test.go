@@ -0,0 +1,57 @@
+package dto
+
+import (
+	"errors"
+	"github.com/lazylex/watch-store-store/internal/dto/validators"
+	"testing"
+)
+
+func TestBarcodeImportDTO(t *testing.T) {
+	testCases := []struct {
+		testName    string
+		data        BarcodeImport
+		expectedErr error
+	}{
+		{
+			testName:    "no barcodes",
+			data:        BarcodeImport{},
+			expectedErr: validators.ErrNoBarcodes,
+		},
+		{
+			testName:    "too many barcodes",
+			data:        BarcodeImport{Barcodes: make([]ArticleBarcode, validators.MaxBarcodesInImport+1)},
+			expectedErr: validators.ErrTooManyBarcodes,
+		},
+		{
+			testName:    "incorrect article",
+			data:        BarcodeImport{Barcodes: []ArticleBarcode{{Barcode: "4006381333931"}}},
+			expectedErr: validators.ErrIncorrectArticle,
+		},
+		{
+			testName:    "incorrect barcode",
+			data:        BarcodeImport{Barcodes: []ArticleBarcode{{Article: "CA-F91W", Barcode: "4006381333932"}}},
+			expectedErr: validators.ErrIncorrectBarcode,
+		},
+		{
+			testName: "same barcode as UPC-A and EAN-13",
+			data: BarcodeImport{Barcodes: []ArticleBarcode{{Article: "CA-F91W", Barcode: "036000291452"},
+				{Article: "CA-F91W", Barcode: "0036000291452"}}},
+			expectedErr: validators.ErrDuplicateBarcodes,
+		},
+		{
+			testName: "correct",
+			data: BarcodeImport{Barcodes: []ArticleBarcode{{Article: "CA-F91W", Barcode: "036000291452"},
+				{Article: "CA-F91W", Barcode: "4006381333931"}}},
+			expectedErr: nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		d := tc.data
+		t.Run(tc.testName, func(t *testing.T) {
+			if !errors.Is(d.Validate(), tc.expectedErr) {
+				t.Fail()
+			}
+		})
+	}
+}
@@ -0,0 +1,57 @@
+package dto
+
+import "github.com/lazylex/watch-store-store/internal/domain/value_objects/article"
+
+// PriceUpdateStatus результат изменения цены товара при групповом изменении цен.
+type PriceUpdateStatus string
+
+const (
+	PriceUpdated    PriceUpdateStatus = "updated"     // цена изменена
+	PriceNotApplied PriceUpdateStatus = "not_applied" // цену можно изменить, но изменение не применено из-за ошибок
+	PriceFailed     PriceUpdateStatus = "failed"      // цену изменить невозможно
+)
+
+// BulkPriceReport отчёт о групповом изменении цен. Изменение применяется целиком, поэтому при наличии хотя бы одной
+// ошибки Applied равен false и ни одна цена не изменяется.
+type BulkPriceReport struct {
+	Applied    bool                  `json:"applied"`
+	Updated    uint                  `json:"updated"`
+	NotApplied uint                  `json:"not_applied"`
+	Failed     uint                  `json:"failed"`
+	Items      []BulkPriceItemResult `json:"items"`
+}
+
+// BulkPriceItemResult результат изменения цены товара.
+type BulkPriceItemResult struct {
+	Article  article.Article   `json:"article"`
+	OldPrice float64           `json:"old_price,omitempty"`
+	NewPrice float64           `json:"new_price,omitempty"`
+	Status   PriceUpdateStatus `json:"status"`
+	Error    string            `json:"error,omitempty"`
+}
+
+// Count подсчитывает количество товаров в каждом состоянии.
+func (r *BulkPriceReport) Count() {
+	r.Updated, r.NotApplied, r.Failed = 0, 0, 0
+	for _, item := range r.Items {
+		switch item.Status {
+		case PriceUpdated:
+			r.Updated++
+		case PriceNotApplied:
+			r.NotApplied++
+		case PriceFailed:
+			r.Failed++
+		}
+	}
+}
+
+// Discard отмечает товары, цены которых можно было изменить, как не изменённые (изменение не применено из-за ошибок
+// в других товарах).
+func (r *BulkPriceReport) Discard() {
+	for i := range r.Items {
+		if r.Items[i].Status == PriceUpdated {
+			r.Items[i].Status = PriceNotApplied
+		}
+	}
+	r.Count()
+}
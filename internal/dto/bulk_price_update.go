@@ -0,0 +1,60 @@
+package dto
+
+import (
+	"github.com/lazylex/watch-store-store/internal/domain/value_objects/article"
+	"github.com/lazylex/watch-store-store/internal/dto/validators"
+)
+
+// BulkPriceUpdate групповое изменение цен. Передаётся либо список новых цен товаров Prices, либо артикул товара без
+// дефектов Article и процент изменения цены Percent, применяемый к нему и ко всем товарам с дефектами, артикулы
+// которых образованы от него.
+type BulkPriceUpdate struct {
+	Prices  []ArticlePrice  `json:"prices,omitempty"`
+	Article article.Article `json:"article,omitempty"`
+	Percent float64         `json:"percent,omitempty"`
+}
+
+// ByPercent возвращает true, если цены изменяются на процент, а не устанавливаются списком.
+func (b *BulkPriceUpdate) ByPercent() bool {
+	return len(b.Prices) == 0
+}
+
+// Validate валидация корректности сохраненных в DTO данных.
+func (b *BulkPriceUpdate) Validate() error {
+	if !b.ByPercent() {
+		return b.validatePrices()
+	}
+
+	if b.Article == "" && b.Percent == 0 {
+		return validators.ErrNoPricesInUpdate
+	}
+	if err := validators.Article(b.Article); err != nil {
+		return err
+	}
+	if b.Article.Base() != b.Article {
+		return validators.ErrNotBaseArticle
+	}
+	return validators.PricePercent(b.Percent)
+}
+
+// validatePrices валидация списка новых цен.
+func (b *BulkPriceUpdate) validatePrices() error {
+	if b.Article != "" || b.Percent != 0 {
+		return validators.ErrAmbiguousPriceUpdate
+	}
+	if len(b.Prices) > validators.MaxPricesInUpdate {
+		return validators.ErrTooManyPricesInUpdate
+	}
+
+	articles := make(map[article.Article]struct{}, len(b.Prices))
+	for i := range b.Prices {
+		if err := b.Prices[i].Validate(); err != nil {
+			return err
+		}
+		if _, ok := articles[b.Prices[i].Article]; ok {
+			return validators.ErrDuplicateArticlesInPriceUpdate
+		}
+		articles[b.Prices[i].Article] = struct{}{}
+	}
+	return nil
+}
@@ -0,0 +1,77 @@
+package dto
+
+import (
+	"errors"
+	"github.com/lazylex/watch-store-store/internal/dto/validators"
+	"testing"
+)
+
+func TestBulkPriceUpdateDTO(t *testing.T) {
+	testCases := []struct {
+		testName    string
+		data        BulkPriceUpdate
+		expectedErr error
+	}{
+		{
+			testName:    "empty",
+			data:        BulkPriceUpdate{},
+			expectedErr: validators.ErrNoPricesInUpdate,
+		},
+		{
+			testName: "prices",
+			data: BulkPriceUpdate{Prices: []ArticlePrice{
+				{Article: "CA-F91W", Price: 3490}, {Article: "CA-F91W.1000", Price: 2990}}},
+			expectedErr: nil,
+		},
+		{
+			testName:    "incorrect price",
+			data:        BulkPriceUpdate{Prices: []ArticlePrice{{Article: "CA-F91W", Price: 0}}},
+			expectedErr: validators.ErrZeroPrice,
+		},
+		{
+			testName: "duplicate articles",
+			data: BulkPriceUpdate{Prices: []ArticlePrice{
+				{Article: "CA-F91W", Price: 3490}, {Article: "CA-F91W", Price: 2990}}},
+			expectedErr: validators.ErrDuplicateArticlesInPriceUpdate,
+		},
+		{
+			testName:    "too many prices",
+			data:        BulkPriceUpdate{Prices: make([]ArticlePrice, validators.MaxPricesInUpdate+1)},
+			expectedErr: validators.ErrTooManyPricesInUpdate,
+		},
+		{
+			testName:    "prices and percent",
+			data:        BulkPriceUpdate{Prices: []ArticlePrice{{Article: "CA-F91W", Price: 3490}}, Percent: 10},
+			expectedErr: validators.ErrAmbiguousPriceUpdate,
+		},
+		{
+			testName:    "percent",
+			data:        BulkPriceUpdate{Article: "CA-F91W", Percent: -15},
+			expectedErr: nil,
+		},
+		{
+			testName:    "percent for defect variant",
+			data:        BulkPriceUpdate{Article: "CA-F91W.1000", Percent: -15},
+			expectedErr: validators.ErrNotBaseArticle,
+		},
+		{
+			testName:    "percent without article",
+			data:        BulkPriceUpdate{Percent: 10},
+			expectedErr: validators.ErrIncorrectArticle,
+		},
+		{
+			testName:    "zero percent",
+			data:        BulkPriceUpdate{Article: "CA-F91W"},
+			expectedErr: validators.ErrIncorrectPricePercent,
+		},
+	}
+
+	for _, tc := range testCases {
+		d := tc.data
+		t.Run(tc.testName, func(t *testing.T) {
+			if !errors.Is(d.Validate(), tc.expectedErr) {
+				t.Fail()
+			}
+		})
+	}
+}
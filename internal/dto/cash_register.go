@@ -0,0 +1,16 @@
+package dto
+
+import (
+	rs "github.com/lazylex/watch-store-store/internal/domain/aggregates/reservation"
+	"github.com/lazylex/watch-store-store/internal/dto/validators"
+)
+
+// CashRegister номер кассы.
+type CashRegister struct {
+	CashRegister rs.OrderNumber `json:"cash_register"`
+}
+
+// Validate валидация корректности сохраненных в DTO данных.
+func (c *CashRegister) Validate() error {
+	return validators.CashRegister(c.CashRegister)
+}
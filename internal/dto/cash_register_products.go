@@ -0,0 +1,61 @@
+package dto
+
+import (
+	rs "github.com/lazylex/watch-store-store/internal/domain/aggregates/reservation"
+	"github.com/lazylex/watch-store-store/internal/domain/value_objects/article"
+	"github.com/lazylex/watch-store-store/internal/domain/value_objects/payment"
+	"github.com/lazylex/watch-store-store/internal/domain/value_objects/phone"
+	"github.com/lazylex/watch-store-store/internal/domain/value_objects/serial"
+	"github.com/lazylex/watch-store-store/internal/dto/validators"
+)
+
+// CashRegisterProducts продажа товаров на кассе. Продажа оплачивается целиком способом PaymentMethod либо платежами
+// Payments разными способами (тогда способ оплаты не передаётся). Serials - серийные номера продаваемых экземпляров
+// товаров, учитываемых по серийным номерам. Phone - необязательный номер телефона покупателя, по которому можно найти
+// выданные при продаже гарантии.
+type CashRegisterProducts struct {
+	CashRegister  rs.OrderNumber       `json:"cash_register"`
+	PaymentMethod payment.Method       `json:"payment_method"`
+	Products      []ArticlePriceAmount `json:"products"`
+	Payments      []Payment            `json:"payments,omitempty"`
+	Serials       []serial.Number      `json:"serials,omitempty"`
+	Phone         phone.Phone          `json:"phone,omitempty"`
+}
+
+// Validate валидация корректности сохраненных в DTO данных.
+func (c *CashRegisterProducts) Validate() error {
+	if err := validators.CashRegister(c.CashRegister); err != nil {
+		return err
+	}
+
+	if len(c.Payments) > 0 {
+		if err := validatePayments(c.PaymentMethod, c.Payments); err != nil {
+			return err
+		}
+	} else if err := validators.PaymentMethod(c.PaymentMethod); err != nil {
+		return err
+	}
+
+	if len(c.Products) == 0 {
+		return validators.ErrNoProductsInSale
+	}
+
+	articles := make(map[article.Article]struct{})
+	for _, product := range c.Products {
+		if err := product.Validate(); err != nil {
+			return err
+		}
+		if _, ok := articles[product.Article]; ok {
+			return validators.ErrDuplicateProductsInSale
+		}
+		articles[product.Article] = struct{}{}
+	}
+
+	if c.Phone != "" {
+		if err := validators.Phone(c.Phone); err != nil {
+			return err
+		}
+	}
+
+	return validators.SerialNumbers(c.Serials)
+}
@@ -0,0 +1,87 @@
+package dto
+
+import (
+	"errors"
+	"github.com/lazylex/watch-store-store/internal/domain/value_objects/payment"
+	"github.com/lazylex/watch-store-store/internal/dto/validators"
+	"testing"
+)
+
+func TestCashRegisterProductsDTO(t *testing.T) {
+	products := []ArticlePriceAmount{{Article: "ca-09.1000", Price: 4660, Amount: 5}}
+
+	t.Run("zero cash register", func(t *testing.T) {
+		c := CashRegisterProducts{CashRegister: 0, PaymentMethod: payment.Cash, Products: products}
+		if !errors.Is(c.Validate(), validators.ErrIncorrectCashRegister) {
+			t.Fail()
+		}
+	})
+
+	t.Run("negative cash register", func(t *testing.T) {
+		c := CashRegisterProducts{CashRegister: -1, PaymentMethod: payment.Cash, Products: products}
+		if !errors.Is(c.Validate(), validators.ErrIncorrectCashRegister) {
+			t.Fail()
+		}
+	})
+
+	t.Run("no payment method", func(t *testing.T) {
+		c := CashRegisterProducts{CashRegister: 1, Products: products}
+		if !errors.Is(c.Validate(), validators.ErrIncorrectPaymentMethod) {
+			t.Fail()
+		}
+	})
+
+	t.Run("no products", func(t *testing.T) {
+		c := CashRegisterProducts{CashRegister: 1, PaymentMethod: payment.Card}
+		if !errors.Is(c.Validate(), validators.ErrNoProductsInSale) {
+			t.Fail()
+		}
+	})
+
+	t.Run("incorrect product", func(t *testing.T) {
+		c := CashRegisterProducts{CashRegister: 1, PaymentMethod: payment.Card,
+			Products: []ArticlePriceAmount{{Article: "ca-09.1000", Price: 0, Amount: 5}}}
+		if !errors.Is(c.Validate(), validators.ErrZeroPrice) {
+			t.Fail()
+		}
+	})
+
+	t.Run("duplicate products", func(t *testing.T) {
+		c := CashRegisterProducts{CashRegister: 1, PaymentMethod: payment.Card,
+			Products: []ArticlePriceAmount{products[0], {Article: "ca-09.1000", Price: 4660, Amount: 1}}}
+		if !errors.Is(c.Validate(), validators.ErrDuplicateProductsInSale) {
+			t.Fail()
+		}
+	})
+
+	t.Run("payment method with payments", func(t *testing.T) {
+		c := CashRegisterProducts{CashRegister: 1, PaymentMethod: payment.Cash, Products: products,
+			Payments: []Payment{{Method: payment.Cash, Amount: 23300}}}
+		if !errors.Is(c.Validate(), validators.ErrPaymentMethodWithPayments) {
+			t.Fail()
+		}
+	})
+
+	t.Run("correct payments", func(t *testing.T) {
+		c := CashRegisterProducts{CashRegister: 1, Products: products,
+			Payments: []Payment{{Method: payment.Card, Amount: 20000}, {Method: payment.Cash, Amount: 3300}}}
+		if c.Validate() != nil {
+			t.Fail()
+		}
+	})
+
+	t.Run("incorrect phone", func(t *testing.T) {
+		c := CashRegisterProducts{CashRegister: 10, PaymentMethod: payment.Cash, Products: products, Phone: "12-34"}
+		if !errors.Is(c.Validate(), validators.ErrIncorrectPhone) {
+			t.Fail()
+		}
+	})
+
+	t.Run("correct", func(t *testing.T) {
+		c := CashRegisterProducts{CashRegister: 10, PaymentMethod: payment.Cash, Products: products,
+			Phone: "+7 (912) 345-67-89"}
+		if c.Validate() != nil {
+			t.Fail()
+		}
+	})
+}
@@ -0,0 +1,36 @@
+package dto
+
+import (
+	rs "github.com/lazylex/watch-store-store/internal/domain/aggregates/reservation"
+	"github.com/lazylex/watch-store-store/internal/domain/value_objects/location"
+	"github.com/lazylex/watch-store-store/internal/dto/validators"
+	"time"
+)
+
+// CashRegisterRecord касса из реестра касс. Location - место хранения, с которого в первую очередь резервируется товар
+// для заказов, оформленных на кассе (если не указано, используется место хранения по умолчанию). Выключенная касса не
+// может открывать смены, резервировать и продавать товар. LastSeenAt - время последней операции, выполненной на кассе,
+// задаётся сервисом.
+type CashRegisterRecord struct {
+	CashRegister rs.OrderNumber    `json:"cash_register"`
+	Name         string            `json:"name"`
+	Location     location.Location `json:"location,omitempty"`
+	Enabled      bool              `json:"enabled"`
+	LastSeenAt   *time.Time        `json:"last_seen_at,omitempty"`
+}
+
+// Validate валидация корректности сохраненных в DTO данных.
+func (c *CashRegisterRecord) Validate() error {
+	if err := validators.CashRegister(c.CashRegister); err != nil {
+		return err
+	}
+	if err := validators.CashRegisterName(c.Name); err != nil {
+		return err
+	}
+	if c.Location != "" {
+		if err := validators.Location(c.Location); err != nil {
+			return err
+		}
+	}
+	return nil
+}
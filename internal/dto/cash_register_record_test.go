@@ -0,0 +1,45 @@
+package dto
+
+import (
+	"errors"
+	"github.com/lazylex/watch-store-store/internal/dto/validators"
+	"strings"
+	"testing"
+)
+
+func TestCashRegisterRecordDTO(t *testing.T) {
+	t.Run("correct", func(t *testing.T) {
+		c := CashRegisterRecord{CashRegister: 11, Name: "Касса у входа", Location: "back_room", Enabled: true}
+		if c.Validate() != nil {
+			t.Fail()
+		}
+	})
+
+	t.Run("incorrect cash register", func(t *testing.T) {
+		c := CashRegisterRecord{CashRegister: 0, Name: "Касса"}
+		if !errors.Is(c.Validate(), validators.ErrIncorrectCashRegister) {
+			t.Fail()
+		}
+	})
+
+	t.Run("empty name", func(t *testing.T) {
+		c := CashRegisterRecord{CashRegister: 1}
+		if !errors.Is(c.Validate(), validators.ErrIncorrectCashRegisterName) {
+			t.Fail()
+		}
+	})
+
+	t.Run("too long name", func(t *testing.T) {
+		c := CashRegisterRecord{CashRegister: 1, Name: strings.Repeat("к", validators.MaxCashRegisterNameLength+1)}
+		if !errors.Is(c.Validate(), validators.ErrIncorrectCashRegisterName) {
+			t.Fail()
+		}
+	})
+
+	t.Run("incorrect location", func(t *testing.T) {
+		c := CashRegisterRecord{CashRegister: 1, Name: "Касса", Location: "roof"}
+		if !errors.Is(c.Validate(), validators.ErrIncorrectLocation) {
+			t.Fail()
+		}
+	})
+}
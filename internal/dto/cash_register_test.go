@@ -0,0 +1,16 @@
+package dto
+
+import (
+	"errors"
+	"github.com/lazylex/watch-store-store/internal/dto/validators"
+	"testing"
+)
+
+func TestCashRegisterDTO(t *testing.T) {
+	t.Run("incorrect cash register", func(t *testing.T) {
+		c := CashRegister{CashRegister: -1}
+		if !errors.Is(c.Validate(), validators.ErrIncorrectCashRegister) {
+			t.Fail()
+		}
+	})
+}
@@ -0,0 +1,15 @@
+package dto
+
+import "github.com/lazylex/watch-store-store/internal/domain/value_objects/channel"
+
+// ChannelSales продажи за период по каналу продаж. Количество единиц Units и выручка Revenue указываются за вычетом
+// возвратов, RefundedUnits и Refunded - возвращённые за период единицы и сумма возвратов. Возвраты относятся к каналу
+// чека, по которому был продан товар.
+type ChannelSales struct {
+	Channel       channel.Channel `json:"channel"`
+	Receipts      uint            `json:"receipts"`
+	Units         int             `json:"units"`
+	Revenue       float64         `json:"revenue"`
+	RefundedUnits uint            `json:"refunded_units"`
+	Refunded      float64         `json:"refunded"`
+}
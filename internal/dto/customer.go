@@ -0,0 +1,75 @@
+package dto
+
+import (
+	"fmt"
+	"github.com/lazylex/watch-store-store/internal/domain/value_objects/phone"
+	"github.com/lazylex/watch-store-store/internal/dto/validators"
+	"log/slog"
+	"strings"
+	"unicode/utf8"
+)
+
+// Customer данные покупателя, оформившего заказ. Имя и телефон обязательны, адрес электронной почты - нет. При выводе
+// через fmt и slog данные покупателя скрываются: от имени остаётся первая буква, от телефона - последние цифры, от
+// адреса почты - первая буква и домен.
+type Customer struct {
+	Name  string      `json:"name"`
+	Phone phone.Phone `json:"phone"`
+	Email string      `json:"email,omitempty"`
+}
+
+// Validate валидация корректности сохраненных в DTO данных.
+func (c *Customer) Validate() error {
+	if err := validators.CustomerName(c.Name); err != nil {
+		return err
+	}
+	if err := validators.Phone(c.Phone); err != nil {
+		return err
+	}
+	if c.Email != "" {
+		if err := validators.Email(c.Email); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Normalize приводит номер телефона покупателя к виду, в котором он хранится и ищется, и удаляет пробелы по краям
+// имени и адреса электронной почты.
+func (c *Customer) Normalize() {
+	c.Name = strings.TrimSpace(c.Name)
+	c.Phone = c.Phone.Normalized()
+	c.Email = strings.TrimSpace(c.Email)
+}
+
+// String скрывает данные покупателя при выводе через fmt.
+func (c Customer) String() string {
+	return fmt.Sprintf("{%s %s %s}", redactName(c.Name), c.Phone.Redacted(), redactEmail(c.Email))
+}
+
+// LogValue скрывает данные покупателя при записи в лог.
+func (c Customer) LogValue() slog.Value {
+	return slog.GroupValue(
+		slog.String("name", redactName(c.Name)),
+		slog.String("phone", c.Phone.Redacted()),
+		slog.String("email", redactEmail(c.Email)),
+	)
+}
+
+// redactName возвращает первую букву имени, за которой следуют звёздочки.
+func redactName(name string) string {
+	if name == "" {
+		return ""
+	}
+	r, _ := utf8.DecodeRuneInString(name)
+	return string(r) + "***"
+}
+
+// redactEmail возвращает первую букву адреса электронной почты и его домен.
+func redactEmail(email string) string {
+	at := strings.LastIndex(email, "@")
+	if at < 1 {
+		return redactName(email)
+	}
+	return redactName(email[:at]) + email[at:]
+}
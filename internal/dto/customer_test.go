@@ -0,0 +1,69 @@
+package dto
+
+import (
+	"errors"
+	"fmt"
+	"github.com/lazylex/watch-store-store/internal/dto/validators"
+	"strings"
+	"testing"
+)
+
+func TestCustomerDTO(t *testing.T) {
+	testCases := []struct {
+		testName    string
+		customer    Customer
+		expectedErr error
+	}{
+		{
+			testName:    "correct",
+			customer:    Customer{Name: "Иван Петров", Phone: "+7 (912) 345-67-89", Email: "ivan@example.com"},
+			expectedErr: nil,
+		},
+		{
+			testName:    "without email",
+			customer:    Customer{Name: "Иван Петров", Phone: "89123456789"},
+			expectedErr: nil,
+		},
+		{
+			testName:    "without name",
+			customer:    Customer{Phone: "89123456789"},
+			expectedErr: validators.ErrIncorrectCustomerName,
+		},
+		{
+			testName:    "without phone",
+			customer:    Customer{Name: "Иван Петров"},
+			expectedErr: validators.ErrIncorrectPhone,
+		},
+		{
+			testName:    "incorrect email",
+			customer:    Customer{Name: "Иван Петров", Phone: "89123456789", Email: "ivan@"},
+			expectedErr: validators.ErrIncorrectEmail,
+		},
+	}
+
+	for _, tc := range testCases {
+		c := tc.customer
+		t.Run(tc.testName, func(t *testing.T) {
+			if !errors.Is(c.Validate(), tc.expectedErr) {
+				t.Fail()
+			}
+		})
+	}
+
+	t.Run("normalize", func(t *testing.T) {
+		c := Customer{Name: " Иван ", Phone: "+7 (912) 345-67-89", Email: " ivan@example.com "}
+		c.Normalize()
+		if c.Name != "Иван" || c.Phone != "79123456789" || c.Email != "ivan@example.com" {
+			t.Errorf("unexpected customer after normalization: %q %q %q", c.Name, c.Phone, c.Email)
+		}
+	})
+
+	t.Run("redacted output", func(t *testing.T) {
+		c := Customer{Name: "Иван Петров", Phone: "79123456789", Email: "ivan@example.com"}
+		for _, out := range []string{fmt.Sprint(c), fmt.Sprintf("%v", &c), c.LogValue().String()} {
+			if strings.Contains(out, "Петров") || strings.Contains(out, "912345") || strings.Contains(out, "ivan@") {
+				t.Errorf("customer data is not redacted: %s", out)
+			}
+		}
+	})
+}
@@ -0,0 +1,23 @@
+package dto
+
+import (
+	"github.com/lazylex/watch-store-store/internal/domain/value_objects/payment"
+	"time"
+)
+
+// DailyPayments платежи по чекам одним способом оплаты за день: количество платежей, полученная от покупателей сумма и
+// выданная с неё сдача, а также количество возвратов и выданная по ним сумма.
+type DailyPayments struct {
+	Date     time.Time      `json:"date"`
+	Method   payment.Method `json:"method"`
+	Count    uint           `json:"count"`
+	Amount   float64        `json:"amount"`
+	Change   float64        `json:"change"`
+	Refunds  uint           `json:"refunds"`
+	Refunded float64        `json:"refunded"`
+}
+
+// Net возвращает сумму платежей за вычетом выданной сдачи и возвратов.
+func (d *DailyPayments) Net() float64 {
+	return d.Amount - d.Change - d.Refunded
+}
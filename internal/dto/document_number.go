@@ -0,0 +1,12 @@
+package dto
+
+import "github.com/lazylex/watch-store-store/internal/dto/validators"
+
+type DocumentNumber struct {
+	DocumentNumber string `json:"document_number"`
+}
+
+// Validate валидация корректности сохраненных в DTO данных.
+func (d *DocumentNumber) Validate() error {
+	return validators.DocumentNumber(d.DocumentNumber)
+}
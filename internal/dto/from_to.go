@@ -0,0 +1,16 @@
+package dto
+
+import (
+	"github.com/lazylex/watch-store-store/internal/dto/validators"
+	"time"
+)
+
+type FromTo struct {
+	From time.Time `json:"from"`
+	To   time.Time `json:"to"`
+}
+
+// Validate валидация корректности сохраненных в DTO данных.
+func (ft *FromTo) Validate() error {
+	return validators.DateOrder(ft.From, ft.To)
+}
@@ -0,0 +1,20 @@
+package dto
+
+import (
+	"github.com/lazylex/watch-store-store/internal/domain/value_objects/bucket"
+	"github.com/lazylex/watch-store-store/internal/dto/validators"
+)
+
+// FromToBucket период, продажи за который группируются по интервалам размера Bucket.
+type FromToBucket struct {
+	FromTo
+	Bucket bucket.Bucket `json:"bucket"`
+}
+
+// Validate валидация корректности сохраненных в DTO данных.
+func (fb *FromToBucket) Validate() error {
+	if err := fb.FromTo.Validate(); err != nil {
+		return err
+	}
+	return validators.Bucket(fb.Bucket)
+}
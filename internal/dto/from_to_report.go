@@ -0,0 +1,20 @@
+package dto
+
+import (
+	"github.com/lazylex/watch-store-store/internal/domain/value_objects/report"
+	"github.com/lazylex/watch-store-store/internal/dto/validators"
+)
+
+// FromToReport период, за который выгружается отчёт о продажах вида Report.
+type FromToReport struct {
+	FromTo
+	Report report.Kind `json:"report"`
+}
+
+// Validate валидация корректности сохраненных в DTO данных.
+func (fr *FromToReport) Validate() error {
+	if err := fr.FromTo.Validate(); err != nil {
+		return err
+	}
+	return validators.Report(fr.Report)
+}
@@ -0,0 +1,24 @@
+package dto
+
+import (
+	"github.com/lazylex/watch-store-store/internal/domain/value_objects/measure"
+	"github.com/lazylex/watch-store-store/internal/dto/validators"
+)
+
+// FromToTop запрос Limit самых продаваемых за период товаров по показателю By.
+type FromToTop struct {
+	FromTo
+	By    measure.Measure `json:"by"`
+	Limit int             `json:"limit"`
+}
+
+// Validate валидация корректности сохраненных в DTO данных.
+func (ft *FromToTop) Validate() error {
+	if err := ft.FromTo.Validate(); err != nil {
+		return err
+	}
+	if err := validators.Measure(ft.By); err != nil {
+		return err
+	}
+	return validators.Limit(ft.Limit)
+}
@@ -0,0 +1,78 @@
+package dto
+
+import (
+	"github.com/lazylex/watch-store-store/internal/domain/value_objects/article"
+	"github.com/lazylex/watch-store-store/internal/domain/value_objects/serial"
+	"github.com/lazylex/watch-store-store/internal/dto/validators"
+	"time"
+)
+
+// GoodsReceipt приёмка поставки товаров по сопроводительному документу DocumentNumber от поставщика Supplier. Номер
+// документа уникален: повторная приёмка документа не изменяет остатки.
+type GoodsReceipt struct {
+	DocumentNumber string             `json:"document_number"`
+	Supplier       string             `json:"supplier"`
+	ReceivedAt     time.Time          `json:"received_at"`
+	Lines          []GoodsReceiptLine `json:"lines"`
+}
+
+// GoodsReceiptLine строка поставки. Cost - закупочная стоимость единицы товара. Name и Price (цена продажи)
+// обязательны только для товаров, которых ещё нет в ассортименте, и игнорируются для имеющихся. Serials - серийные
+// номера поступивших экземпляров, передаваемые только для товаров, учитываемых по серийным номерам (по одному номеру
+// на каждую единицу товара).
+type GoodsReceiptLine struct {
+	Article article.Article `json:"article"`
+	Name    string          `json:"name"`
+	Price   float64         `json:"price"`
+	Cost    float64         `json:"cost"`
+	Amount  uint            `json:"amount"`
+	Serials []serial.Number `json:"serials,omitempty"`
+}
+
+// Validate валидация корректности сохраненных в DTO данных.
+func (g *GoodsReceipt) Validate() error {
+	if err := validators.DocumentNumber(g.DocumentNumber); err != nil {
+		return err
+	}
+
+	if err := validators.Supplier(g.Supplier); err != nil {
+		return err
+	}
+
+	if len(g.Lines) == 0 {
+		return validators.ErrNoLinesInGoodsReceipt
+	}
+
+	var serials []serial.Number
+	articles := make(map[article.Article]struct{})
+	for _, line := range g.Lines {
+		if err := validators.Article(line.Article); err != nil {
+			return err
+		}
+		if err := validators.Amount(line.Amount); err != nil {
+			return err
+		}
+		if err := validators.Cost(line.Cost); err != nil {
+			return err
+		}
+		if line.Price < 0 {
+			return validators.ErrNegativePrice
+		}
+		if _, ok := articles[line.Article]; ok {
+			return validators.ErrDuplicateArticlesInGoodsReceipt
+		}
+		articles[line.Article] = struct{}{}
+		if len(line.Serials) > 0 && uint(len(line.Serials)) != line.Amount {
+			return validators.ErrSerialsDontMatchAmount
+		}
+		serials = append(serials, line.Serials...)
+	}
+
+	return validators.SerialNumbers(serials)
+}
+
+// NewStockRecord возвращает запись о новом товаре, создаваемую при приёмке строки поставки с отсутствующим в
+// ассортименте товаром.
+func (l *GoodsReceiptLine) NewStockRecord() ArticlePriceNameAmount {
+	return ArticlePriceNameAmount{Article: l.Article, Name: l.Name, Price: l.Price, Amount: l.Amount}
+}
@@ -0,0 +1,85 @@
+package dto
+
+import (
+	"errors"
+	"github.com/lazylex/watch-store-store/internal/domain/value_objects/serial"
+	"github.com/lazylex/watch-store-store/internal/dto/validators"
+	"testing"
+)
+
+func TestGoodsReceiptDTO(t *testing.T) {
+	line := GoodsReceiptLine{Article: "ca-09", Cost: 100, Amount: 1}
+	testCases := []struct {
+		testName    string
+		receipt     GoodsReceipt
+		expectedErr error
+	}{
+		{
+			testName:    "empty document number",
+			receipt:     GoodsReceipt{Supplier: "casio", Lines: []GoodsReceiptLine{line}},
+			expectedErr: validators.ErrEmptyDocumentNumber,
+		},
+		{
+			testName:    "empty supplier",
+			receipt:     GoodsReceipt{DocumentNumber: "TN-1", Lines: []GoodsReceiptLine{line}},
+			expectedErr: validators.ErrEmptySupplier,
+		},
+		{
+			testName:    "no lines",
+			receipt:     GoodsReceipt{DocumentNumber: "TN-1", Supplier: "casio"},
+			expectedErr: validators.ErrNoLinesInGoodsReceipt,
+		},
+		{
+			testName: "zero amount",
+			receipt: GoodsReceipt{DocumentNumber: "TN-1", Supplier: "casio",
+				Lines: []GoodsReceiptLine{{Article: "ca-09", Cost: 100}}},
+			expectedErr: validators.ErrZeroAmount,
+		},
+		{
+			testName: "negative cost",
+			receipt: GoodsReceipt{DocumentNumber: "TN-1", Supplier: "casio",
+				Lines: []GoodsReceiptLine{{Article: "ca-09", Cost: -1, Amount: 1}}},
+			expectedErr: validators.ErrNegativeCost,
+		},
+		{
+			testName: "duplicate articles",
+			receipt: GoodsReceipt{DocumentNumber: "TN-1", Supplier: "casio",
+				Lines: []GoodsReceiptLine{line, line}},
+			expectedErr: validators.ErrDuplicateArticlesInGoodsReceipt,
+		},
+		{
+			testName: "serials don't match amount",
+			receipt: GoodsReceipt{DocumentNumber: "TN-1", Supplier: "casio",
+				Lines: []GoodsReceiptLine{{Article: "ca-09", Cost: 100, Amount: 2, Serials: []serial.Number{"A1"}}}},
+			expectedErr: validators.ErrSerialsDontMatchAmount,
+		},
+		{
+			testName: "duplicate serials in different lines",
+			receipt: GoodsReceipt{DocumentNumber: "TN-1", Supplier: "casio",
+				Lines: []GoodsReceiptLine{{Article: "ca-09", Cost: 100, Amount: 1, Serials: []serial.Number{"A1"}},
+					{Article: "ca-10", Cost: 100, Amount: 1, Serials: []serial.Number{"A1"}}}},
+			expectedErr: validators.ErrDuplicateSerialNumbers,
+		},
+		{
+			testName: "correct with serials",
+			receipt: GoodsReceipt{DocumentNumber: "TN-1", Supplier: "casio",
+				Lines: []GoodsReceiptLine{{Article: "ca-09", Cost: 100, Amount: 2,
+					Serials: []serial.Number{"A1", "A2"}}}},
+			expectedErr: nil,
+		},
+		{
+			testName:    "correct",
+			receipt:     GoodsReceipt{DocumentNumber: "TN-1", Supplier: "casio", Lines: []GoodsReceiptLine{line}},
+			expectedErr: nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		g := tc.receipt
+		t.Run(tc.testName, func(t *testing.T) {
+			if !errors.Is(g.Validate(), tc.expectedErr) {
+				t.Fail()
+			}
+		})
+	}
+}
@@ -0,0 +1,18 @@
+package dto
+
+import "github.com/lazylex/watch-store-store/internal/dto/validators"
+
+// IdempotencyKey ключ идемпотентности. Subject - субъект JWT-токена, отправившего запрос (пустой, если запрос выполнен
+// без токена). Ключи разных субъектов не пересекаются.
+type IdempotencyKey struct {
+	Subject string `json:"subject"`
+	Key     string `json:"idempotency_key"`
+}
+
+// Validate валидация корректности сохраненных в DTO данных.
+func (k *IdempotencyKey) Validate() error {
+	if err := validators.IdempotencySubject(k.Subject); err != nil {
+		return err
+	}
+	return validators.IdempotencyKey(k.Key)
+}
@@ -0,0 +1,21 @@
+package dto
+
+import "time"
+
+// IdempotencyRecord результат обработки запроса с ключом идемпотентности. Fingerprint - отпечаток запроса (метод, путь
+// и тело), позволяющий обнаружить повторное использование ключа для другого запроса. Пока Completed равно false,
+// запрос считается выполняющимся. Запись действительна до ExpiresAt.
+type IdempotencyRecord struct {
+	IdempotencyKey
+	Fingerprint string
+	Completed   bool
+	Status      int
+	ContentType string
+	Body        []byte
+	ExpiresAt   time.Time
+}
+
+// Validate валидация корректности сохраненных в DTO данных.
+func (r *IdempotencyRecord) Validate() error {
+	return r.IdempotencyKey.Validate()
+}
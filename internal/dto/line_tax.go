@@ -0,0 +1,32 @@
+package dto
+
+import (
+	"github.com/lazylex/watch-store-store/internal/domain/value_objects/article"
+	"github.com/lazylex/watch-store-store/internal/domain/value_objects/tax"
+	"math"
+)
+
+// LineTax НДС, включённый в стоимость строки чека: налоговая категория товара, ставка в процентах, стоимость без налога
+// и сумма налога.
+type LineTax struct {
+	Article  article.Article `json:"article"`
+	Category tax.Category    `json:"category"`
+	Rate     float64         `json:"rate"`
+	Net      float64         `json:"net"`
+	Tax      float64         `json:"tax"`
+}
+
+// NewLineTax выделяет из стоимости строки чека p, включающей налог, НДС по ставке rate категории category. Сумма налога
+// округляется до копеек, стоимость без налога и сумма налога в сумме дают стоимость строки.
+func NewLineTax(p ArticlePriceAmount, category tax.Category, rate float64) LineTax {
+	gross := cents(p.Price * float64(p.Amount))
+	net := int64(math.Round(float64(gross) * 100 / (100 + rate)))
+
+	return LineTax{
+		Article:  p.Article,
+		Category: category,
+		Rate:     rate,
+		Net:      float64(net) / 100,
+		Tax:      float64(gross-net) / 100,
+	}
+}
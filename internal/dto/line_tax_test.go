@@ -0,0 +1,27 @@
+package dto
+
+import "testing"
+
+func TestNewLineTax(t *testing.T) {
+	testCases := []struct {
+		testName string
+		product  ArticlePriceAmount
+		rate     float64
+		net      float64
+		tax      float64
+	}{
+		{"standard rate", ArticlePriceAmount{Article: "ca-09", Price: 1200, Amount: 2}, 20, 2000, 400},
+		{"rounding", ArticlePriceAmount{Article: "ca-09", Price: 99.99, Amount: 3}, 20, 249.98, 49.99},
+		{"reduced rate", ArticlePriceAmount{Article: "ca-09", Price: 110, Amount: 1}, 10, 100, 10},
+		{"zero rate", ArticlePriceAmount{Article: "ca-09", Price: 350.5, Amount: 1}, 0, 350.5, 0},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.testName, func(t *testing.T) {
+			line := NewLineTax(tc.product, "standard", tc.rate)
+			if line.Net != tc.net || line.Tax != tc.tax || line.Article != tc.product.Article {
+				t.Errorf("expected %v/%v, got %v/%v", tc.net, tc.tax, line.Net, line.Tax)
+			}
+		})
+	}
+}
@@ -0,0 +1,9 @@
+package dto
+
+import "github.com/lazylex/watch-store-store/internal/domain/value_objects/location"
+
+// LocationAmount количество товара на месте хранения.
+type LocationAmount struct {
+	Location location.Location `json:"location"`
+	Amount   uint              `json:"amount"`
+}
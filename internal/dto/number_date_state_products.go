@@ -3,38 +3,63 @@ package dto
 import (
 	rs "github.com/lazylex/watch-store-store/internal/domain/aggregates/reservation"
 	"github.com/lazylex/watch-store-store/internal/domain/value_objects/article"
+	"github.com/lazylex/watch-store-store/internal/domain/value_objects/location"
+	"github.com/lazylex/watch-store-store/internal/domain/value_objects/serial"
 	"github.com/lazylex/watch-store-store/internal/dto/validators"
 	"time"
 )
 
+// NumberDateStateProducts заказ. Если номер заказа через интернет или для покупателя в магазине не указан, он
+// выделяется сервисом. Location - место хранения, с которого в первую очередь резервируется товар (если не указано,
+// товар резервируется с места хранения по умолчанию). Место хранения используется только при резервировании и не
+// сохраняется вместе с заказом. Customer - необязательные данные покупателя (не передаются для заказов на кассе).
+// Serials - серийные номера резервируемых экземпляров товаров, учитываемых по серийным номерам.
 type NumberDateStateProducts struct {
 	Products    []ArticlePriceAmount `json:"products"`
 	OrderNumber rs.OrderNumber       `json:"order_number"`
 	Date        time.Time            `json:"date"`
-	State       uint                 `json:"state"`
+	State       rs.State             `json:"state"`
+	Location    location.Location    `json:"location,omitempty"`
+	Customer    *Customer            `json:"customer,omitempty"`
+	Serials     []serial.Number      `json:"serials,omitempty"`
 }
 
-// IsNew возвращает true, если бронь еще не была снята (по причине отмены или завершения заказа).
+// IsNew возвращает true, если бронирование находится в начальном состоянии.
 func (r *NumberDateStateProducts) IsNew() bool {
-	return r.State == rs.NewForInternetCustomer || r.State == rs.NewForLocalCustomer || r.State == rs.NewForCashRegister
+	return r.State.IsInitial()
+}
+
+// NeedsOrderNumber возвращает true, если номер заказа не передан и должен быть выделен сервисом. Номер выделяется
+// только для заказов, оформляемых не на кассе.
+func (r *NumberDateStateProducts) NeedsOrderNumber() bool {
+	return r.OrderNumber == 0 && r.State != rs.NewForCashRegister
 }
 
 // Validate валидация корректности сохраненных в DTO данных.
 func (r *NumberDateStateProducts) Validate() error {
-	if err := validators.OrderNumber(r.OrderNumber); err != nil {
-		return err
-	}
-
 	if !r.IsNew() {
 		return validators.ErrIncorrectState
 	}
 
-	if r.State == rs.NewForCashRegister && r.OrderNumber > rs.MaxCashRegisterNumber {
-		return validators.ErrCashRegisterOrder
+	if !r.NeedsOrderNumber() {
+		if err := validators.OrderNumber(r.OrderNumber); err != nil {
+			return err
+		}
 	}
 
-	if r.State != rs.NewForCashRegister && r.OrderNumber <= rs.MaxCashRegisterNumber {
-		return validators.ErrOrderForInternetCustomer
+	if r.Location != "" {
+		if err := validators.Location(r.Location); err != nil {
+			return err
+		}
+	}
+
+	if r.Customer != nil {
+		if r.State == rs.NewForCashRegister {
+			return validators.ErrCustomerInCashRegisterOrder
+		}
+		if err := r.Customer.Validate(); err != nil {
+			return err
+		}
 	}
 
 	if len(r.Products) == 0 {
@@ -53,5 +78,5 @@ func (r *NumberDateStateProducts) Validate() error {
 		}
 	}
 
-	return nil
+	return validators.SerialNumbers(r.Serials)
 }
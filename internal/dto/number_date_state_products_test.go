@@ -10,7 +10,7 @@ import (
 func TestReservationDTO_Validate(t *testing.T) {
 	testCases := []struct {
 		testName    string
-		state       uint
+		state       reservation.State
 		order       reservation.OrderNumber
 		products    []ArticlePriceAmount
 		expectedErr error
@@ -32,49 +32,40 @@ func TestReservationDTO_Validate(t *testing.T) {
 		{
 			testName:    "correct order for cash register",
 			state:       reservation.NewForCashRegister,
-			order:       reservation.OrderNumber(reservation.MaxCashRegisterNumber),
+			order:       reservation.OrderNumber(10),
 			products:    []ArticlePriceAmount{{Article: "ca-09.1000", Price: 4660, Amount: 5}},
 			expectedErr: nil,
 		},
-		{
-			testName:    "incorrect order for cash register",
-			state:       reservation.NewForCashRegister,
-			order:       reservation.OrderNumber(reservation.MaxCashRegisterNumber + 1),
-			products:    []ArticlePriceAmount{{Article: "ca-09.1000", Price: 4660, Amount: 5}},
-			expectedErr: validators.ErrCashRegisterOrder,
-		},
 		{
 			testName:    "correct order for local customer",
 			state:       reservation.NewForLocalCustomer,
-			order:       reservation.OrderNumber(reservation.MaxCashRegisterNumber + 1),
+			order:       reservation.OrderNumber(11),
 			products:    []ArticlePriceAmount{{Article: "ca-09.1000", Price: 4660, Amount: 5}},
 			expectedErr: nil,
 		},
 		{
-			testName:    "incorrect order for local customer",
-			state:       reservation.NewForLocalCustomer,
-			order:       reservation.OrderNumber(reservation.MaxCashRegisterNumber),
+			testName:    "correct order for internet customer",
+			state:       reservation.NewForInternetCustomer,
+			order:       reservation.OrderNumber(11),
 			products:    []ArticlePriceAmount{{Article: "ca-09.1000", Price: 4660, Amount: 5}},
-			expectedErr: validators.ErrOrderForInternetCustomer,
+			expectedErr: nil,
 		},
 		{
-			testName:    "correct order for internet customer",
+			testName:    "order for internet customer without number",
 			state:       reservation.NewForInternetCustomer,
-			order:       reservation.OrderNumber(reservation.MaxCashRegisterNumber + 1),
 			products:    []ArticlePriceAmount{{Article: "ca-09.1000", Price: 4660, Amount: 5}},
 			expectedErr: nil,
 		},
 		{
-			testName:    "incorrect order for internet customer",
-			state:       reservation.NewForInternetCustomer,
-			order:       reservation.OrderNumber(reservation.MaxCashRegisterNumber),
+			testName:    "order for cash register without number",
+			state:       reservation.NewForCashRegister,
 			products:    []ArticlePriceAmount{{Article: "ca-09.1000", Price: 4660, Amount: 5}},
-			expectedErr: validators.ErrOrderForInternetCustomer,
+			expectedErr: validators.ErrIncorrectOrder,
 		},
 		{
 			testName:    "empty products",
 			state:       reservation.NewForCashRegister,
-			order:       reservation.OrderNumber(reservation.MaxCashRegisterNumber),
+			order:       reservation.OrderNumber(10),
 			products:    []ArticlePriceAmount{},
 			expectedErr: validators.ErrNoProductsInReservation,
 		},
@@ -127,4 +118,21 @@ func TestReservationDTO_Validate(t *testing.T) {
 		}
 	})
 
+	t.Run("customer in cash register order", func(t *testing.T) {
+		r := &NumberDateStateProducts{OrderNumber: 1, State: reservation.NewForCashRegister,
+			Customer: &Customer{Name: "Иван", Phone: "89123456789"},
+			Products: []ArticlePriceAmount{{Article: "ca-09.1000", Price: 4660, Amount: 5}}}
+		if !errors.Is(r.Validate(), validators.ErrCustomerInCashRegisterOrder) {
+			t.Fail()
+		}
+	})
+
+	t.Run("incorrect customer", func(t *testing.T) {
+		r := &NumberDateStateProducts{State: reservation.NewForLocalCustomer,
+			Customer: &Customer{Name: "Иван", Phone: "12-34"},
+			Products: []ArticlePriceAmount{{Article: "ca-09.1000", Price: 4660, Amount: 5}}}
+		if !errors.Is(r.Validate(), validators.ErrIncorrectPhone) {
+			t.Fail()
+		}
+	})
 }
@@ -0,0 +1,39 @@
+package dto
+
+import (
+	rs "github.com/lazylex/watch-store-store/internal/domain/aggregates/reservation"
+	"github.com/lazylex/watch-store-store/internal/domain/value_objects/payment"
+	"github.com/lazylex/watch-store-store/internal/domain/value_objects/pickup"
+	"github.com/lazylex/watch-store-store/internal/dto/validators"
+)
+
+// NumberPaymentMethod номер завершаемого заказа и способ его оплаты. Для заказов, оформленных на кассе, способ оплаты
+// обязателен (проверяется сервисом, так как по номеру заказа нельзя определить, оформлен ли он на кассе). Для заказов
+// интернет-магазина он может быть не указан (тогда заказ считается оплаченным через интернет). Вместо способа оплаты
+// могут быть переданы платежи Payments разными способами. Код получения заказа обязателен для заказов покупателей в
+// магазине, при оформлении которых он был выдан.
+type NumberPaymentMethod struct {
+	OrderNumber   rs.OrderNumber `json:"order_number"`
+	PaymentMethod payment.Method `json:"payment_method"`
+	PickupCode    pickup.Code    `json:"pickup_code,omitempty"`
+	Payments      []Payment      `json:"payments,omitempty"`
+}
+
+// Validate валидация корректности сохраненных в DTO данных.
+func (n *NumberPaymentMethod) Validate() error {
+	if err := validators.OrderNumber(n.OrderNumber); err != nil {
+		return err
+	}
+	if n.PickupCode != "" {
+		if err := validators.PickupCode(n.PickupCode); err != nil {
+			return err
+		}
+	}
+	if len(n.Payments) > 0 {
+		return validatePayments(n.PaymentMethod, n.Payments)
+	}
+	if n.PaymentMethod == "" {
+		return nil
+	}
+	return validators.PaymentMethod(n.PaymentMethod)
+}
@@ -0,0 +1,82 @@
+package dto
+
+import (
+	"errors"
+	"github.com/lazylex/watch-store-store/internal/domain/aggregates/reservation"
+	"github.com/lazylex/watch-store-store/internal/domain/value_objects/payment"
+	"github.com/lazylex/watch-store-store/internal/domain/value_objects/pickup"
+	"github.com/lazylex/watch-store-store/internal/dto/validators"
+	"testing"
+)
+
+func TestNumberPaymentMethodDTO(t *testing.T) {
+	testCases := []struct {
+		testName    string
+		order       reservation.OrderNumber
+		method      payment.Method
+		code        pickup.Code
+		payments    []Payment
+		expectedErr error
+	}{
+		{
+			testName:    "incorrect order",
+			order:       0,
+			method:      payment.Cash,
+			expectedErr: validators.ErrIncorrectOrder,
+		},
+		{
+			testName:    "order without payment method",
+			order:       11,
+			method:      "",
+			expectedErr: nil,
+		},
+		{
+			testName:    "internet order with unknown payment method",
+			order:       11,
+			method:      "barter",
+			expectedErr: validators.ErrIncorrectPaymentMethod,
+		},
+		{
+			testName:    "cash register order paid by card",
+			order:       1,
+			method:      payment.Card,
+			expectedErr: nil,
+		},
+		{
+			testName:    "pickup order with code",
+			order:       11,
+			method:      payment.Cash,
+			code:        "402913",
+			expectedErr: nil,
+		},
+		{
+			testName:    "pickup order with incorrect code",
+			order:       11,
+			method:      payment.Cash,
+			code:        "4029",
+			expectedErr: validators.ErrIncorrectPickupCode,
+		},
+		{
+			testName:    "order paid by several methods",
+			order:       11,
+			payments:    []Payment{{Method: payment.Voucher, Amount: 500}, {Method: payment.Card, Amount: 700}},
+			expectedErr: nil,
+		},
+		{
+			testName:    "order with incorrect payment",
+			order:       11,
+			payments:    []Payment{{Method: payment.BankTransfer, Amount: -1}},
+			expectedErr: validators.ErrIncorrectPaymentAmount,
+		},
+	}
+
+	for _, tc := range testCases {
+		n := NumberPaymentMethod{OrderNumber: tc.order, PaymentMethod: tc.method, PickupCode: tc.code,
+			Payments: tc.payments}
+		t.Run(tc.testName, func(t *testing.T) {
+			if !errors.Is(n.Validate(), tc.expectedErr) {
+				t.Fail()
+			}
+		})
+	}
+}
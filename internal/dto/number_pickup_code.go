@@ -0,0 +1,13 @@
+package dto
+
+import (
+	rs "github.com/lazylex/watch-store-store/internal/domain/aggregates/reservation"
+	"github.com/lazylex/watch-store-store/internal/domain/value_objects/pickup"
+)
+
+// NumberPickupCode номер оформленного заказа и код его получения. Код выдаётся только для заказов покупателей в
+// магазине и сообщается покупателю, в хранилище он не сохраняется.
+type NumberPickupCode struct {
+	OrderNumber rs.OrderNumber `json:"order_number"`
+	PickupCode  pickup.Code    `json:"pickup_code,omitempty"`
+}
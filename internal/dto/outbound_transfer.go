@@ -0,0 +1,38 @@
+package dto
+
+import (
+	"github.com/lazylex/watch-store-store/internal/domain/value_objects/article"
+	"github.com/lazylex/watch-store-store/internal/dto/validators"
+)
+
+// OutboundTransfer отправка товаров Items в магазин (экземпляр приложения) Target.
+type OutboundTransfer struct {
+	Target string          `json:"target"`
+	Items  []ArticleAmount `json:"items"`
+}
+
+// Validate валидация корректности сохраненных в DTO данных.
+func (o *OutboundTransfer) Validate() error {
+	if err := validators.Instance(o.Target); err != nil {
+		return err
+	}
+	if len(o.Items) == 0 {
+		return validators.ErrNoItemsInTransfer
+	}
+
+	articles := make(map[article.Article]struct{})
+	for _, item := range o.Items {
+		if err := item.Validate(); err != nil {
+			return err
+		}
+		if err := validators.Amount(item.Amount); err != nil {
+			return err
+		}
+		if _, ok := articles[item.Article]; ok {
+			return validators.ErrDuplicateArticlesInTransfer
+		}
+		articles[item.Article] = struct{}{}
+	}
+
+	return nil
+}
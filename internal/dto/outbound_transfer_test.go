@@ -0,0 +1,56 @@
+package dto
+
+import (
+	"errors"
+	"github.com/lazylex/watch-store-store/internal/dto/validators"
+	"testing"
+)
+
+func TestOutboundTransferDTO(t *testing.T) {
+	testCases := []struct {
+		testName    string
+		data        OutboundTransfer
+		expectedErr error
+	}{
+		{
+			testName:    "empty target",
+			data:        OutboundTransfer{Items: []ArticleAmount{{Article: "ca-09", Amount: 1}}},
+			expectedErr: validators.ErrIncorrectInstance,
+		},
+		{
+			testName:    "no items",
+			data:        OutboundTransfer{Target: "store-2"},
+			expectedErr: validators.ErrNoItemsInTransfer,
+		},
+		{
+			testName:    "incorrect article",
+			data:        OutboundTransfer{Target: "store-2", Items: []ArticleAmount{{Article: "", Amount: 1}}},
+			expectedErr: validators.ErrIncorrectArticle,
+		},
+		{
+			testName:    "zero amount",
+			data:        OutboundTransfer{Target: "store-2", Items: []ArticleAmount{{Article: "ca-09"}}},
+			expectedErr: validators.ErrZeroAmount,
+		},
+		{
+			testName: "duplicate articles",
+			data: OutboundTransfer{Target: "store-2",
+				Items: []ArticleAmount{{Article: "ca-09", Amount: 1}, {Article: "ca-09", Amount: 1}}},
+			expectedErr: validators.ErrDuplicateArticlesInTransfer,
+		},
+		{
+			testName:    "correct",
+			data:        OutboundTransfer{Target: "store-2", Items: []ArticleAmount{{Article: "ca-09", Amount: 3}}},
+			expectedErr: nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		d := tc.data
+		t.Run(tc.testName, func(t *testing.T) {
+			if !errors.Is(d.Validate(), tc.expectedErr) {
+				t.Fail()
+			}
+		})
+	}
+}
@@ -0,0 +1,49 @@
+package dto
+
+import (
+	"github.com/lazylex/watch-store-store/internal/domain/value_objects/payment"
+	"github.com/lazylex/watch-store-store/internal/dto/validators"
+	"math"
+)
+
+// Payment платёж, которым полностью или частично оплачен чек. Amount - сумма, полученная от покупателя, Change - сдача,
+// выданная с этой суммы. Сдача выдаётся только с наличных и рассчитывается сервисом.
+type Payment struct {
+	Method payment.Method `json:"method"`
+	Amount float64        `json:"amount"`
+	Change float64        `json:"change,omitempty"`
+}
+
+// Validate валидация корректности сохраненных в DTO данных.
+func (p *Payment) Validate() error {
+	if err := validators.PaymentMethod(p.Method); err != nil {
+		return err
+	}
+	return validators.PaymentAmount(p.Amount)
+}
+
+// Net возвращает сумму, оставшуюся у магазина после выдачи сдачи.
+func (p *Payment) Net() float64 {
+	return p.Amount - p.Change
+}
+
+// validatePayments проверяет платежи, переданные вместо единственного способа оплаты method.
+func validatePayments(method payment.Method, payments []Payment) error {
+	if method != "" {
+		return validators.ErrPaymentMethodWithPayments
+	}
+	if len(payments) > validators.MaxPayments {
+		return validators.ErrTooManyPayments
+	}
+	for i := range payments {
+		if err := payments[i].Validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// cents возвращает денежную сумму в копейках.
+func cents(amount float64) int64 {
+	return int64(math.Round(amount * 100))
+}
@@ -0,0 +1,10 @@
+package dto
+
+import "github.com/lazylex/watch-store-store/internal/domain/value_objects/payment"
+
+// PaymentCountTotal количество операций и их сумма для одного способа оплаты.
+type PaymentCountTotal struct {
+	Method payment.Method `json:"method"`
+	Count  uint           `json:"count"`
+	Total  float64        `json:"total"`
+}
@@ -0,0 +1,57 @@
+package dto
+
+import (
+	"errors"
+	"github.com/lazylex/watch-store-store/internal/domain/value_objects/payment"
+	"github.com/lazylex/watch-store-store/internal/dto/validators"
+	"testing"
+)
+
+func TestPaymentDTO(t *testing.T) {
+	testCases := []struct {
+		testName    string
+		payment     Payment
+		expectedErr error
+	}{
+		{
+			testName:    "correct",
+			payment:     Payment{Method: payment.Voucher, Amount: 500},
+			expectedErr: nil,
+		},
+		{
+			testName:    "mixed method",
+			payment:     Payment{Method: payment.Mixed, Amount: 500},
+			expectedErr: validators.ErrIncorrectPaymentMethod,
+		},
+		{
+			testName:    "zero amount",
+			payment:     Payment{Method: payment.Cash},
+			expectedErr: validators.ErrIncorrectPaymentAmount,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.testName, func(t *testing.T) {
+			if !errors.Is(tc.payment.Validate(), tc.expectedErr) {
+				t.Fail()
+			}
+		})
+	}
+}
+
+func TestValidatePayments(t *testing.T) {
+	payments := []Payment{{Method: payment.Card, Amount: 100}, {Method: payment.Cash, Amount: 50}}
+
+	if validatePayments("", payments) != nil {
+		t.Fail()
+	}
+	if !errors.Is(validatePayments(payment.Cash, payments), validators.ErrPaymentMethodWithPayments) {
+		t.Fail()
+	}
+	if !errors.Is(validatePayments("", make([]Payment, validators.MaxPayments+1)), validators.ErrTooManyPayments) {
+		t.Fail()
+	}
+	if !errors.Is(validatePayments("", []Payment{{Method: payment.Card}}), validators.ErrIncorrectPaymentAmount) {
+		t.Fail()
+	}
+}
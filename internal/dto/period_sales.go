@@ -0,0 +1,16 @@
+package dto
+
+import "time"
+
+// PeriodSales продажи за интервал, начинающийся с Period. Количество единиц Units, выручка Revenue (включает НДС),
+// Net - выручка без налога и Tax - сумма налога указываются за вычетом возвратов. RefundedUnits и Refunded -
+// возвращённые за интервал единицы и сумма возвратов.
+type PeriodSales struct {
+	Period        time.Time `json:"period"`
+	Units         int       `json:"units"`
+	Revenue       float64   `json:"revenue"`
+	Net           float64   `json:"net"`
+	Tax           float64   `json:"tax"`
+	RefundedUnits uint      `json:"refunded_units"`
+	Refunded      float64   `json:"refunded"`
+}
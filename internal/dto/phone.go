@@ -0,0 +1,16 @@
+package dto
+
+import (
+	"github.com/lazylex/watch-store-store/internal/domain/value_objects/phone"
+	"github.com/lazylex/watch-store-store/internal/dto/validators"
+)
+
+// Phone номер телефона покупателя.
+type Phone struct {
+	Phone phone.Phone `json:"phone"`
+}
+
+// Validate валидация корректности сохраненных в DTO данных.
+func (p *Phone) Validate() error {
+	return validators.Phone(p.Phone)
+}
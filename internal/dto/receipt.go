@@ -0,0 +1,102 @@
+package dto
+
+import (
+	"github.com/lazylex/watch-store-store/internal/domain/aggregates/receipt"
+	rs "github.com/lazylex/watch-store-store/internal/domain/aggregates/reservation"
+	"github.com/lazylex/watch-store-store/internal/domain/aggregates/shift"
+	"github.com/lazylex/watch-store-store/internal/domain/value_objects/article"
+	"github.com/lazylex/watch-store-store/internal/domain/value_objects/payment"
+	"github.com/lazylex/watch-store-store/internal/domain/value_objects/tax"
+	"github.com/lazylex/watch-store-store/internal/dto/validators"
+	"time"
+)
+
+// Receipt чек, объединяющий проданные в рамках одной покупки товары. Для продажи через кассу заполняется номер кассы
+// CashRegister и кассовая смена ShiftID, для выполненного заказа интернет-магазина или самовывоза - номер заказа
+// OrderNumber. Чек может быть оплачен несколькими платежами Payments, тогда способ оплаты PaymentMethod равен
+// payment.Mixed. Taxes содержит включённый в стоимость каждой строки чека НДС в порядке строк Products, Tax - общую
+// сумму налога.
+type Receipt struct {
+	ID            receipt.ID           `json:"id"`
+	CashRegister  rs.OrderNumber       `json:"cash_register"`
+	ShiftID       shift.ID             `json:"shift_id"`
+	OrderNumber   rs.OrderNumber       `json:"order_number"`
+	PaymentMethod payment.Method       `json:"payment_method"`
+	Total         float64              `json:"total"`
+	Tax           float64              `json:"tax"`
+	Date          time.Time            `json:"date"`
+	Products      []ArticlePriceAmount `json:"products"`
+	Payments      []Payment            `json:"payments"`
+	Taxes         []LineTax            `json:"taxes"`
+}
+
+// CalculateTotal вычисляет и сохраняет в Total итоговую сумму чека по содержащимся в нём товарам.
+func (r *Receipt) CalculateTotal() {
+	r.Total = 0
+	for _, p := range r.Products {
+		r.Total += p.Price * float64(p.Amount)
+	}
+}
+
+// ApplyTaxes рассчитывает НДС каждой строки чека по налоговым категориям и ставкам товаров rates и общую сумму налога.
+// Товары, отсутствующие в rates, относятся к категории tax.Default с нулевой ставкой.
+func (r *Receipt) ApplyTaxes(rates []ArticleTaxRate) {
+	byArticle := make(map[article.Article]ArticleTaxRate, len(rates))
+	for _, rate := range rates {
+		byArticle[rate.Article] = rate
+	}
+
+	var total int64
+	r.Taxes = make([]LineTax, len(r.Products))
+	for i, p := range r.Products {
+		rate, ok := byArticle[p.Article]
+		if !ok {
+			rate.Category = tax.Default
+		}
+		r.Taxes[i] = NewLineTax(p, rate.Category, rate.Rate)
+		total += cents(r.Taxes[i].Tax)
+	}
+	r.Tax = float64(total) / 100
+}
+
+// ApplyPayments сохраняет в чеке платежи, которыми он оплачен. Если платежи не переданы, чек оплачивается целиком
+// способом method. Сумма безналичных платежей не может превышать итоговую сумму чека, а сумма всех платежей - быть
+// меньше неё, иначе возвращается validators.ErrPaymentsDontMatchTotal. Превышение суммы платежей над суммой чека
+// выдаётся сдачей с наличных платежей. Вызывается после CalculateTotal.
+func (r *Receipt) ApplyPayments(method payment.Method, payments []Payment) error {
+	if len(payments) == 0 {
+		r.PaymentMethod = method
+		r.Payments = []Payment{{Method: method, Amount: r.Total}}
+		return nil
+	}
+
+	var paid, cashless int64
+	for _, p := range payments {
+		paid += cents(p.Amount)
+		if p.Method != payment.Cash {
+			cashless += cents(p.Amount)
+		}
+	}
+	total := cents(r.Total)
+	if paid < total || cashless > total {
+		return validators.ErrPaymentsDontMatchTotal
+	}
+
+	change := paid - total
+	r.PaymentMethod = payments[0].Method
+	r.Payments = make([]Payment, len(payments))
+	for i, p := range payments {
+		p.Change = 0
+		if p.Method == payment.Cash && change > 0 {
+			given := min(change, cents(p.Amount))
+			p.Change = float64(given) / 100
+			change -= given
+		}
+		if p.Method != r.PaymentMethod {
+			r.PaymentMethod = payment.Mixed
+		}
+		r.Payments[i] = p
+	}
+
+	return nil
+}
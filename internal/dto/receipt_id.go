@@ -0,0 +1,15 @@
+package dto
+
+import (
+	"github.com/lazylex/watch-store-store/internal/domain/aggregates/receipt"
+	"github.com/lazylex/watch-store-store/internal/dto/validators"
+)
+
+type ReceiptID struct {
+	ID receipt.ID `json:"receipt_id"`
+}
+
+// Validate валидация корректности сохраненных в DTO данных.
+func (r *ReceiptID) Validate() error {
+	return validators.ReceiptID(r.ID)
+}
@@ -0,0 +1,23 @@
+package dto
+
+import (
+	"errors"
+	"github.com/lazylex/watch-store-store/internal/dto/validators"
+	"testing"
+)
+
+func TestReceiptIDDTO(t *testing.T) {
+	t.Run("zero id", func(t *testing.T) {
+		r := ReceiptID{ID: 0}
+		if !errors.Is(r.Validate(), validators.ErrIncorrectReceiptID) {
+			t.Fail()
+		}
+	})
+
+	t.Run("correct id", func(t *testing.T) {
+		r := ReceiptID{ID: 13}
+		if r.Validate() != nil {
+			t.Fail()
+		}
+	})
+}
@@ -0,0 +1,107 @@
+package dto
+
+import (
+	"errors"
+	"github.com/lazylex/watch-store-store/internal/domain/value_objects/payment"
+	"github.com/lazylex/watch-store-store/internal/domain/value_objects/tax"
+	"github.com/lazylex/watch-store-store/internal/dto/validators"
+	"testing"
+)
+
+func TestReceiptDTO_CalculateTotal(t *testing.T) {
+	r := Receipt{Total: 1, Products: []ArticlePriceAmount{
+		{Article: "ca-09", Price: 1000, Amount: 2},
+		{Article: "ca-10", Price: 350.5, Amount: 1},
+	}}
+
+	r.CalculateTotal()
+	if r.Total != 2350.5 {
+		t.Fail()
+	}
+}
+
+func TestReceiptDTO_ApplyPaymentsSingleMethod(t *testing.T) {
+	r := Receipt{Total: 2350.5}
+
+	if err := r.ApplyPayments(payment.Card, nil); err != nil {
+		t.Fatal(err)
+	}
+	if r.PaymentMethod != payment.Card || len(r.Payments) != 1 || r.Payments[0].Amount != 2350.5 {
+		t.Fail()
+	}
+}
+
+func TestReceiptDTO_ApplyPaymentsChange(t *testing.T) {
+	r := Receipt{Total: 2350.5}
+	payments := []Payment{{Method: payment.Voucher, Amount: 1000}, {Method: payment.Cash, Amount: 1500}}
+
+	if err := r.ApplyPayments("", payments); err != nil {
+		t.Fatal(err)
+	}
+	if r.PaymentMethod != payment.Mixed || r.Payments[0].Change != 0 || r.Payments[1].Change != 149.5 ||
+		r.Payments[1].Net() != 1350.5 {
+		t.Fail()
+	}
+	if payments[1].Change != 0 {
+		t.Error("passed payments must not be changed")
+	}
+}
+
+func TestReceiptDTO_ApplyPaymentsSameMethod(t *testing.T) {
+	r := Receipt{Total: 300}
+
+	if err := r.ApplyPayments("", []Payment{{Method: payment.Card, Amount: 100},
+		{Method: payment.Card, Amount: 200}}); err != nil {
+		t.Fatal(err)
+	}
+	if r.PaymentMethod != payment.Card {
+		t.Fail()
+	}
+}
+
+func TestReceiptDTO_ApplyPaymentsDontMatchTotal(t *testing.T) {
+	testCases := []struct {
+		testName string
+		payments []Payment
+	}{
+		{
+			testName: "underpaid",
+			payments: []Payment{{Method: payment.Cash, Amount: 100}, {Method: payment.Card, Amount: 150}},
+		},
+		{
+			testName: "change from card",
+			payments: []Payment{{Method: payment.Card, Amount: 350}},
+		},
+		{
+			testName: "cashless exceeds total",
+			payments: []Payment{{Method: payment.Card, Amount: 250}, {Method: payment.Voucher, Amount: 100},
+				{Method: payment.Cash, Amount: 50}},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.testName, func(t *testing.T) {
+			r := Receipt{Total: 300}
+			if !errors.Is(r.ApplyPayments("", tc.payments), validators.ErrPaymentsDontMatchTotal) {
+				t.Fail()
+			}
+		})
+	}
+}
+
+func TestReceiptDTO_ApplyTaxes(t *testing.T) {
+	r := Receipt{Products: []ArticlePriceAmount{
+		{Article: "ca-09", Price: 1200, Amount: 2},
+		{Article: "ca-10", Price: 110, Amount: 1},
+		{Article: "ca-11", Price: 500, Amount: 1},
+	}}
+
+	r.ApplyTaxes([]ArticleTaxRate{
+		{Article: "ca-09", Category: "standard", Rate: 20},
+		{Article: "ca-10", Category: "reduced", Rate: 10},
+	})
+	if len(r.Taxes) != 3 || r.Tax != 410 || r.Taxes[1].Category != "reduced" || r.Taxes[2].Category != tax.Default ||
+		r.Taxes[2].Tax != 0 {
+		t.Fail()
+	}
+}
@@ -0,0 +1,49 @@
+package dto
+
+import (
+	"github.com/lazylex/watch-store-store/internal/domain/aggregates/receipt"
+	rs "github.com/lazylex/watch-store-store/internal/domain/aggregates/reservation"
+	"github.com/lazylex/watch-store-store/internal/domain/value_objects/article"
+	"github.com/lazylex/watch-store-store/internal/domain/value_objects/serial"
+	"github.com/lazylex/watch-store-store/internal/dto/validators"
+)
+
+// Refund запрос на возврат товаров, проданных по чеку ReceiptID. Возврат оформляется на кассе CashRegister. Serials -
+// серийные номера возвращаемых экземпляров товаров, учитываемых по серийным номерам.
+type Refund struct {
+	ReceiptID    receipt.ID      `json:"receipt_id"`
+	CashRegister rs.OrderNumber  `json:"cash_register"`
+	Products     []ArticleAmount `json:"products"`
+	Serials      []serial.Number `json:"serials,omitempty"`
+}
+
+// Validate валидация корректности сохраненных в DTO данных.
+func (r *Refund) Validate() error {
+	if err := validators.ReceiptID(r.ReceiptID); err != nil {
+		return err
+	}
+
+	if err := validators.CashRegister(r.CashRegister); err != nil {
+		return err
+	}
+
+	if len(r.Products) == 0 {
+		return validators.ErrNoProductsInRefund
+	}
+
+	articles := make(map[article.Article]struct{})
+	for _, product := range r.Products {
+		if err := product.Validate(); err != nil {
+			return err
+		}
+		if err := validators.Amount(product.Amount); err != nil {
+			return err
+		}
+		if _, ok := articles[product.Article]; ok {
+			return validators.ErrDuplicateProductsInRefund
+		}
+		articles[product.Article] = struct{}{}
+	}
+
+	return validators.SerialNumbers(r.Serials)
+}
@@ -0,0 +1,7 @@
+package dto
+
+import "github.com/lazylex/watch-store-store/internal/domain/aggregates/refund"
+
+type RefundID struct {
+	ID refund.ID `json:"refund_id"`
+}
@@ -0,0 +1,23 @@
+package dto
+
+import (
+	"github.com/lazylex/watch-store-store/internal/domain/aggregates/receipt"
+	"github.com/lazylex/watch-store-store/internal/domain/aggregates/refund"
+	rs "github.com/lazylex/watch-store-store/internal/domain/aggregates/reservation"
+	"github.com/lazylex/watch-store-store/internal/domain/aggregates/shift"
+	"github.com/lazylex/watch-store-store/internal/domain/value_objects/payment"
+	"time"
+)
+
+// RefundRecord сохраняемый в хранилище возврат товаров. Цены возвращаемых товаров берутся из чека продажи, способ
+// возврата денег совпадает со способом оплаты чека.
+type RefundRecord struct {
+	ID            refund.ID            `json:"refund_id"`
+	ReceiptID     receipt.ID           `json:"receipt_id"`
+	ShiftID       shift.ID             `json:"shift_id"`
+	CashRegister  rs.OrderNumber       `json:"cash_register"`
+	PaymentMethod payment.Method       `json:"payment_method"`
+	Total         float64              `json:"total"`
+	Date          time.Time            `json:"date"`
+	Products      []ArticlePriceAmount `json:"products"`
+}
@@ -0,0 +1,56 @@
+package dto
+
+import (
+	"errors"
+	"github.com/lazylex/watch-store-store/internal/dto/validators"
+	"testing"
+)
+
+func TestRefundDTO(t *testing.T) {
+	testCases := []struct {
+		testName    string
+		refund      Refund
+		expectedErr error
+	}{
+		{
+			testName:    "incorrect receipt",
+			refund:      Refund{ReceiptID: 0, CashRegister: 1, Products: []ArticleAmount{{Article: "ca-09", Amount: 1}}},
+			expectedErr: validators.ErrIncorrectReceiptID,
+		},
+		{
+			testName:    "incorrect cash register",
+			refund:      Refund{ReceiptID: 1, CashRegister: 0, Products: []ArticleAmount{{Article: "ca-09", Amount: 1}}},
+			expectedErr: validators.ErrIncorrectCashRegister,
+		},
+		{
+			testName:    "no products",
+			refund:      Refund{ReceiptID: 1, CashRegister: 1},
+			expectedErr: validators.ErrNoProductsInRefund,
+		},
+		{
+			testName:    "zero amount",
+			refund:      Refund{ReceiptID: 1, CashRegister: 1, Products: []ArticleAmount{{Article: "ca-09", Amount: 0}}},
+			expectedErr: validators.ErrZeroAmount,
+		},
+		{
+			testName: "duplicate products",
+			refund: Refund{ReceiptID: 1, CashRegister: 1,
+				Products: []ArticleAmount{{Article: "ca-09", Amount: 1}, {Article: "ca-09", Amount: 2}}},
+			expectedErr: validators.ErrDuplicateProductsInRefund,
+		},
+		{
+			testName:    "correct",
+			refund:      Refund{ReceiptID: 1, CashRegister: 1, Products: []ArticleAmount{{Article: "ca-09", Amount: 1}}},
+			expectedErr: nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		r := tc.refund
+		t.Run(tc.testName, func(t *testing.T) {
+			if !errors.Is(r.Validate(), tc.expectedErr) {
+				t.Fail()
+			}
+		})
+	}
+}
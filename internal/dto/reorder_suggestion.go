@@ -0,0 +1,17 @@
+package dto
+
+import "github.com/lazylex/watch-store-store/internal/domain/value_objects/article"
+
+// ReorderSuggestion предложение о заказе товара. Velocity - средние продажи в день, DaysOfCover - на сколько дней
+// хватит товара при текущей скорости продаж (nil, если товар не продавался), ReorderPoint - остаток, при котором товар
+// нужно заказывать, Quantity - рекомендуемое к заказу количество.
+type ReorderSuggestion struct {
+	Article      article.Article `json:"article"`
+	InStock      uint            `json:"in_stock"`
+	Velocity     float64         `json:"velocity"`
+	DaysOfCover  *float64        `json:"days_of_cover"`
+	LeadTimeDays uint            `json:"lead_time_days"`
+	SafetyStock  uint            `json:"safety_stock"`
+	ReorderPoint float64         `json:"reorder_point"`
+	Quantity     uint            `json:"quantity"`
+}
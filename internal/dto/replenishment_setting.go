@@ -0,0 +1,21 @@
+package dto
+
+import (
+	"github.com/lazylex/watch-store-store/internal/domain/value_objects/article"
+	"github.com/lazylex/watch-store-store/internal/dto/validators"
+)
+
+// ReplenishmentSetting параметры пополнения запасов товара: срок поставки в днях и страховой запас в единицах товара.
+type ReplenishmentSetting struct {
+	Article      article.Article `json:"article"`
+	LeadTimeDays uint            `json:"lead_time_days"`
+	SafetyStock  uint            `json:"safety_stock"`
+}
+
+// Validate валидация корректности сохраненных в DTO данных.
+func (r *ReplenishmentSetting) Validate() error {
+	if err := validators.Article(r.Article); err != nil {
+		return err
+	}
+	return validators.LeadTime(r.LeadTimeDays)
+}
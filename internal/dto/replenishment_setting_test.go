@@ -0,0 +1,40 @@
+package dto
+
+import (
+	"errors"
+	"github.com/lazylex/watch-store-store/internal/dto/validators"
+	"testing"
+)
+
+func TestReplenishmentSettingDTO(t *testing.T) {
+	testCases := []struct {
+		testName    string
+		data        ReplenishmentSetting
+		expectedErr error
+	}{
+		{
+			testName:    "incorrect article",
+			data:        ReplenishmentSetting{Article: "", LeadTimeDays: 7},
+			expectedErr: validators.ErrIncorrectArticle,
+		},
+		{
+			testName:    "zero lead time",
+			data:        ReplenishmentSetting{Article: "CA-F91W", SafetyStock: 2},
+			expectedErr: validators.ErrIncorrectLeadTime,
+		},
+		{
+			testName:    "correct",
+			data:        ReplenishmentSetting{Article: "CA-F91W", LeadTimeDays: 7, SafetyStock: 2},
+			expectedErr: nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		d := tc.data
+		t.Run(tc.testName, func(t *testing.T) {
+			if !errors.Is(d.Validate(), tc.expectedErr) {
+				t.Fail()
+			}
+		})
+	}
+}
@@ -0,0 +1,13 @@
+package dto
+
+import (
+	rs "github.com/lazylex/watch-store-store/internal/domain/aggregates/reservation"
+)
+
+// ReservationCustomer данные покупателя и хэш кода получения заказа. Покупатель может быть не указан, а хэш кода
+// сохраняется только для заказов покупателей в магазине.
+type ReservationCustomer struct {
+	OrderNumber    rs.OrderNumber
+	Customer       *Customer
+	PickupCodeHash string
+}
@@ -0,0 +1,15 @@
+package dto
+
+import (
+	"github.com/lazylex/watch-store-store/internal/domain/aggregates/reservation"
+	"time"
+)
+
+// ReservationTransition запись истории смены состояния бронирования. Для создания бронирования From равно нулю.
+type ReservationTransition struct {
+	OrderNumber reservation.OrderNumber `json:"order_number"`
+	From        reservation.State       `json:"from"`
+	To          reservation.State       `json:"to"`
+	Actor       string                  `json:"actor"`
+	Date        time.Time               `json:"date"`
+}
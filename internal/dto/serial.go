@@ -0,0 +1,15 @@
+package dto
+
+import (
+	"github.com/lazylex/watch-store-store/internal/domain/value_objects/serial"
+	"github.com/lazylex/watch-store-store/internal/dto/validators"
+)
+
+type Serial struct {
+	Serial serial.Number `json:"serial"`
+}
+
+// Validate валидация корректности сохраненных в DTO данных.
+func (s *Serial) Validate() error {
+	return validators.SerialNumber(s.Serial)
+}
@@ -0,0 +1,24 @@
+package dto
+
+import (
+	"github.com/lazylex/watch-store-store/internal/domain/aggregates/receipt"
+	"github.com/lazylex/watch-store-store/internal/domain/aggregates/refund"
+	"github.com/lazylex/watch-store-store/internal/domain/aggregates/reservation"
+	"github.com/lazylex/watch-store-store/internal/domain/value_objects/article"
+	"github.com/lazylex/watch-store-store/internal/domain/value_objects/serial"
+	"time"
+)
+
+// SerialEvent запись истории экземпляра товара с серийным номером. State - состояние экземпляра после события. Событие
+// ссылается на документ, которым оно вызвано: поставку DocumentNumber, заказ OrderNumber, чек ReceiptID или возврат
+// RefundID. Регистрация серийного номера при включении учёта не ссылается ни на один документ.
+type SerialEvent struct {
+	Serial         serial.Number           `json:"serial"`
+	Article        article.Article         `json:"article"`
+	State          serial.State            `json:"state"`
+	DocumentNumber string                  `json:"document_number,omitempty"`
+	OrderNumber    reservation.OrderNumber `json:"order_number,omitempty"`
+	ReceiptID      receipt.ID              `json:"receipt_id,omitempty"`
+	RefundID       refund.ID               `json:"refund_id,omitempty"`
+	Date           time.Time               `json:"date"`
+}
@@ -0,0 +1,8 @@
+package dto
+
+// SerialHistory текущее состояние экземпляра товара с серийным номером и история его событий в порядке их
+// возникновения.
+type SerialHistory struct {
+	SerialRecord
+	Events []SerialEvent `json:"events"`
+}
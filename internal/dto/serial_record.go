@@ -0,0 +1,18 @@
+package dto
+
+import (
+	"github.com/lazylex/watch-store-store/internal/domain/aggregates/receipt"
+	"github.com/lazylex/watch-store-store/internal/domain/aggregates/reservation"
+	"github.com/lazylex/watch-store-store/internal/domain/value_objects/article"
+	"github.com/lazylex/watch-store-store/internal/domain/value_objects/serial"
+)
+
+// SerialRecord текущее состояние экземпляра товара с серийным номером. OrderNumber - номер заказа, под который
+// экземпляр зарезервирован, ReceiptID - идентификатор чека, по которому он продан.
+type SerialRecord struct {
+	Serial      serial.Number           `json:"serial"`
+	Article     article.Article         `json:"article"`
+	State       serial.State            `json:"state"`
+	OrderNumber reservation.OrderNumber `json:"order_number,omitempty"`
+	ReceiptID   receipt.ID              `json:"receipt_id,omitempty"`
+}
@@ -0,0 +1,28 @@
+package dto
+
+import (
+	"github.com/lazylex/watch-store-store/internal/domain/value_objects/article"
+	"github.com/lazylex/watch-store-store/internal/domain/value_objects/serial"
+	"github.com/lazylex/watch-store-store/internal/dto/validators"
+)
+
+// SerialTracking включение (Tracked равно true) или отключение учёта товара по серийным номерам. При включении учёта
+// в Serials передаются серийные номера имеющихся в продаже экземпляров товара, ещё не зарегистрированных в магазине.
+type SerialTracking struct {
+	Article article.Article `json:"article"`
+	Tracked bool            `json:"tracked"`
+	Serials []serial.Number `json:"serials,omitempty"`
+}
+
+// Validate валидация корректности сохраненных в DTO данных.
+func (s *SerialTracking) Validate() error {
+	if err := validators.Article(s.Article); err != nil {
+		return err
+	}
+
+	if !s.Tracked && len(s.Serials) > 0 {
+		return validators.ErrSerialsWhenTrackingDisabled
+	}
+
+	return validators.SerialNumbers(s.Serials)
+}
@@ -0,0 +1,51 @@
+package dto
+
+import (
+	"errors"
+	"github.com/lazylex/watch-store-store/internal/domain/value_objects/serial"
+	"github.com/lazylex/watch-store-store/internal/dto/validators"
+	"testing"
+)
+
+func TestSerialTrackingDTO(t *testing.T) {
+	testCases := []struct {
+		testName    string
+		tracking    SerialTracking
+		expectedErr error
+	}{
+		{
+			testName:    "incorrect article",
+			tracking:    SerialTracking{Tracked: true},
+			expectedErr: validators.ErrIncorrectArticle,
+		},
+		{
+			testName:    "serials when disabling",
+			tracking:    SerialTracking{Article: "ca-09", Serials: []serial.Number{"A1"}},
+			expectedErr: validators.ErrSerialsWhenTrackingDisabled,
+		},
+		{
+			testName:    "duplicate serials",
+			tracking:    SerialTracking{Article: "ca-09", Tracked: true, Serials: []serial.Number{"A1", "A1"}},
+			expectedErr: validators.ErrDuplicateSerialNumbers,
+		},
+		{
+			testName:    "correct enabling",
+			tracking:    SerialTracking{Article: "ca-09", Tracked: true, Serials: []serial.Number{"A1", "A2"}},
+			expectedErr: nil,
+		},
+		{
+			testName:    "correct disabling",
+			tracking:    SerialTracking{Article: "ca-09"},
+			expectedErr: nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		s := tc.tracking
+		t.Run(tc.testName, func(t *testing.T) {
+			if !errors.Is(s.Validate(), tc.expectedErr) {
+				t.Fail()
+			}
+		})
+	}
+}
@@ -0,0 +1,32 @@
+package dto
+
+import (
+	rs "github.com/lazylex/watch-store-store/internal/domain/aggregates/reservation"
+	"github.com/lazylex/watch-store-store/internal/domain/aggregates/shift"
+	"github.com/lazylex/watch-store-store/internal/dto/validators"
+	"time"
+)
+
+// Shift кассовая смена. Открытая смена имеет нулевое время закрытия ClosedAt.
+type Shift struct {
+	ID           shift.ID       `json:"shift_id"`
+	CashRegister rs.OrderNumber `json:"cash_register"`
+	CashierID    string         `json:"cashier_id"`
+	OpeningFloat float64        `json:"opening_float"`
+	OpenedAt     time.Time      `json:"opened_at"`
+	ClosedAt     time.Time      `json:"closed_at"`
+}
+
+// Validate валидация корректности сохраненных в DTO данных.
+func (s *Shift) Validate() error {
+	if err := validators.CashRegister(s.CashRegister); err != nil {
+		return err
+	}
+	if err := validators.CashierID(s.CashierID); err != nil {
+		return err
+	}
+	if err := validators.OpeningFloat(s.OpeningFloat); err != nil {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,15 @@
+package dto
+
+import (
+	"github.com/lazylex/watch-store-store/internal/domain/aggregates/shift"
+	"github.com/lazylex/watch-store-store/internal/dto/validators"
+)
+
+type ShiftID struct {
+	ID shift.ID `json:"shift_id"`
+}
+
+// Validate валидация корректности сохраненных в DTO данных.
+func (s *ShiftID) Validate() error {
+	return validators.ShiftID(s.ID)
+}
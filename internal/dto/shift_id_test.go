@@ -0,0 +1,16 @@
+package dto
+
+import (
+	"errors"
+	"github.com/lazylex/watch-store-store/internal/dto/validators"
+	"testing"
+)
+
+func TestShiftIDDTO(t *testing.T) {
+	t.Run("zero id", func(t *testing.T) {
+		s := ShiftID{ID: 0}
+		if !errors.Is(s.Validate(), validators.ErrIncorrectShiftID) {
+			t.Fail()
+		}
+	})
+}
@@ -0,0 +1,37 @@
+package dto
+
+import (
+	"errors"
+	"github.com/lazylex/watch-store-store/internal/dto/validators"
+	"testing"
+)
+
+func TestShiftDTO(t *testing.T) {
+	t.Run("incorrect cash register", func(t *testing.T) {
+		s := Shift{CashRegister: 0, CashierID: "cashier-1", OpeningFloat: 5000}
+		if !errors.Is(s.Validate(), validators.ErrIncorrectCashRegister) {
+			t.Fail()
+		}
+	})
+
+	t.Run("empty cashier", func(t *testing.T) {
+		s := Shift{CashRegister: 1, CashierID: "", OpeningFloat: 5000}
+		if !errors.Is(s.Validate(), validators.ErrEmptyCashierID) {
+			t.Fail()
+		}
+	})
+
+	t.Run("negative opening float", func(t *testing.T) {
+		s := Shift{CashRegister: 1, CashierID: "cashier-1", OpeningFloat: -5000}
+		if !errors.Is(s.Validate(), validators.ErrNegativeOpeningFloat) {
+			t.Fail()
+		}
+	})
+
+	t.Run("correct", func(t *testing.T) {
+		s := Shift{CashRegister: 1, CashierID: "cashier-1", OpeningFloat: 0}
+		if s.Validate() != nil {
+			t.Fail()
+		}
+	})
+}
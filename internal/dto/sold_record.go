@@ -0,0 +1,17 @@
+package dto
+
+import (
+	"github.com/lazylex/watch-store-store/internal/domain/aggregates/receipt"
+	"github.com/lazylex/watch-store-store/internal/domain/value_objects/tax"
+)
+
+// SoldRecord запись о проданном товаре с идентификатором чека и включённым в стоимость НДС. Для продаж, записанных до
+// появления чеков, ReceiptID равен нулю.
+type SoldRecord struct {
+	ArticlePriceAmountDate
+	ReceiptID   receipt.ID   `json:"receipt_id"`
+	TaxCategory tax.Category `json:"tax_category"`
+	TaxRate     float64      `json:"tax_rate"`
+	Net         float64      `json:"net"`
+	Tax         float64      `json:"tax"`
+}
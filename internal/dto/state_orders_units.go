@@ -0,0 +1,10 @@
+package dto
+
+import "github.com/lazylex/watch-store-store/internal/domain/aggregates/reservation"
+
+// StateOrdersUnits количество заказов в состоянии State и зарезервированных под них единиц товара.
+type StateOrdersUnits struct {
+	State  reservation.State `json:"state"`
+	Orders uint              `json:"orders"`
+	Units  uint              `json:"units"`
+}
@@ -0,0 +1,11 @@
+package dto
+
+import "time"
+
+// StockAdjustment сохраняемая запись о корректировке количества товара. AmountAfter - количество товара после
+// корректировки.
+type StockAdjustment struct {
+	ArticleDeltaReason
+	AmountAfter uint      `json:"amount_after"`
+	Date        time.Time `json:"date"`
+}
@@ -0,0 +1,9 @@
+package dto
+
+import "github.com/lazylex/watch-store-store/internal/domain/value_objects/attribute"
+
+// StockAttributes информация о товаре в продаже вместе со значениями его атрибутов.
+type StockAttributes struct {
+	ArticlePriceNameAmount
+	Attributes map[attribute.Code]string `json:"attributes,omitempty"`
+}
@@ -0,0 +1,56 @@
+package dto
+
+import (
+	"github.com/lazylex/watch-store-store/internal/domain/value_objects/attribute"
+	"github.com/lazylex/watch-store-store/internal/dto/validators"
+)
+
+// AttributeFilter условие на значение атрибута товара: либо равенство значению Value, либо вхождение числового
+// значения в диапазон от Min до Max (любая из границ может отсутствовать).
+type AttributeFilter struct {
+	Code  attribute.Code `json:"code"`
+	Value *string        `json:"value,omitempty"`
+	Min   *float64       `json:"min,omitempty"`
+	Max   *float64       `json:"max,omitempty"`
+}
+
+// Validate валидация корректности сохраненных в DTO данных.
+func (f *AttributeFilter) Validate() error {
+	if err := validators.AttributeCode(f.Code); err != nil {
+		return err
+	}
+	isRange := f.Min != nil || f.Max != nil
+	if (f.Value != nil) == isRange {
+		return validators.ErrIncorrectAttributeFilter
+	}
+	if f.Min != nil && f.Max != nil && *f.Min > *f.Max {
+		return validators.ErrIncorrectAttributeFilter
+	}
+	return nil
+}
+
+// StockFilter условия поиска товаров в продаже по атрибутам. Товар должен удовлетворять всем условиям Filters. Limit и
+// Offset задают страницу результатов, упорядоченных по артикулу. При WithAttributes к товарам добавляются значения их
+// атрибутов.
+type StockFilter struct {
+	Filters        []AttributeFilter `json:"filters"`
+	Limit          int               `json:"limit"`
+	Offset         int               `json:"offset"`
+	WithAttributes bool              `json:"with_attributes"`
+}
+
+// Validate валидация корректности сохраненных в DTO данных.
+func (f *StockFilter) Validate() error {
+	if err := validators.Limit(f.Limit); err != nil {
+		return err
+	}
+	if f.Offset < 0 {
+		return validators.ErrIncorrectOffset
+	}
+	for i := range f.Filters {
+		if err := f.Filters[i].Validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
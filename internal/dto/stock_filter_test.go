@@ -0,0 +1,60 @@
+package dto
+
+import (
+	"errors"
+	"github.com/lazylex/watch-store-store/internal/dto/validators"
+	"testing"
+)
+
+func TestStockFilterDTO(t *testing.T) {
+	value, low, high := "resin", 10.0, 100.0
+
+	testCases := []struct {
+		testName    string
+		data        StockFilter
+		expectedErr error
+	}{
+		{
+			testName:    "zero limit",
+			data:        StockFilter{},
+			expectedErr: validators.ErrIncorrectLimit,
+		},
+		{
+			testName:    "negative offset",
+			data:        StockFilter{Limit: 10, Offset: -1},
+			expectedErr: validators.ErrIncorrectOffset,
+		},
+		{
+			testName:    "filter without condition",
+			data:        StockFilter{Limit: 10, Filters: []AttributeFilter{{Code: "case_material"}}},
+			expectedErr: validators.ErrIncorrectAttributeFilter,
+		},
+		{
+			testName: "filter with value and range",
+			data: StockFilter{Limit: 10, Filters: []AttributeFilter{
+				{Code: "water_resistance", Value: &value, Min: &low}}},
+			expectedErr: validators.ErrIncorrectAttributeFilter,
+		},
+		{
+			testName: "inverted range",
+			data: StockFilter{Limit: 10, Filters: []AttributeFilter{
+				{Code: "water_resistance", Min: &high, Max: &low}}},
+			expectedErr: validators.ErrIncorrectAttributeFilter,
+		},
+		{
+			testName: "correct",
+			data: StockFilter{Limit: 10, Filters: []AttributeFilter{
+				{Code: "case_material", Value: &value}, {Code: "water_resistance", Min: &low}}},
+			expectedErr: nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		d := tc.data
+		t.Run(tc.testName, func(t *testing.T) {
+			if !errors.Is(d.Validate(), tc.expectedErr) {
+				t.Fail()
+			}
+		})
+	}
+}
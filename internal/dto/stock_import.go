@@ -0,0 +1,32 @@
+package dto
+
+import (
+	"github.com/lazylex/watch-store-store/internal/domain/value_objects/conflict"
+	"github.com/lazylex/watch-store-store/internal/dto/validators"
+)
+
+// StockImport загрузка товаров в ассортимент. Conflict - действие для товаров, уже имеющихся в ассортименте. ChunkSize
+// - количество строк, применяемых в одной транзакции: при 0 загрузка применяется целиком или (при наличии ошибочных
+// строк) не применяется вовсе, иначе ошибочные строки пропускаются, а части применяются независимо друг от друга.
+// При DryRun загрузка только проверяется.
+type StockImport struct {
+	Rows      []StockImportRow
+	Conflict  conflict.Policy
+	ChunkSize uint
+	DryRun    bool
+}
+
+// Validate валидация корректности сохраненных в DTO данных. Строки загрузки не проверяются - ошибки в них
+// возвращаются в отчёте о загрузке.
+func (s *StockImport) Validate() error {
+	if len(s.Rows) == 0 {
+		return validators.ErrNoImportRows
+	}
+	if len(s.Rows) > validators.MaxImportRows {
+		return validators.ErrTooManyImportRows
+	}
+	if err := validators.ConflictPolicy(s.Conflict); err != nil {
+		return err
+	}
+	return validators.ChunkSize(s.ChunkSize)
+}
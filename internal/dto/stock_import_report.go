@@ -0,0 +1,52 @@
+package dto
+
+import "github.com/lazylex/watch-store-store/internal/domain/value_objects/article"
+
+// ImportStatus результат обработки строки загрузки товаров.
+type ImportStatus string
+
+const (
+	ImportCreated ImportStatus = "created" // товар добавлен в ассортимент
+	ImportUpdated ImportStatus = "updated" // данные имеющегося товара заменены
+	ImportSkipped ImportStatus = "skipped" // товар уже имеется в ассортименте и не изменялся
+	ImportFailed  ImportStatus = "failed"  // строка содержит ошибку или не была применена
+)
+
+// StockImportReport отчёт о загрузке товаров. Applied - была ли загрузка применена. При проверке загрузки или если
+// загрузка целиком не применена из-за ошибочных строк, состояния строк и их количества показывают, что произошло бы
+// при её применении.
+type StockImportReport struct {
+	DryRun  bool                   `json:"dry_run"`
+	Applied bool                   `json:"applied"`
+	Total   uint                   `json:"total"`
+	Created uint                   `json:"created"`
+	Updated uint                   `json:"updated"`
+	Skipped uint                   `json:"skipped"`
+	Failed  uint                   `json:"failed"`
+	Rows    []StockImportRowResult `json:"rows"`
+}
+
+// StockImportRowResult результат обработки строки загрузки товаров.
+type StockImportRowResult struct {
+	Line    int             `json:"line"`
+	Article article.Article `json:"article"`
+	Status  ImportStatus    `json:"status"`
+	Error   string          `json:"error,omitempty"`
+}
+
+// Count подсчитывает количество строк в каждом состоянии.
+func (r *StockImportReport) Count() {
+	r.Total, r.Created, r.Updated, r.Skipped, r.Failed = uint(len(r.Rows)), 0, 0, 0, 0
+	for _, row := range r.Rows {
+		switch row.Status {
+		case ImportCreated:
+			r.Created++
+		case ImportUpdated:
+			r.Updated++
+		case ImportSkipped:
+			r.Skipped++
+		case ImportFailed:
+			r.Failed++
+		}
+	}
+}
@@ -0,0 +1,9 @@
+package dto
+
+// StockImportRow строка загрузки товаров. Line - номер строки в загружаемом файле, Err - ошибка разбора строки (если
+// строку не удалось преобразовать в запись о товаре, Record содержит только разобранные поля).
+type StockImportRow struct {
+	Line   int
+	Record ArticlePriceNameAmount
+	Err    error
+}
@@ -0,0 +1,53 @@
+package dto
+
+import (
+	"errors"
+	"github.com/lazylex/watch-store-store/internal/domain/value_objects/conflict"
+	"github.com/lazylex/watch-store-store/internal/dto/validators"
+	"testing"
+)
+
+func TestStockImportDTO(t *testing.T) {
+	rows := []StockImportRow{{Line: 1, Record: ArticlePriceNameAmount{Article: "CA-F91W"}}}
+
+	testCases := []struct {
+		testName    string
+		data        StockImport
+		expectedErr error
+	}{
+		{
+			testName:    "no rows",
+			data:        StockImport{Conflict: conflict.Reject},
+			expectedErr: validators.ErrNoImportRows,
+		},
+		{
+			testName:    "too many rows",
+			data:        StockImport{Rows: make([]StockImportRow, validators.MaxImportRows+1), Conflict: conflict.Reject},
+			expectedErr: validators.ErrTooManyImportRows,
+		},
+		{
+			testName:    "no conflict policy",
+			data:        StockImport{Rows: rows},
+			expectedErr: validators.ErrIncorrectConflictPolicy,
+		},
+		{
+			testName:    "too big chunk",
+			data:        StockImport{Rows: rows, Conflict: conflict.Skip, ChunkSize: validators.MaxImportRows + 1},
+			expectedErr: validators.ErrIncorrectChunkSize,
+		},
+		{
+			testName:    "incorrect row is not validated",
+			data:        StockImport{Rows: rows, Conflict: conflict.Update, ChunkSize: 100},
+			expectedErr: nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		d := tc.data
+		t.Run(tc.testName, func(t *testing.T) {
+			if !errors.Is(d.Validate(), tc.expectedErr) {
+				t.Fail()
+			}
+		})
+	}
+}
@@ -0,0 +1,35 @@
+package dto
+
+import (
+	"github.com/lazylex/watch-store-store/internal/domain/value_objects/article"
+	"github.com/lazylex/watch-store-store/internal/domain/value_objects/location"
+	"github.com/lazylex/watch-store-store/internal/dto/validators"
+)
+
+// StockTransfer перемещение Amount единиц товара с места хранения From на место хранения To.
+type StockTransfer struct {
+	Article article.Article   `json:"article"`
+	From    location.Location `json:"from"`
+	To      location.Location `json:"to"`
+	Amount  uint              `json:"amount"`
+}
+
+// Validate валидация корректности сохраненных в DTO данных.
+func (s *StockTransfer) Validate() error {
+	if err := validators.Article(s.Article); err != nil {
+		return err
+	}
+	if err := validators.Location(s.From); err != nil {
+		return err
+	}
+	if err := validators.Location(s.To); err != nil {
+		return err
+	}
+	if s.From == s.To {
+		return validators.ErrSameLocation
+	}
+	if s.Amount == 0 {
+		return validators.ErrZeroAmount
+	}
+	return nil
+}
@@ -0,0 +1,50 @@
+package dto
+
+import (
+	"errors"
+	"github.com/lazylex/watch-store-store/internal/dto/validators"
+	"testing"
+)
+
+func TestStockTransferDTO(t *testing.T) {
+	testCases := []struct {
+		testName    string
+		data        StockTransfer
+		expectedErr error
+	}{
+		{
+			testName:    "incorrect article",
+			data:        StockTransfer{Article: "", From: "back_room", To: "showcase", Amount: 1},
+			expectedErr: validators.ErrIncorrectArticle,
+		},
+		{
+			testName:    "unknown location",
+			data:        StockTransfer{Article: "ca-09", From: "attic", To: "showcase", Amount: 1},
+			expectedErr: validators.ErrIncorrectLocation,
+		},
+		{
+			testName:    "same location",
+			data:        StockTransfer{Article: "ca-09", From: "showcase", To: "showcase", Amount: 1},
+			expectedErr: validators.ErrSameLocation,
+		},
+		{
+			testName:    "zero amount",
+			data:        StockTransfer{Article: "ca-09", From: "back_room", To: "showcase"},
+			expectedErr: validators.ErrZeroAmount,
+		},
+		{
+			testName:    "correct",
+			data:        StockTransfer{Article: "ca-09", From: "back_room", To: "repair_desk", Amount: 2},
+			expectedErr: nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		d := tc.data
+		t.Run(tc.testName, func(t *testing.T) {
+			if !errors.Is(d.Validate(), tc.expectedErr) {
+				t.Fail()
+			}
+		})
+	}
+}
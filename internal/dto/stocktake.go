@@ -0,0 +1,45 @@
+package dto
+
+import (
+	"github.com/lazylex/watch-store-store/internal/domain/aggregates/stocktake"
+	"github.com/lazylex/watch-store-store/internal/domain/value_objects/article"
+	"time"
+)
+
+// Stocktake инвентаризация вместе с подсчитываемыми товарами. После применения инвентаризации хранится как отчёт о
+// внесённых в остатки корректировках.
+type Stocktake struct {
+	ID        stocktake.ID    `json:"stocktake_id"`
+	State     stocktake.State `json:"state"`
+	StartedAt time.Time       `json:"started_at"`
+	AppliedAt time.Time       `json:"applied_at"`
+	Items     []StocktakeItem `json:"items"`
+}
+
+// StocktakeItem подсчитываемый при инвентаризации товар. SystemAmount - количество товара в магазине по учёту на
+// момент начала инвентаризации: доступное к продаже вместе с зарезервированным под заказы, товар которых ещё находится
+// в магазине (Reserved). Movement - изменение этого количества во время подсчёта (продажи, возвраты, поставки,
+// корректировки, перемещения, отправка заказов). Expected - количество, которое должно было быть подсчитано,
+// Difference - расхождение подсчитанного с ожидаемым.
+type StocktakeItem struct {
+	Article      article.Article `json:"article"`
+	SystemAmount uint            `json:"system_amount"`
+	Reserved     uint            `json:"reserved"`
+	Counted      uint            `json:"counted"`
+	IsCounted    bool            `json:"is_counted"`
+	Movement     int             `json:"movement"`
+	Expected     uint            `json:"expected"`
+	Difference   int             `json:"difference"`
+}
+
+// CalculateDiscrepancy рассчитывает ожидаемое количество товара с учётом его изменения во время подсчёта и
+// расхождение с подсчитанным количеством. Для неподсчитанного товара расхождение считается нулевым.
+func (s *StocktakeItem) CalculateDiscrepancy() {
+	expected := int(s.SystemAmount) + s.Movement
+	s.Expected = uint(max(expected, 0))
+
+	s.Difference = 0
+	if s.IsCounted {
+		s.Difference = int(s.Counted) - int(s.Expected)
+	}
+}
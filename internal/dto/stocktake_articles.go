@@ -0,0 +1,28 @@
+package dto
+
+import (
+	"github.com/lazylex/watch-store-store/internal/domain/value_objects/article"
+	"github.com/lazylex/watch-store-store/internal/dto/validators"
+)
+
+// StocktakeArticles артикулы товаров, подсчитываемых при инвентаризации. Пустой список означает инвентаризацию всех
+// товаров, имеющихся в ассортименте.
+type StocktakeArticles struct {
+	Articles []article.Article `json:"articles"`
+}
+
+// Validate валидация корректности сохраненных в DTO данных.
+func (s *StocktakeArticles) Validate() error {
+	articles := make(map[article.Article]struct{})
+	for _, a := range s.Articles {
+		if err := validators.Article(a); err != nil {
+			return err
+		}
+		if _, ok := articles[a]; ok {
+			return validators.ErrDuplicateArticlesInStocktake
+		}
+		articles[a] = struct{}{}
+	}
+
+	return nil
+}
@@ -0,0 +1,38 @@
+package dto
+
+import (
+	"github.com/lazylex/watch-store-store/internal/domain/aggregates/stocktake"
+	"github.com/lazylex/watch-store-store/internal/domain/value_objects/article"
+	"github.com/lazylex/watch-store-store/internal/dto/validators"
+)
+
+// StocktakeCounts очередная порция подсчитанного при инвентаризации товара. Количество прибавляется к уже
+// подсчитанному ранее, что позволяет вести подсчёт частями (например, по разным витринам).
+type StocktakeCounts struct {
+	ID     stocktake.ID    `json:"stocktake_id"`
+	Counts []ArticleAmount `json:"counts"`
+}
+
+// Validate валидация корректности сохраненных в DTO данных.
+func (s *StocktakeCounts) Validate() error {
+	if err := validators.StocktakeID(s.ID); err != nil {
+		return err
+	}
+
+	if len(s.Counts) == 0 {
+		return validators.ErrNoCountsInStocktake
+	}
+
+	articles := make(map[article.Article]struct{})
+	for _, c := range s.Counts {
+		if err := c.Validate(); err != nil {
+			return err
+		}
+		if _, ok := articles[c.Article]; ok {
+			return validators.ErrDuplicateArticlesInStocktake
+		}
+		articles[c.Article] = struct{}{}
+	}
+
+	return nil
+}
@@ -0,0 +1,15 @@
+package dto
+
+import (
+	"github.com/lazylex/watch-store-store/internal/domain/aggregates/stocktake"
+	"github.com/lazylex/watch-store-store/internal/dto/validators"
+)
+
+type StocktakeID struct {
+	ID stocktake.ID `json:"stocktake_id"`
+}
+
+// Validate валидация корректности сохраненных в DTO данных.
+func (s *StocktakeID) Validate() error {
+	return validators.StocktakeID(s.ID)
+}
@@ -0,0 +1,98 @@
+package dto
+
+import (
+	"errors"
+	"github.com/lazylex/watch-store-store/internal/domain/value_objects/article"
+	"github.com/lazylex/watch-store-store/internal/dto/validators"
+	"testing"
+)
+
+func TestStocktakeArticlesDTO(t *testing.T) {
+	testCases := []struct {
+		testName    string
+		articles    StocktakeArticles
+		expectedErr error
+	}{
+		{
+			testName:    "all articles",
+			articles:    StocktakeArticles{},
+			expectedErr: nil,
+		},
+		{
+			testName:    "incorrect article",
+			articles:    StocktakeArticles{Articles: []article.Article{""}},
+			expectedErr: validators.ErrIncorrectArticle,
+		},
+		{
+			testName:    "duplicate articles",
+			articles:    StocktakeArticles{Articles: []article.Article{"ca-09", "ca-09"}},
+			expectedErr: validators.ErrDuplicateArticlesInStocktake,
+		},
+	}
+
+	for _, tc := range testCases {
+		a := tc.articles
+		t.Run(tc.testName, func(t *testing.T) {
+			if !errors.Is(a.Validate(), tc.expectedErr) {
+				t.Fail()
+			}
+		})
+	}
+}
+
+func TestStocktakeCountsDTO(t *testing.T) {
+	testCases := []struct {
+		testName    string
+		counts      StocktakeCounts
+		expectedErr error
+	}{
+		{
+			testName:    "incorrect id",
+			counts:      StocktakeCounts{ID: 0, Counts: []ArticleAmount{{Article: "ca-09", Amount: 1}}},
+			expectedErr: validators.ErrIncorrectStocktakeID,
+		},
+		{
+			testName:    "no counts",
+			counts:      StocktakeCounts{ID: 1},
+			expectedErr: validators.ErrNoCountsInStocktake,
+		},
+		{
+			testName: "duplicate articles",
+			counts: StocktakeCounts{ID: 1,
+				Counts: []ArticleAmount{{Article: "ca-09", Amount: 1}, {Article: "ca-09", Amount: 0}}},
+			expectedErr: validators.ErrDuplicateArticlesInStocktake,
+		},
+		{
+			testName:    "zero counted",
+			counts:      StocktakeCounts{ID: 1, Counts: []ArticleAmount{{Article: "ca-09", Amount: 0}}},
+			expectedErr: nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		c := tc.counts
+		t.Run(tc.testName, func(t *testing.T) {
+			if !errors.Is(c.Validate(), tc.expectedErr) {
+				t.Fail()
+			}
+		})
+	}
+}
+
+func TestStocktakeItem_CalculateDiscrepancy(t *testing.T) {
+	t.Run("sold during count", func(t *testing.T) {
+		item := StocktakeItem{SystemAmount: 10, Movement: -2, Counted: 7, IsCounted: true}
+		item.CalculateDiscrepancy()
+		if item.Expected != 8 || item.Difference != -1 {
+			t.Fail()
+		}
+	})
+
+	t.Run("not counted", func(t *testing.T) {
+		item := StocktakeItem{SystemAmount: 10}
+		item.CalculateDiscrepancy()
+		if item.Expected != 10 || item.Difference != 0 {
+			t.Fail()
+		}
+	})
+}
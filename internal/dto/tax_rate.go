@@ -0,0 +1,26 @@
+package dto
+
+import (
+	"github.com/lazylex/watch-store-store/internal/domain/value_objects/tax"
+	"github.com/lazylex/watch-store-store/internal/dto/validators"
+	"time"
+)
+
+// TaxRate ставка НДС в процентах для налоговой категории товаров, действующая с момента EffectiveFrom до начала
+// действия следующей ставки той же категории.
+type TaxRate struct {
+	Category      tax.Category `json:"category"`
+	Rate          float64      `json:"rate"`
+	EffectiveFrom time.Time    `json:"effective_from"`
+}
+
+// Validate валидация корректности сохраненных в DTO данных.
+func (t *TaxRate) Validate() error {
+	if err := validators.TaxCategory(t.Category); err != nil {
+		return err
+	}
+	if err := validators.TaxRate(t.Rate); err != nil {
+		return err
+	}
+	return validators.EffectiveDate(t.EffectiveFrom)
+}
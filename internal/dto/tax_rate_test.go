@@ -0,0 +1,63 @@
+package dto
+
+import (
+	"errors"
+	"github.com/lazylex/watch-store-store/internal/dto/validators"
+	"testing"
+	"time"
+)
+
+func TestTaxRateDTO(t *testing.T) {
+	from := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	testCases := []struct {
+		testName    string
+		rate        TaxRate
+		expectedErr error
+	}{
+		{
+			testName:    "correct",
+			rate:        TaxRate{Category: "standard", Rate: 20, EffectiveFrom: from},
+			expectedErr: nil,
+		},
+		{
+			testName:    "zero rate",
+			rate:        TaxRate{Category: "exempt", Rate: 0, EffectiveFrom: from},
+			expectedErr: nil,
+		},
+		{
+			testName:    "incorrect category",
+			rate:        TaxRate{Category: "Standard", Rate: 20, EffectiveFrom: from},
+			expectedErr: validators.ErrIncorrectTaxCategory,
+		},
+		{
+			testName:    "incorrect rate",
+			rate:        TaxRate{Category: "standard", Rate: 120, EffectiveFrom: from},
+			expectedErr: validators.ErrIncorrectTaxRate,
+		},
+		{
+			testName:    "no effective date",
+			rate:        TaxRate{Category: "standard", Rate: 20},
+			expectedErr: validators.ErrIncorrectEffectiveDate,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.testName, func(t *testing.T) {
+			if !errors.Is(tc.rate.Validate(), tc.expectedErr) {
+				t.Fail()
+			}
+		})
+	}
+}
+
+func TestArticleTaxCategoryDTO(t *testing.T) {
+	a := ArticleTaxCategory{Article: "CA-F91W", Category: "reduced"}
+	if a.Validate() != nil {
+		t.Fail()
+	}
+
+	a.Category = ""
+	if !errors.Is(a.Validate(), validators.ErrIncorrectTaxCategory) {
+		t.Fail()
+	}
+}
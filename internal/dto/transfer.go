@@ -0,0 +1,83 @@
+package dto
+
+import (
+	"github.com/lazylex/watch-store-store/internal/domain/aggregates/transfer"
+	"github.com/lazylex/watch-store-store/internal/domain/value_objects/article"
+	"github.com/lazylex/watch-store-store/internal/dto/validators"
+	"time"
+)
+
+// Transfer перемещение товара между магазинами (экземплярами приложения). Counterpart - название экземпляра-получателя
+// для исходящего перемещения и экземпляра-отправителя для входящего. SourceID - идентификатор перемещения у
+// отправителя, заполняется только для входящих перемещений. Notified - признак того, что второй стороне перемещения
+// отправлено сообщение о его создании (для исходящего) или приёмке (для входящего).
+type Transfer struct {
+	ID          transfer.ID        `json:"transfer_id"`
+	Direction   transfer.Direction `json:"direction"`
+	Counterpart string             `json:"counterpart"`
+	SourceID    transfer.ID        `json:"source_transfer_id,omitempty"`
+	State       transfer.State     `json:"state"`
+	CreatedAt   time.Time          `json:"created_at"`
+	UpdatedAt   time.Time          `json:"updated_at"`
+	Notified    bool               `json:"-"`
+	Items       []TransferItem     `json:"items,omitempty"`
+}
+
+// TransferItem перемещаемый товар. Amount - отправленное количество, Received - принятое получателем. Name и Price
+// передаются получателю для создания записи о товаре, отсутствующем в его ассортименте.
+type TransferItem struct {
+	Article  article.Article `json:"article"`
+	Name     string          `json:"name"`
+	Price    float64         `json:"price"`
+	Amount   uint            `json:"amount"`
+	Received uint            `json:"received_amount"`
+}
+
+// Validate валидация корректности сохраненных в DTO данных. Используется для поступившего от отправителя входящего
+// перемещения.
+func (t *Transfer) Validate() error {
+	if err := validators.Instance(t.Counterpart); err != nil {
+		return err
+	}
+	if err := validators.TransferID(t.SourceID); err != nil {
+		return err
+	}
+	if len(t.Items) == 0 {
+		return validators.ErrNoItemsInTransfer
+	}
+
+	articles := make(map[article.Article]struct{})
+	for _, item := range t.Items {
+		if err := validators.Article(item.Article); err != nil {
+			return err
+		}
+		if err := validators.Amount(item.Amount); err != nil {
+			return err
+		}
+		if item.Price < 0 {
+			return validators.ErrNegativePrice
+		}
+		if _, ok := articles[item.Article]; ok {
+			return validators.ErrDuplicateArticlesInTransfer
+		}
+		articles[item.Article] = struct{}{}
+	}
+
+	return nil
+}
+
+// HasDiscrepancy возвращает true, если принятое количество хотя бы одного товара отличается от отправленного.
+func (t *Transfer) HasDiscrepancy() bool {
+	for _, item := range t.Items {
+		if item.Amount != item.Received {
+			return true
+		}
+	}
+	return false
+}
+
+// NewStockRecord возвращает запись о новом товаре, создаваемую при приёмке перемещённого товара, отсутствующего в
+// ассортименте.
+func (i *TransferItem) NewStockRecord() ArticlePriceNameAmount {
+	return ArticlePriceNameAmount{Article: i.Article, Name: i.Name, Price: i.Price, Amount: i.Received}
+}
@@ -0,0 +1,34 @@
+package dto
+
+import (
+	"github.com/lazylex/watch-store-store/internal/domain/aggregates/transfer"
+	"github.com/lazylex/watch-store-store/internal/domain/value_objects/article"
+	"github.com/lazylex/watch-store-store/internal/dto/validators"
+)
+
+// TransferConfirmation подтверждение приёмки входящего перемещения ID с фактически принятым количеством товаров.
+// Товары перемещения, отсутствующие в Items, считаются не поступившими (принятое количество равно нулю).
+type TransferConfirmation struct {
+	ID    transfer.ID     `json:"transfer_id"`
+	Items []ArticleAmount `json:"items"`
+}
+
+// Validate валидация корректности сохраненных в DTO данных.
+func (t *TransferConfirmation) Validate() error {
+	if err := validators.TransferID(t.ID); err != nil {
+		return err
+	}
+
+	articles := make(map[article.Article]struct{})
+	for _, item := range t.Items {
+		if err := item.Validate(); err != nil {
+			return err
+		}
+		if _, ok := articles[item.Article]; ok {
+			return validators.ErrDuplicateArticlesInTransfer
+		}
+		articles[item.Article] = struct{}{}
+	}
+
+	return nil
+}
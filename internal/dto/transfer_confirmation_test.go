@@ -0,0 +1,51 @@
+package dto
+
+import (
+	"errors"
+	"github.com/lazylex/watch-store-store/internal/dto/validators"
+	"testing"
+)
+
+func TestTransferConfirmationDTO(t *testing.T) {
+	testCases := []struct {
+		testName    string
+		data        TransferConfirmation
+		expectedErr error
+	}{
+		{
+			testName:    "incorrect id",
+			data:        TransferConfirmation{Items: []ArticleAmount{{Article: "ca-09", Amount: 1}}},
+			expectedErr: validators.ErrIncorrectTransferID,
+		},
+		{
+			testName:    "incorrect article",
+			data:        TransferConfirmation{ID: 1, Items: []ArticleAmount{{Article: "", Amount: 1}}},
+			expectedErr: validators.ErrIncorrectArticle,
+		},
+		{
+			testName: "duplicate articles",
+			data: TransferConfirmation{ID: 1,
+				Items: []ArticleAmount{{Article: "ca-09", Amount: 1}, {Article: "ca-09"}}},
+			expectedErr: validators.ErrDuplicateArticlesInTransfer,
+		},
+		{
+			testName:    "nothing received",
+			data:        TransferConfirmation{ID: 1},
+			expectedErr: nil,
+		},
+		{
+			testName:    "correct",
+			data:        TransferConfirmation{ID: 1, Items: []ArticleAmount{{Article: "ca-09", Amount: 0}}},
+			expectedErr: nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		d := tc.data
+		t.Run(tc.testName, func(t *testing.T) {
+			if !errors.Is(d.Validate(), tc.expectedErr) {
+				t.Fail()
+			}
+		})
+	}
+}
@@ -0,0 +1,27 @@
+package dto
+
+import (
+	"github.com/lazylex/watch-store-store/internal/domain/aggregates/transfer"
+	"github.com/lazylex/watch-store-store/internal/dto/validators"
+)
+
+// TransferFilter условия отбора перемещений товара между магазинами. Незаданные (пустые) условия не применяются.
+type TransferFilter struct {
+	Direction transfer.Direction `json:"direction"`
+	State     transfer.State     `json:"state"`
+}
+
+// Validate валидация корректности сохраненных в DTO данных.
+func (t *TransferFilter) Validate() error {
+	if t.Direction != "" {
+		if err := validators.TransferDirection(t.Direction); err != nil {
+			return err
+		}
+	}
+	if t.State != 0 {
+		if err := validators.TransferState(t.State); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,15 @@
+package dto
+
+import (
+	"github.com/lazylex/watch-store-store/internal/domain/aggregates/transfer"
+	"github.com/lazylex/watch-store-store/internal/dto/validators"
+)
+
+type TransferID struct {
+	ID transfer.ID `json:"transfer_id"`
+}
+
+// Validate валидация корректности сохраненных в DTO данных.
+func (t *TransferID) Validate() error {
+	return validators.TransferID(t.ID)
+}
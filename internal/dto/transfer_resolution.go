@@ -0,0 +1,18 @@
+package dto
+
+import (
+	"github.com/lazylex/watch-store-store/internal/domain/aggregates/transfer"
+	"github.com/lazylex/watch-store-store/internal/dto/validators"
+)
+
+// TransferResolution урегулирование расхождения исходящего перемещения ID. При Restock недостача (не принятый
+// получателем товар) возвращается в остатки отправителя, иначе считается списанной.
+type TransferResolution struct {
+	ID      transfer.ID `json:"transfer_id"`
+	Restock bool        `json:"restock"`
+}
+
+// Validate валидация корректности сохраненных в DTO данных.
+func (t *TransferResolution) Validate() error {
+	return validators.TransferID(t.ID)
+}
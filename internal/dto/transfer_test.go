@@ -0,0 +1,75 @@
+package dto
+
+import (
+	"errors"
+	"github.com/lazylex/watch-store-store/internal/dto/validators"
+	"testing"
+)
+
+func TestTransferDTO(t *testing.T) {
+	testCases := []struct {
+		testName    string
+		data        Transfer
+		expectedErr error
+	}{
+		{
+			testName:    "empty sender",
+			data:        Transfer{SourceID: 1, Items: []TransferItem{{Article: "ca-09", Amount: 1}}},
+			expectedErr: validators.ErrIncorrectInstance,
+		},
+		{
+			testName:    "no source id",
+			data:        Transfer{Counterpart: "store-1", Items: []TransferItem{{Article: "ca-09", Amount: 1}}},
+			expectedErr: validators.ErrIncorrectTransferID,
+		},
+		{
+			testName:    "no items",
+			data:        Transfer{Counterpart: "store-1", SourceID: 1},
+			expectedErr: validators.ErrNoItemsInTransfer,
+		},
+		{
+			testName:    "zero amount",
+			data:        Transfer{Counterpart: "store-1", SourceID: 1, Items: []TransferItem{{Article: "ca-09"}}},
+			expectedErr: validators.ErrZeroAmount,
+		},
+		{
+			testName: "negative price",
+			data: Transfer{Counterpart: "store-1", SourceID: 1,
+				Items: []TransferItem{{Article: "ca-09", Amount: 1, Price: -1}}},
+			expectedErr: validators.ErrNegativePrice,
+		},
+		{
+			testName: "duplicate articles",
+			data: Transfer{Counterpart: "store-1", SourceID: 1,
+				Items: []TransferItem{{Article: "ca-09", Amount: 1}, {Article: "ca-09", Amount: 2}}},
+			expectedErr: validators.ErrDuplicateArticlesInTransfer,
+		},
+		{
+			testName: "correct",
+			data: Transfer{Counterpart: "store-1", SourceID: 1,
+				Items: []TransferItem{{Article: "ca-09", Name: "watch", Price: 10, Amount: 1}}},
+			expectedErr: nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		d := tc.data
+		t.Run(tc.testName, func(t *testing.T) {
+			if !errors.Is(d.Validate(), tc.expectedErr) {
+				t.Fail()
+			}
+		})
+	}
+}
+
+func TestTransferHasDiscrepancy(t *testing.T) {
+	data := Transfer{Items: []TransferItem{{Article: "ca-09", Amount: 2, Received: 2}}}
+	if data.HasDiscrepancy() {
+		t.Fail()
+	}
+
+	data.Items = append(data.Items, TransferItem{Article: "ca-10", Amount: 1})
+	if !data.HasDiscrepancy() {
+		t.Fail()
+	}
+}
@@ -2,10 +2,34 @@ package validators
 
 import (
 	"errors"
+	"github.com/lazylex/watch-store-store/internal/domain/aggregates/receipt"
 	"github.com/lazylex/watch-store-store/internal/domain/aggregates/reservation"
+	"github.com/lazylex/watch-store-store/internal/domain/aggregates/shift"
+	"github.com/lazylex/watch-store-store/internal/domain/aggregates/stocktake"
+	"github.com/lazylex/watch-store-store/internal/domain/aggregates/transfer"
+	"github.com/lazylex/watch-store-store/internal/domain/aggregates/warranty"
+	"github.com/lazylex/watch-store-store/internal/domain/value_objects/adjustment"
 	"github.com/lazylex/watch-store-store/internal/domain/value_objects/article"
+	"github.com/lazylex/watch-store-store/internal/domain/value_objects/attribute"
+	"github.com/lazylex/watch-store-store/internal/domain/value_objects/barcode"
+	"github.com/lazylex/watch-store-store/internal/domain/value_objects/bucket"
+	"github.com/lazylex/watch-store-store/internal/domain/value_objects/conflict"
+	"github.com/lazylex/watch-store-store/internal/domain/value_objects/location"
+	"github.com/lazylex/watch-store-store/internal/domain/value_objects/measure"
+	"github.com/lazylex/watch-store-store/internal/domain/value_objects/payment"
+	"github.com/lazylex/watch-store-store/internal/domain/value_objects/phone"
+	"github.com/lazylex/watch-store-store/internal/domain/value_objects/pickup"
+	"github.com/lazylex/watch-store-store/internal/domain/value_objects/report"
+	"github.com/lazylex/watch-store-store/internal/domain/value_objects/serial"
+	"github.com/lazylex/watch-store-store/internal/domain/value_objects/tax"
 	"github.com/lazylex/watch-store-store/internal/helpers/constants/prefixes"
+	"math"
+	"net/mail"
+	"regexp"
+	"strconv"
+	"strings"
 	"time"
+	"unicode/utf8"
 )
 
 // dtoErr добавляет к тексту ошибки префикс, указывающий на её принадлежность к DTO.
@@ -14,18 +38,99 @@ func dtoErr(text string) error {
 }
 
 var (
-	ErrIncorrectArticle               = dtoErr("incorrect article")
-	ErrIncorrectState                 = dtoErr("incorrect state")
-	ErrIncorrectOrder                 = dtoErr("incorrect order")
-	ErrNegativePrice                  = dtoErr("negative product price")
-	ErrZeroPrice                      = dtoErr("zero product price")
-	ErrEmptyName                      = dtoErr("empty product name")
-	ErrIncorrectDatesOrder            = dtoErr("incorrect dates order")
-	ErrDatesIsEqual                   = dtoErr("dates is equal")
-	ErrCashRegisterOrder              = dtoErr("order number for cash register can't be higher than 10")
-	ErrOrderForInternetCustomer       = dtoErr("number for internet based orders must be higher than 10")
-	ErrDuplicateProductsInReservation = dtoErr("duplicate products in reservation")
-	ErrNoProductsInReservation        = dtoErr("no products in reservation")
+	ErrIncorrectArticle                = dtoErr("incorrect article")
+	ErrIncorrectState                  = dtoErr("incorrect state")
+	ErrIncorrectOrder                  = dtoErr("incorrect order")
+	ErrNegativePrice                   = dtoErr("negative product price")
+	ErrZeroPrice                       = dtoErr("zero product price")
+	ErrEmptyName                       = dtoErr("empty product name")
+	ErrIncorrectDatesOrder             = dtoErr("incorrect dates order")
+	ErrDatesIsEqual                    = dtoErr("dates is equal")
+	ErrDuplicateProductsInReservation  = dtoErr("duplicate products in reservation")
+	ErrNoProductsInReservation         = dtoErr("no products in reservation")
+	ErrNoProductsInSale                = dtoErr("no products in sale")
+	ErrDuplicateProductsInSale         = dtoErr("duplicate products in sale")
+	ErrIncorrectCashRegister           = dtoErr("incorrect cash register number")
+	ErrIncorrectReceiptID              = dtoErr("incorrect receipt id")
+	ErrIncorrectShiftID                = dtoErr("incorrect shift id")
+	ErrEmptyCashierID                  = dtoErr("empty cashier id")
+	ErrNegativeOpeningFloat            = dtoErr("negative opening float")
+	ErrIncorrectPaymentMethod          = dtoErr("incorrect payment method")
+	ErrZeroAmount                      = dtoErr("zero product amount")
+	ErrNoProductsInRefund              = dtoErr("no products in refund")
+	ErrDuplicateProductsInRefund       = dtoErr("duplicate products in refund")
+	ErrIncorrectStocktakeID            = dtoErr("incorrect stocktake id")
+	ErrDuplicateArticlesInStocktake    = dtoErr("duplicate articles in stocktake")
+	ErrNoCountsInStocktake             = dtoErr("no counted products in stocktake")
+	ErrEmptyDocumentNumber             = dtoErr("empty document number")
+	ErrEmptySupplier                   = dtoErr("empty supplier")
+	ErrNegativeCost                    = dtoErr("negative product cost")
+	ErrNoLinesInGoodsReceipt           = dtoErr("no lines in goods receipt")
+	ErrDuplicateArticlesInGoodsReceipt = dtoErr("duplicate articles in goods receipt")
+	ErrZeroDelta                       = dtoErr("zero amount change")
+	ErrIncorrectAdjustmentReason       = dtoErr("incorrect adjustment reason")
+	ErrIncorrectIdempotencyKey         = dtoErr("incorrect idempotency key")
+	ErrIncorrectIdempotencySubject     = dtoErr("incorrect idempotency key subject")
+	ErrIncorrectBucket                 = dtoErr("incorrect period bucket")
+	ErrIncorrectMeasure                = dtoErr("incorrect measure")
+	ErrIncorrectLimit                  = dtoErr("incorrect limit")
+	ErrIncorrectReport                 = dtoErr("incorrect report kind")
+	ErrIncorrectLeadTime               = dtoErr("incorrect lead time")
+	ErrIncorrectWindow                 = dtoErr("incorrect sales window")
+	ErrIncorrectAttributeCode          = dtoErr("incorrect attribute code")
+	ErrIncorrectAttributeType          = dtoErr("incorrect attribute type")
+	ErrEmptyAttributeValues            = dtoErr("no values in enum attribute")
+	ErrIncorrectAttributeValue         = dtoErr("incorrect attribute value")
+	ErrUnknownAttribute                = dtoErr("unknown attribute")
+	ErrNoAttributes                    = dtoErr("no attributes")
+	ErrIncorrectAttributeFilter        = dtoErr("incorrect attribute filter")
+	ErrIncorrectOffset                 = dtoErr("incorrect offset")
+	ErrIncorrectBarcode                = dtoErr("incorrect barcode")
+	ErrNoBarcodes                      = dtoErr("no barcodes")
+	ErrTooManyBarcodes                 = dtoErr("too many barcodes")
+	ErrDuplicateBarcodes               = dtoErr("duplicate barcodes")
+	ErrIncorrectLocation               = dtoErr("incorrect stock location")
+	ErrSameLocation                    = dtoErr("transfer to the same location")
+	ErrIncorrectInstance               = dtoErr("incorrect store instance")
+	ErrIncorrectTransferID             = dtoErr("incorrect transfer id")
+	ErrIncorrectTransferDirection      = dtoErr("incorrect transfer direction")
+	ErrIncorrectTransferState          = dtoErr("incorrect transfer state")
+	ErrNoItemsInTransfer               = dtoErr("no items in transfer")
+	ErrDuplicateArticlesInTransfer     = dtoErr("duplicate articles in transfer")
+	ErrNoImportRows                    = dtoErr("no rows in import")
+	ErrTooManyImportRows               = dtoErr("too many rows in import")
+	ErrIncorrectConflictPolicy         = dtoErr("incorrect conflict policy")
+	ErrIncorrectChunkSize              = dtoErr("incorrect chunk size")
+	ErrDuplicateArticleInImport        = dtoErr("duplicate article in import")
+	ErrNoPricesInUpdate                = dtoErr("no prices in update")
+	ErrTooManyPricesInUpdate           = dtoErr("too many prices in update")
+	ErrDuplicateArticlesInPriceUpdate  = dtoErr("duplicate articles in price update")
+	ErrAmbiguousPriceUpdate            = dtoErr("both prices and percent change in price update")
+	ErrNotBaseArticle                  = dtoErr("article with defect code passed instead of base article")
+	ErrIncorrectPricePercent           = dtoErr("incorrect price change percent")
+	ErrIncorrectCashRegisterName       = dtoErr("incorrect cash register name")
+	ErrIncorrectCustomerName           = dtoErr("incorrect customer name")
+	ErrIncorrectPhone                  = dtoErr("incorrect phone number")
+	ErrIncorrectEmail                  = dtoErr("incorrect email")
+	ErrIncorrectPickupCode             = dtoErr("incorrect pickup code")
+	ErrCustomerInCashRegisterOrder     = dtoErr("customer data passed for cash register order")
+	ErrIncorrectPaymentAmount          = dtoErr("incorrect payment amount")
+	ErrTooManyPayments                 = dtoErr("too many payments")
+	ErrPaymentMethodWithPayments       = dtoErr("both payment method and payments passed")
+	ErrPaymentsDontMatchTotal          = dtoErr("payments don't match receipt total")
+	ErrIncorrectTaxCategory            = dtoErr("incorrect tax category")
+	ErrIncorrectTaxRate                = dtoErr("incorrect tax rate")
+	ErrIncorrectEffectiveDate          = dtoErr("incorrect effective date")
+	ErrIncorrectSerialNumber           = dtoErr("incorrect serial number")
+	ErrDuplicateSerialNumbers          = dtoErr("duplicate serial numbers")
+	ErrTooManySerialNumbers            = dtoErr("too many serial numbers")
+	ErrSerialsDontMatchAmount          = dtoErr("serial numbers don't match amount")
+	ErrSerialsWhenTrackingDisabled     = dtoErr("serial numbers passed when disabling tracking")
+	ErrIncorrectWarrantyMonths         = dtoErr("incorrect warranty term")
+	ErrIncorrectWarrantyID             = dtoErr("incorrect warranty id")
+	ErrIncorrectClaimID                = dtoErr("incorrect warranty claim id")
+	ErrIncorrectClaimDescription       = dtoErr("incorrect warranty claim description")
+	ErrIncorrectClaimStatus            = dtoErr("incorrect warranty claim status")
 )
 
 // Article функция валидации артикула.
@@ -85,8 +190,514 @@ func Name(name string) error {
 
 // OrderNumber функция валидации номера заказа.
 func OrderNumber(order reservation.OrderNumber) error {
-	if int(order) <= 0 {
+	if order <= 0 {
 		return ErrIncorrectOrder
 	}
 	return nil
 }
+
+// CashRegister функция валидации номера кассы.
+func CashRegister(number reservation.OrderNumber) error {
+	if number <= 0 {
+		return ErrIncorrectCashRegister
+	}
+	return nil
+}
+
+// ReceiptID функция валидации идентификатора чека.
+func ReceiptID(id receipt.ID) error {
+	if id <= 0 {
+		return ErrIncorrectReceiptID
+	}
+	return nil
+}
+
+// ShiftID функция валидации идентификатора кассовой смены.
+func ShiftID(id shift.ID) error {
+	if id <= 0 {
+		return ErrIncorrectShiftID
+	}
+	return nil
+}
+
+// CashierID функция валидации идентификатора кассира.
+func CashierID(id string) error {
+	if id == "" {
+		return ErrEmptyCashierID
+	}
+	return nil
+}
+
+// OpeningFloat функция валидации суммы размена, находящейся в кассе при открытии смены.
+func OpeningFloat(float float64) error {
+	if float < 0 {
+		return ErrNegativeOpeningFloat
+	}
+	return nil
+}
+
+// PaymentMethod функция валидации способа оплаты.
+func PaymentMethod(method payment.Method) error {
+	for _, m := range payment.Methods() {
+		if m == method {
+			return nil
+		}
+	}
+	return ErrIncorrectPaymentMethod
+}
+
+// Amount функция валидации количества товара в операциях, где нулевое количество не имеет смысла.
+func Amount(amount uint) error {
+	if amount == 0 {
+		return ErrZeroAmount
+	}
+	return nil
+}
+
+// StocktakeID функция валидации идентификатора инвентаризации.
+func StocktakeID(id stocktake.ID) error {
+	if id <= 0 {
+		return ErrIncorrectStocktakeID
+	}
+	return nil
+}
+
+// DocumentNumber функция валидации номера сопроводительного документа поставки.
+func DocumentNumber(number string) error {
+	if number == "" {
+		return ErrEmptyDocumentNumber
+	}
+	return nil
+}
+
+// Supplier функция валидации наименования поставщика.
+func Supplier(supplier string) error {
+	if supplier == "" {
+		return ErrEmptySupplier
+	}
+	return nil
+}
+
+// Cost функция валидации закупочной стоимости товара.
+func Cost(cost float64) error {
+	if cost < 0 {
+		return ErrNegativeCost
+	}
+	return nil
+}
+
+// Delta функция валидации относительного изменения количества товара.
+func Delta(delta int) error {
+	if delta == 0 {
+		return ErrZeroDelta
+	}
+	return nil
+}
+
+// AdjustmentReason функция валидации кода причины корректировки. Код должен содержаться в списке допустимых.
+func AdjustmentReason(reason adjustment.Reason, allowed []adjustment.Reason) error {
+	for _, r := range allowed {
+		if r == reason {
+			return nil
+		}
+	}
+	return ErrIncorrectAdjustmentReason
+}
+
+// maxIdempotencyKeyLength максимальная длина ключа идемпотентности.
+const maxIdempotencyKeyLength = 255
+
+// IdempotencyKey функция валидации ключа идемпотентности. Ключ не может быть пустым или длиннее
+// maxIdempotencyKeyLength символов.
+func IdempotencyKey(key string) error {
+	if ln := len([]rune(key)); ln == 0 || ln > maxIdempotencyKeyLength {
+		return ErrIncorrectIdempotencyKey
+	}
+	return nil
+}
+
+// IdempotencySubject функция валидации субъекта ключа идемпотентности. Субъект может быть пустым, но не длиннее
+// maxIdempotencyKeyLength символов.
+func IdempotencySubject(subject string) error {
+	if len([]rune(subject)) > maxIdempotencyKeyLength {
+		return ErrIncorrectIdempotencySubject
+	}
+	return nil
+}
+
+// Bucket функция валидации размера интервала группировки продаж.
+func Bucket(b bucket.Bucket) error {
+	for _, v := range bucket.Buckets() {
+		if v == b {
+			return nil
+		}
+	}
+	return ErrIncorrectBucket
+}
+
+// Measure функция валидации показателя ранжирования товаров.
+func Measure(m measure.Measure) error {
+	for _, v := range measure.Measures() {
+		if v == m {
+			return nil
+		}
+	}
+	return ErrIncorrectMeasure
+}
+
+// MaxLimit максимальное количество записей, возвращаемых в рейтингах.
+const MaxLimit = 100
+
+// Limit функция валидации количества возвращаемых записей. Допустимы значения от 1 до MaxLimit.
+func Limit(limit int) error {
+	if limit < 1 || limit > MaxLimit {
+		return ErrIncorrectLimit
+	}
+	return nil
+}
+
+// Report функция валидации вида отчёта о продажах.
+func Report(k report.Kind) error {
+	for _, v := range report.Kinds() {
+		if v == k {
+			return nil
+		}
+	}
+	return ErrIncorrectReport
+}
+
+// MaxDays максимальное количество дней в сроке поставки и периоде расчёта скорости продаж.
+const MaxDays = 365
+
+// LeadTime функция валидации срока поставки товара в днях. Допустимы значения от 1 до MaxDays.
+func LeadTime(days uint) error {
+	if days < 1 || days > MaxDays {
+		return ErrIncorrectLeadTime
+	}
+	return nil
+}
+
+// Window функция валидации периода расчёта скорости продаж в днях. Допустимы значения от 1 до MaxDays.
+func Window(days uint) error {
+	if days < 1 || days > MaxDays {
+		return ErrIncorrectWindow
+	}
+	return nil
+}
+
+// MaxAttributeValueLength максимальная длина строкового значения атрибута товара.
+const MaxAttributeValueLength = 255
+
+// attributeCodeRegexp допустимый формат кода атрибута: латинские буквы в нижнем регистре, цифры и подчёркивание,
+// начиная с буквы.
+var attributeCodeRegexp = regexp.MustCompile(`^[a-z][a-z0-9_]{0,49}$`)
+
+// AttributeCode функция валидации кода атрибута товара.
+func AttributeCode(code attribute.Code) error {
+	if !attributeCodeRegexp.MatchString(string(code)) {
+		return ErrIncorrectAttributeCode
+	}
+	return nil
+}
+
+// AttributeType функция валидации типа атрибута товара.
+func AttributeType(t attribute.Type) error {
+	for _, v := range attribute.Types() {
+		if v == t {
+			return nil
+		}
+	}
+	return ErrIncorrectAttributeType
+}
+
+// AttributeValue функция валидации значения атрибута товара типа t. Для перечислимого типа значение должно совпадать с
+// одним из значений allowed.
+func AttributeValue(t attribute.Type, allowed []string, value string) error {
+	switch t {
+	case attribute.String:
+		if value == "" || len([]rune(value)) > MaxAttributeValueLength {
+			return ErrIncorrectAttributeValue
+		}
+	case attribute.Number:
+		if number, err := strconv.ParseFloat(value, 64); err != nil || math.IsInf(number, 0) || math.IsNaN(number) {
+			return ErrIncorrectAttributeValue
+		}
+	case attribute.Boolean:
+		if value != "true" && value != "false" {
+			return ErrIncorrectAttributeValue
+		}
+	case attribute.Enum:
+		for _, v := range allowed {
+			if v == value {
+				return nil
+			}
+		}
+		return ErrIncorrectAttributeValue
+	default:
+		return ErrIncorrectAttributeType
+	}
+	return nil
+}
+
+// MaxBarcodesInImport максимальное количество штрихкодов, загружаемых одним запросом.
+const MaxBarcodesInImport = 1000
+
+// Barcode функция валидации штрихкода. Допустимы коды EAN-13 и UPC-A с верной контрольной цифрой.
+func Barcode(b barcode.Barcode) error {
+	if !b.IsValid() {
+		return ErrIncorrectBarcode
+	}
+	return nil
+}
+
+// Location функция валидации места хранения товара.
+func Location(l location.Location) error {
+	for _, v := range location.Locations() {
+		if v == l {
+			return nil
+		}
+	}
+	return ErrIncorrectLocation
+}
+
+// Instance функция валидации названия экземпляра приложения (магазина).
+func Instance(instance string) error {
+	if len(instance) == 0 || len(instance) > 100 {
+		return ErrIncorrectInstance
+	}
+	return nil
+}
+
+// TransferID функция валидации идентификатора перемещения товара между магазинами.
+func TransferID(id transfer.ID) error {
+	if id <= 0 {
+		return ErrIncorrectTransferID
+	}
+	return nil
+}
+
+// TransferDirection функция валидации направления перемещения товара между магазинами.
+func TransferDirection(d transfer.Direction) error {
+	for _, v := range transfer.Directions() {
+		if v == d {
+			return nil
+		}
+	}
+	return ErrIncorrectTransferDirection
+}
+
+// TransferState функция валидации состояния перемещения товара между магазинами.
+func TransferState(s transfer.State) error {
+	if !s.IsKnown() {
+		return ErrIncorrectTransferState
+	}
+	return nil
+}
+
+// MaxImportRows максимальное количество строк в загрузке товаров.
+const MaxImportRows = 10000
+
+// ConflictPolicy функция валидации действия при загрузке товара, уже имеющегося в ассортименте.
+func ConflictPolicy(p conflict.Policy) error {
+	for _, v := range conflict.Policies() {
+		if v == p {
+			return nil
+		}
+	}
+	return ErrIncorrectConflictPolicy
+}
+
+// ChunkSize функция валидации размера части загрузки товаров. Допустимы значения до MaxImportRows, 0 означает
+// загрузку одной частью.
+func ChunkSize(size uint) error {
+	if size > MaxImportRows {
+		return ErrIncorrectChunkSize
+	}
+	return nil
+}
+
+// MaxPricesInUpdate максимальное количество цен в групповом изменении цен.
+const MaxPricesInUpdate = 1000
+
+// MaxPricePercent максимальное увеличение цены в процентах при групповом изменении цен.
+const MaxPricePercent = 1000
+
+// PricePercent функция валидации процента изменения цены. Цена может быть уменьшена менее чем на 100% или увеличена не
+// более чем на MaxPricePercent процентов.
+func PricePercent(percent float64) error {
+	if percent == 0 || percent <= -100 || percent > MaxPricePercent || math.IsNaN(percent) {
+		return ErrIncorrectPricePercent
+	}
+	return nil
+}
+
+// MaxCashRegisterNameLength максимальная длина названия кассы.
+const MaxCashRegisterNameLength = 100
+
+// CashRegisterName функция валидации названия кассы.
+func CashRegisterName(name string) error {
+	if name == "" || utf8.RuneCountInString(name) > MaxCashRegisterNameLength {
+		return ErrIncorrectCashRegisterName
+	}
+	return nil
+}
+
+// MaxCustomerNameLength максимальная длина имени покупателя.
+const MaxCustomerNameLength = 100
+
+// MaxEmailLength максимальная длина адреса электронной почты.
+const MaxEmailLength = 254
+
+// CustomerName функция валидации имени покупателя.
+func CustomerName(name string) error {
+	if strings.TrimSpace(name) == "" || utf8.RuneCountInString(name) > MaxCustomerNameLength {
+		return ErrIncorrectCustomerName
+	}
+	return nil
+}
+
+// Phone функция валидации номера телефона.
+func Phone(p phone.Phone) error {
+	if !p.IsValid() {
+		return ErrIncorrectPhone
+	}
+	return nil
+}
+
+// Email функция валидации адреса электронной почты. Адрес передаётся без отображаемого имени.
+func Email(email string) error {
+	if len(email) > MaxEmailLength {
+		return ErrIncorrectEmail
+	}
+	if address, err := mail.ParseAddress(email); err != nil || address.Address != email {
+		return ErrIncorrectEmail
+	}
+	return nil
+}
+
+// PickupCode функция валидации кода получения заказа.
+func PickupCode(code pickup.Code) error {
+	if !code.IsValid() {
+		return ErrIncorrectPickupCode
+	}
+	return nil
+}
+
+// MaxPayments максимальное количество платежей, которыми может быть оплачен один чек.
+const MaxPayments = 10
+
+// PaymentAmount функция валидации суммы платежа. Сумма должна быть положительной и содержать не более двух знаков после
+// запятой.
+func PaymentAmount(amount float64) error {
+	if !(amount > 0) || math.IsInf(amount, 1) || math.Abs(amount*100-math.Round(amount*100)) > 1e-6 {
+		return ErrIncorrectPaymentAmount
+	}
+	return nil
+}
+
+// taxCategoryRegexp допустимый формат налоговой категории: латинские буквы в нижнем регистре, цифры и подчёркивание,
+// начиная с буквы.
+var taxCategoryRegexp = regexp.MustCompile(`^[a-z][a-z0-9_]{0,49}$`)
+
+// TaxCategory функция валидации налоговой категории товара.
+func TaxCategory(category tax.Category) error {
+	if !taxCategoryRegexp.MatchString(string(category)) {
+		return ErrIncorrectTaxCategory
+	}
+	return nil
+}
+
+// TaxRate функция валидации ставки НДС в процентах. Ставка должна быть от 0 до 100 (не включительно) и содержать не
+// более двух знаков после запятой.
+func TaxRate(rate float64) error {
+	if !(rate >= 0 && rate < 100) || math.Abs(rate*100-math.Round(rate*100)) > 1e-6 {
+		return ErrIncorrectTaxRate
+	}
+	return nil
+}
+
+// EffectiveDate функция валидации даты, с которой действует ставка налога.
+func EffectiveDate(date time.Time) error {
+	if date.IsZero() {
+		return ErrIncorrectEffectiveDate
+	}
+	return nil
+}
+
+// MaxSerialNumbers максимальное количество серийных номеров в одном запросе.
+const MaxSerialNumbers = 1000
+
+var serialNumberRegexp = regexp.MustCompile(`^[A-Za-z0-9][A-Za-z0-9-]{0,63}$`)
+
+// SerialNumber функция валидации серийного номера экземпляра товара. Номер должен состоять из латинских букв, цифр и
+// дефисов, начинаться с буквы или цифры и быть не длиннее 64 символов.
+func SerialNumber(n serial.Number) error {
+	if !serialNumberRegexp.MatchString(string(n)) {
+		return ErrIncorrectSerialNumber
+	}
+	return nil
+}
+
+// SerialNumbers функция валидации списка серийных номеров: каждый номер должен быть корректным и встречаться в списке
+// только один раз.
+func SerialNumbers(numbers []serial.Number) error {
+	if len(numbers) > MaxSerialNumbers {
+		return ErrTooManySerialNumbers
+	}
+
+	unique := make(map[serial.Number]struct{}, len(numbers))
+	for _, n := range numbers {
+		if err := SerialNumber(n); err != nil {
+			return err
+		}
+		if _, ok := unique[n]; ok {
+			return ErrDuplicateSerialNumbers
+		}
+		unique[n] = struct{}{}
+	}
+
+	return nil
+}
+
+// WarrantyMonths функция валидации гарантийного срока товара в месяцах. Нулевой срок означает отсутствие гарантии.
+func WarrantyMonths(months uint) error {
+	if months > warranty.MaxMonths {
+		return ErrIncorrectWarrantyMonths
+	}
+	return nil
+}
+
+// WarrantyID функция валидации идентификатора гарантии.
+func WarrantyID(id warranty.ID) error {
+	if id <= 0 {
+		return ErrIncorrectWarrantyID
+	}
+	return nil
+}
+
+// ClaimID функция валидации идентификатора гарантийного обращения.
+func ClaimID(id warranty.ClaimID) error {
+	if id <= 0 {
+		return ErrIncorrectClaimID
+	}
+	return nil
+}
+
+// MaxClaimDescriptionLength максимальная длина описания неисправности в гарантийном обращении (в символах).
+const MaxClaimDescriptionLength = 1000
+
+// ClaimDescription функция валидации описания неисправности в гарантийном обращении.
+func ClaimDescription(description string) error {
+	if strings.TrimSpace(description) == "" || utf8.RuneCountInString(description) > MaxClaimDescriptionLength {
+		return ErrIncorrectClaimDescription
+	}
+	return nil
+}
+
+// ClaimStatus функция валидации состояния гарантийного обращения.
+func ClaimStatus(status warranty.Status) error {
+	if !status.IsKnown() {
+		return ErrIncorrectClaimStatus
+	}
+	return nil
+}
@@ -2,8 +2,24 @@ package validators
 
 import (
 	"errors"
+	"github.com/lazylex/watch-store-store/internal/domain/aggregates/receipt"
 	"github.com/lazylex/watch-store-store/internal/domain/aggregates/reservation"
+	"github.com/lazylex/watch-store-store/internal/domain/aggregates/stocktake"
+	"github.com/lazylex/watch-store-store/internal/domain/aggregates/transfer"
+	"github.com/lazylex/watch-store-store/internal/domain/aggregates/warranty"
+	"github.com/lazylex/watch-store-store/internal/domain/value_objects/adjustment"
 	"github.com/lazylex/watch-store-store/internal/domain/value_objects/article"
+	"github.com/lazylex/watch-store-store/internal/domain/value_objects/attribute"
+	"github.com/lazylex/watch-store-store/internal/domain/value_objects/barcode"
+	"github.com/lazylex/watch-store-store/internal/domain/value_objects/conflict"
+	"github.com/lazylex/watch-store-store/internal/domain/value_objects/location"
+	"github.com/lazylex/watch-store-store/internal/domain/value_objects/payment"
+	"github.com/lazylex/watch-store-store/internal/domain/value_objects/phone"
+	"github.com/lazylex/watch-store-store/internal/domain/value_objects/pickup"
+	"github.com/lazylex/watch-store-store/internal/domain/value_objects/serial"
+	"github.com/lazylex/watch-store-store/internal/domain/value_objects/tax"
+	"math"
+	"strings"
 	"testing"
 	"time"
 )
@@ -196,3 +212,815 @@ func TestName(t *testing.T) {
 		})
 	}
 }
+
+func TestCashRegister(t *testing.T) {
+	t.Parallel()
+	testCases := []struct {
+		testName    string
+		number      reservation.OrderNumber
+		expectedErr error
+	}{
+		{
+			testName:    "correct cash register",
+			number:      10,
+			expectedErr: nil,
+		},
+		{
+			testName:    "zero cash register",
+			number:      0,
+			expectedErr: ErrIncorrectCashRegister,
+		},
+		{
+			testName:    "negative cash register",
+			number:      -1,
+			expectedErr: ErrIncorrectCashRegister,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.testName, func(t *testing.T) {
+			if !errors.Is(CashRegister(tc.number), tc.expectedErr) {
+				t.Fail()
+			}
+		})
+	}
+}
+
+func TestReceiptID(t *testing.T) {
+	t.Parallel()
+	testCases := []struct {
+		testName    string
+		id          receipt.ID
+		expectedErr error
+	}{
+		{
+			testName:    "correct id",
+			id:          1,
+			expectedErr: nil,
+		},
+		{
+			testName:    "zero id",
+			id:          0,
+			expectedErr: ErrIncorrectReceiptID,
+		},
+		{
+			testName:    "negative id",
+			id:          -1,
+			expectedErr: ErrIncorrectReceiptID,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.testName, func(t *testing.T) {
+			if !errors.Is(ReceiptID(tc.id), tc.expectedErr) {
+				t.Fail()
+			}
+		})
+	}
+}
+
+func TestPaymentMethod(t *testing.T) {
+	t.Parallel()
+	testCases := []struct {
+		testName    string
+		method      payment.Method
+		expectedErr error
+	}{
+		{
+			testName:    "cash",
+			method:      payment.Cash,
+			expectedErr: nil,
+		},
+		{
+			testName:    "card",
+			method:      payment.Card,
+			expectedErr: nil,
+		},
+		{
+			testName:    "empty method",
+			method:      "",
+			expectedErr: ErrIncorrectPaymentMethod,
+		},
+		{
+			testName:    "unknown method",
+			method:      "barter",
+			expectedErr: ErrIncorrectPaymentMethod,
+		},
+		{
+			testName:    "voucher",
+			method:      payment.Voucher,
+			expectedErr: nil,
+		},
+		{
+			testName:    "mixed is set by service only",
+			method:      payment.Mixed,
+			expectedErr: ErrIncorrectPaymentMethod,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.testName, func(t *testing.T) {
+			if !errors.Is(PaymentMethod(tc.method), tc.expectedErr) {
+				t.Fail()
+			}
+		})
+	}
+}
+
+func TestPaymentAmount(t *testing.T) {
+	t.Parallel()
+	for _, amount := range []float64{0.01, 100, 2350.5, 999.99} {
+		if PaymentAmount(amount) != nil {
+			t.Errorf("amount %v must be correct", amount)
+		}
+	}
+	for _, amount := range []float64{0, -10, 0.001, 10.555, math.Inf(1), math.NaN()} {
+		if !errors.Is(PaymentAmount(amount), ErrIncorrectPaymentAmount) {
+			t.Errorf("amount %v must be incorrect", amount)
+		}
+	}
+}
+
+func TestOpeningFloat(t *testing.T) {
+	t.Parallel()
+	if OpeningFloat(0) != nil || OpeningFloat(5000) != nil {
+		t.Fail()
+	}
+	if !errors.Is(OpeningFloat(-1), ErrNegativeOpeningFloat) {
+		t.Fail()
+	}
+}
+
+func TestStocktakeID(t *testing.T) {
+	t.Parallel()
+	testCases := []struct {
+		testName    string
+		id          stocktake.ID
+		expectedErr error
+	}{
+		{
+			testName:    "correct id",
+			id:          1,
+			expectedErr: nil,
+		},
+		{
+			testName:    "zero id",
+			id:          0,
+			expectedErr: ErrIncorrectStocktakeID,
+		},
+		{
+			testName:    "negative id",
+			id:          -1,
+			expectedErr: ErrIncorrectStocktakeID,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.testName, func(t *testing.T) {
+			if !errors.Is(StocktakeID(tc.id), tc.expectedErr) {
+				t.Fail()
+			}
+		})
+	}
+}
+
+func TestAdjustmentReason(t *testing.T) {
+	t.Parallel()
+	allowed := []adjustment.Reason{adjustment.Damaged, adjustment.Found}
+	testCases := []struct {
+		testName    string
+		reason      adjustment.Reason
+		expectedErr error
+	}{
+		{
+			testName:    "allowed reason",
+			reason:      adjustment.Found,
+			expectedErr: nil,
+		},
+		{
+			testName:    "not allowed reason",
+			reason:      adjustment.Theft,
+			expectedErr: ErrIncorrectAdjustmentReason,
+		},
+		{
+			testName:    "empty reason",
+			reason:      "",
+			expectedErr: ErrIncorrectAdjustmentReason,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.testName, func(t *testing.T) {
+			if !errors.Is(AdjustmentReason(tc.reason, allowed), tc.expectedErr) {
+				t.Fail()
+			}
+		})
+	}
+}
+
+func TestIdempotencyKey(t *testing.T) {
+	t.Parallel()
+	testCases := []struct {
+		testName    string
+		key         string
+		expectedErr error
+	}{
+		{
+			testName:    "correct key",
+			key:         "5f0c2a9e-7d1b-4c33-9a1e-3f8a2b6d9c01",
+			expectedErr: nil,
+		},
+		{
+			testName:    "empty key",
+			key:         "",
+			expectedErr: ErrIncorrectIdempotencyKey,
+		},
+		{
+			testName:    "too long key",
+			key:         strings.Repeat("k", maxIdempotencyKeyLength+1),
+			expectedErr: ErrIncorrectIdempotencyKey,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.testName, func(t *testing.T) {
+			if !errors.Is(IdempotencyKey(tc.key), tc.expectedErr) {
+				t.Fail()
+			}
+		})
+	}
+}
+
+func TestIdempotencySubject(t *testing.T) {
+	t.Parallel()
+	testCases := []struct {
+		testName    string
+		subject     string
+		expectedErr error
+	}{
+		{
+			testName:    "empty subject",
+			subject:     "",
+			expectedErr: nil,
+		},
+		{
+			testName:    "correct subject",
+			subject:     "cashier-7",
+			expectedErr: nil,
+		},
+		{
+			testName:    "too long subject",
+			subject:     strings.Repeat("s", maxIdempotencyKeyLength+1),
+			expectedErr: ErrIncorrectIdempotencySubject,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.testName, func(t *testing.T) {
+			if !errors.Is(IdempotencySubject(tc.subject), tc.expectedErr) {
+				t.Fail()
+			}
+		})
+	}
+}
+
+func TestLimit(t *testing.T) {
+	t.Parallel()
+	testCases := []struct {
+		testName    string
+		limit       int
+		expectedErr error
+	}{
+		{
+			testName:    "correct limit",
+			limit:       10,
+			expectedErr: nil,
+		},
+		{
+			testName:    "zero limit",
+			limit:       0,
+			expectedErr: ErrIncorrectLimit,
+		},
+		{
+			testName:    "too big limit",
+			limit:       MaxLimit + 1,
+			expectedErr: ErrIncorrectLimit,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.testName, func(t *testing.T) {
+			if !errors.Is(Limit(tc.limit), tc.expectedErr) {
+				t.Fail()
+			}
+		})
+	}
+}
+
+func TestLeadTime(t *testing.T) {
+	t.Parallel()
+	testCases := []struct {
+		testName    string
+		days        uint
+		expectedErr error
+	}{
+		{
+			testName:    "correct lead time",
+			days:        14,
+			expectedErr: nil,
+		},
+		{
+			testName:    "zero lead time",
+			days:        0,
+			expectedErr: ErrIncorrectLeadTime,
+		},
+		{
+			testName:    "too long lead time",
+			days:        MaxDays + 1,
+			expectedErr: ErrIncorrectLeadTime,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.testName, func(t *testing.T) {
+			if !errors.Is(LeadTime(tc.days), tc.expectedErr) {
+				t.Fail()
+			}
+		})
+	}
+}
+
+func TestAttributeValue(t *testing.T) {
+	t.Parallel()
+	testCases := []struct {
+		testName    string
+		attrType    attribute.Type
+		allowed     []string
+		value       string
+		expectedErr error
+	}{
+		{
+			testName:    "correct string",
+			attrType:    attribute.String,
+			value:       "Casio",
+			expectedErr: nil,
+		},
+		{
+			testName:    "empty string",
+			attrType:    attribute.String,
+			value:       "",
+			expectedErr: ErrIncorrectAttributeValue,
+		},
+		{
+			testName:    "too long string",
+			attrType:    attribute.String,
+			value:       strings.Repeat("a", MaxAttributeValueLength+1),
+			expectedErr: ErrIncorrectAttributeValue,
+		},
+		{
+			testName:    "correct number",
+			attrType:    attribute.Number,
+			value:       "30.5",
+			expectedErr: nil,
+		},
+		{
+			testName:    "not a number",
+			attrType:    attribute.Number,
+			value:       "30m",
+			expectedErr: ErrIncorrectAttributeValue,
+		},
+		{
+			testName:    "infinite number",
+			attrType:    attribute.Number,
+			value:       "Inf",
+			expectedErr: ErrIncorrectAttributeValue,
+		},
+		{
+			testName:    "correct boolean",
+			attrType:    attribute.Boolean,
+			value:       "false",
+			expectedErr: nil,
+		},
+		{
+			testName:    "incorrect boolean",
+			attrType:    attribute.Boolean,
+			value:       "yes",
+			expectedErr: ErrIncorrectAttributeValue,
+		},
+		{
+			testName:    "allowed enum value",
+			attrType:    attribute.Enum,
+			allowed:     []string{"steel", "resin"},
+			value:       "resin",
+			expectedErr: nil,
+		},
+		{
+			testName:    "not allowed enum value",
+			attrType:    attribute.Enum,
+			allowed:     []string{"steel", "resin"},
+			value:       "gold",
+			expectedErr: ErrIncorrectAttributeValue,
+		},
+		{
+			testName:    "unknown type",
+			attrType:    "date",
+			value:       "2024-01-01",
+			expectedErr: ErrIncorrectAttributeType,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.testName, func(t *testing.T) {
+			if !errors.Is(AttributeValue(tc.attrType, tc.allowed, tc.value), tc.expectedErr) {
+				t.Fail()
+			}
+		})
+	}
+}
+
+func TestBarcode(t *testing.T) {
+	t.Parallel()
+	testCases := []struct {
+		testName    string
+		barcode     barcode.Barcode
+		expectedErr error
+	}{
+		{
+			testName:    "correct EAN-13",
+			barcode:     "4006381333931",
+			expectedErr: nil,
+		},
+		{
+			testName:    "correct UPC-A",
+			barcode:     "036000291452",
+			expectedErr: nil,
+		},
+		{
+			testName:    "wrong check digit",
+			barcode:     "4006381333930",
+			expectedErr: ErrIncorrectBarcode,
+		},
+		{
+			testName:    "article instead of barcode",
+			barcode:     "CA-F91W",
+			expectedErr: ErrIncorrectBarcode,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.testName, func(t *testing.T) {
+			if !errors.Is(Barcode(tc.barcode), tc.expectedErr) {
+				t.Fail()
+			}
+		})
+	}
+}
+
+func TestLocation(t *testing.T) {
+	t.Parallel()
+	testCases := []struct {
+		testName    string
+		location    location.Location
+		expectedErr error
+	}{
+		{
+			testName:    "showcase",
+			location:    location.Showcase,
+			expectedErr: nil,
+		},
+		{
+			testName:    "repair desk",
+			location:    location.RepairDesk,
+			expectedErr: nil,
+		},
+		{
+			testName:    "empty location",
+			location:    "",
+			expectedErr: ErrIncorrectLocation,
+		},
+		{
+			testName:    "unknown location",
+			location:    "attic",
+			expectedErr: ErrIncorrectLocation,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.testName, func(t *testing.T) {
+			if !errors.Is(Location(tc.location), tc.expectedErr) {
+				t.Fail()
+			}
+		})
+	}
+}
+
+func TestTransferDirection(t *testing.T) {
+	t.Parallel()
+	testCases := []struct {
+		testName    string
+		direction   transfer.Direction
+		expectedErr error
+	}{
+		{
+			testName:    "outbound",
+			direction:   transfer.Outbound,
+			expectedErr: nil,
+		},
+		{
+			testName:    "inbound",
+			direction:   transfer.Inbound,
+			expectedErr: nil,
+		},
+		{
+			testName:    "unknown direction",
+			direction:   "sideways",
+			expectedErr: ErrIncorrectTransferDirection,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.testName, func(t *testing.T) {
+			if !errors.Is(TransferDirection(tc.direction), tc.expectedErr) {
+				t.Fail()
+			}
+		})
+	}
+}
+
+func TestTransferState(t *testing.T) {
+	t.Parallel()
+	testCases := []struct {
+		testName    string
+		state       transfer.State
+		expectedErr error
+	}{
+		{
+			testName:    "in transit",
+			state:       transfer.InTransit,
+			expectedErr: nil,
+		},
+		{
+			testName:    "resolved",
+			state:       transfer.Resolved,
+			expectedErr: nil,
+		},
+		{
+			testName:    "zero state",
+			state:       0,
+			expectedErr: ErrIncorrectTransferState,
+		},
+		{
+			testName:    "unknown state",
+			state:       transfer.Resolved + 1,
+			expectedErr: ErrIncorrectTransferState,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.testName, func(t *testing.T) {
+			if !errors.Is(TransferState(tc.state), tc.expectedErr) {
+				t.Fail()
+			}
+		})
+	}
+}
+
+func TestConflictPolicy(t *testing.T) {
+	t.Parallel()
+	testCases := []struct {
+		testName    string
+		policy      conflict.Policy
+		expectedErr error
+	}{
+		{
+			testName:    "reject",
+			policy:      conflict.Reject,
+			expectedErr: nil,
+		},
+		{
+			testName:    "update",
+			policy:      conflict.Update,
+			expectedErr: nil,
+		},
+		{
+			testName:    "empty",
+			policy:      "",
+			expectedErr: ErrIncorrectConflictPolicy,
+		},
+		{
+			testName:    "unknown",
+			policy:      "replace",
+			expectedErr: ErrIncorrectConflictPolicy,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.testName, func(t *testing.T) {
+			if !errors.Is(ConflictPolicy(tc.policy), tc.expectedErr) {
+				t.Fail()
+			}
+		})
+	}
+}
+
+func TestPricePercent(t *testing.T) {
+	t.Parallel()
+	testCases := []struct {
+		testName    string
+		percent     float64
+		expectedErr error
+	}{
+		{testName: "increase", percent: 12.5, expectedErr: nil},
+		{testName: "decrease", percent: -99.5, expectedErr: nil},
+		{testName: "zero", percent: 0, expectedErr: ErrIncorrectPricePercent},
+		{testName: "whole price", percent: -100, expectedErr: ErrIncorrectPricePercent},
+		{testName: "too big", percent: MaxPricePercent + 1, expectedErr: ErrIncorrectPricePercent},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.testName, func(t *testing.T) {
+			if !errors.Is(PricePercent(tc.percent), tc.expectedErr) {
+				t.Fail()
+			}
+		})
+	}
+}
+
+func TestCustomerName(t *testing.T) {
+	t.Parallel()
+	testCases := []struct {
+		testName    string
+		name        string
+		expectedErr error
+	}{
+		{testName: "correct", name: "Иван Петров", expectedErr: nil},
+		{testName: "empty", name: "", expectedErr: ErrIncorrectCustomerName},
+		{testName: "spaces", name: "   ", expectedErr: ErrIncorrectCustomerName},
+		{testName: "too long", name: strings.Repeat("и", MaxCustomerNameLength+1),
+			expectedErr: ErrIncorrectCustomerName},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.testName, func(t *testing.T) {
+			if !errors.Is(CustomerName(tc.name), tc.expectedErr) {
+				t.Fail()
+			}
+		})
+	}
+}
+
+func TestPhone(t *testing.T) {
+	t.Parallel()
+	testCases := []struct {
+		testName    string
+		phone       phone.Phone
+		expectedErr error
+	}{
+		{testName: "digits", phone: "89123456789", expectedErr: nil},
+		{testName: "formatted", phone: "+7 (912) 345-67-89", expectedErr: nil},
+		{testName: "too short", phone: "12345", expectedErr: ErrIncorrectPhone},
+		{testName: "too long", phone: "1234567890123456", expectedErr: ErrIncorrectPhone},
+		{testName: "letters", phone: "8912345678a", expectedErr: ErrIncorrectPhone},
+		{testName: "plus inside", phone: "7+9123456789", expectedErr: ErrIncorrectPhone},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.testName, func(t *testing.T) {
+			if !errors.Is(Phone(tc.phone), tc.expectedErr) {
+				t.Fail()
+			}
+		})
+	}
+}
+
+func TestEmail(t *testing.T) {
+	t.Parallel()
+	testCases := []struct {
+		testName    string
+		email       string
+		expectedErr error
+	}{
+		{testName: "correct", email: "ivan@example.com", expectedErr: nil},
+		{testName: "no domain", email: "ivan", expectedErr: ErrIncorrectEmail},
+		{testName: "display name", email: "Ivan <ivan@example.com>", expectedErr: ErrIncorrectEmail},
+		{testName: "too long", email: strings.Repeat("i", MaxEmailLength) + "@example.com",
+			expectedErr: ErrIncorrectEmail},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.testName, func(t *testing.T) {
+			if !errors.Is(Email(tc.email), tc.expectedErr) {
+				t.Fail()
+			}
+		})
+	}
+}
+
+func TestPickupCode(t *testing.T) {
+	t.Parallel()
+	testCases := []struct {
+		testName    string
+		code        pickup.Code
+		expectedErr error
+	}{
+		{testName: "correct", code: "402913", expectedErr: nil},
+		{testName: "short", code: "40291", expectedErr: ErrIncorrectPickupCode},
+		{testName: "letters", code: "40291a", expectedErr: ErrIncorrectPickupCode},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.testName, func(t *testing.T) {
+			if !errors.Is(PickupCode(tc.code), tc.expectedErr) {
+				t.Fail()
+			}
+		})
+	}
+}
+
+func TestTaxCategory(t *testing.T) {
+	t.Parallel()
+	for _, category := range []tax.Category{"standard", "reduced_10", tax.Default} {
+		if TaxCategory(category) != nil {
+			t.Errorf("category %s must be correct", category)
+		}
+	}
+	for _, category := range []tax.Category{"", "Standard", "10_reduced", "reduced rate", tax.Category(
+		strings.Repeat("a", 51))} {
+		if !errors.Is(TaxCategory(category), ErrIncorrectTaxCategory) {
+			t.Errorf("category %s must be incorrect", category)
+		}
+	}
+}
+
+func TestTaxRate(t *testing.T) {
+	t.Parallel()
+	for _, rate := range []float64{0, 10, 20, 6.5} {
+		if TaxRate(rate) != nil {
+			t.Errorf("rate %v must be correct", rate)
+		}
+	}
+	for _, rate := range []float64{-1, 100, 12.345, math.NaN(), math.Inf(1)} {
+		if !errors.Is(TaxRate(rate), ErrIncorrectTaxRate) {
+			t.Errorf("rate %v must be incorrect", rate)
+		}
+	}
+}
+
+func TestEffectiveDate(t *testing.T) {
+	t.Parallel()
+	if EffectiveDate(time.Now()) != nil || !errors.Is(EffectiveDate(time.Time{}), ErrIncorrectEffectiveDate) {
+		t.Fail()
+	}
+}
+
+func TestSerialNumber(t *testing.T) {
+	t.Parallel()
+	for _, n := range []serial.Number{"A1B2C3", "123456", "SN-2026-0001", serial.Number(strings.Repeat("9", 64))} {
+		if SerialNumber(n) != nil {
+			t.Errorf("serial number %s must be correct", n)
+		}
+	}
+	for _, n := range []serial.Number{"", "-123", "12 34", "№123", serial.Number(strings.Repeat("9", 65))} {
+		if !errors.Is(SerialNumber(n), ErrIncorrectSerialNumber) {
+			t.Errorf("serial number %s must be incorrect", n)
+		}
+	}
+}
+
+func TestSerialNumbers(t *testing.T) {
+	t.Parallel()
+	if SerialNumbers(nil) != nil || SerialNumbers([]serial.Number{"A1", "A2"}) != nil {
+		t.Error("correct serial numbers must pass validation")
+	}
+	if !errors.Is(SerialNumbers([]serial.Number{"A1", "A1"}), ErrDuplicateSerialNumbers) {
+		t.Error("duplicate serial numbers must not pass validation")
+	}
+	if !errors.Is(SerialNumbers([]serial.Number{"A1", ""}), ErrIncorrectSerialNumber) {
+		t.Error("incorrect serial number must not pass validation")
+	}
+	if !errors.Is(SerialNumbers(make([]serial.Number, MaxSerialNumbers+1)), ErrTooManySerialNumbers) {
+		t.Error("too many serial numbers must not pass validation")
+	}
+}
+
+func TestWarrantyMonths(t *testing.T) {
+	t.Parallel()
+	if WarrantyMonths(0) != nil || WarrantyMonths(warranty.MaxMonths) != nil {
+		t.Error("correct warranty terms must pass validation")
+	}
+	if !errors.Is(WarrantyMonths(warranty.MaxMonths+1), ErrIncorrectWarrantyMonths) {
+		t.Error("too long warranty term must not pass validation")
+	}
+}
+
+func TestClaimDescription(t *testing.T) {
+	t.Parallel()
+	if ClaimDescription("Отстают на 10 секунд в сутки") != nil {
+		t.Error("correct description must pass validation")
+	}
+	for _, d := range []string{"", "   ", strings.Repeat("я", MaxClaimDescriptionLength+1)} {
+		if !errors.Is(ClaimDescription(d), ErrIncorrectClaimDescription) {
+			t.Errorf("description of length %d must not pass validation", len(d))
+		}
+	}
+}
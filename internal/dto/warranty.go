@@ -0,0 +1,52 @@
+package dto
+
+import (
+	"github.com/lazylex/watch-store-store/internal/domain/aggregates/receipt"
+	rs "github.com/lazylex/watch-store-store/internal/domain/aggregates/reservation"
+	"github.com/lazylex/watch-store-store/internal/domain/aggregates/warranty"
+	"github.com/lazylex/watch-store-store/internal/domain/value_objects/article"
+	"github.com/lazylex/watch-store-store/internal/domain/value_objects/phone"
+	"github.com/lazylex/watch-store-store/internal/domain/value_objects/serial"
+	"time"
+)
+
+// Warranty гарантия на проданный товар. На экземпляр товара, учитываемого по серийным номерам, выдаётся отдельная
+// гарантия с его серийным номером, на остальные товары - одна гарантия на строку чека с количеством Amount.
+// OrderNumber - номер заказа, при выполнении которого продан товар (для продаж на кассе не указывается), Phone -
+// нормализованный номер телефона покупателя, если он известен. Refunded - количество возвращённого покупателем товара,
+// гарантия на который аннулирована. При возврате всего количества гарантия аннулируется целиком в момент VoidedAt.
+type Warranty struct {
+	ID          warranty.ID     `json:"id"`
+	Article     article.Article `json:"article"`
+	Serial      serial.Number   `json:"serial,omitempty"`
+	Amount      uint            `json:"amount"`
+	Refunded    uint            `json:"refunded,omitempty"`
+	ReceiptID   receipt.ID      `json:"receipt_id"`
+	OrderNumber rs.OrderNumber  `json:"order_number,omitempty"`
+	Phone       phone.Phone     `json:"phone,omitempty"`
+	SoldAt      time.Time       `json:"sold_at"`
+	ExpiresAt   time.Time       `json:"expires_at"`
+	VoidedAt    *time.Time      `json:"voided_at,omitempty"`
+}
+
+// IsActive возвращает true, если в момент date срок гарантии не истёк.
+func (w *Warranty) IsActive(date time.Time) bool {
+	return date.Before(w.ExpiresAt)
+}
+
+// IsVoid возвращает true, если гарантия аннулирована из-за возврата всего товара.
+func (w *Warranty) IsVoid() bool {
+	return w.VoidedAt != nil
+}
+
+// Void аннулирует гарантию на amount единиц возвращённого товара (но не больше, чем осталось под гарантией). Если
+// гарантия аннулирована на всё количество, запоминается момент аннулирования date. Возвращается количество единиц, на
+// которые гарантия аннулирована.
+func (w *Warranty) Void(amount uint, date time.Time) uint {
+	voided := min(amount, w.Amount-min(w.Refunded, w.Amount))
+	w.Refunded += voided
+	if w.Refunded >= w.Amount && w.VoidedAt == nil {
+		w.VoidedAt = &date
+	}
+	return voided
+}
@@ -0,0 +1,71 @@
+package dto
+
+import (
+	"github.com/lazylex/watch-store-store/internal/domain/aggregates/warranty"
+	"github.com/lazylex/watch-store-store/internal/dto/validators"
+	"strings"
+	"time"
+)
+
+// WarrantyClaim гарантийное обращение покупателя с описанием неисправности товара и история смены его состояний в
+// хронологическом порядке.
+type WarrantyClaim struct {
+	ID          warranty.ClaimID          `json:"id"`
+	WarrantyID  warranty.ID               `json:"warranty_id"`
+	Description string                    `json:"description"`
+	Status      warranty.Status           `json:"status"`
+	CreatedAt   time.Time                 `json:"created_at"`
+	History     []WarrantyClaimTransition `json:"history,omitempty"`
+}
+
+// WarrantyIDDescription регистрируемое гарантийное обращение по гарантии с идентификатором WarrantyID.
+type WarrantyIDDescription struct {
+	WarrantyID  warranty.ID `json:"warranty_id"`
+	Description string      `json:"description"`
+}
+
+// Validate валидация корректности сохраненных в DTO данных.
+func (w *WarrantyIDDescription) Validate() error {
+	if err := validators.WarrantyID(w.WarrantyID); err != nil {
+		return err
+	}
+	return validators.ClaimDescription(w.Description)
+}
+
+// Normalize удаляет пробелы по краям описания неисправности.
+func (w *WarrantyIDDescription) Normalize() {
+	w.Description = strings.TrimSpace(w.Description)
+}
+
+type WarrantyClaimID struct {
+	ID warranty.ClaimID `json:"claim_id"`
+}
+
+// Validate валидация корректности сохраненных в DTO данных.
+func (w *WarrantyClaimID) Validate() error {
+	return validators.ClaimID(w.ID)
+}
+
+// ClaimIDStatus новое состояние гарантийного обращения.
+type ClaimIDStatus struct {
+	ClaimID warranty.ClaimID `json:"claim_id"`
+	Status  warranty.Status  `json:"status"`
+}
+
+// Validate валидация корректности сохраненных в DTO данных.
+func (c *ClaimIDStatus) Validate() error {
+	if err := validators.ClaimID(c.ClaimID); err != nil {
+		return err
+	}
+	return validators.ClaimStatus(c.Status)
+}
+
+// WarrantyClaimTransition запись истории смены состояния гарантийного обращения. Для регистрации обращения From
+// пусто.
+type WarrantyClaimTransition struct {
+	ClaimID warranty.ClaimID `json:"claim_id"`
+	From    warranty.Status  `json:"from,omitempty"`
+	To      warranty.Status  `json:"to"`
+	Actor   string           `json:"actor"`
+	Date    time.Time        `json:"date"`
+}
@@ -0,0 +1,74 @@
+package dto
+
+import (
+	"errors"
+	"github.com/lazylex/watch-store-store/internal/domain/aggregates/warranty"
+	"github.com/lazylex/watch-store-store/internal/dto/validators"
+	"testing"
+)
+
+func TestWarrantyIDDescriptionDTO(t *testing.T) {
+	testCases := []struct {
+		testName    string
+		claim       WarrantyIDDescription
+		expectedErr error
+	}{
+		{
+			testName:    "incorrect warranty id",
+			claim:       WarrantyIDDescription{Description: "Не работает подсветка"},
+			expectedErr: validators.ErrIncorrectWarrantyID,
+		},
+		{
+			testName:    "blank description",
+			claim:       WarrantyIDDescription{WarrantyID: 5, Description: "  "},
+			expectedErr: validators.ErrIncorrectClaimDescription,
+		},
+		{
+			testName:    "correct",
+			claim:       WarrantyIDDescription{WarrantyID: 5, Description: "Не работает подсветка"},
+			expectedErr: nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		c := tc.claim
+		t.Run(tc.testName, func(t *testing.T) {
+			if !errors.Is(c.Validate(), tc.expectedErr) {
+				t.Fail()
+			}
+		})
+	}
+}
+
+func TestClaimIDStatusDTO(t *testing.T) {
+	testCases := []struct {
+		testName    string
+		status      ClaimIDStatus
+		expectedErr error
+	}{
+		{
+			testName:    "incorrect claim id",
+			status:      ClaimIDStatus{Status: warranty.InRepair},
+			expectedErr: validators.ErrIncorrectClaimID,
+		},
+		{
+			testName:    "unknown status",
+			status:      ClaimIDStatus{ClaimID: 2, Status: "lost"},
+			expectedErr: validators.ErrIncorrectClaimStatus,
+		},
+		{
+			testName:    "correct",
+			status:      ClaimIDStatus{ClaimID: 2, Status: warranty.InRepair},
+			expectedErr: nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		s := tc.status
+		t.Run(tc.testName, func(t *testing.T) {
+			if !errors.Is(s.Validate(), tc.expectedErr) {
+				t.Fail()
+			}
+		})
+	}
+}
@@ -0,0 +1,15 @@
+package dto
+
+import (
+	"github.com/lazylex/watch-store-store/internal/domain/aggregates/warranty"
+	"github.com/lazylex/watch-store-store/internal/dto/validators"
+)
+
+type WarrantyID struct {
+	ID warranty.ID `json:"warranty_id"`
+}
+
+// Validate валидация корректности сохраненных в DTO данных.
+func (w *WarrantyID) Validate() error {
+	return validators.WarrantyID(w.ID)
+}
@@ -0,0 +1,20 @@
+package dto
+
+import (
+	"github.com/lazylex/watch-store-store/internal/domain/value_objects/article"
+	"github.com/lazylex/watch-store-store/internal/dto/validators"
+)
+
+// WarrantyTerm гарантийный срок товара в месяцах. Нулевой срок означает, что гарантия на товар не выдаётся.
+type WarrantyTerm struct {
+	Article article.Article `json:"article"`
+	Months  uint            `json:"months"`
+}
+
+// Validate валидация корректности сохраненных в DTO данных.
+func (w *WarrantyTerm) Validate() error {
+	if err := validators.Article(w.Article); err != nil {
+		return err
+	}
+	return validators.WarrantyMonths(w.Months)
+}
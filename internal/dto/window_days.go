@@ -0,0 +1,13 @@
+package dto
+
+import "github.com/lazylex/watch-store-store/internal/dto/validators"
+
+// WindowDays количество последних дней, продажи за которые учитываются при расчёте скорости продаж.
+type WindowDays struct {
+	WindowDays uint `json:"window_days"`
+}
+
+// Validate валидация корректности сохраненных в DTO данных.
+func (w *WindowDays) Validate() error {
+	return validators.Window(w.WindowDays)
+}
@@ -0,0 +1,57 @@
+package dto
+
+import (
+	rs "github.com/lazylex/watch-store-store/internal/domain/aggregates/reservation"
+	"github.com/lazylex/watch-store-store/internal/domain/aggregates/shift"
+	"github.com/lazylex/watch-store-store/internal/domain/value_objects/payment"
+	"time"
+)
+
+// ZReport отчёт о закрытии кассовой смены.
+type ZReport struct {
+	ShiftID      shift.ID            `json:"shift_id"`
+	CashRegister rs.OrderNumber      `json:"cash_register"`
+	CashierID    string              `json:"cashier_id"`
+	OpenedAt     time.Time           `json:"opened_at"`
+	ClosedAt     time.Time           `json:"closed_at"`
+	OpeningFloat float64             `json:"opening_float"`
+	SalesCount   uint                `json:"sales_count"`
+	SalesTotal   float64             `json:"sales_total"`
+	RefundsCount uint                `json:"refunds_count"`
+	RefundsTotal float64             `json:"refunds_total"`
+	CashInDrawer float64             `json:"cash_in_drawer"`
+	Sales        []PaymentCountTotal `json:"sales"`
+	Refunds      []PaymentCountTotal `json:"refunds"`
+}
+
+// Fill заполняет отчёт данными смены, количеством чеков receipts, а также платежами по чекам и возвратами,
+// сгруппированными по способу оплаты. Так как чек может быть оплачен несколькими способами, количество продаж равно
+// количеству чеков, а не платежей. Итоговые значения и сумма наличных в кассе рассчитываются.
+func (z *ZReport) Fill(data Shift, receipts uint, sales, refunds []PaymentCountTotal) {
+	z.ShiftID = data.ID
+	z.CashRegister = data.CashRegister
+	z.CashierID = data.CashierID
+	z.OpenedAt = data.OpenedAt
+	z.ClosedAt = data.ClosedAt
+	z.OpeningFloat = data.OpeningFloat
+	z.Sales = sales
+	z.Refunds = refunds
+
+	z.SalesCount, z.SalesTotal, z.RefundsCount, z.RefundsTotal = receipts, 0, 0, 0
+	z.CashInDrawer = data.OpeningFloat
+
+	for _, s := range sales {
+		z.SalesTotal += s.Total
+		if s.Method == payment.Cash {
+			z.CashInDrawer += s.Total
+		}
+	}
+
+	for _, r := range refunds {
+		z.RefundsCount += r.Count
+		z.RefundsTotal += r.Total
+		if r.Method == payment.Cash {
+			z.CashInDrawer -= r.Total
+		}
+	}
+}
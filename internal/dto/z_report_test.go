@@ -0,0 +1,22 @@
+package dto
+
+import (
+	"github.com/lazylex/watch-store-store/internal/domain/value_objects/payment"
+	"testing"
+	"time"
+)
+
+func TestZReportDTO_Fill(t *testing.T) {
+	var z ZReport
+	s := Shift{ID: 3, CashRegister: 1, CashierID: "cashier-1", OpeningFloat: 5000, OpenedAt: time.Now(),
+		ClosedAt: time.Now()}
+	sales := []PaymentCountTotal{{Method: payment.Cash, Count: 2, Total: 3000}, {Method: payment.Card, Count: 3, Total: 9000}}
+	refunds := []PaymentCountTotal{{Method: payment.Cash, Count: 1, Total: 1000}}
+
+	z.Fill(s, 4, sales, refunds)
+
+	if z.ShiftID != 3 || z.SalesCount != 4 || z.SalesTotal != 12000 || z.RefundsCount != 1 ||
+		z.RefundsTotal != 1000 || z.CashInDrawer != 7000 {
+		t.Fail()
+	}
+}
@@ -17,10 +17,12 @@ type ContextKey int
 const (
 	RequestId     ContextKey = 0
 	TxId          ContextKey = 1
+	Actor         ContextKey = 2
 	OPLabel                  = "op"
 	RequestLabel             = "request_id"
 	instanceLabel            = "instance"
 	TxLabel                  = "tx_number"
+	ActorLabel               = "actor"
 )
 
 // MustCreate возвращает экземпляр *slog.Logger или останавливает программу, если окружение environment указано неверно.
@@ -51,7 +53,8 @@ func AddPlaceAndRequestId(log *slog.Logger, place string, r *http.Request) *slog
 	)
 }
 
-// LogWithCtxData извлекает, при наличии, из контекста идентификатор запроса и номер транзакции и добавляет в logger.
+// LogWithCtxData извлекает, при наличии, из контекста идентификатор запроса, номер транзакции и инициатора операции и
+// добавляет в logger.
 func LogWithCtxData(ctx context.Context, log *slog.Logger) *slog.Logger {
 	if ctx.Value(RequestId) != nil {
 		log = log.With(RequestLabel, ctx.Value(RequestId))
@@ -59,6 +62,9 @@ func LogWithCtxData(ctx context.Context, log *slog.Logger) *slog.Logger {
 	if ctx.Value(TxId) != nil {
 		log = log.With(TxLabel, ctx.Value(TxId))
 	}
+	if ctx.Value(Actor) != nil {
+		log = log.With(ActorLabel, ctx.Value(Actor))
+	}
 
 	return log
 }
@@ -1,5 +1,9 @@
 package metrics
 
 const (
-	PATH = "path"
+	PATH    = "path"
+	CHANNEL = "channel"
+	STATE   = "state"
+	ARTICLE = "article"
+	METHOD  = "method"
 )
@@ -69,6 +69,8 @@ func registerMetrics() (*Metrics, error) {
 	var (
 		err                                                               error
 		requests, canceledOrders, placedInternetOrders, placedLocalOrders *prometheus.CounterVec
+		revenue, soldUnits, payments, paymentsAmount                      *prometheus.CounterVec
+		openReservations, reservedUnits, stockLevel                       *prometheus.GaugeVec
 		requestDuration                                                   *prometheus.HistogramVec
 	)
 
@@ -97,11 +99,53 @@ func registerMetrics() (*Metrics, error) {
 		return nil, err
 	}
 
+	revenue, err = createRevenueTotalMetric()
+	if err != nil {
+		return nil, err
+	}
+
+	soldUnits, err = createSoldUnitsTotalMetric()
+	if err != nil {
+		return nil, err
+	}
+
+	payments, err = createPaymentsTotalMetric()
+	if err != nil {
+		return nil, err
+	}
+
+	paymentsAmount, err = createPaymentsAmountTotalMetric()
+	if err != nil {
+		return nil, err
+	}
+
+	openReservations, err = createOpenReservationsMetric()
+	if err != nil {
+		return nil, err
+	}
+
+	reservedUnits, err = createReservedUnitsMetric()
+	if err != nil {
+		return nil, err
+	}
+
+	stockLevel, err = createStockLevelMetric()
+	if err != nil {
+		return nil, err
+	}
+
 	return &Metrics{
 		Service: &Service{
 			canceledOrders:       canceledOrders,
 			placedLocalOrders:    placedLocalOrders,
-			placedInternetOrders: placedInternetOrders},
+			placedInternetOrders: placedInternetOrders,
+			revenue:              revenue,
+			soldUnits:            soldUnits,
+			payments:             payments,
+			paymentsAmount:       paymentsAmount,
+			openReservations:     openReservations,
+			reservedUnits:        reservedUnits,
+			stockLevel:           stockLevel},
 		HTTP: &HTTP{requests: requests, duration: requestDuration},
 	}, nil
 }
@@ -1,13 +1,30 @@
 package metrics
 
-import "github.com/prometheus/client_golang/prometheus"
+import (
+	"github.com/lazylex/watch-store-store/internal/domain/aggregates/reservation"
+	"github.com/lazylex/watch-store-store/internal/domain/value_objects/channel"
+	"github.com/lazylex/watch-store-store/internal/domain/value_objects/payment"
+	"github.com/lazylex/watch-store-store/internal/dto"
+	"github.com/prometheus/client_golang/prometheus"
+	"strings"
+)
 
 type Service struct {
 	canceledOrders       *prometheus.CounterVec
 	placedInternetOrders *prometheus.CounterVec
 	placedLocalOrders    *prometheus.CounterVec
+	revenue              *prometheus.CounterVec
+	soldUnits            *prometheus.CounterVec
+	payments             *prometheus.CounterVec
+	paymentsAmount       *prometheus.CounterVec
+	openReservations     *prometheus.GaugeVec
+	reservedUnits        *prometheus.GaugeVec
+	stockLevel           *prometheus.GaugeVec
 }
 
+// saleChannels каналы продаж, для которых метрики продаж создаются при запуске.
+var saleChannels = []channel.Channel{channel.Register, channel.LocalPickup, channel.Internet}
+
 // CancelOrdersInc увеличивает счетчик отмененных заказов.
 func (s *Service) CancelOrdersInc() {
 	s.canceledOrders.With(prometheus.Labels{}).Inc()
@@ -23,6 +40,48 @@ func (s *Service) PlacedLocalOrdersInc() {
 	s.placedLocalOrders.With(prometheus.Labels{}).Inc()
 }
 
+// SalesAdd увеличивает выручку и количество проданных единиц товара по каналу продаж ch.
+func (s *Service) SalesAdd(ch channel.Channel, revenue float64, units uint) {
+	s.revenue.With(prometheus.Labels{CHANNEL: string(ch)}).Add(revenue)
+	s.soldUnits.With(prometheus.Labels{CHANNEL: string(ch)}).Add(float64(units))
+}
+
+// PaymentsAdd увеличивает количество платежей способом method и их сумму за вычетом выданной сдачи.
+func (s *Service) PaymentsAdd(method payment.Method, amount float64) {
+	s.payments.With(prometheus.Labels{METHOD: string(method)}).Inc()
+	s.paymentsAmount.With(prometheus.Labels{METHOD: string(method)}).Add(amount)
+}
+
+// OpenReservationsSet устанавливает количество открытых заказов по состояниям и общее количество зарезервированных
+// единиц товара. Состояния, отсутствующие в data, считаются не содержащими заказов.
+func (s *Service) OpenReservationsSet(data []dto.StateOrdersUnits) {
+	var units uint
+	orders := make(map[reservation.State]uint, len(data))
+	for _, d := range data {
+		orders[d.State] += d.Orders
+		units += d.Units
+	}
+
+	for _, state := range reservation.OpenStates() {
+		s.openReservations.With(prometheus.Labels{STATE: stateLabel(state)}).Set(float64(orders[state]))
+	}
+	s.reservedUnits.With(prometheus.Labels{}).Set(float64(units))
+}
+
+// StockLevelsSet устанавливает доступное для продажи количество товаров. Значения для товаров, отсутствующих в data,
+// удаляются.
+func (s *Service) StockLevelsSet(data []dto.ArticleAmount) {
+	s.stockLevel.Reset()
+	for _, d := range data {
+		s.stockLevel.With(prometheus.Labels{ARTICLE: string(d.Article)}).Set(float64(d.Amount))
+	}
+}
+
+// stateLabel возвращает значение метки состояния заказа.
+func stateLabel(state reservation.State) string {
+	return strings.ReplaceAll(state.String(), " ", "_")
+}
+
 // createCanceledOrdersTotalMetric создает и регистрирует метрику canceled_orders_total, являющуюся счетчиком отмененных
 // заказов.
 func createCanceledOrdersTotalMetric() (*prometheus.CounterVec, error) {
@@ -76,3 +135,136 @@ func createPlacedLocalOrdersTotalMetric() (*prometheus.CounterVec, error) {
 
 	return orders, nil
 }
+
+// createRevenueTotalMetric создает и регистрирует метрику revenue_total, являющуюся счетчиком выручки по каналам продаж.
+func createRevenueTotalMetric() (*prometheus.CounterVec, error) {
+	var err error
+	revenue := prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name:      "revenue_total",
+		Namespace: NAMESPACE,
+		Help:      "Revenue by sales channel",
+	}, []string{CHANNEL})
+	if err = prometheus.Register(revenue); err != nil {
+		return nil, err
+	}
+
+	for _, ch := range saleChannels {
+		revenue.With(prometheus.Labels{CHANNEL: string(ch)})
+	}
+
+	return revenue, nil
+}
+
+// createSoldUnitsTotalMetric создает и регистрирует метрику sold_units_total, являющуюся счетчиком проданных единиц
+// товара по каналам продаж.
+func createSoldUnitsTotalMetric() (*prometheus.CounterVec, error) {
+	var err error
+	units := prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name:      "sold_units_total",
+		Namespace: NAMESPACE,
+		Help:      "Count of sold units by sales channel",
+	}, []string{CHANNEL})
+	if err = prometheus.Register(units); err != nil {
+		return nil, err
+	}
+
+	for _, ch := range saleChannels {
+		units.With(prometheus.Labels{CHANNEL: string(ch)})
+	}
+
+	return units, nil
+}
+
+// createPaymentsTotalMetric создает и регистрирует метрику payments_total, являющуюся счетчиком платежей по чекам по
+// способам оплаты.
+func createPaymentsTotalMetric() (*prometheus.CounterVec, error) {
+	var err error
+	payments := prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name:      "payments_total",
+		Namespace: NAMESPACE,
+		Help:      "Count of receipt payments by payment method",
+	}, []string{METHOD})
+	if err = prometheus.Register(payments); err != nil {
+		return nil, err
+	}
+
+	for _, method := range payment.Methods() {
+		payments.With(prometheus.Labels{METHOD: string(method)})
+	}
+
+	return payments, nil
+}
+
+// createPaymentsAmountTotalMetric создает и регистрирует метрику payments_amount_total, являющуюся счетчиком суммы
+// платежей по чекам за вычетом выданной сдачи по способам оплаты.
+func createPaymentsAmountTotalMetric() (*prometheus.CounterVec, error) {
+	var err error
+	amount := prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name:      "payments_amount_total",
+		Namespace: NAMESPACE,
+		Help:      "Amount of receipt payments less change by payment method",
+	}, []string{METHOD})
+	if err = prometheus.Register(amount); err != nil {
+		return nil, err
+	}
+
+	for _, method := range payment.Methods() {
+		amount.With(prometheus.Labels{METHOD: string(method)})
+	}
+
+	return amount, nil
+}
+
+// createOpenReservationsMetric создает и регистрирует метрику open_reservations, содержащую количество открытых заказов
+// по состояниям.
+func createOpenReservationsMetric() (*prometheus.GaugeVec, error) {
+	var err error
+	reservations := prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name:      "open_reservations",
+		Namespace: NAMESPACE,
+		Help:      "Count of open reservations by state",
+	}, []string{STATE})
+	if err = prometheus.Register(reservations); err != nil {
+		return nil, err
+	}
+
+	for _, state := range reservation.OpenStates() {
+		reservations.With(prometheus.Labels{STATE: stateLabel(state)})
+	}
+
+	return reservations, nil
+}
+
+// createReservedUnitsMetric создает и регистрирует метрику reserved_units, содержащую общее количество
+// зарезервированных под открытые заказы единиц товара.
+func createReservedUnitsMetric() (*prometheus.GaugeVec, error) {
+	var err error
+	units := prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name:      "reserved_units",
+		Namespace: NAMESPACE,
+		Help:      "Count of units reserved for open orders",
+	}, []string{})
+	if err = prometheus.Register(units); err != nil {
+		return nil, err
+	}
+
+	units.With(prometheus.Labels{})
+
+	return units, nil
+}
+
+// createStockLevelMetric создает и регистрирует метрику stock_level, содержащую доступное для продажи количество
+// товаров по артикулам. Значения заполняются, только если сбор метрики включён в конфигурации.
+func createStockLevelMetric() (*prometheus.GaugeVec, error) {
+	var err error
+	stock := prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name:      "stock_level",
+		Namespace: NAMESPACE,
+		Help:      "Amount of units available for sale by article",
+	}, []string{ARTICLE})
+	if err = prometheus.Register(stock); err != nil {
+		return nil, err
+	}
+
+	return stock, nil
+}
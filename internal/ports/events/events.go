@@ -0,0 +1,13 @@
+package events
+
+import (
+	"context"
+	"github.com/lazylex/watch-store-store/internal/domain/event"
+)
+
+//go:generate mockgen -source=events.go -destination=mocks/events.go
+type Interface interface {
+	// Publish передаёт доменное событие для публикации. Вызывается после успешного изменения данных и не должна
+	// блокировать вызывающего. Ошибки публикации обрабатываются реализацией и вызывающему не возвращаются
+	Publish(ctx context.Context, e event.Event)
+}
@@ -0,0 +1,7 @@
+package export
+
+// Interface построчная запись таблицы отчёта в файл. Ячейки могут быть строками, числами или временем. Реализация не
+// должна накапливать строки в памяти, чтобы отчёт любого размера выгружался потоком.
+type Interface interface {
+	WriteRow(cells ...any) error
+}
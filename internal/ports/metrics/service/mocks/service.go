@@ -8,6 +8,9 @@ import (
 	reflect "reflect"
 
 	gomock "github.com/golang/mock/gomock"
+	channel "github.com/lazylex/watch-store-store/internal/domain/value_objects/channel"
+	payment "github.com/lazylex/watch-store-store/internal/domain/value_objects/payment"
+	dto "github.com/lazylex/watch-store-store/internal/dto"
 )
 
 // MockMetricsInterface is a mock of MetricsInterface interface.
@@ -45,6 +48,30 @@ func (mr *MockMetricsInterfaceMockRecorder) CancelOrdersInc() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelOrdersInc", reflect.TypeOf((*MockMetricsInterface)(nil).CancelOrdersInc))
 }
 
+// OpenReservationsSet mocks base method.
+func (m *MockMetricsInterface) OpenReservationsSet(data []dto.StateOrdersUnits) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "OpenReservationsSet", data)
+}
+
+// OpenReservationsSet indicates an expected call of OpenReservationsSet.
+func (mr *MockMetricsInterfaceMockRecorder) OpenReservationsSet(data interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OpenReservationsSet", reflect.TypeOf((*MockMetricsInterface)(nil).OpenReservationsSet), data)
+}
+
+// PaymentsAdd mocks base method.
+func (m *MockMetricsInterface) PaymentsAdd(method payment.Method, amount float64) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "PaymentsAdd", method, amount)
+}
+
+// PaymentsAdd indicates an expected call of PaymentsAdd.
+func (mr *MockMetricsInterfaceMockRecorder) PaymentsAdd(method, amount interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PaymentsAdd", reflect.TypeOf((*MockMetricsInterface)(nil).PaymentsAdd), method, amount)
+}
+
 // PlacedInternetOrdersInc mocks base method.
 func (m *MockMetricsInterface) PlacedInternetOrdersInc() {
 	m.ctrl.T.Helper()
@@ -68,3 +95,27 @@ func (mr *MockMetricsInterfaceMockRecorder) PlacedLocalOrdersInc() *gomock.Call
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PlacedLocalOrdersInc", reflect.TypeOf((*MockMetricsInterface)(nil).PlacedLocalOrdersInc))
 }
+
+// SalesAdd mocks base method.
+func (m *MockMetricsInterface) SalesAdd(ch channel.Channel, revenue float64, units uint) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SalesAdd", ch, revenue, units)
+}
+
+// SalesAdd indicates an expected call of SalesAdd.
+func (mr *MockMetricsInterfaceMockRecorder) SalesAdd(ch, revenue, units interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SalesAdd", reflect.TypeOf((*MockMetricsInterface)(nil).SalesAdd), ch, revenue, units)
+}
+
+// StockLevelsSet mocks base method.
+func (m *MockMetricsInterface) StockLevelsSet(data []dto.ArticleAmount) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "StockLevelsSet", data)
+}
+
+// StockLevelsSet indicates an expected call of StockLevelsSet.
+func (mr *MockMetricsInterfaceMockRecorder) StockLevelsSet(data interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StockLevelsSet", reflect.TypeOf((*MockMetricsInterface)(nil).StockLevelsSet), data)
+}
@@ -1,8 +1,18 @@
 package service
 
+import (
+	"github.com/lazylex/watch-store-store/internal/domain/value_objects/channel"
+	"github.com/lazylex/watch-store-store/internal/domain/value_objects/payment"
+	"github.com/lazylex/watch-store-store/internal/dto"
+)
+
 //go:generate mockgen -source=service.go -destination=mocks/service.go
 type MetricsInterface interface {
 	CancelOrdersInc()
 	PlacedInternetOrdersInc()
 	PlacedLocalOrdersInc()
+	SalesAdd(ch channel.Channel, revenue float64, units uint)
+	PaymentsAdd(method payment.Method, amount float64)
+	OpenReservationsSet(data []dto.StateOrdersUnits)
+	StockLevelsSet(data []dto.ArticleAmount)
 }
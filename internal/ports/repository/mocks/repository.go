@@ -8,8 +8,19 @@ import (
 	context "context"
 	sql "database/sql"
 	reflect "reflect"
+	time "time"
 
 	gomock "github.com/golang/mock/gomock"
+	receipt "github.com/lazylex/watch-store-store/internal/domain/aggregates/receipt"
+	refund "github.com/lazylex/watch-store-store/internal/domain/aggregates/refund"
+	shift "github.com/lazylex/watch-store-store/internal/domain/aggregates/shift"
+	stocktake "github.com/lazylex/watch-store-store/internal/domain/aggregates/stocktake"
+	transfer "github.com/lazylex/watch-store-store/internal/domain/aggregates/transfer"
+	warranty "github.com/lazylex/watch-store-store/internal/domain/aggregates/warranty"
+	article "github.com/lazylex/watch-store-store/internal/domain/value_objects/article"
+	attribute "github.com/lazylex/watch-store-store/internal/domain/value_objects/attribute"
+	barcode "github.com/lazylex/watch-store-store/internal/domain/value_objects/barcode"
+	serial "github.com/lazylex/watch-store-store/internal/domain/value_objects/serial"
 	dto "github.com/lazylex/watch-store-store/internal/dto"
 )
 
@@ -39,194 +50,1459 @@ func (m *MockInterface) EXPECT() *MockInterfaceMockRecorder {
 	return m.recorder
 }
 
+// CloseShift mocks base method.
+func (m *MockInterface) CloseShift(arg0 context.Context, arg1 *dto.Shift) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CloseShift", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CloseShift indicates an expected call of CloseShift.
+func (mr *MockInterfaceMockRecorder) CloseShift(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CloseShift", reflect.TypeOf((*MockInterface)(nil).CloseShift), arg0, arg1)
+}
+
 // ConvertToCommonErr mocks base method.
 func (m *MockInterface) ConvertToCommonErr(arg0 error) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ConvertToCommonErr", arg0)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "ConvertToCommonErr", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ConvertToCommonErr indicates an expected call of ConvertToCommonErr.
+func (mr *MockInterfaceMockRecorder) ConvertToCommonErr(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConvertToCommonErr", reflect.TypeOf((*MockInterface)(nil).ConvertToCommonErr), arg0)
+}
+
+// CreateArticleBarcodes mocks base method.
+func (m *MockInterface) CreateArticleBarcodes(arg0 context.Context, arg1 []dto.ArticleBarcode) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateArticleBarcodes", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateArticleBarcodes indicates an expected call of CreateArticleBarcodes.
+func (mr *MockInterfaceMockRecorder) CreateArticleBarcodes(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateArticleBarcodes", reflect.TypeOf((*MockInterface)(nil).CreateArticleBarcodes), arg0, arg1)
+}
+
+// CreateCashRegister mocks base method.
+func (m *MockInterface) CreateCashRegister(arg0 context.Context, arg1 *dto.CashRegisterRecord) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateCashRegister", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateCashRegister indicates an expected call of CreateCashRegister.
+func (mr *MockInterfaceMockRecorder) CreateCashRegister(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateCashRegister", reflect.TypeOf((*MockInterface)(nil).CreateCashRegister), arg0, arg1)
+}
+
+// CreateGoodsReceipt mocks base method.
+func (m *MockInterface) CreateGoodsReceipt(arg0 context.Context, arg1 *dto.GoodsReceipt) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateGoodsReceipt", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateGoodsReceipt indicates an expected call of CreateGoodsReceipt.
+func (mr *MockInterfaceMockRecorder) CreateGoodsReceipt(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateGoodsReceipt", reflect.TypeOf((*MockInterface)(nil).CreateGoodsReceipt), arg0, arg1)
+}
+
+// CreateIdempotencyRecord mocks base method.
+func (m *MockInterface) CreateIdempotencyRecord(arg0 context.Context, arg1 *dto.IdempotencyRecord) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateIdempotencyRecord", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateIdempotencyRecord indicates an expected call of CreateIdempotencyRecord.
+func (mr *MockInterfaceMockRecorder) CreateIdempotencyRecord(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateIdempotencyRecord", reflect.TypeOf((*MockInterface)(nil).CreateIdempotencyRecord), arg0, arg1)
+}
+
+// CreateReceipt mocks base method.
+func (m *MockInterface) CreateReceipt(arg0 context.Context, arg1 *dto.Receipt) (receipt.ID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateReceipt", arg0, arg1)
+	ret0, _ := ret[0].(receipt.ID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateReceipt indicates an expected call of CreateReceipt.
+func (mr *MockInterfaceMockRecorder) CreateReceipt(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateReceipt", reflect.TypeOf((*MockInterface)(nil).CreateReceipt), arg0, arg1)
+}
+
+// CreateRefund mocks base method.
+func (m *MockInterface) CreateRefund(arg0 context.Context, arg1 *dto.RefundRecord) (refund.ID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateRefund", arg0, arg1)
+	ret0, _ := ret[0].(refund.ID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateRefund indicates an expected call of CreateRefund.
+func (mr *MockInterfaceMockRecorder) CreateRefund(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateRefund", reflect.TypeOf((*MockInterface)(nil).CreateRefund), arg0, arg1)
+}
+
+// CreateReservation mocks base method.
+func (m *MockInterface) CreateReservation(arg0 context.Context, arg1 *dto.NumberDateStateProducts) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateReservation", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateReservation indicates an expected call of CreateReservation.
+func (mr *MockInterfaceMockRecorder) CreateReservation(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateReservation", reflect.TypeOf((*MockInterface)(nil).CreateReservation), arg0, arg1)
+}
+
+// CreateReservationCustomer mocks base method.
+func (m *MockInterface) CreateReservationCustomer(arg0 context.Context, arg1 *dto.ReservationCustomer) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateReservationCustomer", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateReservationCustomer indicates an expected call of CreateReservationCustomer.
+func (mr *MockInterfaceMockRecorder) CreateReservationCustomer(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateReservationCustomer", reflect.TypeOf((*MockInterface)(nil).CreateReservationCustomer), arg0, arg1)
+}
+
+// CreateReservationTransition mocks base method.
+func (m *MockInterface) CreateReservationTransition(arg0 context.Context, arg1 *dto.ReservationTransition) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateReservationTransition", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateReservationTransition indicates an expected call of CreateReservationTransition.
+func (mr *MockInterfaceMockRecorder) CreateReservationTransition(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateReservationTransition", reflect.TypeOf((*MockInterface)(nil).CreateReservationTransition), arg0, arg1)
+}
+
+// CreateSerialEvents mocks base method.
+func (m *MockInterface) CreateSerialEvents(arg0 context.Context, arg1 []dto.SerialEvent) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateSerialEvents", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateSerialEvents indicates an expected call of CreateSerialEvents.
+func (mr *MockInterfaceMockRecorder) CreateSerialEvents(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSerialEvents", reflect.TypeOf((*MockInterface)(nil).CreateSerialEvents), arg0, arg1)
+}
+
+// CreateSerialTracking mocks base method.
+func (m *MockInterface) CreateSerialTracking(arg0 context.Context, arg1 *dto.Article, arg2 time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateSerialTracking", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateSerialTracking indicates an expected call of CreateSerialTracking.
+func (mr *MockInterfaceMockRecorder) CreateSerialTracking(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSerialTracking", reflect.TypeOf((*MockInterface)(nil).CreateSerialTracking), arg0, arg1, arg2)
+}
+
+// CreateSerials mocks base method.
+func (m *MockInterface) CreateSerials(arg0 context.Context, arg1 []dto.SerialRecord) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateSerials", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateSerials indicates an expected call of CreateSerials.
+func (mr *MockInterfaceMockRecorder) CreateSerials(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSerials", reflect.TypeOf((*MockInterface)(nil).CreateSerials), arg0, arg1)
+}
+
+// CreateShift mocks base method.
+func (m *MockInterface) CreateShift(arg0 context.Context, arg1 *dto.Shift) (shift.ID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateShift", arg0, arg1)
+	ret0, _ := ret[0].(shift.ID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateShift indicates an expected call of CreateShift.
+func (mr *MockInterfaceMockRecorder) CreateShift(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateShift", reflect.TypeOf((*MockInterface)(nil).CreateShift), arg0, arg1)
+}
+
+// CreateSoldRecord mocks base method.
+func (m *MockInterface) CreateSoldRecord(arg0 context.Context, arg1 *dto.ArticlePriceAmountDate) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateSoldRecord", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateSoldRecord indicates an expected call of CreateSoldRecord.
+func (mr *MockInterfaceMockRecorder) CreateSoldRecord(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSoldRecord", reflect.TypeOf((*MockInterface)(nil).CreateSoldRecord), arg0, arg1)
+}
+
+// CreateStock mocks base method.
+func (m *MockInterface) CreateStock(arg0 context.Context, arg1 *dto.ArticlePriceNameAmount) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateStock", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateStock indicates an expected call of CreateStock.
+func (mr *MockInterfaceMockRecorder) CreateStock(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateStock", reflect.TypeOf((*MockInterface)(nil).CreateStock), arg0, arg1)
+}
+
+// CreateStockAdjustment mocks base method.
+func (m *MockInterface) CreateStockAdjustment(arg0 context.Context, arg1 *dto.StockAdjustment) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateStockAdjustment", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateStockAdjustment indicates an expected call of CreateStockAdjustment.
+func (mr *MockInterfaceMockRecorder) CreateStockAdjustment(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateStockAdjustment", reflect.TypeOf((*MockInterface)(nil).CreateStockAdjustment), arg0, arg1)
+}
+
+// CreateStocktake mocks base method.
+func (m *MockInterface) CreateStocktake(arg0 context.Context, arg1 *dto.Stocktake) (stocktake.ID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateStocktake", arg0, arg1)
+	ret0, _ := ret[0].(stocktake.ID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateStocktake indicates an expected call of CreateStocktake.
+func (mr *MockInterfaceMockRecorder) CreateStocktake(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateStocktake", reflect.TypeOf((*MockInterface)(nil).CreateStocktake), arg0, arg1)
+}
+
+// CreateTransfer mocks base method.
+func (m *MockInterface) CreateTransfer(arg0 context.Context, arg1 *dto.Transfer) (transfer.ID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateTransfer", arg0, arg1)
+	ret0, _ := ret[0].(transfer.ID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateTransfer indicates an expected call of CreateTransfer.
+func (mr *MockInterfaceMockRecorder) CreateTransfer(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTransfer", reflect.TypeOf((*MockInterface)(nil).CreateTransfer), arg0, arg1)
+}
+
+// CreateWarranties mocks base method.
+func (m *MockInterface) CreateWarranties(arg0 context.Context, arg1 []dto.Warranty) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateWarranties", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateWarranties indicates an expected call of CreateWarranties.
+func (mr *MockInterfaceMockRecorder) CreateWarranties(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateWarranties", reflect.TypeOf((*MockInterface)(nil).CreateWarranties), arg0, arg1)
+}
+
+// CreateWarrantyClaim mocks base method.
+func (m *MockInterface) CreateWarrantyClaim(arg0 context.Context, arg1 *dto.WarrantyClaim) (warranty.ClaimID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateWarrantyClaim", arg0, arg1)
+	ret0, _ := ret[0].(warranty.ClaimID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateWarrantyClaim indicates an expected call of CreateWarrantyClaim.
+func (mr *MockInterfaceMockRecorder) CreateWarrantyClaim(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateWarrantyClaim", reflect.TypeOf((*MockInterface)(nil).CreateWarrantyClaim), arg0, arg1)
+}
+
+// CreateWarrantyClaimTransition mocks base method.
+func (m *MockInterface) CreateWarrantyClaimTransition(arg0 context.Context, arg1 *dto.WarrantyClaimTransition) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateWarrantyClaimTransition", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateWarrantyClaimTransition indicates an expected call of CreateWarrantyClaimTransition.
+func (mr *MockInterfaceMockRecorder) CreateWarrantyClaimTransition(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateWarrantyClaimTransition", reflect.TypeOf((*MockInterface)(nil).CreateWarrantyClaimTransition), arg0, arg1)
+}
+
+// CreateZReport mocks base method.
+func (m *MockInterface) CreateZReport(arg0 context.Context, arg1 *dto.ZReport) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateZReport", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateZReport indicates an expected call of CreateZReport.
+func (mr *MockInterfaceMockRecorder) CreateZReport(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateZReport", reflect.TypeOf((*MockInterface)(nil).CreateZReport), arg0, arg1)
+}
+
+// DeleteArticleAttribute mocks base method.
+func (m *MockInterface) DeleteArticleAttribute(arg0 context.Context, arg1 *dto.ArticleAttributeCode) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteArticleAttribute", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteArticleAttribute indicates an expected call of DeleteArticleAttribute.
+func (mr *MockInterfaceMockRecorder) DeleteArticleAttribute(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteArticleAttribute", reflect.TypeOf((*MockInterface)(nil).DeleteArticleAttribute), arg0, arg1)
+}
+
+// DeleteBarcode mocks base method.
+func (m *MockInterface) DeleteBarcode(arg0 context.Context, arg1 *dto.Barcode) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteBarcode", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteBarcode indicates an expected call of DeleteBarcode.
+func (mr *MockInterfaceMockRecorder) DeleteBarcode(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteBarcode", reflect.TypeOf((*MockInterface)(nil).DeleteBarcode), arg0, arg1)
+}
+
+// DeleteExpiredIdempotencyRecords mocks base method.
+func (m *MockInterface) DeleteExpiredIdempotencyRecords(arg0 context.Context, arg1 time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteExpiredIdempotencyRecords", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteExpiredIdempotencyRecords indicates an expected call of DeleteExpiredIdempotencyRecords.
+func (mr *MockInterfaceMockRecorder) DeleteExpiredIdempotencyRecords(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteExpiredIdempotencyRecords", reflect.TypeOf((*MockInterface)(nil).DeleteExpiredIdempotencyRecords), arg0, arg1)
+}
+
+// DeleteIdempotencyRecord mocks base method.
+func (m *MockInterface) DeleteIdempotencyRecord(arg0 context.Context, arg1 *dto.IdempotencyKey) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteIdempotencyRecord", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteIdempotencyRecord indicates an expected call of DeleteIdempotencyRecord.
+func (mr *MockInterfaceMockRecorder) DeleteIdempotencyRecord(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteIdempotencyRecord", reflect.TypeOf((*MockInterface)(nil).DeleteIdempotencyRecord), arg0, arg1)
+}
+
+// DeleteReservation mocks base method.
+func (m *MockInterface) DeleteReservation(arg0 context.Context, arg1 *dto.Number) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteReservation", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteReservation indicates an expected call of DeleteReservation.
+func (mr *MockInterfaceMockRecorder) DeleteReservation(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteReservation", reflect.TypeOf((*MockInterface)(nil).DeleteReservation), arg0, arg1)
+}
+
+// DeleteSerialTracking mocks base method.
+func (m *MockInterface) DeleteSerialTracking(arg0 context.Context, arg1 *dto.Article) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteSerialTracking", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteSerialTracking indicates an expected call of DeleteSerialTracking.
+func (mr *MockInterfaceMockRecorder) DeleteSerialTracking(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSerialTracking", reflect.TypeOf((*MockInterface)(nil).DeleteSerialTracking), arg0, arg1)
+}
+
+// DeleteWarrantyTerm mocks base method.
+func (m *MockInterface) DeleteWarrantyTerm(arg0 context.Context, arg1 *dto.Article) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteWarrantyTerm", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteWarrantyTerm indicates an expected call of DeleteWarrantyTerm.
+func (mr *MockInterfaceMockRecorder) DeleteWarrantyTerm(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteWarrantyTerm", reflect.TypeOf((*MockInterface)(nil).DeleteWarrantyTerm), arg0, arg1)
+}
+
+// IterateArticlesSales mocks base method.
+func (m *MockInterface) IterateArticlesSales(arg0 context.Context, arg1 *dto.FromTo, arg2 func(dto.ArticleSales) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IterateArticlesSales", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// IterateArticlesSales indicates an expected call of IterateArticlesSales.
+func (mr *MockInterfaceMockRecorder) IterateArticlesSales(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IterateArticlesSales", reflect.TypeOf((*MockInterface)(nil).IterateArticlesSales), arg0, arg1, arg2)
+}
+
+// IterateSoldRecords mocks base method.
+func (m *MockInterface) IterateSoldRecords(arg0 context.Context, arg1 *dto.FromTo, arg2 func(dto.SoldRecord) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IterateSoldRecords", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// IterateSoldRecords indicates an expected call of IterateSoldRecords.
+func (mr *MockInterfaceMockRecorder) IterateSoldRecords(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IterateSoldRecords", reflect.TypeOf((*MockInterface)(nil).IterateSoldRecords), arg0, arg1, arg2)
+}
+
+// NextOrderNumber mocks base method.
+func (m *MockInterface) NextOrderNumber(arg0 context.Context) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NextOrderNumber", arg0)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// NextOrderNumber indicates an expected call of NextOrderNumber.
+func (mr *MockInterfaceMockRecorder) NextOrderNumber(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NextOrderNumber", reflect.TypeOf((*MockInterface)(nil).NextOrderNumber), arg0)
+}
+
+// ReadArticleBarcodes mocks base method.
+func (m *MockInterface) ReadArticleBarcodes(arg0 context.Context, arg1 *dto.Article) ([]barcode.Barcode, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadArticleBarcodes", arg0, arg1)
+	ret0, _ := ret[0].([]barcode.Barcode)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadArticleBarcodes indicates an expected call of ReadArticleBarcodes.
+func (mr *MockInterfaceMockRecorder) ReadArticleBarcodes(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadArticleBarcodes", reflect.TypeOf((*MockInterface)(nil).ReadArticleBarcodes), arg0, arg1)
+}
+
+// ReadArticleByBarcode mocks base method.
+func (m *MockInterface) ReadArticleByBarcode(arg0 context.Context, arg1 *dto.Barcode) (article.Article, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadArticleByBarcode", arg0, arg1)
+	ret0, _ := ret[0].(article.Article)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadArticleByBarcode indicates an expected call of ReadArticleByBarcode.
+func (mr *MockInterfaceMockRecorder) ReadArticleByBarcode(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadArticleByBarcode", reflect.TypeOf((*MockInterface)(nil).ReadArticleByBarcode), arg0, arg1)
+}
+
+// ReadArticleSales mocks base method.
+func (m *MockInterface) ReadArticleSales(arg0 context.Context, arg1 *dto.ArticleFromTo) (dto.ArticleSales, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadArticleSales", arg0, arg1)
+	ret0, _ := ret[0].(dto.ArticleSales)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadArticleSales indicates an expected call of ReadArticleSales.
+func (mr *MockInterfaceMockRecorder) ReadArticleSales(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadArticleSales", reflect.TypeOf((*MockInterface)(nil).ReadArticleSales), arg0, arg1)
+}
+
+// ReadArticlesAttributes mocks base method.
+func (m *MockInterface) ReadArticlesAttributes(arg0 context.Context, arg1 []article.Article) (map[article.Article]map[attribute.Code]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadArticlesAttributes", arg0, arg1)
+	ret0, _ := ret[0].(map[article.Article]map[attribute.Code]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadArticlesAttributes indicates an expected call of ReadArticlesAttributes.
+func (mr *MockInterfaceMockRecorder) ReadArticlesAttributes(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadArticlesAttributes", reflect.TypeOf((*MockInterface)(nil).ReadArticlesAttributes), arg0, arg1)
+}
+
+// ReadArticlesSales mocks base method.
+func (m *MockInterface) ReadArticlesSales(arg0 context.Context, arg1 *dto.FromTo) ([]dto.ArticleSales, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadArticlesSales", arg0, arg1)
+	ret0, _ := ret[0].([]dto.ArticleSales)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadArticlesSales indicates an expected call of ReadArticlesSales.
+func (mr *MockInterfaceMockRecorder) ReadArticlesSales(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadArticlesSales", reflect.TypeOf((*MockInterface)(nil).ReadArticlesSales), arg0, arg1)
+}
+
+// ReadArticlesTaxRates mocks base method.
+func (m *MockInterface) ReadArticlesTaxRates(arg0 context.Context, arg1 []article.Article, arg2 time.Time) ([]dto.ArticleTaxRate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadArticlesTaxRates", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]dto.ArticleTaxRate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadArticlesTaxRates indicates an expected call of ReadArticlesTaxRates.
+func (mr *MockInterfaceMockRecorder) ReadArticlesTaxRates(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadArticlesTaxRates", reflect.TypeOf((*MockInterface)(nil).ReadArticlesTaxRates), arg0, arg1, arg2)
+}
+
+// ReadAttributeDefinitions mocks base method.
+func (m *MockInterface) ReadAttributeDefinitions(arg0 context.Context) ([]dto.AttributeDefinition, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadAttributeDefinitions", arg0)
+	ret0, _ := ret[0].([]dto.AttributeDefinition)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadAttributeDefinitions indicates an expected call of ReadAttributeDefinitions.
+func (mr *MockInterfaceMockRecorder) ReadAttributeDefinitions(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadAttributeDefinitions", reflect.TypeOf((*MockInterface)(nil).ReadAttributeDefinitions), arg0)
+}
+
+// ReadAvailableSerials mocks base method.
+func (m *MockInterface) ReadAvailableSerials(arg0 context.Context, arg1 *dto.Article) ([]dto.SerialRecord, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadAvailableSerials", arg0, arg1)
+	ret0, _ := ret[0].([]dto.SerialRecord)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadAvailableSerials indicates an expected call of ReadAvailableSerials.
+func (mr *MockInterfaceMockRecorder) ReadAvailableSerials(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadAvailableSerials", reflect.TypeOf((*MockInterface)(nil).ReadAvailableSerials), arg0, arg1)
+}
+
+// ReadBarcodesArticles mocks base method.
+func (m *MockInterface) ReadBarcodesArticles(arg0 context.Context, arg1 []barcode.Barcode) (map[barcode.Barcode]article.Article, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadBarcodesArticles", arg0, arg1)
+	ret0, _ := ret[0].(map[barcode.Barcode]article.Article)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadBarcodesArticles indicates an expected call of ReadBarcodesArticles.
+func (mr *MockInterfaceMockRecorder) ReadBarcodesArticles(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadBarcodesArticles", reflect.TypeOf((*MockInterface)(nil).ReadBarcodesArticles), arg0, arg1)
+}
+
+// ReadCashRegister mocks base method.
+func (m *MockInterface) ReadCashRegister(arg0 context.Context, arg1 *dto.CashRegister) (dto.CashRegisterRecord, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadCashRegister", arg0, arg1)
+	ret0, _ := ret[0].(dto.CashRegisterRecord)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadCashRegister indicates an expected call of ReadCashRegister.
+func (mr *MockInterfaceMockRecorder) ReadCashRegister(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadCashRegister", reflect.TypeOf((*MockInterface)(nil).ReadCashRegister), arg0, arg1)
+}
+
+// ReadCashRegisters mocks base method.
+func (m *MockInterface) ReadCashRegisters(arg0 context.Context) ([]dto.CashRegisterRecord, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadCashRegisters", arg0)
+	ret0, _ := ret[0].([]dto.CashRegisterRecord)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadCashRegisters indicates an expected call of ReadCashRegisters.
+func (mr *MockInterfaceMockRecorder) ReadCashRegisters(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadCashRegisters", reflect.TypeOf((*MockInterface)(nil).ReadCashRegisters), arg0)
+}
+
+// ReadGoodsReceipt mocks base method.
+func (m *MockInterface) ReadGoodsReceipt(arg0 context.Context, arg1 *dto.DocumentNumber) (dto.GoodsReceipt, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadGoodsReceipt", arg0, arg1)
+	ret0, _ := ret[0].(dto.GoodsReceipt)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadGoodsReceipt indicates an expected call of ReadGoodsReceipt.
+func (mr *MockInterfaceMockRecorder) ReadGoodsReceipt(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadGoodsReceipt", reflect.TypeOf((*MockInterface)(nil).ReadGoodsReceipt), arg0, arg1)
+}
+
+// ReadIdempotencyRecord mocks base method.
+func (m *MockInterface) ReadIdempotencyRecord(arg0 context.Context, arg1 *dto.IdempotencyKey) (dto.IdempotencyRecord, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadIdempotencyRecord", arg0, arg1)
+	ret0, _ := ret[0].(dto.IdempotencyRecord)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadIdempotencyRecord indicates an expected call of ReadIdempotencyRecord.
+func (mr *MockInterfaceMockRecorder) ReadIdempotencyRecord(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadIdempotencyRecord", reflect.TypeOf((*MockInterface)(nil).ReadIdempotencyRecord), arg0, arg1)
+}
+
+// ReadOpenReservationsByPhone mocks base method.
+func (m *MockInterface) ReadOpenReservationsByPhone(arg0 context.Context, arg1 *dto.Phone) ([]dto.NumberDateStateProducts, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadOpenReservationsByPhone", arg0, arg1)
+	ret0, _ := ret[0].([]dto.NumberDateStateProducts)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadOpenReservationsByPhone indicates an expected call of ReadOpenReservationsByPhone.
+func (mr *MockInterfaceMockRecorder) ReadOpenReservationsByPhone(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadOpenReservationsByPhone", reflect.TypeOf((*MockInterface)(nil).ReadOpenReservationsByPhone), arg0, arg1)
+}
+
+// ReadOpenReservationsByState mocks base method.
+func (m *MockInterface) ReadOpenReservationsByState(arg0 context.Context) ([]dto.StateOrdersUnits, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadOpenReservationsByState", arg0)
+	ret0, _ := ret[0].([]dto.StateOrdersUnits)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadOpenReservationsByState indicates an expected call of ReadOpenReservationsByState.
+func (mr *MockInterfaceMockRecorder) ReadOpenReservationsByState(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadOpenReservationsByState", reflect.TypeOf((*MockInterface)(nil).ReadOpenReservationsByState), arg0)
+}
+
+// ReadOpenShift mocks base method.
+func (m *MockInterface) ReadOpenShift(arg0 context.Context, arg1 *dto.CashRegister) (dto.Shift, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadOpenShift", arg0, arg1)
+	ret0, _ := ret[0].(dto.Shift)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadOpenShift indicates an expected call of ReadOpenShift.
+func (mr *MockInterfaceMockRecorder) ReadOpenShift(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadOpenShift", reflect.TypeOf((*MockInterface)(nil).ReadOpenShift), arg0, arg1)
+}
+
+// ReadPaymentsByDay mocks base method.
+func (m *MockInterface) ReadPaymentsByDay(arg0 context.Context, arg1 *dto.FromTo) ([]dto.DailyPayments, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadPaymentsByDay", arg0, arg1)
+	ret0, _ := ret[0].([]dto.DailyPayments)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadPaymentsByDay indicates an expected call of ReadPaymentsByDay.
+func (mr *MockInterfaceMockRecorder) ReadPaymentsByDay(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadPaymentsByDay", reflect.TypeOf((*MockInterface)(nil).ReadPaymentsByDay), arg0, arg1)
+}
+
+// ReadReceipt mocks base method.
+func (m *MockInterface) ReadReceipt(arg0 context.Context, arg1 *dto.ReceiptID) (dto.Receipt, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadReceipt", arg0, arg1)
+	ret0, _ := ret[0].(dto.Receipt)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadReceipt indicates an expected call of ReadReceipt.
+func (mr *MockInterfaceMockRecorder) ReadReceipt(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadReceipt", reflect.TypeOf((*MockInterface)(nil).ReadReceipt), arg0, arg1)
+}
+
+// ReadRefundedProducts mocks base method.
+func (m *MockInterface) ReadRefundedProducts(arg0 context.Context, arg1 *dto.ReceiptID) ([]dto.ArticleAmount, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadRefundedProducts", arg0, arg1)
+	ret0, _ := ret[0].([]dto.ArticleAmount)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadRefundedProducts indicates an expected call of ReadRefundedProducts.
+func (mr *MockInterfaceMockRecorder) ReadRefundedProducts(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadRefundedProducts", reflect.TypeOf((*MockInterface)(nil).ReadRefundedProducts), arg0, arg1)
+}
+
+// ReadReplenishmentSetting mocks base method.
+func (m *MockInterface) ReadReplenishmentSetting(arg0 context.Context, arg1 *dto.Article) (dto.ReplenishmentSetting, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadReplenishmentSetting", arg0, arg1)
+	ret0, _ := ret[0].(dto.ReplenishmentSetting)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadReplenishmentSetting indicates an expected call of ReadReplenishmentSetting.
+func (mr *MockInterfaceMockRecorder) ReadReplenishmentSetting(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadReplenishmentSetting", reflect.TypeOf((*MockInterface)(nil).ReadReplenishmentSetting), arg0, arg1)
+}
+
+// ReadReservation mocks base method.
+func (m *MockInterface) ReadReservation(arg0 context.Context, arg1 *dto.Number) (dto.NumberDateStateProducts, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadReservation", arg0, arg1)
+	ret0, _ := ret[0].(dto.NumberDateStateProducts)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadReservation indicates an expected call of ReadReservation.
+func (mr *MockInterfaceMockRecorder) ReadReservation(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadReservation", reflect.TypeOf((*MockInterface)(nil).ReadReservation), arg0, arg1)
+}
+
+// ReadReservationCustomer mocks base method.
+func (m *MockInterface) ReadReservationCustomer(arg0 context.Context, arg1 *dto.Number) (dto.ReservationCustomer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadReservationCustomer", arg0, arg1)
+	ret0, _ := ret[0].(dto.ReservationCustomer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadReservationCustomer indicates an expected call of ReadReservationCustomer.
+func (mr *MockInterfaceMockRecorder) ReadReservationCustomer(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadReservationCustomer", reflect.TypeOf((*MockInterface)(nil).ReadReservationCustomer), arg0, arg1)
+}
+
+// ReadReservationTransitions mocks base method.
+func (m *MockInterface) ReadReservationTransitions(arg0 context.Context, arg1 *dto.Number) ([]dto.ReservationTransition, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadReservationTransitions", arg0, arg1)
+	ret0, _ := ret[0].([]dto.ReservationTransition)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadReservationTransitions indicates an expected call of ReadReservationTransitions.
+func (mr *MockInterfaceMockRecorder) ReadReservationTransitions(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadReservationTransitions", reflect.TypeOf((*MockInterface)(nil).ReadReservationTransitions), arg0, arg1)
+}
+
+// ReadReservedAmount mocks base method.
+func (m *MockInterface) ReadReservedAmount(arg0 context.Context, arg1 *dto.Article) (uint, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadReservedAmount", arg0, arg1)
+	ret0, _ := ret[0].(uint)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadReservedAmount indicates an expected call of ReadReservedAmount.
+func (mr *MockInterfaceMockRecorder) ReadReservedAmount(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadReservedAmount", reflect.TypeOf((*MockInterface)(nil).ReadReservedAmount), arg0, arg1)
+}
+
+// ReadReservedInStoreAmounts mocks base method.
+func (m *MockInterface) ReadReservedInStoreAmounts(arg0 context.Context) ([]dto.ArticleAmount, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadReservedInStoreAmounts", arg0)
+	ret0, _ := ret[0].([]dto.ArticleAmount)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadReservedInStoreAmounts indicates an expected call of ReadReservedInStoreAmounts.
+func (mr *MockInterfaceMockRecorder) ReadReservedInStoreAmounts(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadReservedInStoreAmounts", reflect.TypeOf((*MockInterface)(nil).ReadReservedInStoreAmounts), arg0)
+}
+
+// ReadSalesByChannel mocks base method.
+func (m *MockInterface) ReadSalesByChannel(arg0 context.Context, arg1 *dto.FromTo) ([]dto.ChannelSales, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadSalesByChannel", arg0, arg1)
+	ret0, _ := ret[0].([]dto.ChannelSales)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadSalesByChannel indicates an expected call of ReadSalesByChannel.
+func (mr *MockInterfaceMockRecorder) ReadSalesByChannel(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadSalesByChannel", reflect.TypeOf((*MockInterface)(nil).ReadSalesByChannel), arg0, arg1)
+}
+
+// ReadSalesByPeriod mocks base method.
+func (m *MockInterface) ReadSalesByPeriod(arg0 context.Context, arg1 *dto.FromToBucket) ([]dto.PeriodSales, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadSalesByPeriod", arg0, arg1)
+	ret0, _ := ret[0].([]dto.PeriodSales)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadSalesByPeriod indicates an expected call of ReadSalesByPeriod.
+func (mr *MockInterfaceMockRecorder) ReadSalesByPeriod(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadSalesByPeriod", reflect.TypeOf((*MockInterface)(nil).ReadSalesByPeriod), arg0, arg1)
+}
+
+// ReadSerialEvents mocks base method.
+func (m *MockInterface) ReadSerialEvents(arg0 context.Context, arg1 *dto.Serial) ([]dto.SerialEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadSerialEvents", arg0, arg1)
+	ret0, _ := ret[0].([]dto.SerialEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadSerialEvents indicates an expected call of ReadSerialEvents.
+func (mr *MockInterfaceMockRecorder) ReadSerialEvents(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadSerialEvents", reflect.TypeOf((*MockInterface)(nil).ReadSerialEvents), arg0, arg1)
+}
+
+// ReadSerialTrackedArticles mocks base method.
+func (m *MockInterface) ReadSerialTrackedArticles(arg0 context.Context, arg1 []article.Article) ([]article.Article, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadSerialTrackedArticles", arg0, arg1)
+	ret0, _ := ret[0].([]article.Article)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadSerialTrackedArticles indicates an expected call of ReadSerialTrackedArticles.
+func (mr *MockInterfaceMockRecorder) ReadSerialTrackedArticles(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadSerialTrackedArticles", reflect.TypeOf((*MockInterface)(nil).ReadSerialTrackedArticles), arg0, arg1)
+}
+
+// ReadSerials mocks base method.
+func (m *MockInterface) ReadSerials(arg0 context.Context, arg1 []serial.Number) ([]dto.SerialRecord, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadSerials", arg0, arg1)
+	ret0, _ := ret[0].([]dto.SerialRecord)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadSerials indicates an expected call of ReadSerials.
+func (mr *MockInterfaceMockRecorder) ReadSerials(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadSerials", reflect.TypeOf((*MockInterface)(nil).ReadSerials), arg0, arg1)
+}
+
+// ReadSerialsByOrder mocks base method.
+func (m *MockInterface) ReadSerialsByOrder(arg0 context.Context, arg1 *dto.Number) ([]dto.SerialRecord, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadSerialsByOrder", arg0, arg1)
+	ret0, _ := ret[0].([]dto.SerialRecord)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadSerialsByOrder indicates an expected call of ReadSerialsByOrder.
+func (mr *MockInterfaceMockRecorder) ReadSerialsByOrder(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadSerialsByOrder", reflect.TypeOf((*MockInterface)(nil).ReadSerialsByOrder), arg0, arg1)
+}
+
+// ReadSerialsByReceipt mocks base method.
+func (m *MockInterface) ReadSerialsByReceipt(arg0 context.Context, arg1 *dto.ReceiptID) ([]dto.SerialRecord, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadSerialsByReceipt", arg0, arg1)
+	ret0, _ := ret[0].([]dto.SerialRecord)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadSerialsByReceipt indicates an expected call of ReadSerialsByReceipt.
+func (mr *MockInterfaceMockRecorder) ReadSerialsByReceipt(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadSerialsByReceipt", reflect.TypeOf((*MockInterface)(nil).ReadSerialsByReceipt), arg0, arg1)
+}
+
+// ReadShiftReceiptsCount mocks base method.
+func (m *MockInterface) ReadShiftReceiptsCount(arg0 context.Context, arg1 *dto.ShiftID) (uint, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadShiftReceiptsCount", arg0, arg1)
+	ret0, _ := ret[0].(uint)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadShiftReceiptsCount indicates an expected call of ReadShiftReceiptsCount.
+func (mr *MockInterfaceMockRecorder) ReadShiftReceiptsCount(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadShiftReceiptsCount", reflect.TypeOf((*MockInterface)(nil).ReadShiftReceiptsCount), arg0, arg1)
+}
+
+// ReadShiftRefunds mocks base method.
+func (m *MockInterface) ReadShiftRefunds(arg0 context.Context, arg1 *dto.ShiftID) ([]dto.PaymentCountTotal, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadShiftRefunds", arg0, arg1)
+	ret0, _ := ret[0].([]dto.PaymentCountTotal)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadShiftRefunds indicates an expected call of ReadShiftRefunds.
+func (mr *MockInterfaceMockRecorder) ReadShiftRefunds(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadShiftRefunds", reflect.TypeOf((*MockInterface)(nil).ReadShiftRefunds), arg0, arg1)
+}
+
+// ReadShiftSales mocks base method.
+func (m *MockInterface) ReadShiftSales(arg0 context.Context, arg1 *dto.ShiftID) ([]dto.PaymentCountTotal, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadShiftSales", arg0, arg1)
+	ret0, _ := ret[0].([]dto.PaymentCountTotal)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadShiftSales indicates an expected call of ReadShiftSales.
+func (mr *MockInterfaceMockRecorder) ReadShiftSales(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadShiftSales", reflect.TypeOf((*MockInterface)(nil).ReadShiftSales), arg0, arg1)
+}
+
+// ReadSoldAmount mocks base method.
+func (m *MockInterface) ReadSoldAmount(arg0 context.Context, arg1 *dto.Article) (uint, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadSoldAmount", arg0, arg1)
+	ret0, _ := ret[0].(uint)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadSoldAmount indicates an expected call of ReadSoldAmount.
+func (mr *MockInterfaceMockRecorder) ReadSoldAmount(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadSoldAmount", reflect.TypeOf((*MockInterface)(nil).ReadSoldAmount), arg0, arg1)
+}
+
+// ReadSoldAmountInPeriod mocks base method.
+func (m *MockInterface) ReadSoldAmountInPeriod(arg0 context.Context, arg1 *dto.ArticleFromTo) (uint, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadSoldAmountInPeriod", arg0, arg1)
+	ret0, _ := ret[0].(uint)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadSoldAmountInPeriod indicates an expected call of ReadSoldAmountInPeriod.
+func (mr *MockInterfaceMockRecorder) ReadSoldAmountInPeriod(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadSoldAmountInPeriod", reflect.TypeOf((*MockInterface)(nil).ReadSoldAmountInPeriod), arg0, arg1)
+}
+
+// ReadSoldRecords mocks base method.
+func (m *MockInterface) ReadSoldRecords(arg0 context.Context, arg1 *dto.Article) ([]dto.ArticlePriceAmountDate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadSoldRecords", arg0, arg1)
+	ret0, _ := ret[0].([]dto.ArticlePriceAmountDate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadSoldRecords indicates an expected call of ReadSoldRecords.
+func (mr *MockInterfaceMockRecorder) ReadSoldRecords(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadSoldRecords", reflect.TypeOf((*MockInterface)(nil).ReadSoldRecords), arg0, arg1)
+}
+
+// ReadSoldRecordsInPeriod mocks base method.
+func (m *MockInterface) ReadSoldRecordsInPeriod(arg0 context.Context, arg1 *dto.ArticleFromTo) ([]dto.ArticlePriceAmountDate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadSoldRecordsInPeriod", arg0, arg1)
+	ret0, _ := ret[0].([]dto.ArticlePriceAmountDate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadSoldRecordsInPeriod indicates an expected call of ReadSoldRecordsInPeriod.
+func (mr *MockInterfaceMockRecorder) ReadSoldRecordsInPeriod(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadSoldRecordsInPeriod", reflect.TypeOf((*MockInterface)(nil).ReadSoldRecordsInPeriod), arg0, arg1)
+}
+
+// ReadStock mocks base method.
+func (m *MockInterface) ReadStock(arg0 context.Context, arg1 *dto.Article) (dto.ArticlePriceNameAmount, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadStock", arg0, arg1)
+	ret0, _ := ret[0].(dto.ArticlePriceNameAmount)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadStock indicates an expected call of ReadStock.
+func (mr *MockInterfaceMockRecorder) ReadStock(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadStock", reflect.TypeOf((*MockInterface)(nil).ReadStock), arg0, arg1)
+}
+
+// ReadStockAmount mocks base method.
+func (m *MockInterface) ReadStockAmount(arg0 context.Context, arg1 *dto.Article) (uint, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadStockAmount", arg0, arg1)
+	ret0, _ := ret[0].(uint)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadStockAmount indicates an expected call of ReadStockAmount.
+func (mr *MockInterfaceMockRecorder) ReadStockAmount(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadStockAmount", reflect.TypeOf((*MockInterface)(nil).ReadStockAmount), arg0, arg1)
+}
+
+// ReadStockAmounts mocks base method.
+func (m *MockInterface) ReadStockAmounts(arg0 context.Context) ([]dto.ArticleAmount, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadStockAmounts", arg0)
+	ret0, _ := ret[0].([]dto.ArticleAmount)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadStockAmounts indicates an expected call of ReadStockAmounts.
+func (mr *MockInterfaceMockRecorder) ReadStockAmounts(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadStockAmounts", reflect.TypeOf((*MockInterface)(nil).ReadStockAmounts), arg0)
+}
+
+// ReadStockByFilter mocks base method.
+func (m *MockInterface) ReadStockByFilter(arg0 context.Context, arg1 *dto.StockFilter) ([]dto.ArticlePriceNameAmount, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadStockByFilter", arg0, arg1)
+	ret0, _ := ret[0].([]dto.ArticlePriceNameAmount)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadStockByFilter indicates an expected call of ReadStockByFilter.
+func (mr *MockInterfaceMockRecorder) ReadStockByFilter(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadStockByFilter", reflect.TypeOf((*MockInterface)(nil).ReadStockByFilter), arg0, arg1)
+}
+
+// ReadStockLocations mocks base method.
+func (m *MockInterface) ReadStockLocations(arg0 context.Context, arg1 *dto.Article) ([]dto.LocationAmount, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadStockLocations", arg0, arg1)
+	ret0, _ := ret[0].([]dto.LocationAmount)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadStockLocations indicates an expected call of ReadStockLocations.
+func (mr *MockInterfaceMockRecorder) ReadStockLocations(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadStockLocations", reflect.TypeOf((*MockInterface)(nil).ReadStockLocations), arg0, arg1)
+}
+
+// ReadStockPrice mocks base method.
+func (m *MockInterface) ReadStockPrice(arg0 context.Context, arg1 *dto.Article) (float64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadStockPrice", arg0, arg1)
+	ret0, _ := ret[0].(float64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadStockPrice indicates an expected call of ReadStockPrice.
+func (mr *MockInterfaceMockRecorder) ReadStockPrice(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadStockPrice", reflect.TypeOf((*MockInterface)(nil).ReadStockPrice), arg0, arg1)
+}
+
+// ReadStockPricesByBase mocks base method.
+func (m *MockInterface) ReadStockPricesByBase(arg0 context.Context, arg1 article.Article) ([]dto.ArticlePrice, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadStockPricesByBase", arg0, arg1)
+	ret0, _ := ret[0].([]dto.ArticlePrice)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadStockPricesByBase indicates an expected call of ReadStockPricesByBase.
+func (mr *MockInterfaceMockRecorder) ReadStockPricesByBase(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadStockPricesByBase", reflect.TypeOf((*MockInterface)(nil).ReadStockPricesByBase), arg0, arg1)
+}
+
+// ReadStockSoldSince mocks base method.
+func (m *MockInterface) ReadStockSoldSince(arg0 context.Context, arg1 time.Time) ([]dto.ArticleStockSold, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadStockSoldSince", arg0, arg1)
+	ret0, _ := ret[0].([]dto.ArticleStockSold)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadStockSoldSince indicates an expected call of ReadStockSoldSince.
+func (mr *MockInterfaceMockRecorder) ReadStockSoldSince(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadStockSoldSince", reflect.TypeOf((*MockInterface)(nil).ReadStockSoldSince), arg0, arg1)
+}
+
+// ReadStocktake mocks base method.
+func (m *MockInterface) ReadStocktake(arg0 context.Context, arg1 *dto.StocktakeID) (dto.Stocktake, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadStocktake", arg0, arg1)
+	ret0, _ := ret[0].(dto.Stocktake)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// ConvertToCommonErr indicates an expected call of ConvertToCommonErr.
-func (mr *MockInterfaceMockRecorder) ConvertToCommonErr(arg0 interface{}) *gomock.Call {
+// ReadStocktake indicates an expected call of ReadStocktake.
+func (mr *MockInterfaceMockRecorder) ReadStocktake(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConvertToCommonErr", reflect.TypeOf((*MockInterface)(nil).ConvertToCommonErr), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadStocktake", reflect.TypeOf((*MockInterface)(nil).ReadStocktake), arg0, arg1)
 }
 
-// CreateReservation mocks base method.
-func (m *MockInterface) CreateReservation(arg0 context.Context, arg1 *dto.NumberDateStateProducts) error {
+// ReadTaxRates mocks base method.
+func (m *MockInterface) ReadTaxRates(arg0 context.Context) ([]dto.TaxRate, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateReservation", arg0, arg1)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "ReadTaxRates", arg0)
+	ret0, _ := ret[0].([]dto.TaxRate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// CreateReservation indicates an expected call of CreateReservation.
-func (mr *MockInterfaceMockRecorder) CreateReservation(arg0, arg1 interface{}) *gomock.Call {
+// ReadTaxRates indicates an expected call of ReadTaxRates.
+func (mr *MockInterfaceMockRecorder) ReadTaxRates(arg0 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateReservation", reflect.TypeOf((*MockInterface)(nil).CreateReservation), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadTaxRates", reflect.TypeOf((*MockInterface)(nil).ReadTaxRates), arg0)
 }
 
-// CreateSoldRecord mocks base method.
-func (m *MockInterface) CreateSoldRecord(arg0 context.Context, arg1 *dto.ArticlePriceAmountDate) error {
+// ReadTopArticles mocks base method.
+func (m *MockInterface) ReadTopArticles(arg0 context.Context, arg1 *dto.FromToTop) ([]dto.ArticleSales, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateSoldRecord", arg0, arg1)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "ReadTopArticles", arg0, arg1)
+	ret0, _ := ret[0].([]dto.ArticleSales)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// CreateSoldRecord indicates an expected call of CreateSoldRecord.
-func (mr *MockInterfaceMockRecorder) CreateSoldRecord(arg0, arg1 interface{}) *gomock.Call {
+// ReadTopArticles indicates an expected call of ReadTopArticles.
+func (mr *MockInterfaceMockRecorder) ReadTopArticles(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSoldRecord", reflect.TypeOf((*MockInterface)(nil).CreateSoldRecord), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadTopArticles", reflect.TypeOf((*MockInterface)(nil).ReadTopArticles), arg0, arg1)
 }
 
-// CreateStock mocks base method.
-func (m *MockInterface) CreateStock(arg0 context.Context, arg1 *dto.ArticlePriceNameAmount) error {
+// ReadTransfer mocks base method.
+func (m *MockInterface) ReadTransfer(arg0 context.Context, arg1 *dto.TransferID) (dto.Transfer, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateStock", arg0, arg1)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "ReadTransfer", arg0, arg1)
+	ret0, _ := ret[0].(dto.Transfer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// CreateStock indicates an expected call of CreateStock.
-func (mr *MockInterfaceMockRecorder) CreateStock(arg0, arg1 interface{}) *gomock.Call {
+// ReadTransfer indicates an expected call of ReadTransfer.
+func (mr *MockInterfaceMockRecorder) ReadTransfer(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateStock", reflect.TypeOf((*MockInterface)(nil).CreateStock), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadTransfer", reflect.TypeOf((*MockInterface)(nil).ReadTransfer), arg0, arg1)
 }
 
-// DeleteReservation mocks base method.
-func (m *MockInterface) DeleteReservation(arg0 context.Context, arg1 *dto.Number) error {
+// ReadTransfers mocks base method.
+func (m *MockInterface) ReadTransfers(arg0 context.Context, arg1 *dto.TransferFilter) ([]dto.Transfer, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteReservation", arg0, arg1)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "ReadTransfers", arg0, arg1)
+	ret0, _ := ret[0].([]dto.Transfer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// DeleteReservation indicates an expected call of DeleteReservation.
-func (mr *MockInterfaceMockRecorder) DeleteReservation(arg0, arg1 interface{}) *gomock.Call {
+// ReadTransfers indicates an expected call of ReadTransfers.
+func (mr *MockInterfaceMockRecorder) ReadTransfers(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteReservation", reflect.TypeOf((*MockInterface)(nil).DeleteReservation), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadTransfers", reflect.TypeOf((*MockInterface)(nil).ReadTransfers), arg0, arg1)
 }
 
-// ReadReservation mocks base method.
-func (m *MockInterface) ReadReservation(arg0 context.Context, arg1 *dto.Number) (dto.NumberDateStateProducts, error) {
+// ReadUnnotifiedTransfers mocks base method.
+func (m *MockInterface) ReadUnnotifiedTransfers(arg0 context.Context) ([]dto.Transfer, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ReadReservation", arg0, arg1)
-	ret0, _ := ret[0].(dto.NumberDateStateProducts)
+	ret := m.ctrl.Call(m, "ReadUnnotifiedTransfers", arg0)
+	ret0, _ := ret[0].([]dto.Transfer)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// ReadReservation indicates an expected call of ReadReservation.
-func (mr *MockInterfaceMockRecorder) ReadReservation(arg0, arg1 interface{}) *gomock.Call {
+// ReadUnnotifiedTransfers indicates an expected call of ReadUnnotifiedTransfers.
+func (mr *MockInterfaceMockRecorder) ReadUnnotifiedTransfers(arg0 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadReservation", reflect.TypeOf((*MockInterface)(nil).ReadReservation), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadUnnotifiedTransfers", reflect.TypeOf((*MockInterface)(nil).ReadUnnotifiedTransfers), arg0)
 }
 
-// ReadSoldAmount mocks base method.
-func (m *MockInterface) ReadSoldAmount(arg0 context.Context, arg1 *dto.Article) (uint, error) {
+// ReadWarrantiesByPhone mocks base method.
+func (m *MockInterface) ReadWarrantiesByPhone(arg0 context.Context, arg1 *dto.Phone) ([]dto.Warranty, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ReadSoldAmount", arg0, arg1)
-	ret0, _ := ret[0].(uint)
+	ret := m.ctrl.Call(m, "ReadWarrantiesByPhone", arg0, arg1)
+	ret0, _ := ret[0].([]dto.Warranty)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// ReadSoldAmount indicates an expected call of ReadSoldAmount.
-func (mr *MockInterfaceMockRecorder) ReadSoldAmount(arg0, arg1 interface{}) *gomock.Call {
+// ReadWarrantiesByPhone indicates an expected call of ReadWarrantiesByPhone.
+func (mr *MockInterfaceMockRecorder) ReadWarrantiesByPhone(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadSoldAmount", reflect.TypeOf((*MockInterface)(nil).ReadSoldAmount), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadWarrantiesByPhone", reflect.TypeOf((*MockInterface)(nil).ReadWarrantiesByPhone), arg0, arg1)
 }
 
-// ReadSoldAmountInPeriod mocks base method.
-func (m *MockInterface) ReadSoldAmountInPeriod(arg0 context.Context, arg1 *dto.ArticleFromTo) (uint, error) {
+// ReadWarrantiesByReceipt mocks base method.
+func (m *MockInterface) ReadWarrantiesByReceipt(arg0 context.Context, arg1 *dto.ReceiptID) ([]dto.Warranty, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ReadSoldAmountInPeriod", arg0, arg1)
-	ret0, _ := ret[0].(uint)
+	ret := m.ctrl.Call(m, "ReadWarrantiesByReceipt", arg0, arg1)
+	ret0, _ := ret[0].([]dto.Warranty)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// ReadSoldAmountInPeriod indicates an expected call of ReadSoldAmountInPeriod.
-func (mr *MockInterfaceMockRecorder) ReadSoldAmountInPeriod(arg0, arg1 interface{}) *gomock.Call {
+// ReadWarrantiesByReceipt indicates an expected call of ReadWarrantiesByReceipt.
+func (mr *MockInterfaceMockRecorder) ReadWarrantiesByReceipt(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadSoldAmountInPeriod", reflect.TypeOf((*MockInterface)(nil).ReadSoldAmountInPeriod), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadWarrantiesByReceipt", reflect.TypeOf((*MockInterface)(nil).ReadWarrantiesByReceipt), arg0, arg1)
 }
 
-// ReadSoldRecords mocks base method.
-func (m *MockInterface) ReadSoldRecords(arg0 context.Context, arg1 *dto.Article) ([]dto.ArticlePriceAmountDate, error) {
+// ReadWarrantiesBySerial mocks base method.
+func (m *MockInterface) ReadWarrantiesBySerial(arg0 context.Context, arg1 *dto.Serial) ([]dto.Warranty, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ReadSoldRecords", arg0, arg1)
-	ret0, _ := ret[0].([]dto.ArticlePriceAmountDate)
+	ret := m.ctrl.Call(m, "ReadWarrantiesBySerial", arg0, arg1)
+	ret0, _ := ret[0].([]dto.Warranty)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// ReadSoldRecords indicates an expected call of ReadSoldRecords.
-func (mr *MockInterfaceMockRecorder) ReadSoldRecords(arg0, arg1 interface{}) *gomock.Call {
+// ReadWarrantiesBySerial indicates an expected call of ReadWarrantiesBySerial.
+func (mr *MockInterfaceMockRecorder) ReadWarrantiesBySerial(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadSoldRecords", reflect.TypeOf((*MockInterface)(nil).ReadSoldRecords), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadWarrantiesBySerial", reflect.TypeOf((*MockInterface)(nil).ReadWarrantiesBySerial), arg0, arg1)
 }
 
-// ReadSoldRecordsInPeriod mocks base method.
-func (m *MockInterface) ReadSoldRecordsInPeriod(arg0 context.Context, arg1 *dto.ArticleFromTo) ([]dto.ArticlePriceAmountDate, error) {
+// ReadWarranty mocks base method.
+func (m *MockInterface) ReadWarranty(arg0 context.Context, arg1 *dto.WarrantyID) (dto.Warranty, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ReadSoldRecordsInPeriod", arg0, arg1)
-	ret0, _ := ret[0].([]dto.ArticlePriceAmountDate)
+	ret := m.ctrl.Call(m, "ReadWarranty", arg0, arg1)
+	ret0, _ := ret[0].(dto.Warranty)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// ReadSoldRecordsInPeriod indicates an expected call of ReadSoldRecordsInPeriod.
-func (mr *MockInterfaceMockRecorder) ReadSoldRecordsInPeriod(arg0, arg1 interface{}) *gomock.Call {
+// ReadWarranty indicates an expected call of ReadWarranty.
+func (mr *MockInterfaceMockRecorder) ReadWarranty(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadSoldRecordsInPeriod", reflect.TypeOf((*MockInterface)(nil).ReadSoldRecordsInPeriod), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadWarranty", reflect.TypeOf((*MockInterface)(nil).ReadWarranty), arg0, arg1)
 }
 
-// ReadStock mocks base method.
-func (m *MockInterface) ReadStock(arg0 context.Context, arg1 *dto.Article) (dto.ArticlePriceNameAmount, error) {
+// ReadWarrantyClaim mocks base method.
+func (m *MockInterface) ReadWarrantyClaim(arg0 context.Context, arg1 *dto.WarrantyClaimID) (dto.WarrantyClaim, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ReadStock", arg0, arg1)
-	ret0, _ := ret[0].(dto.ArticlePriceNameAmount)
+	ret := m.ctrl.Call(m, "ReadWarrantyClaim", arg0, arg1)
+	ret0, _ := ret[0].(dto.WarrantyClaim)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// ReadStock indicates an expected call of ReadStock.
-func (mr *MockInterfaceMockRecorder) ReadStock(arg0, arg1 interface{}) *gomock.Call {
+// ReadWarrantyClaim indicates an expected call of ReadWarrantyClaim.
+func (mr *MockInterfaceMockRecorder) ReadWarrantyClaim(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadStock", reflect.TypeOf((*MockInterface)(nil).ReadStock), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadWarrantyClaim", reflect.TypeOf((*MockInterface)(nil).ReadWarrantyClaim), arg0, arg1)
 }
 
-// ReadStockAmount mocks base method.
-func (m *MockInterface) ReadStockAmount(arg0 context.Context, arg1 *dto.Article) (uint, error) {
+// ReadWarrantyClaimTransitions mocks base method.
+func (m *MockInterface) ReadWarrantyClaimTransitions(arg0 context.Context, arg1 *dto.WarrantyID) ([]dto.WarrantyClaimTransition, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ReadStockAmount", arg0, arg1)
-	ret0, _ := ret[0].(uint)
+	ret := m.ctrl.Call(m, "ReadWarrantyClaimTransitions", arg0, arg1)
+	ret0, _ := ret[0].([]dto.WarrantyClaimTransition)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// ReadStockAmount indicates an expected call of ReadStockAmount.
-func (mr *MockInterfaceMockRecorder) ReadStockAmount(arg0, arg1 interface{}) *gomock.Call {
+// ReadWarrantyClaimTransitions indicates an expected call of ReadWarrantyClaimTransitions.
+func (mr *MockInterfaceMockRecorder) ReadWarrantyClaimTransitions(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadStockAmount", reflect.TypeOf((*MockInterface)(nil).ReadStockAmount), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadWarrantyClaimTransitions", reflect.TypeOf((*MockInterface)(nil).ReadWarrantyClaimTransitions), arg0, arg1)
 }
 
-// ReadStockPrice mocks base method.
-func (m *MockInterface) ReadStockPrice(arg0 context.Context, arg1 *dto.Article) (float64, error) {
+// ReadWarrantyClaims mocks base method.
+func (m *MockInterface) ReadWarrantyClaims(arg0 context.Context, arg1 *dto.WarrantyID) ([]dto.WarrantyClaim, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ReadStockPrice", arg0, arg1)
-	ret0, _ := ret[0].(float64)
+	ret := m.ctrl.Call(m, "ReadWarrantyClaims", arg0, arg1)
+	ret0, _ := ret[0].([]dto.WarrantyClaim)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// ReadStockPrice indicates an expected call of ReadStockPrice.
-func (mr *MockInterfaceMockRecorder) ReadStockPrice(arg0, arg1 interface{}) *gomock.Call {
+// ReadWarrantyClaims indicates an expected call of ReadWarrantyClaims.
+func (mr *MockInterfaceMockRecorder) ReadWarrantyClaims(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadStockPrice", reflect.TypeOf((*MockInterface)(nil).ReadStockPrice), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadWarrantyClaims", reflect.TypeOf((*MockInterface)(nil).ReadWarrantyClaims), arg0, arg1)
+}
+
+// ReadWarrantyTerms mocks base method.
+func (m *MockInterface) ReadWarrantyTerms(arg0 context.Context, arg1 []article.Article) ([]dto.WarrantyTerm, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadWarrantyTerms", arg0, arg1)
+	ret0, _ := ret[0].([]dto.WarrantyTerm)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadWarrantyTerms indicates an expected call of ReadWarrantyTerms.
+func (mr *MockInterfaceMockRecorder) ReadWarrantyTerms(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadWarrantyTerms", reflect.TypeOf((*MockInterface)(nil).ReadWarrantyTerms), arg0, arg1)
+}
+
+// ReadZReport mocks base method.
+func (m *MockInterface) ReadZReport(arg0 context.Context, arg1 *dto.ShiftID) (dto.ZReport, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadZReport", arg0, arg1)
+	ret0, _ := ret[0].(dto.ZReport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadZReport indicates an expected call of ReadZReport.
+func (mr *MockInterfaceMockRecorder) ReadZReport(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadZReport", reflect.TypeOf((*MockInterface)(nil).ReadZReport), arg0, arg1)
+}
+
+// TouchCashRegister mocks base method.
+func (m *MockInterface) TouchCashRegister(arg0 context.Context, arg1 *dto.CashRegister, arg2 time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TouchCashRegister", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// TouchCashRegister indicates an expected call of TouchCashRegister.
+func (mr *MockInterfaceMockRecorder) TouchCashRegister(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TouchCashRegister", reflect.TypeOf((*MockInterface)(nil).TouchCashRegister), arg0, arg1, arg2)
+}
+
+// UpdateCashRegister mocks base method.
+func (m *MockInterface) UpdateCashRegister(arg0 context.Context, arg1 *dto.CashRegisterRecord) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateCashRegister", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateCashRegister indicates an expected call of UpdateCashRegister.
+func (mr *MockInterfaceMockRecorder) UpdateCashRegister(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateCashRegister", reflect.TypeOf((*MockInterface)(nil).UpdateCashRegister), arg0, arg1)
+}
+
+// UpdateIdempotencyRecord mocks base method.
+func (m *MockInterface) UpdateIdempotencyRecord(arg0 context.Context, arg1 *dto.IdempotencyRecord) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateIdempotencyRecord", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateIdempotencyRecord indicates an expected call of UpdateIdempotencyRecord.
+func (mr *MockInterfaceMockRecorder) UpdateIdempotencyRecord(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateIdempotencyRecord", reflect.TypeOf((*MockInterface)(nil).UpdateIdempotencyRecord), arg0, arg1)
 }
 
 // UpdateReservation mocks base method.
@@ -243,6 +1519,20 @@ func (mr *MockInterfaceMockRecorder) UpdateReservation(arg0, arg1 interface{}) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateReservation", reflect.TypeOf((*MockInterface)(nil).UpdateReservation), arg0, arg1)
 }
 
+// UpdateSerials mocks base method.
+func (m *MockInterface) UpdateSerials(arg0 context.Context, arg1 []dto.SerialRecord) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateSerials", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateSerials indicates an expected call of UpdateSerials.
+func (mr *MockInterfaceMockRecorder) UpdateSerials(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateSerials", reflect.TypeOf((*MockInterface)(nil).UpdateSerials), arg0, arg1)
+}
+
 // UpdateStock mocks base method.
 func (m *MockInterface) UpdateStock(arg0 context.Context, arg1 *dto.ArticlePriceNameAmount) error {
 	m.ctrl.T.Helper()
@@ -285,6 +1575,188 @@ func (mr *MockInterfaceMockRecorder) UpdateStockPrice(arg0, arg1 interface{}) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateStockPrice", reflect.TypeOf((*MockInterface)(nil).UpdateStockPrice), arg0, arg1)
 }
 
+// UpdateStocktake mocks base method.
+func (m *MockInterface) UpdateStocktake(arg0 context.Context, arg1 *dto.Stocktake) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateStocktake", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateStocktake indicates an expected call of UpdateStocktake.
+func (mr *MockInterfaceMockRecorder) UpdateStocktake(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateStocktake", reflect.TypeOf((*MockInterface)(nil).UpdateStocktake), arg0, arg1)
+}
+
+// UpdateStocktakeCounts mocks base method.
+func (m *MockInterface) UpdateStocktakeCounts(arg0 context.Context, arg1 *dto.StocktakeCounts) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateStocktakeCounts", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateStocktakeCounts indicates an expected call of UpdateStocktakeCounts.
+func (mr *MockInterfaceMockRecorder) UpdateStocktakeCounts(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateStocktakeCounts", reflect.TypeOf((*MockInterface)(nil).UpdateStocktakeCounts), arg0, arg1)
+}
+
+// UpdateTransfer mocks base method.
+func (m *MockInterface) UpdateTransfer(arg0 context.Context, arg1 *dto.Transfer) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateTransfer", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateTransfer indicates an expected call of UpdateTransfer.
+func (mr *MockInterfaceMockRecorder) UpdateTransfer(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTransfer", reflect.TypeOf((*MockInterface)(nil).UpdateTransfer), arg0, arg1)
+}
+
+// UpdateTransferNotified mocks base method.
+func (m *MockInterface) UpdateTransferNotified(arg0 context.Context, arg1 *dto.TransferID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateTransferNotified", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateTransferNotified indicates an expected call of UpdateTransferNotified.
+func (mr *MockInterfaceMockRecorder) UpdateTransferNotified(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTransferNotified", reflect.TypeOf((*MockInterface)(nil).UpdateTransferNotified), arg0, arg1)
+}
+
+// UpdateWarrantyClaimStatus mocks base method.
+func (m *MockInterface) UpdateWarrantyClaimStatus(arg0 context.Context, arg1 *dto.ClaimIDStatus) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateWarrantyClaimStatus", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateWarrantyClaimStatus indicates an expected call of UpdateWarrantyClaimStatus.
+func (mr *MockInterfaceMockRecorder) UpdateWarrantyClaimStatus(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateWarrantyClaimStatus", reflect.TypeOf((*MockInterface)(nil).UpdateWarrantyClaimStatus), arg0, arg1)
+}
+
+// UpdateWarrantyRefund mocks base method.
+func (m *MockInterface) UpdateWarrantyRefund(arg0 context.Context, arg1 *dto.Warranty) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateWarrantyRefund", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateWarrantyRefund indicates an expected call of UpdateWarrantyRefund.
+func (mr *MockInterfaceMockRecorder) UpdateWarrantyRefund(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateWarrantyRefund", reflect.TypeOf((*MockInterface)(nil).UpdateWarrantyRefund), arg0, arg1)
+}
+
+// UpsertArticleAttributes mocks base method.
+func (m *MockInterface) UpsertArticleAttributes(arg0 context.Context, arg1 *dto.ArticleAttributes) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpsertArticleAttributes", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpsertArticleAttributes indicates an expected call of UpsertArticleAttributes.
+func (mr *MockInterfaceMockRecorder) UpsertArticleAttributes(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertArticleAttributes", reflect.TypeOf((*MockInterface)(nil).UpsertArticleAttributes), arg0, arg1)
+}
+
+// UpsertArticleTaxCategory mocks base method.
+func (m *MockInterface) UpsertArticleTaxCategory(arg0 context.Context, arg1 *dto.ArticleTaxCategory) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpsertArticleTaxCategory", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpsertArticleTaxCategory indicates an expected call of UpsertArticleTaxCategory.
+func (mr *MockInterfaceMockRecorder) UpsertArticleTaxCategory(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertArticleTaxCategory", reflect.TypeOf((*MockInterface)(nil).UpsertArticleTaxCategory), arg0, arg1)
+}
+
+// UpsertAttributeDefinition mocks base method.
+func (m *MockInterface) UpsertAttributeDefinition(arg0 context.Context, arg1 *dto.AttributeDefinition) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpsertAttributeDefinition", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpsertAttributeDefinition indicates an expected call of UpsertAttributeDefinition.
+func (mr *MockInterfaceMockRecorder) UpsertAttributeDefinition(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertAttributeDefinition", reflect.TypeOf((*MockInterface)(nil).UpsertAttributeDefinition), arg0, arg1)
+}
+
+// UpsertReplenishmentSetting mocks base method.
+func (m *MockInterface) UpsertReplenishmentSetting(arg0 context.Context, arg1 *dto.ReplenishmentSetting) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpsertReplenishmentSetting", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpsertReplenishmentSetting indicates an expected call of UpsertReplenishmentSetting.
+func (mr *MockInterfaceMockRecorder) UpsertReplenishmentSetting(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertReplenishmentSetting", reflect.TypeOf((*MockInterface)(nil).UpsertReplenishmentSetting), arg0, arg1)
+}
+
+// UpsertStockLocationAmount mocks base method.
+func (m *MockInterface) UpsertStockLocationAmount(arg0 context.Context, arg1 *dto.ArticleLocationAmount) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpsertStockLocationAmount", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpsertStockLocationAmount indicates an expected call of UpsertStockLocationAmount.
+func (mr *MockInterfaceMockRecorder) UpsertStockLocationAmount(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertStockLocationAmount", reflect.TypeOf((*MockInterface)(nil).UpsertStockLocationAmount), arg0, arg1)
+}
+
+// UpsertTaxRate mocks base method.
+func (m *MockInterface) UpsertTaxRate(arg0 context.Context, arg1 *dto.TaxRate) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpsertTaxRate", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpsertTaxRate indicates an expected call of UpsertTaxRate.
+func (mr *MockInterfaceMockRecorder) UpsertTaxRate(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertTaxRate", reflect.TypeOf((*MockInterface)(nil).UpsertTaxRate), arg0, arg1)
+}
+
+// UpsertWarrantyTerm mocks base method.
+func (m *MockInterface) UpsertWarrantyTerm(arg0 context.Context, arg1 *dto.WarrantyTerm) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpsertWarrantyTerm", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpsertWarrantyTerm indicates an expected call of UpsertWarrantyTerm.
+func (mr *MockInterfaceMockRecorder) UpsertWarrantyTerm(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertWarrantyTerm", reflect.TypeOf((*MockInterface)(nil).UpsertWarrantyTerm), arg0, arg1)
+}
+
 // WithinTransaction mocks base method.
 func (m *MockInterface) WithinTransaction(arg0 context.Context, arg1 func(context.Context) error) error {
 	// пришлось внести изменения в сгенерированный код, так как нужно тестировать логику, которую передают в функции arg1
@@ -4,8 +4,19 @@ import (
 	"context"
 	"database/sql"
 	"errors"
+	"github.com/lazylex/watch-store-store/internal/domain/aggregates/receipt"
+	"github.com/lazylex/watch-store-store/internal/domain/aggregates/refund"
+	"github.com/lazylex/watch-store-store/internal/domain/aggregates/shift"
+	"github.com/lazylex/watch-store-store/internal/domain/aggregates/stocktake"
+	"github.com/lazylex/watch-store-store/internal/domain/aggregates/transfer"
+	"github.com/lazylex/watch-store-store/internal/domain/aggregates/warranty"
+	"github.com/lazylex/watch-store-store/internal/domain/value_objects/article"
+	"github.com/lazylex/watch-store-store/internal/domain/value_objects/attribute"
+	"github.com/lazylex/watch-store-store/internal/domain/value_objects/barcode"
+	"github.com/lazylex/watch-store-store/internal/domain/value_objects/serial"
 	"github.com/lazylex/watch-store-store/internal/dto"
 	"github.com/lazylex/watch-store-store/internal/helpers/constants/prefixes"
+	"time"
 )
 
 // repositoryError добавляет к тексту ошибки префикс, указывающий на её принадлежность к хранилищу.
@@ -28,21 +39,141 @@ type Interface interface {
 	CreateStock(context.Context, *dto.ArticlePriceNameAmount) error
 	ReadStock(context.Context, *dto.Article) (dto.ArticlePriceNameAmount, error)
 	ReadStockAmount(context.Context, *dto.Article) (uint, error)
+	ReadStockAmounts(context.Context) ([]dto.ArticleAmount, error)
 	ReadStockPrice(context.Context, *dto.Article) (float64, error)
 	UpdateStock(context.Context, *dto.ArticlePriceNameAmount) error
 	UpdateStockAmount(context.Context, *dto.ArticleAmount) error
 	UpdateStockPrice(context.Context, *dto.ArticlePrice) error
+	ReadStockPricesByBase(context.Context, article.Article) ([]dto.ArticlePrice, error)
 
 	CreateReservation(context.Context, *dto.NumberDateStateProducts) error
 	ReadReservation(context.Context, *dto.Number) (dto.NumberDateStateProducts, error)
 	UpdateReservation(context.Context, *dto.NumberDateStateProducts) error
 	DeleteReservation(context.Context, *dto.Number) error
+	NextOrderNumber(context.Context) (int64, error)
+	CreateReservationCustomer(context.Context, *dto.ReservationCustomer) error
+	ReadReservationCustomer(context.Context, *dto.Number) (dto.ReservationCustomer, error)
+	ReadOpenReservationsByPhone(context.Context, *dto.Phone) ([]dto.NumberDateStateProducts, error)
 
 	CreateSoldRecord(context.Context, *dto.ArticlePriceAmountDate) error
 	ReadSoldRecords(context.Context, *dto.Article) ([]dto.ArticlePriceAmountDate, error)
 	ReadSoldAmount(context.Context, *dto.Article) (uint, error)
 	ReadSoldRecordsInPeriod(context.Context, *dto.ArticleFromTo) ([]dto.ArticlePriceAmountDate, error)
 	ReadSoldAmountInPeriod(context.Context, *dto.ArticleFromTo) (uint, error)
+
+	CreateReceipt(context.Context, *dto.Receipt) (receipt.ID, error)
+	ReadReceipt(context.Context, *dto.ReceiptID) (dto.Receipt, error)
+
+	CreateRefund(context.Context, *dto.RefundRecord) (refund.ID, error)
+	ReadRefundedProducts(context.Context, *dto.ReceiptID) ([]dto.ArticleAmount, error)
+
+	CreateShift(context.Context, *dto.Shift) (shift.ID, error)
+	ReadOpenShift(context.Context, *dto.CashRegister) (dto.Shift, error)
+	CloseShift(context.Context, *dto.Shift) error
+	ReadShiftSales(context.Context, *dto.ShiftID) ([]dto.PaymentCountTotal, error)
+	ReadShiftReceiptsCount(context.Context, *dto.ShiftID) (uint, error)
+	ReadShiftRefunds(context.Context, *dto.ShiftID) ([]dto.PaymentCountTotal, error)
+
+	CreateZReport(context.Context, *dto.ZReport) error
+	ReadZReport(context.Context, *dto.ShiftID) (dto.ZReport, error)
+
+	CreateCashRegister(context.Context, *dto.CashRegisterRecord) error
+	UpdateCashRegister(context.Context, *dto.CashRegisterRecord) error
+	ReadCashRegister(context.Context, *dto.CashRegister) (dto.CashRegisterRecord, error)
+	ReadCashRegisters(context.Context) ([]dto.CashRegisterRecord, error)
+	TouchCashRegister(context.Context, *dto.CashRegister, time.Time) error
+
+	CreateStocktake(context.Context, *dto.Stocktake) (stocktake.ID, error)
+	ReadStocktake(context.Context, *dto.StocktakeID) (dto.Stocktake, error)
+	UpdateStocktakeCounts(context.Context, *dto.StocktakeCounts) error
+	UpdateStocktake(context.Context, *dto.Stocktake) error
+
+	CreateGoodsReceipt(context.Context, *dto.GoodsReceipt) error
+	ReadGoodsReceipt(context.Context, *dto.DocumentNumber) (dto.GoodsReceipt, error)
+
+	CreateStockAdjustment(context.Context, *dto.StockAdjustment) error
+
+	CreateReservationTransition(context.Context, *dto.ReservationTransition) error
+	ReadReservationTransitions(context.Context, *dto.Number) ([]dto.ReservationTransition, error)
+
+	CreateIdempotencyRecord(context.Context, *dto.IdempotencyRecord) error
+	ReadIdempotencyRecord(context.Context, *dto.IdempotencyKey) (dto.IdempotencyRecord, error)
+	UpdateIdempotencyRecord(context.Context, *dto.IdempotencyRecord) error
+	DeleteIdempotencyRecord(context.Context, *dto.IdempotencyKey) error
+	DeleteExpiredIdempotencyRecords(context.Context, time.Time) error
+
+	ReadSalesByPeriod(context.Context, *dto.FromToBucket) ([]dto.PeriodSales, error)
+	ReadTopArticles(context.Context, *dto.FromToTop) ([]dto.ArticleSales, error)
+	ReadArticlesSales(context.Context, *dto.FromTo) ([]dto.ArticleSales, error)
+	ReadArticleSales(context.Context, *dto.ArticleFromTo) (dto.ArticleSales, error)
+	ReadSalesByChannel(context.Context, *dto.FromTo) ([]dto.ChannelSales, error)
+	ReadPaymentsByDay(context.Context, *dto.FromTo) ([]dto.DailyPayments, error)
+	ReadOpenReservationsByState(context.Context) ([]dto.StateOrdersUnits, error)
+
+	IterateSoldRecords(context.Context, *dto.FromTo, func(dto.SoldRecord) error) error
+	IterateArticlesSales(context.Context, *dto.FromTo, func(dto.ArticleSales) error) error
+
+	UpsertReplenishmentSetting(context.Context, *dto.ReplenishmentSetting) error
+	ReadReplenishmentSetting(context.Context, *dto.Article) (dto.ReplenishmentSetting, error)
+	ReadStockSoldSince(context.Context, time.Time) ([]dto.ArticleStockSold, error)
+
+	UpsertAttributeDefinition(context.Context, *dto.AttributeDefinition) error
+	ReadAttributeDefinitions(context.Context) ([]dto.AttributeDefinition, error)
+	UpsertArticleAttributes(context.Context, *dto.ArticleAttributes) error
+	DeleteArticleAttribute(context.Context, *dto.ArticleAttributeCode) error
+	ReadArticlesAttributes(context.Context, []article.Article) (map[article.Article]map[attribute.Code]string, error)
+	ReadStockByFilter(context.Context, *dto.StockFilter) ([]dto.ArticlePriceNameAmount, error)
+
+	CreateArticleBarcodes(context.Context, []dto.ArticleBarcode) error
+	ReadBarcodesArticles(context.Context, []barcode.Barcode) (map[barcode.Barcode]article.Article, error)
+	ReadArticleByBarcode(context.Context, *dto.Barcode) (article.Article, error)
+	ReadArticleBarcodes(context.Context, *dto.Article) ([]barcode.Barcode, error)
+	DeleteBarcode(context.Context, *dto.Barcode) error
+
+	ReadStockLocations(context.Context, *dto.Article) ([]dto.LocationAmount, error)
+	UpsertStockLocationAmount(context.Context, *dto.ArticleLocationAmount) error
+
+	CreateTransfer(context.Context, *dto.Transfer) (transfer.ID, error)
+	ReadTransfer(context.Context, *dto.TransferID) (dto.Transfer, error)
+	ReadTransfers(context.Context, *dto.TransferFilter) ([]dto.Transfer, error)
+	ReadUnnotifiedTransfers(context.Context) ([]dto.Transfer, error)
+	UpdateTransfer(context.Context, *dto.Transfer) error
+	UpdateTransferNotified(context.Context, *dto.TransferID) error
+
+	UpsertTaxRate(context.Context, *dto.TaxRate) error
+	ReadTaxRates(context.Context) ([]dto.TaxRate, error)
+	UpsertArticleTaxCategory(context.Context, *dto.ArticleTaxCategory) error
+	ReadArticlesTaxRates(context.Context, []article.Article, time.Time) ([]dto.ArticleTaxRate, error)
+
+	CreateSerialTracking(context.Context, *dto.Article, time.Time) error
+	DeleteSerialTracking(context.Context, *dto.Article) error
+	ReadSerialTrackedArticles(context.Context, []article.Article) ([]article.Article, error)
+	CreateSerials(context.Context, []dto.SerialRecord) error
+	ReadSerials(context.Context, []serial.Number) ([]dto.SerialRecord, error)
+	ReadSerialsByOrder(context.Context, *dto.Number) ([]dto.SerialRecord, error)
+	ReadSerialsByReceipt(context.Context, *dto.ReceiptID) ([]dto.SerialRecord, error)
+	ReadAvailableSerials(context.Context, *dto.Article) ([]dto.SerialRecord, error)
+	UpdateSerials(context.Context, []dto.SerialRecord) error
+	CreateSerialEvents(context.Context, []dto.SerialEvent) error
+	ReadSerialEvents(context.Context, *dto.Serial) ([]dto.SerialEvent, error)
+	ReadReservedAmount(context.Context, *dto.Article) (uint, error)
+	ReadReservedInStoreAmounts(context.Context) ([]dto.ArticleAmount, error)
+
+	UpsertWarrantyTerm(context.Context, *dto.WarrantyTerm) error
+	DeleteWarrantyTerm(context.Context, *dto.Article) error
+	ReadWarrantyTerms(context.Context, []article.Article) ([]dto.WarrantyTerm, error)
+	CreateWarranties(context.Context, []dto.Warranty) error
+	ReadWarranty(context.Context, *dto.WarrantyID) (dto.Warranty, error)
+	ReadWarrantiesByReceipt(context.Context, *dto.ReceiptID) ([]dto.Warranty, error)
+	UpdateWarrantyRefund(context.Context, *dto.Warranty) error
+	ReadWarrantiesBySerial(context.Context, *dto.Serial) ([]dto.Warranty, error)
+	ReadWarrantiesByPhone(context.Context, *dto.Phone) ([]dto.Warranty, error)
+	CreateWarrantyClaim(context.Context, *dto.WarrantyClaim) (warranty.ClaimID, error)
+	ReadWarrantyClaim(context.Context, *dto.WarrantyClaimID) (dto.WarrantyClaim, error)
+	ReadWarrantyClaims(context.Context, *dto.WarrantyID) ([]dto.WarrantyClaim, error)
+	UpdateWarrantyClaimStatus(context.Context, *dto.ClaimIDStatus) error
+	CreateWarrantyClaimTransition(context.Context, *dto.WarrantyClaimTransition) error
+	ReadWarrantyClaimTransitions(context.Context, *dto.WarrantyID) ([]dto.WarrantyClaimTransition, error)
 }
 
 type SQLDBInterface interface {
@@ -15,4 +15,64 @@ type Interface interface {
 	CancelReservation(w http.ResponseWriter, r *http.Request)
 	MakeLocalSale(w http.ResponseWriter, r *http.Request)
 	FinishOrder(w http.ResponseWriter, r *http.Request)
+	Receipt(w http.ResponseWriter, r *http.Request)
+	ReturnSale(w http.ResponseWriter, r *http.Request)
+	OpenShift(w http.ResponseWriter, r *http.Request)
+	CloseShift(w http.ResponseWriter, r *http.Request)
+	ZReport(w http.ResponseWriter, r *http.Request)
+	CreateCashRegister(w http.ResponseWriter, r *http.Request)
+	UpdateCashRegister(w http.ResponseWriter, r *http.Request)
+	CashRegister(w http.ResponseWriter, r *http.Request)
+	CashRegisters(w http.ResponseWriter, r *http.Request)
+	StartStocktake(w http.ResponseWriter, r *http.Request)
+	CountStocktake(w http.ResponseWriter, r *http.Request)
+	StocktakeDiscrepancies(w http.ResponseWriter, r *http.Request)
+	ApplyStocktake(w http.ResponseWriter, r *http.Request)
+	ReceiveGoods(w http.ResponseWriter, r *http.Request)
+	GoodsReceipt(w http.ResponseWriter, r *http.Request)
+	AdjustAmountInStock(w http.ResponseWriter, r *http.Request)
+	MarkReadyForPickup(w http.ResponseWriter, r *http.Request)
+	ShipOrder(w http.ResponseWriter, r *http.Request)
+	ReservationHistory(w http.ResponseWriter, r *http.Request)
+	OpenReservationsByPhone(w http.ResponseWriter, r *http.Request)
+	SalesByPeriod(w http.ResponseWriter, r *http.Request)
+	TopArticles(w http.ResponseWriter, r *http.Request)
+	AverageSalePrice(w http.ResponseWriter, r *http.Request)
+	SalesByChannel(w http.ResponseWriter, r *http.Request)
+	ExportSales(w http.ResponseWriter, r *http.Request)
+	SetReplenishmentSetting(w http.ResponseWriter, r *http.Request)
+	ReplenishmentSetting(w http.ResponseWriter, r *http.Request)
+	ReorderSuggestions(w http.ResponseWriter, r *http.Request)
+	SetAttributeDefinition(w http.ResponseWriter, r *http.Request)
+	AttributeDefinitions(w http.ResponseWriter, r *http.Request)
+	SetArticleAttributes(w http.ResponseWriter, r *http.Request)
+	DeleteArticleAttribute(w http.ResponseWriter, r *http.Request)
+	FindStock(w http.ResponseWriter, r *http.Request)
+	ImportBarcodes(w http.ResponseWriter, r *http.Request)
+	StockByBarcode(w http.ResponseWriter, r *http.Request)
+	ArticleBarcodes(w http.ResponseWriter, r *http.Request)
+	DeleteBarcode(w http.ResponseWriter, r *http.Request)
+	TransferStock(w http.ResponseWriter, r *http.Request)
+	CreateOutboundTransfer(w http.ResponseWriter, r *http.Request)
+	ConfirmInboundTransfer(w http.ResponseWriter, r *http.Request)
+	ResolveTransfer(w http.ResponseWriter, r *http.Request)
+	Transfer(w http.ResponseWriter, r *http.Request)
+	Transfers(w http.ResponseWriter, r *http.Request)
+	ImportStock(w http.ResponseWriter, r *http.Request)
+	UpdatePrices(w http.ResponseWriter, r *http.Request)
+	SetTaxRate(w http.ResponseWriter, r *http.Request)
+	TaxRates(w http.ResponseWriter, r *http.Request)
+	SetArticleTaxCategory(w http.ResponseWriter, r *http.Request)
+	ArticleTaxRate(w http.ResponseWriter, r *http.Request)
+	SetSerialTracking(w http.ResponseWriter, r *http.Request)
+	AvailableSerials(w http.ResponseWriter, r *http.Request)
+	SerialHistory(w http.ResponseWriter, r *http.Request)
+	SetWarrantyTerm(w http.ResponseWriter, r *http.Request)
+	WarrantyTerm(w http.ResponseWriter, r *http.Request)
+	WarrantiesByReceipt(w http.ResponseWriter, r *http.Request)
+	WarrantiesBySerial(w http.ResponseWriter, r *http.Request)
+	WarrantiesByPhone(w http.ResponseWriter, r *http.Request)
+	RegisterWarrantyClaim(w http.ResponseWriter, r *http.Request)
+	ChangeWarrantyClaimStatus(w http.ResponseWriter, r *http.Request)
+	WarrantyClaims(w http.ResponseWriter, r *http.Request)
 }
@@ -9,7 +9,17 @@ import (
 	reflect "reflect"
 
 	gomock "github.com/golang/mock/gomock"
+	receipt "github.com/lazylex/watch-store-store/internal/domain/aggregates/receipt"
+	refund "github.com/lazylex/watch-store-store/internal/domain/aggregates/refund"
+	shift "github.com/lazylex/watch-store-store/internal/domain/aggregates/shift"
+	stocktake "github.com/lazylex/watch-store-store/internal/domain/aggregates/stocktake"
+	transfer "github.com/lazylex/watch-store-store/internal/domain/aggregates/transfer"
+	warranty "github.com/lazylex/watch-store-store/internal/domain/aggregates/warranty"
+	article "github.com/lazylex/watch-store-store/internal/domain/value_objects/article"
+	barcode "github.com/lazylex/watch-store-store/internal/domain/value_objects/barcode"
+	serial "github.com/lazylex/watch-store-store/internal/domain/value_objects/serial"
 	dto "github.com/lazylex/watch-store-store/internal/dto"
+	export "github.com/lazylex/watch-store-store/internal/ports/export"
 )
 
 // MockInterface is a mock of Interface interface.
@@ -35,6 +45,20 @@ func (m *MockInterface) EXPECT() *MockInterfaceMockRecorder {
 	return m.recorder
 }
 
+// AbortIdempotentRequest mocks base method.
+func (m *MockInterface) AbortIdempotentRequest(ctx context.Context, data dto.IdempotencyKey) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AbortIdempotentRequest", ctx, data)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AbortIdempotentRequest indicates an expected call of AbortIdempotentRequest.
+func (mr *MockInterfaceMockRecorder) AbortIdempotentRequest(ctx, data interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AbortIdempotentRequest", reflect.TypeOf((*MockInterface)(nil).AbortIdempotentRequest), ctx, data)
+}
+
 // AddProductToStock mocks base method.
 func (m *MockInterface) AddProductToStock(ctx context.Context, data dto.ArticlePriceNameAmount) error {
 	m.ctrl.T.Helper()
@@ -49,6 +73,36 @@ func (mr *MockInterfaceMockRecorder) AddProductToStock(ctx, data interface{}) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddProductToStock", reflect.TypeOf((*MockInterface)(nil).AddProductToStock), ctx, data)
 }
 
+// AdjustAmountInStock mocks base method.
+func (m *MockInterface) AdjustAmountInStock(ctx context.Context, data dto.ArticleDeltaReason) (uint, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AdjustAmountInStock", ctx, data)
+	ret0, _ := ret[0].(uint)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AdjustAmountInStock indicates an expected call of AdjustAmountInStock.
+func (mr *MockInterfaceMockRecorder) AdjustAmountInStock(ctx, data interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AdjustAmountInStock", reflect.TypeOf((*MockInterface)(nil).AdjustAmountInStock), ctx, data)
+}
+
+// AmountByLocation mocks base method.
+func (m *MockInterface) AmountByLocation(ctx context.Context, data dto.Article) (dto.AmountLocations, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AmountByLocation", ctx, data)
+	ret0, _ := ret[0].(dto.AmountLocations)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AmountByLocation indicates an expected call of AmountByLocation.
+func (mr *MockInterfaceMockRecorder) AmountByLocation(ctx, data interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AmountByLocation", reflect.TypeOf((*MockInterface)(nil).AmountByLocation), ctx, data)
+}
+
 // AmountInStock mocks base method.
 func (m *MockInterface) AmountInStock(ctx context.Context, data dto.Article) (uint, error) {
 	m.ctrl.T.Helper()
@@ -64,6 +118,127 @@ func (mr *MockInterfaceMockRecorder) AmountInStock(ctx, data interface{}) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AmountInStock", reflect.TypeOf((*MockInterface)(nil).AmountInStock), ctx, data)
 }
 
+// ApplyStocktake mocks base method.
+func (m *MockInterface) ApplyStocktake(ctx context.Context, data dto.StocktakeID) (dto.Stocktake, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ApplyStocktake", ctx, data)
+	ret0, _ := ret[0].(dto.Stocktake)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ApplyStocktake indicates an expected call of ApplyStocktake.
+func (mr *MockInterfaceMockRecorder) ApplyStocktake(ctx, data interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ApplyStocktake", reflect.TypeOf((*MockInterface)(nil).ApplyStocktake), ctx, data)
+}
+
+// ArticleBarcodes mocks base method.
+func (m *MockInterface) ArticleBarcodes(ctx context.Context, data dto.Article) ([]barcode.Barcode, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ArticleBarcodes", ctx, data)
+	ret0, _ := ret[0].([]barcode.Barcode)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ArticleBarcodes indicates an expected call of ArticleBarcodes.
+func (mr *MockInterfaceMockRecorder) ArticleBarcodes(ctx, data interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ArticleBarcodes", reflect.TypeOf((*MockInterface)(nil).ArticleBarcodes), ctx, data)
+}
+
+// ArticleTaxRate mocks base method.
+func (m *MockInterface) ArticleTaxRate(ctx context.Context, data dto.Article) (dto.ArticleTaxRate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ArticleTaxRate", ctx, data)
+	ret0, _ := ret[0].(dto.ArticleTaxRate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ArticleTaxRate indicates an expected call of ArticleTaxRate.
+func (mr *MockInterfaceMockRecorder) ArticleTaxRate(ctx, data interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ArticleTaxRate", reflect.TypeOf((*MockInterface)(nil).ArticleTaxRate), ctx, data)
+}
+
+// AttributeDefinitions mocks base method.
+func (m *MockInterface) AttributeDefinitions(ctx context.Context) ([]dto.AttributeDefinition, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AttributeDefinitions", ctx)
+	ret0, _ := ret[0].([]dto.AttributeDefinition)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AttributeDefinitions indicates an expected call of AttributeDefinitions.
+func (mr *MockInterfaceMockRecorder) AttributeDefinitions(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AttributeDefinitions", reflect.TypeOf((*MockInterface)(nil).AttributeDefinitions), ctx)
+}
+
+// AvailableSerials mocks base method.
+func (m *MockInterface) AvailableSerials(ctx context.Context, data dto.Article) ([]serial.Number, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AvailableSerials", ctx, data)
+	ret0, _ := ret[0].([]serial.Number)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AvailableSerials indicates an expected call of AvailableSerials.
+func (mr *MockInterfaceMockRecorder) AvailableSerials(ctx, data interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AvailableSerials", reflect.TypeOf((*MockInterface)(nil).AvailableSerials), ctx, data)
+}
+
+// AverageSalePrice mocks base method.
+func (m *MockInterface) AverageSalePrice(ctx context.Context, data dto.ArticleFromTo) (dto.ArticleSales, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AverageSalePrice", ctx, data)
+	ret0, _ := ret[0].(dto.ArticleSales)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AverageSalePrice indicates an expected call of AverageSalePrice.
+func (mr *MockInterfaceMockRecorder) AverageSalePrice(ctx, data interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AverageSalePrice", reflect.TypeOf((*MockInterface)(nil).AverageSalePrice), ctx, data)
+}
+
+// AverageSalePrices mocks base method.
+func (m *MockInterface) AverageSalePrices(ctx context.Context, data dto.FromTo) ([]dto.ArticleSales, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AverageSalePrices", ctx, data)
+	ret0, _ := ret[0].([]dto.ArticleSales)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AverageSalePrices indicates an expected call of AverageSalePrices.
+func (mr *MockInterfaceMockRecorder) AverageSalePrices(ctx, data interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AverageSalePrices", reflect.TypeOf((*MockInterface)(nil).AverageSalePrices), ctx, data)
+}
+
+// BeginIdempotentRequest mocks base method.
+func (m *MockInterface) BeginIdempotentRequest(ctx context.Context, data dto.IdempotencyRecord) (dto.IdempotencyRecord, bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BeginIdempotentRequest", ctx, data)
+	ret0, _ := ret[0].(dto.IdempotencyRecord)
+	ret1, _ := ret[1].(bool)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// BeginIdempotentRequest indicates an expected call of BeginIdempotentRequest.
+func (mr *MockInterfaceMockRecorder) BeginIdempotentRequest(ctx, data interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BeginIdempotentRequest", reflect.TypeOf((*MockInterface)(nil).BeginIdempotentRequest), ctx, data)
+}
+
 // CancelReservation mocks base method.
 func (m *MockInterface) CancelReservation(ctx context.Context, data dto.Number) error {
 	m.ctrl.T.Helper()
@@ -78,6 +253,36 @@ func (mr *MockInterfaceMockRecorder) CancelReservation(ctx, data interface{}) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelReservation", reflect.TypeOf((*MockInterface)(nil).CancelReservation), ctx, data)
 }
 
+// CashRegister mocks base method.
+func (m *MockInterface) CashRegister(ctx context.Context, data dto.CashRegister) (dto.CashRegisterRecord, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CashRegister", ctx, data)
+	ret0, _ := ret[0].(dto.CashRegisterRecord)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CashRegister indicates an expected call of CashRegister.
+func (mr *MockInterfaceMockRecorder) CashRegister(ctx, data interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CashRegister", reflect.TypeOf((*MockInterface)(nil).CashRegister), ctx, data)
+}
+
+// CashRegisters mocks base method.
+func (m *MockInterface) CashRegisters(ctx context.Context) ([]dto.CashRegisterRecord, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CashRegisters", ctx)
+	ret0, _ := ret[0].([]dto.CashRegisterRecord)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CashRegisters indicates an expected call of CashRegisters.
+func (mr *MockInterfaceMockRecorder) CashRegisters(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CashRegisters", reflect.TypeOf((*MockInterface)(nil).CashRegisters), ctx)
+}
+
 // ChangeAmountInStock mocks base method.
 func (m *MockInterface) ChangeAmountInStock(ctx context.Context, data dto.ArticleAmount) error {
 	m.ctrl.T.Helper()
@@ -106,89 +311,942 @@ func (mr *MockInterfaceMockRecorder) ChangePriceInStock(ctx, data interface{}) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ChangePriceInStock", reflect.TypeOf((*MockInterface)(nil).ChangePriceInStock), ctx, data)
 }
 
-// FinishOrder mocks base method.
-func (m *MockInterface) FinishOrder(ctx context.Context, data dto.Number) error {
+// ChangeWarrantyClaimStatus mocks base method.
+func (m *MockInterface) ChangeWarrantyClaimStatus(ctx context.Context, data dto.ClaimIDStatus) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "FinishOrder", ctx, data)
+	ret := m.ctrl.Call(m, "ChangeWarrantyClaimStatus", ctx, data)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ChangeWarrantyClaimStatus indicates an expected call of ChangeWarrantyClaimStatus.
+func (mr *MockInterfaceMockRecorder) ChangeWarrantyClaimStatus(ctx, data interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ChangeWarrantyClaimStatus", reflect.TypeOf((*MockInterface)(nil).ChangeWarrantyClaimStatus), ctx, data)
+}
+
+// CloseShift mocks base method.
+func (m *MockInterface) CloseShift(ctx context.Context, data dto.CashRegister) (dto.ZReport, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CloseShift", ctx, data)
+	ret0, _ := ret[0].(dto.ZReport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CloseShift indicates an expected call of CloseShift.
+func (mr *MockInterfaceMockRecorder) CloseShift(ctx, data interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CloseShift", reflect.TypeOf((*MockInterface)(nil).CloseShift), ctx, data)
+}
+
+// CompleteIdempotentRequest mocks base method.
+func (m *MockInterface) CompleteIdempotentRequest(ctx context.Context, data dto.IdempotencyRecord) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CompleteIdempotentRequest", ctx, data)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CompleteIdempotentRequest indicates an expected call of CompleteIdempotentRequest.
+func (mr *MockInterfaceMockRecorder) CompleteIdempotentRequest(ctx, data interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CompleteIdempotentRequest", reflect.TypeOf((*MockInterface)(nil).CompleteIdempotentRequest), ctx, data)
+}
+
+// CompleteOutboundTransfer mocks base method.
+func (m *MockInterface) CompleteOutboundTransfer(ctx context.Context, data dto.Transfer) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CompleteOutboundTransfer", ctx, data)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CompleteOutboundTransfer indicates an expected call of CompleteOutboundTransfer.
+func (mr *MockInterfaceMockRecorder) CompleteOutboundTransfer(ctx, data interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CompleteOutboundTransfer", reflect.TypeOf((*MockInterface)(nil).CompleteOutboundTransfer), ctx, data)
+}
+
+// ConfirmInboundTransfer mocks base method.
+func (m *MockInterface) ConfirmInboundTransfer(ctx context.Context, data dto.TransferConfirmation) (dto.Transfer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ConfirmInboundTransfer", ctx, data)
+	ret0, _ := ret[0].(dto.Transfer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ConfirmInboundTransfer indicates an expected call of ConfirmInboundTransfer.
+func (mr *MockInterfaceMockRecorder) ConfirmInboundTransfer(ctx, data interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConfirmInboundTransfer", reflect.TypeOf((*MockInterface)(nil).ConfirmInboundTransfer), ctx, data)
+}
+
+// CountStocktake mocks base method.
+func (m *MockInterface) CountStocktake(ctx context.Context, data dto.StocktakeCounts) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountStocktake", ctx, data)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CountStocktake indicates an expected call of CountStocktake.
+func (mr *MockInterfaceMockRecorder) CountStocktake(ctx, data interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountStocktake", reflect.TypeOf((*MockInterface)(nil).CountStocktake), ctx, data)
+}
+
+// CreateCashRegister mocks base method.
+func (m *MockInterface) CreateCashRegister(ctx context.Context, data dto.CashRegisterRecord) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateCashRegister", ctx, data)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateCashRegister indicates an expected call of CreateCashRegister.
+func (mr *MockInterfaceMockRecorder) CreateCashRegister(ctx, data interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateCashRegister", reflect.TypeOf((*MockInterface)(nil).CreateCashRegister), ctx, data)
+}
+
+// CreateOutboundTransfer mocks base method.
+func (m *MockInterface) CreateOutboundTransfer(ctx context.Context, data dto.OutboundTransfer) (transfer.ID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateOutboundTransfer", ctx, data)
+	ret0, _ := ret[0].(transfer.ID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateOutboundTransfer indicates an expected call of CreateOutboundTransfer.
+func (mr *MockInterfaceMockRecorder) CreateOutboundTransfer(ctx, data interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateOutboundTransfer", reflect.TypeOf((*MockInterface)(nil).CreateOutboundTransfer), ctx, data)
+}
+
+// DeleteArticleAttribute mocks base method.
+func (m *MockInterface) DeleteArticleAttribute(ctx context.Context, data dto.ArticleAttributeCode) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteArticleAttribute", ctx, data)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteArticleAttribute indicates an expected call of DeleteArticleAttribute.
+func (mr *MockInterfaceMockRecorder) DeleteArticleAttribute(ctx, data interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteArticleAttribute", reflect.TypeOf((*MockInterface)(nil).DeleteArticleAttribute), ctx, data)
+}
+
+// DeleteBarcode mocks base method.
+func (m *MockInterface) DeleteBarcode(ctx context.Context, data dto.Barcode) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteBarcode", ctx, data)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteBarcode indicates an expected call of DeleteBarcode.
+func (mr *MockInterfaceMockRecorder) DeleteBarcode(ctx, data interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteBarcode", reflect.TypeOf((*MockInterface)(nil).DeleteBarcode), ctx, data)
+}
+
+// ExportSales mocks base method.
+func (m *MockInterface) ExportSales(ctx context.Context, data dto.FromToReport, w export.Interface) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExportSales", ctx, data, w)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
+// ExportSales indicates an expected call of ExportSales.
+func (mr *MockInterfaceMockRecorder) ExportSales(ctx, data, w interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExportSales", reflect.TypeOf((*MockInterface)(nil).ExportSales), ctx, data, w)
+}
+
+// FindStock mocks base method.
+func (m *MockInterface) FindStock(ctx context.Context, data dto.StockFilter) ([]dto.StockAttributes, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindStock", ctx, data)
+	ret0, _ := ret[0].([]dto.StockAttributes)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindStock indicates an expected call of FindStock.
+func (mr *MockInterfaceMockRecorder) FindStock(ctx, data interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindStock", reflect.TypeOf((*MockInterface)(nil).FindStock), ctx, data)
+}
+
+// FinishOrder mocks base method.
+func (m *MockInterface) FinishOrder(ctx context.Context, data dto.NumberPaymentMethod) (receipt.ID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FinishOrder", ctx, data)
+	ret0, _ := ret[0].(receipt.ID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
 // FinishOrder indicates an expected call of FinishOrder.
 func (mr *MockInterfaceMockRecorder) FinishOrder(ctx, data interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FinishOrder", reflect.TypeOf((*MockInterface)(nil).FinishOrder), ctx, data)
 }
 
-// MakeReservation mocks base method.
-func (m *MockInterface) MakeReservation(ctx context.Context, data dto.NumberDateStateProducts) error {
+// GoodsReceipt mocks base method.
+func (m *MockInterface) GoodsReceipt(ctx context.Context, data dto.DocumentNumber) (dto.GoodsReceipt, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "MakeReservation", ctx, data)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "GoodsReceipt", ctx, data)
+	ret0, _ := ret[0].(dto.GoodsReceipt)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// MakeReservation indicates an expected call of MakeReservation.
-func (mr *MockInterfaceMockRecorder) MakeReservation(ctx, data interface{}) *gomock.Call {
+// GoodsReceipt indicates an expected call of GoodsReceipt.
+func (mr *MockInterfaceMockRecorder) GoodsReceipt(ctx, data interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MakeReservation", reflect.TypeOf((*MockInterface)(nil).MakeReservation), ctx, data)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GoodsReceipt", reflect.TypeOf((*MockInterface)(nil).GoodsReceipt), ctx, data)
 }
 
-// MakeSale mocks base method.
-func (m *MockInterface) MakeSale(ctx context.Context, data []dto.ArticlePriceAmount) error {
+// ImportBarcodes mocks base method.
+func (m *MockInterface) ImportBarcodes(ctx context.Context, data dto.BarcodeImport) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "MakeSale", ctx, data)
+	ret := m.ctrl.Call(m, "ImportBarcodes", ctx, data)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// MakeSale indicates an expected call of MakeSale.
-func (mr *MockInterfaceMockRecorder) MakeSale(ctx, data interface{}) *gomock.Call {
+// ImportBarcodes indicates an expected call of ImportBarcodes.
+func (mr *MockInterfaceMockRecorder) ImportBarcodes(ctx, data interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MakeSale", reflect.TypeOf((*MockInterface)(nil).MakeSale), ctx, data)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImportBarcodes", reflect.TypeOf((*MockInterface)(nil).ImportBarcodes), ctx, data)
 }
 
-// Stock mocks base method.
-func (m *MockInterface) Stock(ctx context.Context, data dto.Article) (dto.ArticlePriceNameAmount, error) {
+// ImportStock mocks base method.
+func (m *MockInterface) ImportStock(ctx context.Context, data dto.StockImport) (dto.StockImportReport, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Stock", ctx, data)
-	ret0, _ := ret[0].(dto.ArticlePriceNameAmount)
+	ret := m.ctrl.Call(m, "ImportStock", ctx, data)
+	ret0, _ := ret[0].(dto.StockImportReport)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// Stock indicates an expected call of Stock.
-func (mr *MockInterfaceMockRecorder) Stock(ctx, data interface{}) *gomock.Call {
+// ImportStock indicates an expected call of ImportStock.
+func (mr *MockInterfaceMockRecorder) ImportStock(ctx, data interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Stock", reflect.TypeOf((*MockInterface)(nil).Stock), ctx, data)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImportStock", reflect.TypeOf((*MockInterface)(nil).ImportStock), ctx, data)
 }
 
-// TotalSold mocks base method.
-func (m *MockInterface) TotalSold(ctx context.Context, data dto.Article) (uint, error) {
+// MakeReservation mocks base method.
+func (m *MockInterface) MakeReservation(ctx context.Context, data dto.NumberDateStateProducts) (dto.NumberPickupCode, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "TotalSold", ctx, data)
-	ret0, _ := ret[0].(uint)
+	ret := m.ctrl.Call(m, "MakeReservation", ctx, data)
+	ret0, _ := ret[0].(dto.NumberPickupCode)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// TotalSold indicates an expected call of TotalSold.
-func (mr *MockInterfaceMockRecorder) TotalSold(ctx, data interface{}) *gomock.Call {
+// MakeReservation indicates an expected call of MakeReservation.
+func (mr *MockInterfaceMockRecorder) MakeReservation(ctx, data interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TotalSold", reflect.TypeOf((*MockInterface)(nil).TotalSold), ctx, data)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MakeReservation", reflect.TypeOf((*MockInterface)(nil).MakeReservation), ctx, data)
 }
 
-// TotalSoldInPeriod mocks base method.
-func (m *MockInterface) TotalSoldInPeriod(ctx context.Context, data dto.ArticleFromTo) (uint, error) {
+// MakeSale mocks base method.
+func (m *MockInterface) MakeSale(ctx context.Context, data dto.CashRegisterProducts) (receipt.ID, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "TotalSoldInPeriod", ctx, data)
-	ret0, _ := ret[0].(uint)
+	ret := m.ctrl.Call(m, "MakeSale", ctx, data)
+	ret0, _ := ret[0].(receipt.ID)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// TotalSoldInPeriod indicates an expected call of TotalSoldInPeriod.
-func (mr *MockInterfaceMockRecorder) TotalSoldInPeriod(ctx, data interface{}) *gomock.Call {
+// MakeSale indicates an expected call of MakeSale.
+func (mr *MockInterfaceMockRecorder) MakeSale(ctx, data interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TotalSoldInPeriod", reflect.TypeOf((*MockInterface)(nil).TotalSoldInPeriod), ctx, data)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MakeSale", reflect.TypeOf((*MockInterface)(nil).MakeSale), ctx, data)
+}
+
+// MarkReadyForPickup mocks base method.
+func (m *MockInterface) MarkReadyForPickup(ctx context.Context, data dto.Number) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkReadyForPickup", ctx, data)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MarkReadyForPickup indicates an expected call of MarkReadyForPickup.
+func (mr *MockInterfaceMockRecorder) MarkReadyForPickup(ctx, data interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkReadyForPickup", reflect.TypeOf((*MockInterface)(nil).MarkReadyForPickup), ctx, data)
+}
+
+// MarkTransferNotified mocks base method.
+func (m *MockInterface) MarkTransferNotified(ctx context.Context, data dto.TransferID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkTransferNotified", ctx, data)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MarkTransferNotified indicates an expected call of MarkTransferNotified.
+func (mr *MockInterfaceMockRecorder) MarkTransferNotified(ctx, data interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkTransferNotified", reflect.TypeOf((*MockInterface)(nil).MarkTransferNotified), ctx, data)
+}
+
+// OpenReservationsByPhone mocks base method.
+func (m *MockInterface) OpenReservationsByPhone(ctx context.Context, data dto.Phone) ([]dto.NumberDateStateProducts, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "OpenReservationsByPhone", ctx, data)
+	ret0, _ := ret[0].([]dto.NumberDateStateProducts)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// OpenReservationsByPhone indicates an expected call of OpenReservationsByPhone.
+func (mr *MockInterfaceMockRecorder) OpenReservationsByPhone(ctx, data interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OpenReservationsByPhone", reflect.TypeOf((*MockInterface)(nil).OpenReservationsByPhone), ctx, data)
+}
+
+// OpenShift mocks base method.
+func (m *MockInterface) OpenShift(ctx context.Context, data dto.Shift) (shift.ID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "OpenShift", ctx, data)
+	ret0, _ := ret[0].(shift.ID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// OpenShift indicates an expected call of OpenShift.
+func (mr *MockInterfaceMockRecorder) OpenShift(ctx, data interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OpenShift", reflect.TypeOf((*MockInterface)(nil).OpenShift), ctx, data)
+}
+
+// Receipt mocks base method.
+func (m *MockInterface) Receipt(ctx context.Context, data dto.ReceiptID) (dto.Receipt, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Receipt", ctx, data)
+	ret0, _ := ret[0].(dto.Receipt)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Receipt indicates an expected call of Receipt.
+func (mr *MockInterfaceMockRecorder) Receipt(ctx, data interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Receipt", reflect.TypeOf((*MockInterface)(nil).Receipt), ctx, data)
+}
+
+// ReceiveGoods mocks base method.
+func (m *MockInterface) ReceiveGoods(ctx context.Context, data dto.GoodsReceipt) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReceiveGoods", ctx, data)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReceiveGoods indicates an expected call of ReceiveGoods.
+func (mr *MockInterfaceMockRecorder) ReceiveGoods(ctx, data interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReceiveGoods", reflect.TypeOf((*MockInterface)(nil).ReceiveGoods), ctx, data)
+}
+
+// RegisterInboundTransfer mocks base method.
+func (m *MockInterface) RegisterInboundTransfer(ctx context.Context, data dto.Transfer) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RegisterInboundTransfer", ctx, data)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RegisterInboundTransfer indicates an expected call of RegisterInboundTransfer.
+func (mr *MockInterfaceMockRecorder) RegisterInboundTransfer(ctx, data interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegisterInboundTransfer", reflect.TypeOf((*MockInterface)(nil).RegisterInboundTransfer), ctx, data)
+}
+
+// RegisterWarrantyClaim mocks base method.
+func (m *MockInterface) RegisterWarrantyClaim(ctx context.Context, data dto.WarrantyIDDescription) (warranty.ClaimID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RegisterWarrantyClaim", ctx, data)
+	ret0, _ := ret[0].(warranty.ClaimID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RegisterWarrantyClaim indicates an expected call of RegisterWarrantyClaim.
+func (mr *MockInterfaceMockRecorder) RegisterWarrantyClaim(ctx, data interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegisterWarrantyClaim", reflect.TypeOf((*MockInterface)(nil).RegisterWarrantyClaim), ctx, data)
+}
+
+// ReorderSuggestions mocks base method.
+func (m *MockInterface) ReorderSuggestions(ctx context.Context, data dto.WindowDays) ([]dto.ReorderSuggestion, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReorderSuggestions", ctx, data)
+	ret0, _ := ret[0].([]dto.ReorderSuggestion)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReorderSuggestions indicates an expected call of ReorderSuggestions.
+func (mr *MockInterfaceMockRecorder) ReorderSuggestions(ctx, data interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReorderSuggestions", reflect.TypeOf((*MockInterface)(nil).ReorderSuggestions), ctx, data)
+}
+
+// ReplenishmentSetting mocks base method.
+func (m *MockInterface) ReplenishmentSetting(ctx context.Context, data dto.Article) (dto.ReplenishmentSetting, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReplenishmentSetting", ctx, data)
+	ret0, _ := ret[0].(dto.ReplenishmentSetting)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReplenishmentSetting indicates an expected call of ReplenishmentSetting.
+func (mr *MockInterfaceMockRecorder) ReplenishmentSetting(ctx, data interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplenishmentSetting", reflect.TypeOf((*MockInterface)(nil).ReplenishmentSetting), ctx, data)
+}
+
+// ReservationHistory mocks base method.
+func (m *MockInterface) ReservationHistory(ctx context.Context, data dto.Number) ([]dto.ReservationTransition, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReservationHistory", ctx, data)
+	ret0, _ := ret[0].([]dto.ReservationTransition)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReservationHistory indicates an expected call of ReservationHistory.
+func (mr *MockInterfaceMockRecorder) ReservationHistory(ctx, data interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReservationHistory", reflect.TypeOf((*MockInterface)(nil).ReservationHistory), ctx, data)
+}
+
+// ResolveArticle mocks base method.
+func (m *MockInterface) ResolveArticle(ctx context.Context, art article.Article) (article.Article, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResolveArticle", ctx, art)
+	ret0, _ := ret[0].(article.Article)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ResolveArticle indicates an expected call of ResolveArticle.
+func (mr *MockInterfaceMockRecorder) ResolveArticle(ctx, art interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResolveArticle", reflect.TypeOf((*MockInterface)(nil).ResolveArticle), ctx, art)
+}
+
+// ResolveTransfer mocks base method.
+func (m *MockInterface) ResolveTransfer(ctx context.Context, data dto.TransferResolution) (dto.Transfer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResolveTransfer", ctx, data)
+	ret0, _ := ret[0].(dto.Transfer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ResolveTransfer indicates an expected call of ResolveTransfer.
+func (mr *MockInterfaceMockRecorder) ResolveTransfer(ctx, data interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResolveTransfer", reflect.TypeOf((*MockInterface)(nil).ResolveTransfer), ctx, data)
+}
+
+// ReturnSale mocks base method.
+func (m *MockInterface) ReturnSale(ctx context.Context, data dto.Refund) (refund.ID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReturnSale", ctx, data)
+	ret0, _ := ret[0].(refund.ID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReturnSale indicates an expected call of ReturnSale.
+func (mr *MockInterfaceMockRecorder) ReturnSale(ctx, data interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReturnSale", reflect.TypeOf((*MockInterface)(nil).ReturnSale), ctx, data)
+}
+
+// SalesByChannel mocks base method.
+func (m *MockInterface) SalesByChannel(ctx context.Context, data dto.FromTo) ([]dto.ChannelSales, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SalesByChannel", ctx, data)
+	ret0, _ := ret[0].([]dto.ChannelSales)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SalesByChannel indicates an expected call of SalesByChannel.
+func (mr *MockInterfaceMockRecorder) SalesByChannel(ctx, data interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SalesByChannel", reflect.TypeOf((*MockInterface)(nil).SalesByChannel), ctx, data)
+}
+
+// SalesByPeriod mocks base method.
+func (m *MockInterface) SalesByPeriod(ctx context.Context, data dto.FromToBucket) ([]dto.PeriodSales, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SalesByPeriod", ctx, data)
+	ret0, _ := ret[0].([]dto.PeriodSales)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SalesByPeriod indicates an expected call of SalesByPeriod.
+func (mr *MockInterfaceMockRecorder) SalesByPeriod(ctx, data interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SalesByPeriod", reflect.TypeOf((*MockInterface)(nil).SalesByPeriod), ctx, data)
+}
+
+// SerialHistory mocks base method.
+func (m *MockInterface) SerialHistory(ctx context.Context, data dto.Serial) (dto.SerialHistory, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SerialHistory", ctx, data)
+	ret0, _ := ret[0].(dto.SerialHistory)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SerialHistory indicates an expected call of SerialHistory.
+func (mr *MockInterfaceMockRecorder) SerialHistory(ctx, data interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SerialHistory", reflect.TypeOf((*MockInterface)(nil).SerialHistory), ctx, data)
+}
+
+// SetArticleAttributes mocks base method.
+func (m *MockInterface) SetArticleAttributes(ctx context.Context, data dto.ArticleAttributes) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetArticleAttributes", ctx, data)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetArticleAttributes indicates an expected call of SetArticleAttributes.
+func (mr *MockInterfaceMockRecorder) SetArticleAttributes(ctx, data interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetArticleAttributes", reflect.TypeOf((*MockInterface)(nil).SetArticleAttributes), ctx, data)
+}
+
+// SetArticleTaxCategory mocks base method.
+func (m *MockInterface) SetArticleTaxCategory(ctx context.Context, data dto.ArticleTaxCategory) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetArticleTaxCategory", ctx, data)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetArticleTaxCategory indicates an expected call of SetArticleTaxCategory.
+func (mr *MockInterfaceMockRecorder) SetArticleTaxCategory(ctx, data interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetArticleTaxCategory", reflect.TypeOf((*MockInterface)(nil).SetArticleTaxCategory), ctx, data)
+}
+
+// SetAttributeDefinition mocks base method.
+func (m *MockInterface) SetAttributeDefinition(ctx context.Context, data dto.AttributeDefinition) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetAttributeDefinition", ctx, data)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetAttributeDefinition indicates an expected call of SetAttributeDefinition.
+func (mr *MockInterfaceMockRecorder) SetAttributeDefinition(ctx, data interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetAttributeDefinition", reflect.TypeOf((*MockInterface)(nil).SetAttributeDefinition), ctx, data)
+}
+
+// SetReplenishmentSetting mocks base method.
+func (m *MockInterface) SetReplenishmentSetting(ctx context.Context, data dto.ReplenishmentSetting) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetReplenishmentSetting", ctx, data)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetReplenishmentSetting indicates an expected call of SetReplenishmentSetting.
+func (mr *MockInterfaceMockRecorder) SetReplenishmentSetting(ctx, data interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetReplenishmentSetting", reflect.TypeOf((*MockInterface)(nil).SetReplenishmentSetting), ctx, data)
+}
+
+// SetSerialTracking mocks base method.
+func (m *MockInterface) SetSerialTracking(ctx context.Context, data dto.SerialTracking) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetSerialTracking", ctx, data)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetSerialTracking indicates an expected call of SetSerialTracking.
+func (mr *MockInterfaceMockRecorder) SetSerialTracking(ctx, data interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetSerialTracking", reflect.TypeOf((*MockInterface)(nil).SetSerialTracking), ctx, data)
+}
+
+// SetTaxRate mocks base method.
+func (m *MockInterface) SetTaxRate(ctx context.Context, data dto.TaxRate) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetTaxRate", ctx, data)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetTaxRate indicates an expected call of SetTaxRate.
+func (mr *MockInterfaceMockRecorder) SetTaxRate(ctx, data interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetTaxRate", reflect.TypeOf((*MockInterface)(nil).SetTaxRate), ctx, data)
+}
+
+// SetWarrantyTerm mocks base method.
+func (m *MockInterface) SetWarrantyTerm(ctx context.Context, data dto.WarrantyTerm) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetWarrantyTerm", ctx, data)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetWarrantyTerm indicates an expected call of SetWarrantyTerm.
+func (mr *MockInterfaceMockRecorder) SetWarrantyTerm(ctx, data interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetWarrantyTerm", reflect.TypeOf((*MockInterface)(nil).SetWarrantyTerm), ctx, data)
+}
+
+// ShipOrder mocks base method.
+func (m *MockInterface) ShipOrder(ctx context.Context, data dto.Number) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ShipOrder", ctx, data)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ShipOrder indicates an expected call of ShipOrder.
+func (mr *MockInterfaceMockRecorder) ShipOrder(ctx, data interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ShipOrder", reflect.TypeOf((*MockInterface)(nil).ShipOrder), ctx, data)
+}
+
+// StartStocktake mocks base method.
+func (m *MockInterface) StartStocktake(ctx context.Context, data dto.StocktakeArticles) (stocktake.ID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartStocktake", ctx, data)
+	ret0, _ := ret[0].(stocktake.ID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StartStocktake indicates an expected call of StartStocktake.
+func (mr *MockInterfaceMockRecorder) StartStocktake(ctx, data interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartStocktake", reflect.TypeOf((*MockInterface)(nil).StartStocktake), ctx, data)
+}
+
+// Stock mocks base method.
+func (m *MockInterface) Stock(ctx context.Context, data dto.Article) (dto.ArticlePriceNameAmount, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Stock", ctx, data)
+	ret0, _ := ret[0].(dto.ArticlePriceNameAmount)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Stock indicates an expected call of Stock.
+func (mr *MockInterfaceMockRecorder) Stock(ctx, data interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Stock", reflect.TypeOf((*MockInterface)(nil).Stock), ctx, data)
+}
+
+// StockByBarcode mocks base method.
+func (m *MockInterface) StockByBarcode(ctx context.Context, data dto.Barcode) (dto.ArticlePriceNameAmount, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StockByBarcode", ctx, data)
+	ret0, _ := ret[0].(dto.ArticlePriceNameAmount)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StockByBarcode indicates an expected call of StockByBarcode.
+func (mr *MockInterfaceMockRecorder) StockByBarcode(ctx, data interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StockByBarcode", reflect.TypeOf((*MockInterface)(nil).StockByBarcode), ctx, data)
+}
+
+// StockWithAttributes mocks base method.
+func (m *MockInterface) StockWithAttributes(ctx context.Context, data dto.Article) (dto.StockAttributes, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StockWithAttributes", ctx, data)
+	ret0, _ := ret[0].(dto.StockAttributes)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StockWithAttributes indicates an expected call of StockWithAttributes.
+func (mr *MockInterfaceMockRecorder) StockWithAttributes(ctx, data interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StockWithAttributes", reflect.TypeOf((*MockInterface)(nil).StockWithAttributes), ctx, data)
+}
+
+// StocktakeDiscrepancies mocks base method.
+func (m *MockInterface) StocktakeDiscrepancies(ctx context.Context, data dto.StocktakeID) (dto.Stocktake, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StocktakeDiscrepancies", ctx, data)
+	ret0, _ := ret[0].(dto.Stocktake)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StocktakeDiscrepancies indicates an expected call of StocktakeDiscrepancies.
+func (mr *MockInterfaceMockRecorder) StocktakeDiscrepancies(ctx, data interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StocktakeDiscrepancies", reflect.TypeOf((*MockInterface)(nil).StocktakeDiscrepancies), ctx, data)
+}
+
+// TaxRates mocks base method.
+func (m *MockInterface) TaxRates(ctx context.Context) ([]dto.TaxRate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TaxRates", ctx)
+	ret0, _ := ret[0].([]dto.TaxRate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TaxRates indicates an expected call of TaxRates.
+func (mr *MockInterfaceMockRecorder) TaxRates(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TaxRates", reflect.TypeOf((*MockInterface)(nil).TaxRates), ctx)
+}
+
+// TopArticles mocks base method.
+func (m *MockInterface) TopArticles(ctx context.Context, data dto.FromToTop) ([]dto.ArticleSales, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TopArticles", ctx, data)
+	ret0, _ := ret[0].([]dto.ArticleSales)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TopArticles indicates an expected call of TopArticles.
+func (mr *MockInterfaceMockRecorder) TopArticles(ctx, data interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TopArticles", reflect.TypeOf((*MockInterface)(nil).TopArticles), ctx, data)
+}
+
+// TotalSold mocks base method.
+func (m *MockInterface) TotalSold(ctx context.Context, data dto.Article) (uint, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TotalSold", ctx, data)
+	ret0, _ := ret[0].(uint)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TotalSold indicates an expected call of TotalSold.
+func (mr *MockInterfaceMockRecorder) TotalSold(ctx, data interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TotalSold", reflect.TypeOf((*MockInterface)(nil).TotalSold), ctx, data)
+}
+
+// TotalSoldInPeriod mocks base method.
+func (m *MockInterface) TotalSoldInPeriod(ctx context.Context, data dto.ArticleFromTo) (uint, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TotalSoldInPeriod", ctx, data)
+	ret0, _ := ret[0].(uint)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TotalSoldInPeriod indicates an expected call of TotalSoldInPeriod.
+func (mr *MockInterfaceMockRecorder) TotalSoldInPeriod(ctx, data interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TotalSoldInPeriod", reflect.TypeOf((*MockInterface)(nil).TotalSoldInPeriod), ctx, data)
+}
+
+// Transfer mocks base method.
+func (m *MockInterface) Transfer(ctx context.Context, data dto.TransferID) (dto.Transfer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Transfer", ctx, data)
+	ret0, _ := ret[0].(dto.Transfer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Transfer indicates an expected call of Transfer.
+func (mr *MockInterfaceMockRecorder) Transfer(ctx, data interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Transfer", reflect.TypeOf((*MockInterface)(nil).Transfer), ctx, data)
+}
+
+// TransferStock mocks base method.
+func (m *MockInterface) TransferStock(ctx context.Context, data dto.StockTransfer) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TransferStock", ctx, data)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// TransferStock indicates an expected call of TransferStock.
+func (mr *MockInterfaceMockRecorder) TransferStock(ctx, data interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TransferStock", reflect.TypeOf((*MockInterface)(nil).TransferStock), ctx, data)
+}
+
+// Transfers mocks base method.
+func (m *MockInterface) Transfers(ctx context.Context, data dto.TransferFilter) ([]dto.Transfer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Transfers", ctx, data)
+	ret0, _ := ret[0].([]dto.Transfer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Transfers indicates an expected call of Transfers.
+func (mr *MockInterfaceMockRecorder) Transfers(ctx, data interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Transfers", reflect.TypeOf((*MockInterface)(nil).Transfers), ctx, data)
+}
+
+// TransfersToNotify mocks base method.
+func (m *MockInterface) TransfersToNotify(ctx context.Context) ([]dto.Transfer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TransfersToNotify", ctx)
+	ret0, _ := ret[0].([]dto.Transfer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TransfersToNotify indicates an expected call of TransfersToNotify.
+func (mr *MockInterfaceMockRecorder) TransfersToNotify(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TransfersToNotify", reflect.TypeOf((*MockInterface)(nil).TransfersToNotify), ctx)
+}
+
+// UpdateCashRegister mocks base method.
+func (m *MockInterface) UpdateCashRegister(ctx context.Context, data dto.CashRegisterRecord) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateCashRegister", ctx, data)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateCashRegister indicates an expected call of UpdateCashRegister.
+func (mr *MockInterfaceMockRecorder) UpdateCashRegister(ctx, data interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateCashRegister", reflect.TypeOf((*MockInterface)(nil).UpdateCashRegister), ctx, data)
+}
+
+// UpdatePrices mocks base method.
+func (m *MockInterface) UpdatePrices(ctx context.Context, data dto.BulkPriceUpdate) (dto.BulkPriceReport, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdatePrices", ctx, data)
+	ret0, _ := ret[0].(dto.BulkPriceReport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdatePrices indicates an expected call of UpdatePrices.
+func (mr *MockInterfaceMockRecorder) UpdatePrices(ctx, data interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePrices", reflect.TypeOf((*MockInterface)(nil).UpdatePrices), ctx, data)
+}
+
+// WarrantiesByPhone mocks base method.
+func (m *MockInterface) WarrantiesByPhone(ctx context.Context, data dto.Phone) ([]dto.Warranty, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WarrantiesByPhone", ctx, data)
+	ret0, _ := ret[0].([]dto.Warranty)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// WarrantiesByPhone indicates an expected call of WarrantiesByPhone.
+func (mr *MockInterfaceMockRecorder) WarrantiesByPhone(ctx, data interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WarrantiesByPhone", reflect.TypeOf((*MockInterface)(nil).WarrantiesByPhone), ctx, data)
+}
+
+// WarrantiesByReceipt mocks base method.
+func (m *MockInterface) WarrantiesByReceipt(ctx context.Context, data dto.ReceiptID) ([]dto.Warranty, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WarrantiesByReceipt", ctx, data)
+	ret0, _ := ret[0].([]dto.Warranty)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// WarrantiesByReceipt indicates an expected call of WarrantiesByReceipt.
+func (mr *MockInterfaceMockRecorder) WarrantiesByReceipt(ctx, data interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WarrantiesByReceipt", reflect.TypeOf((*MockInterface)(nil).WarrantiesByReceipt), ctx, data)
+}
+
+// WarrantiesBySerial mocks base method.
+func (m *MockInterface) WarrantiesBySerial(ctx context.Context, data dto.Serial) ([]dto.Warranty, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WarrantiesBySerial", ctx, data)
+	ret0, _ := ret[0].([]dto.Warranty)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// WarrantiesBySerial indicates an expected call of WarrantiesBySerial.
+func (mr *MockInterfaceMockRecorder) WarrantiesBySerial(ctx, data interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WarrantiesBySerial", reflect.TypeOf((*MockInterface)(nil).WarrantiesBySerial), ctx, data)
+}
+
+// WarrantyClaims mocks base method.
+func (m *MockInterface) WarrantyClaims(ctx context.Context, data dto.WarrantyID) ([]dto.WarrantyClaim, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WarrantyClaims", ctx, data)
+	ret0, _ := ret[0].([]dto.WarrantyClaim)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// WarrantyClaims indicates an expected call of WarrantyClaims.
+func (mr *MockInterfaceMockRecorder) WarrantyClaims(ctx, data interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WarrantyClaims", reflect.TypeOf((*MockInterface)(nil).WarrantyClaims), ctx, data)
+}
+
+// WarrantyTerm mocks base method.
+func (m *MockInterface) WarrantyTerm(ctx context.Context, data dto.Article) (dto.WarrantyTerm, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WarrantyTerm", ctx, data)
+	ret0, _ := ret[0].(dto.WarrantyTerm)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// WarrantyTerm indicates an expected call of WarrantyTerm.
+func (mr *MockInterfaceMockRecorder) WarrantyTerm(ctx, data interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WarrantyTerm", reflect.TypeOf((*MockInterface)(nil).WarrantyTerm), ctx, data)
+}
+
+// ZReport mocks base method.
+func (m *MockInterface) ZReport(ctx context.Context, data dto.ShiftID) (dto.ZReport, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ZReport", ctx, data)
+	ret0, _ := ret[0].(dto.ZReport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ZReport indicates an expected call of ZReport.
+func (mr *MockInterfaceMockRecorder) ZReport(ctx, data interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ZReport", reflect.TypeOf((*MockInterface)(nil).ZReport), ctx, data)
 }
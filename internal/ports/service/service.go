@@ -3,8 +3,18 @@ package service
 import (
 	"context"
 	"errors"
+	"github.com/lazylex/watch-store-store/internal/domain/aggregates/receipt"
+	"github.com/lazylex/watch-store-store/internal/domain/aggregates/refund"
+	"github.com/lazylex/watch-store-store/internal/domain/aggregates/shift"
+	"github.com/lazylex/watch-store-store/internal/domain/aggregates/stocktake"
+	"github.com/lazylex/watch-store-store/internal/domain/aggregates/transfer"
+	"github.com/lazylex/watch-store-store/internal/domain/aggregates/warranty"
+	"github.com/lazylex/watch-store-store/internal/domain/value_objects/article"
+	"github.com/lazylex/watch-store-store/internal/domain/value_objects/barcode"
+	"github.com/lazylex/watch-store-store/internal/domain/value_objects/serial"
 	"github.com/lazylex/watch-store-store/internal/dto"
 	"github.com/lazylex/watch-store-store/internal/helpers/constants/prefixes"
+	"github.com/lazylex/watch-store-store/internal/ports/export"
 )
 
 // serviceError добавляет к тексту ошибки префикс, указывающий на её принадлежность к сервису.
@@ -16,6 +26,43 @@ var (
 	ErrNoEnoughItemsToReserve = serviceError("no enough items to reserve")
 	ErrNoEnoughItemsInStock   = serviceError("no enough items in stock")
 	ErrAlreadyProcessed       = serviceError("already processed")
+	ErrShiftAlreadyOpen       = serviceError("shift already open on this cash register")
+	ErrNoOpenShift            = serviceError("no open shift on this cash register")
+	ErrProductNotInReceipt    = serviceError("product not in receipt")
+	ErrRefundExceedsSold      = serviceError("refund amount exceeds sold amount")
+	ErrStocktakeApplied       = serviceError("stocktake already applied")
+	ErrArticleNotInStocktake  = serviceError("article not included in stocktake")
+	ErrAdjustmentBelowZero    = serviceError("adjustment makes amount in stock negative")
+	ErrRequestInProgress      = serviceError("request with this idempotency key is in progress")
+	ErrIdempotencyKeyReused   = serviceError("idempotency key reused for another request")
+	ErrAttributeTypeChange    = serviceError("attribute type can't be changed")
+	ErrBarcodeTaken           = serviceError("barcode already assigned to another article")
+	ErrNoEnoughItemsInPlace   = serviceError("no enough items in stock location")
+	ErrTransferToItself       = serviceError("transfer to the same store")
+	ErrNoEnoughItemsToSend    = serviceError("no enough items to send")
+	ErrWrongTransferDirection = serviceError("operation not allowed for transfer direction")
+	ErrTransferNotInTransit   = serviceError("transfer is not in transit")
+	ErrNoTransferDiscrepancy  = serviceError("transfer has no unresolved discrepancy")
+	ErrArticleNotInTransfer   = serviceError("article not included in transfer")
+	ErrTransferCounterpart    = serviceError("transfer belongs to another store")
+	ErrStockExists            = serviceError("article already in stock")
+	ErrImportChunkNotApplied  = serviceError("import chunk not applied")
+	ErrOrderNumberTaken       = serviceError("order number already in use")
+	ErrNoFreeOrderNumber      = serviceError("no free order number allocated")
+	ErrUnknownCashRegister    = serviceError("cash register is not registered")
+	ErrCashRegisterDisabled   = serviceError("cash register is disabled")
+	ErrCashRegisterExists     = serviceError("cash register already registered")
+	ErrPickupCodeMismatch     = serviceError("pickup code doesn't match")
+	ErrUnknownTaxCategory     = serviceError("tax category has no tax rates")
+	ErrSerialTracked          = serviceError("article is tracked by serial numbers")
+	ErrSerialsDontMatch       = serviceError("serial numbers don't match products")
+	ErrSerialUnavailable      = serviceError("serial number is not available")
+	ErrSerialRegistered       = serviceError("serial number already registered")
+	ErrSerialsDontMatchStock  = serviceError("serial numbers don't match amount in stock")
+	ErrArticleReserved        = serviceError("article is reserved for open orders")
+	ErrWarrantyExpired        = serviceError("warranty has expired")
+	ErrWarrantyClaimOpen      = serviceError("warranty already has an open claim")
+	ErrWarrantyVoid           = serviceError("warranty is void: goods were refunded")
 )
 
 // После генерации mock-а добавь структуру
@@ -39,16 +86,170 @@ type Interface interface {
 	AmountInStock(ctx context.Context, data dto.Article) (uint, error)
 	// MakeReservation производит резервирование товара для покупателя. Резервирование проводится как для бронирования
 	// через интернет, так и во время нахождения товара на кассе (в ожидании оплаты локальным покупателем). В таком случае
-	// в качестве номера заказа передаётся номер кассы.
-	MakeReservation(ctx context.Context, data dto.NumberDateStateProducts) error
+	// в качестве номера заказа передаётся номер кассы. Возвращается номер заказа и, для заказов покупателей в магазине,
+	// код получения заказа.
+	MakeReservation(ctx context.Context, data dto.NumberDateStateProducts) (dto.NumberPickupCode, error)
 	// CancelReservation снимает бронь с товара/ов
 	CancelReservation(ctx context.Context, data dto.Number) error
-	// MakeSale уменьшает количества доступного для продажи товара и производит запись в статистику продаж
-	MakeSale(ctx context.Context, data []dto.ArticlePriceAmount) error
+	// MakeSale уменьшает количества доступного для продажи товара и производит запись в статистику продаж. Проданные
+	// товары объединяются в чек, идентификатор которого возвращается
+	MakeSale(ctx context.Context, data dto.CashRegisterProducts) (receipt.ID, error)
 	// FinishOrder помечает заказ, как выполненный. Данные о содержащихся в заказе товарах переносятся в статистику продаж
-	FinishOrder(ctx context.Context, data dto.Number) error
+	// и объединяются в чек, идентификатор которого возвращается. Для заказов покупателей в магазине проверяется код
+	// получения заказа
+	FinishOrder(ctx context.Context, data dto.NumberPaymentMethod) (receipt.ID, error)
+	// Receipt возвращает чек с переданным идентификатором вместе с проданными по нему товарами
+	Receipt(ctx context.Context, data dto.ReceiptID) (dto.Receipt, error)
+	// ReturnSale оформляет возврат товаров, проданных по чеку. Товары возвращаются в продажу, возврат относится к
+	// открытой смене кассы
+	ReturnSale(ctx context.Context, data dto.Refund) (refund.ID, error)
+	// OpenShift открывает кассовую смену и возвращает её идентификатор
+	OpenShift(ctx context.Context, data dto.Shift) (shift.ID, error)
+	// CloseShift закрывает открытую на кассе смену и возвращает сохранённый Z-отчёт
+	CloseShift(ctx context.Context, data dto.CashRegister) (dto.ZReport, error)
+	// ZReport возвращает Z-отчёт закрытой смены
+	ZReport(ctx context.Context, data dto.ShiftID) (dto.ZReport, error)
+	// CreateCashRegister регистрирует кассу в реестре касс
+	CreateCashRegister(ctx context.Context, data dto.CashRegisterRecord) error
+	// UpdateCashRegister изменяет название, место хранения и признак включения зарегистрированной кассы
+	UpdateCashRegister(ctx context.Context, data dto.CashRegisterRecord) error
+	// CashRegister возвращает кассу из реестра касс
+	CashRegister(ctx context.Context, data dto.CashRegister) (dto.CashRegisterRecord, error)
+	// CashRegisters возвращает все зарегистрированные кассы
+	CashRegisters(ctx context.Context) ([]dto.CashRegisterRecord, error)
+	// StartStocktake начинает инвентаризацию переданных товаров (всего ассортимента, если список пуст) и возвращает её
+	// идентификатор
+	StartStocktake(ctx context.Context, data dto.StocktakeArticles) (stocktake.ID, error)
+	// CountStocktake добавляет к инвентаризации очередную порцию подсчитанного товара
+	CountStocktake(ctx context.Context, data dto.StocktakeCounts) error
+	// StocktakeDiscrepancies возвращает расхождения подсчитанного количества товаров с учётным с поправкой на продажи и
+	// возвраты во время подсчёта
+	StocktakeDiscrepancies(ctx context.Context, data dto.StocktakeID) (dto.Stocktake, error)
+	// ApplyStocktake вносит расхождения инвентаризации в остатки и возвращает сохранённый отчёт
+	ApplyStocktake(ctx context.Context, data dto.StocktakeID) (dto.Stocktake, error)
+	// ReceiveGoods принимает поставку товаров, увеличивая их количество. Для повторно переданного документа остатки не
+	// изменяются и возвращается false
+	ReceiveGoods(ctx context.Context, data dto.GoodsReceipt) (bool, error)
+	// GoodsReceipt возвращает принятую поставку по номеру документа
+	GoodsReceipt(ctx context.Context, data dto.DocumentNumber) (dto.GoodsReceipt, error)
+	// AdjustAmountInStock изменяет количество товара на переданную величину с указанием причины и возвращает новое
+	// количество. Количество товара не может стать отрицательным
+	AdjustAmountInStock(ctx context.Context, data dto.ArticleDeltaReason) (uint, error)
+	// MarkReadyForPickup помечает заказ, как собранный и ожидающий покупателя в магазине
+	MarkReadyForPickup(ctx context.Context, data dto.Number) error
+	// ShipOrder помечает заказ, как переданный в доставку
+	ShipOrder(ctx context.Context, data dto.Number) error
+	// ReservationHistory возвращает историю смены состояний заказа
+	ReservationHistory(ctx context.Context, data dto.Number) ([]dto.ReservationTransition, error)
+	// OpenReservationsByPhone возвращает невыполненные и неотменённые заказы покупателя с переданным номером телефона
+	OpenReservationsByPhone(ctx context.Context, data dto.Phone) ([]dto.NumberDateStateProducts, error)
+	// BeginIdempotentRequest регистрирует начало обработки запроса с ключом идемпотентности. Если запрос с этим ключом
+	// уже был выполнен, возвращается сохранённый результат и true
+	BeginIdempotentRequest(ctx context.Context, data dto.IdempotencyRecord) (dto.IdempotencyRecord, bool, error)
+	// CompleteIdempotentRequest сохраняет результат обработки запроса с ключом идемпотентности
+	CompleteIdempotentRequest(ctx context.Context, data dto.IdempotencyRecord) error
+	// AbortIdempotentRequest удаляет запись о запросе с ключом идемпотентности, позволяя выполнить его повторно
+	AbortIdempotentRequest(ctx context.Context, data dto.IdempotencyKey) error
+	// SalesByPeriod возвращает количество проданных единиц и выручку за период с разбивкой по дням, неделям или месяцам
+	SalesByPeriod(ctx context.Context, data dto.FromToBucket) ([]dto.PeriodSales, error)
+	// TopArticles возвращает самые продаваемые за период товары по количеству единиц или выручке
+	TopArticles(ctx context.Context, data dto.FromToTop) ([]dto.ArticleSales, error)
+	// AverageSalePrice возвращает среднюю цену продажи товара за период
+	AverageSalePrice(ctx context.Context, data dto.ArticleFromTo) (dto.ArticleSales, error)
+	// AverageSalePrices возвращает среднюю цену продажи каждого проданного за период товара
+	AverageSalePrices(ctx context.Context, data dto.FromTo) ([]dto.ArticleSales, error)
+	// SalesByChannel возвращает продажи за период по каналам продаж (касса или заказ)
+	SalesByChannel(ctx context.Context, data dto.FromTo) ([]dto.ChannelSales, error)
+	// ExportSales построчно записывает в w отчёт о продажах за период
+	ExportSales(ctx context.Context, data dto.FromToReport, w export.Interface) error
+	// SetReplenishmentSetting сохраняет срок поставки и страховой запас товара
+	SetReplenishmentSetting(ctx context.Context, data dto.ReplenishmentSetting) error
+	// ReplenishmentSetting возвращает срок поставки и страховой запас товара
+	ReplenishmentSetting(ctx context.Context, data dto.Article) (dto.ReplenishmentSetting, error)
+	// ReorderSuggestions возвращает список товаров, которые необходимо заказать, исходя из скорости продаж
+	ReorderSuggestions(ctx context.Context, data dto.WindowDays) ([]dto.ReorderSuggestion, error)
+	// SetAttributeDefinition сохраняет определение атрибута товаров
+	SetAttributeDefinition(ctx context.Context, data dto.AttributeDefinition) error
+	// AttributeDefinitions возвращает определения всех атрибутов товаров
+	AttributeDefinitions(ctx context.Context) ([]dto.AttributeDefinition, error)
+	// SetArticleAttributes сохраняет значения атрибутов товара
+	SetArticleAttributes(ctx context.Context, data dto.ArticleAttributes) error
+	// DeleteArticleAttribute удаляет значение атрибута товара
+	DeleteArticleAttribute(ctx context.Context, data dto.ArticleAttributeCode) error
+	// StockWithAttributes возвращает информацию о товаре вместе со значениями его атрибутов
+	StockWithAttributes(ctx context.Context, data dto.Article) (dto.StockAttributes, error)
+	// FindStock возвращает товары в продаже, удовлетворяющие условиям на значения атрибутов
+	FindStock(ctx context.Context, data dto.StockFilter) ([]dto.StockAttributes, error)
+	// ImportBarcodes назначает товарам штрихкоды
+	ImportBarcodes(ctx context.Context, data dto.BarcodeImport) error
+	// ResolveArticle возвращает артикул товара, которому назначен переданный вместо артикула штрихкод
+	ResolveArticle(ctx context.Context, art article.Article) (article.Article, error)
+	// StockByBarcode возвращает информацию о товаре по его штрихкоду
+	StockByBarcode(ctx context.Context, data dto.Barcode) (dto.ArticlePriceNameAmount, error)
+	// ArticleBarcodes возвращает штрихкоды товара
+	ArticleBarcodes(ctx context.Context, data dto.Article) ([]barcode.Barcode, error)
+	// DeleteBarcode удаляет штрихкод
+	DeleteBarcode(ctx context.Context, data dto.Barcode) error
+	// AmountByLocation возвращает доступное для продажи количество товара с разбивкой по местам хранения
+	AmountByLocation(ctx context.Context, data dto.Article) (dto.AmountLocations, error)
+	// TransferStock перемещает товар между местами хранения
+	TransferStock(ctx context.Context, data dto.StockTransfer) error
+	// CreateOutboundTransfer отправляет товар в другой магазин и возвращает идентификатор перемещения
+	CreateOutboundTransfer(ctx context.Context, data dto.OutboundTransfer) (transfer.ID, error)
+	// RegisterInboundTransfer сохраняет поступившее от другого магазина перемещение. Если перемещение уже сохранено,
+	// возвращается false
+	RegisterInboundTransfer(ctx context.Context, data dto.Transfer) (bool, error)
+	// ConfirmInboundTransfer вносит в остатки фактически принятое количество товаров входящего перемещения
+	ConfirmInboundTransfer(ctx context.Context, data dto.TransferConfirmation) (dto.Transfer, error)
+	// CompleteOutboundTransfer сохраняет поступившее от получателя принятое количество товаров исходящего перемещения.
+	// Если приёмка уже сохранена, возвращается false
+	CompleteOutboundTransfer(ctx context.Context, data dto.Transfer) (bool, error)
+	// ResolveTransfer урегулирует расхождение исходящего перемещения
+	ResolveTransfer(ctx context.Context, data dto.TransferResolution) (dto.Transfer, error)
+	// Transfer возвращает перемещение товара между магазинами
+	Transfer(ctx context.Context, data dto.TransferID) (dto.Transfer, error)
+	// Transfers возвращает удовлетворяющие фильтру перемещения товара между магазинами
+	Transfers(ctx context.Context, data dto.TransferFilter) ([]dto.Transfer, error)
+	// TransfersToNotify возвращает перемещения, о которых необходимо отправить сообщение второй стороне перемещения
+	TransfersToNotify(ctx context.Context) ([]dto.Transfer, error)
+	// MarkTransferNotified отмечает, что второй стороне перемещения отправлено сообщение о нём
+	MarkTransferNotified(ctx context.Context, data dto.TransferID) error
 	// TotalSold возвращает количество проданного товара с переданным артикулом за весь период
 	TotalSold(ctx context.Context, data dto.Article) (uint, error)
 	// TotalSoldInPeriod возвращает количество проданного товара с переданным артикулом за указанный период
 	TotalSoldInPeriod(ctx context.Context, data dto.ArticleFromTo) (uint, error)
+	// ImportStock загружает товары на склад, возвращая отчёт о результате загрузки каждой строки
+	ImportStock(ctx context.Context, data dto.StockImport) (dto.StockImportReport, error)
+	// UpdatePrices изменяет цены группы товаров в одной транзакции, возвращая отчёт о результате для каждого товара
+	UpdatePrices(ctx context.Context, data dto.BulkPriceUpdate) (dto.BulkPriceReport, error)
+	// SetTaxRate сохраняет ставку НДС налоговой категории товаров, действующую с указанного момента
+	SetTaxRate(ctx context.Context, data dto.TaxRate) error
+	// TaxRates возвращает все ставки НДС налоговых категорий товаров
+	TaxRates(ctx context.Context) ([]dto.TaxRate, error)
+	// SetArticleTaxCategory сохраняет налоговую категорию товара
+	SetArticleTaxCategory(ctx context.Context, data dto.ArticleTaxCategory) error
+	// ArticleTaxRate возвращает налоговую категорию товара и действующую для неё ставку НДС
+	ArticleTaxRate(ctx context.Context, data dto.Article) (dto.ArticleTaxRate, error)
+	// SetSerialTracking включает или отключает учёт товара по серийным номерам
+	SetSerialTracking(ctx context.Context, data dto.SerialTracking) error
+	// AvailableSerials возвращает серийные номера доступных для продажи экземпляров товара
+	AvailableSerials(ctx context.Context, data dto.Article) ([]serial.Number, error)
+	// SerialHistory возвращает текущее состояние и историю экземпляра товара с серийным номером
+	SerialHistory(ctx context.Context, data dto.Serial) (dto.SerialHistory, error)
+	// SetWarrantyTerm сохраняет гарантийный срок товара
+	SetWarrantyTerm(ctx context.Context, data dto.WarrantyTerm) error
+	// WarrantyTerm возвращает гарантийный срок товара
+	WarrantyTerm(ctx context.Context, data dto.Article) (dto.WarrantyTerm, error)
+	// WarrantiesByReceipt возвращает гарантии, выданные по чеку
+	WarrantiesByReceipt(ctx context.Context, data dto.ReceiptID) ([]dto.Warranty, error)
+	// WarrantiesBySerial возвращает гарантии на экземпляр товара с серийным номером
+	WarrantiesBySerial(ctx context.Context, data dto.Serial) ([]dto.Warranty, error)
+	// WarrantiesByPhone возвращает гарантии, выданные покупателю с номером телефона
+	WarrantiesByPhone(ctx context.Context, data dto.Phone) ([]dto.Warranty, error)
+	// RegisterWarrantyClaim регистрирует гарантийное обращение
+	RegisterWarrantyClaim(ctx context.Context, data dto.WarrantyIDDescription) (warranty.ClaimID, error)
+	// ChangeWarrantyClaimStatus изменяет состояние гарантийного обращения
+	ChangeWarrantyClaimStatus(ctx context.Context, data dto.ClaimIDStatus) error
+	// WarrantyClaims возвращает гарантийные обращения по гарантии и историю смены их состояний
+	WarrantyClaims(ctx context.Context, data dto.WarrantyID) ([]dto.WarrantyClaim, error)
 }
@@ -0,0 +1,223 @@
+package mysql
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"github.com/lazylex/watch-store-store/internal/domain/value_objects/bucket"
+	"github.com/lazylex/watch-store-store/internal/domain/value_objects/measure"
+	"github.com/lazylex/watch-store-store/internal/dto"
+)
+
+// bucketExpressions выражения, вычисляющие начало интервала, к которому относится продажа.
+var bucketExpressions = map[bucket.Bucket]string{
+	bucket.Day:   `DATE(date_of_sale)`,
+	bucket.Week:  `DATE_SUB(DATE(date_of_sale), INTERVAL WEEKDAY(date_of_sale) DAY)`,
+	bucket.Month: `CAST(DATE_FORMAT(date_of_sale, '%Y-%m-01') AS DATE)`,
+}
+
+// measureColumns выражения показателей за вычетом возвратов, по которым ранжируются товары.
+var measureColumns = map[measure.Measure]string{
+	measure.Units:   `SUM(units) - SUM(refunded_units)`,
+	measure.Revenue: `SUM(revenue) - SUM(refunded)`,
+}
+
+// movements подзапрос, объединяющий продажи и возвраты товаров за период. Параметры подзапроса - начало и конец периода
+// для продаж, затем для возвратов. Возвраты относятся к периоду по дате возврата (столбец date_of_sale) и к чеку, по
+// которому был продан товар. НДС возвращённых единиц рассчитывается по строкам этого чека.
+const movements = `(SELECT article, date_of_sale, receipt_id, amount AS units, price * amount AS revenue,
+			     net_amount AS net, tax_amount AS tax, 0 AS refunded_units, 0 AS refunded, 0 AS refunded_tax
+			 FROM sold
+			 WHERE date_of_sale >= ? AND date_of_sale <= ?
+			 UNION ALL
+			 SELECT ri.article, rf.created_at, rf.receipt_id, 0, 0, 0, 0, ri.amount, ri.price * ri.amount,
+			     IFNULL(ROUND(ri.amount * (SELECT SUM(s.tax_amount) / SUM(s.amount)
+			         FROM sold s
+			         WHERE s.receipt_id = rf.receipt_id AND s.article = ri.article), 2), 0)
+			 FROM refund rf
+			 JOIN refund_item ri ON ri.refund_id = rf.id
+			 WHERE rf.created_at >= ? AND rf.created_at <= ?) movement`
+
+// ReadSalesByPeriod возвращает количество проданных единиц, выручку, выручку без НДС и сумму НДС за вычетом возвратов,
+// а также возвращённые единицы и сумму возвратов за период, сгруппированные по интервалам. Интервалы без продаж и
+// возвратов не возвращаются.
+func (r *Repository) ReadSalesByPeriod(ctx context.Context, data *dto.FromToBucket) ([]dto.PeriodSales, error) {
+	var result []dto.PeriodSales
+	expression, ok := bucketExpressions[data.Bucket]
+	if !ok {
+		return result, fmt.Errorf("unknown bucket %s", data.Bucket)
+	}
+
+	stmt := fmt.Sprintf(`SELECT %s AS period, SUM(units) - SUM(refunded_units), SUM(revenue) - SUM(refunded),
+			     SUM(net) - SUM(refunded) + SUM(refunded_tax), SUM(tax) - SUM(refunded_tax), SUM(refunded_units),
+			     SUM(refunded)
+			 FROM %s
+			 GROUP BY period
+			 ORDER BY period`, expression, movements)
+
+	rows, err := r.executor(ctx).QueryContext(ctx, stmt, data.From, data.To, data.From, data.To)
+	if err != nil {
+		return result, r.ConvertToCommonErr(err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var record dto.PeriodSales
+		if err = rows.Scan(&record.Period, &record.Units, &record.Revenue, &record.Net, &record.Tax,
+			&record.RefundedUnits, &record.Refunded); err != nil {
+			return result, r.ConvertToCommonErr(err)
+		}
+		result = append(result, record)
+	}
+
+	return result, r.ConvertToCommonErr(rows.Err())
+}
+
+// ReadTopArticles возвращает самые продаваемые за период товары, упорядоченные по убыванию переданного показателя за
+// вычетом возвратов.
+func (r *Repository) ReadTopArticles(ctx context.Context, data *dto.FromToTop) ([]dto.ArticleSales, error) {
+	var result []dto.ArticleSales
+	column, ok := measureColumns[data.By]
+	if !ok {
+		return result, fmt.Errorf("unknown measure %s", data.By)
+	}
+
+	stmt := fmt.Sprintf(`SELECT article, SUM(units) - SUM(refunded_units), SUM(revenue) - SUM(refunded),
+			     SUM(refunded_units), SUM(refunded)
+			 FROM %s
+			 GROUP BY article
+			 ORDER BY %s DESC, article
+			 LIMIT ?`, movements, column)
+
+	rows, err := r.executor(ctx).QueryContext(ctx, stmt, data.From, data.To, data.From, data.To, data.Limit)
+	if err != nil {
+		return result, r.ConvertToCommonErr(err)
+	}
+	defer rows.Close()
+
+	return r.scanArticleSales(rows, result)
+}
+
+// ReadArticlesSales возвращает количество проданных единиц и выручку за вычетом возвратов, а также возвращённые единицы
+// и сумму возвратов за период по каждому проданному или возвращённому товару.
+func (r *Repository) ReadArticlesSales(ctx context.Context, data *dto.FromTo) ([]dto.ArticleSales, error) {
+	var result []dto.ArticleSales
+	stmt := fmt.Sprintf(`SELECT article, SUM(units) - SUM(refunded_units), SUM(revenue) - SUM(refunded),
+			     SUM(refunded_units), SUM(refunded)
+			 FROM %s
+			 GROUP BY article
+			 ORDER BY article`, movements)
+
+	rows, err := r.executor(ctx).QueryContext(ctx, stmt, data.From, data.To, data.From, data.To)
+	if err != nil {
+		return result, r.ConvertToCommonErr(err)
+	}
+	defer rows.Close()
+
+	return r.scanArticleSales(rows, result)
+}
+
+// ReadArticleSales возвращает количество проданных единиц и выручку за вычетом возвратов, а также возвращённые единицы
+// и сумму возвратов за период по товару с переданным артикулом.
+func (r *Repository) ReadArticleSales(ctx context.Context, data *dto.ArticleFromTo) (dto.ArticleSales, error) {
+	var units, refundedUnits sql.NullInt64
+	var revenue, refunded sql.NullFloat64
+	stmt := fmt.Sprintf(`SELECT SUM(units) - SUM(refunded_units), SUM(revenue) - SUM(refunded), SUM(refunded_units),
+			     SUM(refunded)
+			 FROM %s
+			 WHERE article = ?`, movements)
+
+	row := r.executor(ctx).QueryRowContext(ctx, stmt, data.From, data.To, data.From, data.To, data.Article)
+	if err := row.Scan(&units, &revenue, &refundedUnits, &refunded); err != nil {
+		return dto.ArticleSales{}, r.ConvertToCommonErr(err)
+	}
+
+	return dto.ArticleSales{Article: data.Article, Units: int(units.Int64), Revenue: revenue.Float64,
+		RefundedUnits: uint(refundedUnits.Int64), Refunded: refunded.Float64}, nil
+}
+
+// ReadSalesByChannel возвращает количество чеков, проданных единиц и выручку за вычетом возвратов, а также возвращённые
+// единицы и сумму возвратов за период по каналам продаж. Продажи на кассе определяются по номеру кассы в чеке,
+// выполненные заказы - по номеру заказа. Возвраты относятся к каналу чека, по которому был продан товар. Продажи,
+// записанные до появления чеков, относятся к неизвестному каналу.
+func (r *Repository) ReadSalesByChannel(ctx context.Context, data *dto.FromTo) ([]dto.ChannelSales, error) {
+	var result []dto.ChannelSales
+	stmt := fmt.Sprintf(`SELECT CASE
+			     WHEN rc.cash_register IS NOT NULL THEN 'register'
+			     WHEN rc.order_number IS NOT NULL THEN 'internet'
+			     ELSE 'unknown' END AS channel,
+			 COUNT(DISTINCT IF(movement.units > 0, movement.receipt_id, NULL)),
+			 SUM(movement.units) - SUM(movement.refunded_units), SUM(movement.revenue) - SUM(movement.refunded),
+			 SUM(movement.refunded_units), SUM(movement.refunded)
+			 FROM %s
+			 LEFT JOIN receipt rc ON rc.id = movement.receipt_id
+			 GROUP BY channel
+			 ORDER BY channel`, movements)
+
+	rows, err := r.executor(ctx).QueryContext(ctx, stmt, data.From, data.To, data.From, data.To)
+	if err != nil {
+		return result, r.ConvertToCommonErr(err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var record dto.ChannelSales
+		if err = rows.Scan(&record.Channel, &record.Receipts, &record.Units, &record.Revenue, &record.RefundedUnits,
+			&record.Refunded); err != nil {
+			return result, r.ConvertToCommonErr(err)
+		}
+		result = append(result, record)
+	}
+
+	return result, r.ConvertToCommonErr(rows.Err())
+}
+
+// ReadPaymentsByDay возвращает платежи по чекам и возвраты за период, сгруппированные по дням и способам оплаты.
+// Возвраты относятся к дню возврата и способу, которым были выданы деньги.
+func (r *Repository) ReadPaymentsByDay(ctx context.Context, data *dto.FromTo) ([]dto.DailyPayments, error) {
+	var result []dto.DailyPayments
+	stmt := `SELECT day, payment_method, SUM(payments), SUM(amount), SUM(change_given), SUM(refunds), SUM(refunded)
+			 FROM (SELECT DATE(rc.created_at) AS day, rp.payment_method, 1 AS payments, rp.amount, rp.change_given,
+			     0 AS refunds, 0 AS refunded
+			 FROM receipt_payment rp
+			 JOIN receipt rc ON rc.id = rp.receipt_id
+			 WHERE rc.created_at >= ? AND rc.created_at <= ?
+			 UNION ALL
+			 SELECT DATE(rf.created_at), rf.payment_method, 0, 0, 0, 1, rf.total
+			 FROM refund rf
+			 WHERE rf.created_at >= ? AND rf.created_at <= ?) payment
+			 GROUP BY day, payment_method
+			 ORDER BY day, payment_method`
+
+	rows, err := r.executor(ctx).QueryContext(ctx, stmt, data.From, data.To, data.From, data.To)
+	if err != nil {
+		return result, r.ConvertToCommonErr(err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var record dto.DailyPayments
+		if err = rows.Scan(&record.Date, &record.Method, &record.Count, &record.Amount, &record.Change,
+			&record.Refunds, &record.Refunded); err != nil {
+			return result, r.ConvertToCommonErr(err)
+		}
+		result = append(result, record)
+	}
+
+	return result, r.ConvertToCommonErr(rows.Err())
+}
+
+// scanArticleSales считывает из rows строки с артикулом, количеством проданных единиц и выручкой за вычетом возвратов,
+// количеством возвращённых единиц и суммой возвратов.
+func (r *Repository) scanArticleSales(rows *sql.Rows, result []dto.ArticleSales) ([]dto.ArticleSales, error) {
+	for rows.Next() {
+		var record dto.ArticleSales
+		if err := rows.Scan(&record.Article, &record.Units, &record.Revenue, &record.RefundedUnits,
+			&record.Refunded); err != nil {
+			return result, r.ConvertToCommonErr(err)
+		}
+		result = append(result, record)
+	}
+
+	return result, r.ConvertToCommonErr(rows.Err())
+}
@@ -0,0 +1,184 @@
+package mysql
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"github.com/lazylex/watch-store-store/internal/domain/value_objects/article"
+	"github.com/lazylex/watch-store-store/internal/domain/value_objects/attribute"
+	"github.com/lazylex/watch-store-store/internal/dto"
+	"strconv"
+	"strings"
+)
+
+// UpsertAttributeDefinition сохраняет определение атрибута товара, заменяя ранее сохранённое.
+func (r *Repository) UpsertAttributeDefinition(ctx context.Context, data *dto.AttributeDefinition) error {
+	var values sql.NullString
+	stmt := `INSERT INTO attribute_definition (code, name, type, enum_values) VALUES (?,?,?,?)
+			 ON DUPLICATE KEY UPDATE name = VALUES(name), type = VALUES(type), enum_values = VALUES(enum_values)`
+
+	if len(data.Values) > 0 {
+		encoded, err := json.Marshal(data.Values)
+		if err != nil {
+			return err
+		}
+		values = sql.NullString{String: string(encoded), Valid: true}
+	}
+
+	_, err := r.executor(ctx).ExecContext(ctx, stmt, data.Code, data.Name, data.Type, values)
+
+	return r.ConvertToCommonErr(err)
+}
+
+// ReadAttributeDefinitions возвращает определения всех атрибутов товаров, упорядоченные по коду.
+func (r *Repository) ReadAttributeDefinitions(ctx context.Context) ([]dto.AttributeDefinition, error) {
+	var result []dto.AttributeDefinition
+	stmt := `SELECT code, name, type, enum_values FROM attribute_definition ORDER BY code`
+
+	rows, err := r.executor(ctx).QueryContext(ctx, stmt)
+	if err != nil {
+		return result, r.ConvertToCommonErr(err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var record dto.AttributeDefinition
+		var values sql.NullString
+		if err = rows.Scan(&record.Code, &record.Name, &record.Type, &values); err != nil {
+			return result, r.ConvertToCommonErr(err)
+		}
+		if values.Valid {
+			if err = json.Unmarshal([]byte(values.String), &record.Values); err != nil {
+				return result, err
+			}
+		}
+		result = append(result, record)
+	}
+
+	return result, r.ConvertToCommonErr(rows.Err())
+}
+
+// UpsertArticleAttributes сохраняет значения атрибутов товара, заменяя ранее сохранённые значения тех же атрибутов.
+// Значения, являющиеся числами, дополнительно сохраняются в числовом виде для поиска по диапазону.
+func (r *Repository) UpsertArticleAttributes(ctx context.Context, data *dto.ArticleAttributes) error {
+	placeholders := make([]string, 0, len(data.Attributes))
+	args := make([]any, 0, 4*len(data.Attributes))
+	for code, value := range data.Attributes {
+		var number sql.NullFloat64
+		if n, err := strconv.ParseFloat(value, 64); err == nil {
+			number = sql.NullFloat64{Float64: n, Valid: true}
+		}
+		placeholders = append(placeholders, "(?,?,?,?)")
+		args = append(args, data.Article, code, value, number)
+	}
+
+	stmt := fmt.Sprintf(`INSERT INTO article_attribute (article, code, value, value_number) VALUES %s
+			 ON DUPLICATE KEY UPDATE value = VALUES(value), value_number = VALUES(value_number)`,
+		strings.Join(placeholders, ","))
+
+	_, err := r.executor(ctx).ExecContext(ctx, stmt, args...)
+
+	return r.ConvertToCommonErr(err)
+}
+
+// DeleteArticleAttribute удаляет значение атрибута товара. Если значение не было сохранено, возвращает ErrNoRecord.
+func (r *Repository) DeleteArticleAttribute(ctx context.Context, data *dto.ArticleAttributeCode) error {
+	stmt := `DELETE FROM article_attribute WHERE article = ? AND code = ?`
+
+	result, err := r.executor(ctx).ExecContext(ctx, stmt, data.Article, data.Code)
+	if err != nil {
+		return r.ConvertToCommonErr(err)
+	}
+	if affected, err := result.RowsAffected(); err == nil && affected == 0 {
+		return r.ConvertToCommonErr(sql.ErrNoRows)
+	}
+
+	return nil
+}
+
+// ReadArticlesAttributes возвращает значения атрибутов товаров с переданными артикулами.
+func (r *Repository) ReadArticlesAttributes(ctx context.Context, articles []article.Article) (
+	map[article.Article]map[attribute.Code]string, error) {
+	result := make(map[article.Article]map[attribute.Code]string)
+	if len(articles) == 0 {
+		return result, nil
+	}
+
+	args := make([]any, len(articles))
+	for i, a := range articles {
+		args[i] = a
+	}
+	stmt := fmt.Sprintf(`SELECT article, code, value FROM article_attribute WHERE article IN (%s)`,
+		strings.TrimSuffix(strings.Repeat("?,", len(articles)), ","))
+
+	rows, err := r.executor(ctx).QueryContext(ctx, stmt, args...)
+	if err != nil {
+		return result, r.ConvertToCommonErr(err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var art article.Article
+		var code attribute.Code
+		var value string
+		if err = rows.Scan(&art, &code, &value); err != nil {
+			return result, r.ConvertToCommonErr(err)
+		}
+		if result[art] == nil {
+			result[art] = make(map[attribute.Code]string)
+		}
+		result[art][code] = value
+	}
+
+	return result, r.ConvertToCommonErr(rows.Err())
+}
+
+// ReadStockByFilter возвращает страницу товаров в продаже, удовлетворяющих всем условиям на значения атрибутов,
+// упорядоченных по артикулу.
+func (r *Repository) ReadStockByFilter(ctx context.Context, data *dto.StockFilter) ([]dto.ArticlePriceNameAmount, error) {
+	var result []dto.ArticlePriceNameAmount
+	conditions := []string{"TRUE"}
+	var args []any
+
+	for _, f := range data.Filters {
+		condition := `EXISTS (SELECT 1 FROM article_attribute aa WHERE aa.article = s.article AND aa.code = ?`
+		args = append(args, f.Code)
+		if f.Value != nil {
+			condition += ` AND aa.value = ?`
+			args = append(args, *f.Value)
+		}
+		if f.Min != nil {
+			condition += ` AND aa.value_number >= ?`
+			args = append(args, *f.Min)
+		}
+		if f.Max != nil {
+			condition += ` AND aa.value_number <= ?`
+			args = append(args, *f.Max)
+		}
+		conditions = append(conditions, condition+`)`)
+	}
+	args = append(args, data.Limit, data.Offset)
+
+	stmt := fmt.Sprintf(`SELECT s.name, s.article, s.price, s.amount
+			 FROM stock s
+			 WHERE %s
+			 ORDER BY s.article
+			 LIMIT ? OFFSET ?`, strings.Join(conditions, " AND "))
+
+	rows, err := r.executor(ctx).QueryContext(ctx, stmt, args...)
+	if err != nil {
+		return result, r.ConvertToCommonErr(err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var record dto.ArticlePriceNameAmount
+		if err = rows.Scan(&record.Name, &record.Article, &record.Price, &record.Amount); err != nil {
+			return result, r.ConvertToCommonErr(err)
+		}
+		result = append(result, record)
+	}
+
+	return result, r.ConvertToCommonErr(rows.Err())
+}
@@ -0,0 +1,116 @@
+package mysql
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"github.com/lazylex/watch-store-store/internal/domain/value_objects/article"
+	"github.com/lazylex/watch-store-store/internal/domain/value_objects/barcode"
+	"github.com/lazylex/watch-store-store/internal/dto"
+	"strings"
+)
+
+// CreateArticleBarcodes сохраняет штрихкоды товаров. Уже сохранённые штрихкоды не изменяются, поэтому перед вызовом
+// необходимо убедиться, что они не назначены другим товарам.
+func (r *Repository) CreateArticleBarcodes(ctx context.Context, data []dto.ArticleBarcode) error {
+	if len(data) == 0 {
+		return nil
+	}
+
+	placeholders := make([]string, len(data))
+	args := make([]any, 0, 2*len(data))
+	for i, record := range data {
+		placeholders[i] = "(?,?)"
+		args = append(args, record.Barcode.EAN13(), record.Article)
+	}
+
+	stmt := fmt.Sprintf(`INSERT INTO barcode (barcode, article) VALUES %s ON DUPLICATE KEY UPDATE barcode = barcode`,
+		strings.Join(placeholders, ","))
+
+	_, err := r.executor(ctx).ExecContext(ctx, stmt, args...)
+
+	return r.ConvertToCommonErr(err)
+}
+
+// ReadBarcodesArticles возвращает артикулы товаров, которым назначены переданные штрихкоды. Штрихкоды в ключах
+// результата приведены к формату EAN-13. Не найденные штрихкоды в результат не попадают.
+func (r *Repository) ReadBarcodesArticles(ctx context.Context, data []barcode.Barcode) (
+	map[barcode.Barcode]article.Article, error) {
+	result := make(map[barcode.Barcode]article.Article)
+	if len(data) == 0 {
+		return result, nil
+	}
+
+	placeholders := make([]string, len(data))
+	args := make([]any, len(data))
+	for i, code := range data {
+		placeholders[i] = "?"
+		args[i] = code.EAN13()
+	}
+
+	stmt := fmt.Sprintf(`SELECT barcode, article FROM barcode WHERE barcode IN (%s)`, strings.Join(placeholders, ","))
+
+	rows, err := r.executor(ctx).QueryContext(ctx, stmt, args...)
+	if err != nil {
+		return result, r.ConvertToCommonErr(err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var code barcode.Barcode
+		var art article.Article
+		if err = rows.Scan(&code, &art); err != nil {
+			return result, r.ConvertToCommonErr(err)
+		}
+		result[code] = art
+	}
+
+	return result, r.ConvertToCommonErr(rows.Err())
+}
+
+// ReadArticleByBarcode возвращает артикул товара, которому назначен штрихкод.
+func (r *Repository) ReadArticleByBarcode(ctx context.Context, data *dto.Barcode) (article.Article, error) {
+	var result article.Article
+	stmt := `SELECT article FROM barcode WHERE barcode = ?`
+
+	err := r.executor(ctx).QueryRowContext(ctx, stmt, data.Barcode.EAN13()).Scan(&result)
+
+	return result, r.ConvertToCommonErr(err)
+}
+
+// ReadArticleBarcodes возвращает штрихкоды товара в формате EAN-13, упорядоченные по возрастанию.
+func (r *Repository) ReadArticleBarcodes(ctx context.Context, data *dto.Article) ([]barcode.Barcode, error) {
+	var result []barcode.Barcode
+	stmt := `SELECT barcode FROM barcode WHERE article = ? ORDER BY barcode`
+
+	rows, err := r.executor(ctx).QueryContext(ctx, stmt, data.Article)
+	if err != nil {
+		return result, r.ConvertToCommonErr(err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var code barcode.Barcode
+		if err = rows.Scan(&code); err != nil {
+			return result, r.ConvertToCommonErr(err)
+		}
+		result = append(result, code)
+	}
+
+	return result, r.ConvertToCommonErr(rows.Err())
+}
+
+// DeleteBarcode удаляет штрихкод.
+func (r *Repository) DeleteBarcode(ctx context.Context, data *dto.Barcode) error {
+	stmt := `DELETE FROM barcode WHERE barcode = ?`
+
+	result, err := r.executor(ctx).ExecContext(ctx, stmt, data.Barcode.EAN13())
+	if err != nil {
+		return r.ConvertToCommonErr(err)
+	}
+	if affected, err := result.RowsAffected(); err == nil && affected == 0 {
+		return r.ConvertToCommonErr(sql.ErrNoRows)
+	}
+
+	return nil
+}
@@ -0,0 +1,90 @@
+package mysql
+
+import (
+	"context"
+	"database/sql"
+	"github.com/lazylex/watch-store-store/internal/dto"
+	"time"
+)
+
+// CreateCashRegister сохраняет кассу в реестре касс. Если касса с таким номером уже зарегистрирована, возвращается
+// repository.ErrDuplicate.
+func (r *Repository) CreateCashRegister(ctx context.Context, data *dto.CashRegisterRecord) error {
+	stmt := `INSERT INTO cash_register (number, name, location, enabled) VALUES (?,?,?,?)`
+
+	_, err := r.executor(ctx).ExecContext(ctx, stmt, data.CashRegister, data.Name, data.Location, data.Enabled)
+
+	return r.ConvertToCommonErr(err)
+}
+
+// UpdateCashRegister обновляет название, место хранения и признак включения кассы. Если касса не зарегистрирована,
+// возвращается repository.ErrNoRecord.
+func (r *Repository) UpdateCashRegister(ctx context.Context, data *dto.CashRegisterRecord) error {
+	stmt := `UPDATE cash_register SET name = ?, location = ?, enabled = ? WHERE number = ?`
+
+	result, err := r.executor(ctx).ExecContext(ctx, stmt, data.Name, data.Location, data.Enabled, data.CashRegister)
+	if err != nil {
+		return r.ConvertToCommonErr(err)
+	}
+	if affected, err := result.RowsAffected(); err != nil || affected == 0 {
+		if _, err = r.ReadCashRegister(ctx, &dto.CashRegister{CashRegister: data.CashRegister}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ReadCashRegister возвращает кассу из реестра касс. Если касса не зарегистрирована, возвращается
+// repository.ErrNoRecord.
+func (r *Repository) ReadCashRegister(ctx context.Context, data *dto.CashRegister) (dto.CashRegisterRecord, error) {
+	stmt := `SELECT number, name, location, enabled, last_seen_at FROM cash_register WHERE number = ?`
+
+	return r.scanCashRegister(r.executor(ctx).QueryRowContext(ctx, stmt, data.CashRegister))
+}
+
+// ReadCashRegisters возвращает все кассы из реестра касс, упорядоченные по номеру.
+func (r *Repository) ReadCashRegisters(ctx context.Context) ([]dto.CashRegisterRecord, error) {
+	var result []dto.CashRegisterRecord
+	stmt := `SELECT number, name, location, enabled, last_seen_at FROM cash_register ORDER BY number`
+
+	rows, err := r.executor(ctx).QueryContext(ctx, stmt)
+	if err != nil {
+		return result, r.ConvertToCommonErr(err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		record, err := r.scanCashRegister(rows)
+		if err != nil {
+			return result, err
+		}
+		result = append(result, record)
+	}
+
+	return result, r.ConvertToCommonErr(rows.Err())
+}
+
+// TouchCashRegister сохраняет время последней операции, выполненной на кассе.
+func (r *Repository) TouchCashRegister(ctx context.Context, data *dto.CashRegister, seenAt time.Time) error {
+	stmt := `UPDATE cash_register SET last_seen_at = ? WHERE number = ?`
+
+	_, err := r.executor(ctx).ExecContext(ctx, stmt, seenAt, data.CashRegister)
+
+	return r.ConvertToCommonErr(err)
+}
+
+// scanCashRegister считывает кассу из строки результата запроса.
+func (r *Repository) scanCashRegister(row interface{ Scan(...any) error }) (dto.CashRegisterRecord, error) {
+	var result dto.CashRegisterRecord
+	var lastSeen sql.NullTime
+
+	if err := row.Scan(&result.CashRegister, &result.Name, &result.Location, &result.Enabled, &lastSeen); err != nil {
+		return dto.CashRegisterRecord{}, r.ConvertToCommonErr(err)
+	}
+	if lastSeen.Valid {
+		result.LastSeenAt = &lastSeen.Time
+	}
+
+	return result, nil
+}
@@ -0,0 +1,70 @@
+package mysql
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"github.com/lazylex/watch-store-store/internal/domain/aggregates/receipt"
+	"github.com/lazylex/watch-store-store/internal/dto"
+)
+
+// IterateSoldRecords последовательно передаёт в функцию fn записи о продажах за период, упорядоченные по дате продажи.
+// Возвраты в записи не включаются. Записи не накапливаются в памяти. Ошибка, возвращённая fn, прерывает чтение и
+// возвращается без изменений.
+func (r *Repository) IterateSoldRecords(ctx context.Context, data *dto.FromTo, fn func(dto.SoldRecord) error) error {
+	stmt := `SELECT article, price, amount, date_of_sale, receipt_id, tax_category, tax_rate, net_amount, tax_amount
+			 FROM sold
+			 WHERE date_of_sale >= ? AND date_of_sale <= ?
+			 ORDER BY date_of_sale`
+
+	rows, err := r.executor(ctx).QueryContext(ctx, stmt, data.From, data.To)
+	if err != nil {
+		return r.ConvertToCommonErr(err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var record dto.SoldRecord
+		var receiptID sql.NullInt64
+		if err = rows.Scan(&record.Article, &record.Price, &record.Amount, &record.Date, &receiptID,
+			&record.TaxCategory, &record.TaxRate, &record.Net, &record.Tax); err != nil {
+			return r.ConvertToCommonErr(err)
+		}
+		record.ReceiptID = receipt.ID(receiptID.Int64)
+		if err = fn(record); err != nil {
+			return err
+		}
+	}
+
+	return r.ConvertToCommonErr(rows.Err())
+}
+
+// IterateArticlesSales последовательно передаёт в функцию fn количество проданных единиц и выручку за вычетом
+// возвратов, а также возвращённые единицы и сумму возвратов за период по каждому проданному или возвращённому товару.
+// Ошибка, возвращённая fn, прерывает чтение и возвращается без изменений.
+func (r *Repository) IterateArticlesSales(ctx context.Context, data *dto.FromTo, fn func(dto.ArticleSales) error) error {
+	stmt := fmt.Sprintf(`SELECT article, SUM(units) - SUM(refunded_units), SUM(revenue) - SUM(refunded),
+			     SUM(refunded_units), SUM(refunded)
+			 FROM %s
+			 GROUP BY article
+			 ORDER BY article`, movements)
+
+	rows, err := r.executor(ctx).QueryContext(ctx, stmt, data.From, data.To, data.From, data.To)
+	if err != nil {
+		return r.ConvertToCommonErr(err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var record dto.ArticleSales
+		if err = rows.Scan(&record.Article, &record.Units, &record.Revenue, &record.RefundedUnits,
+			&record.Refunded); err != nil {
+			return r.ConvertToCommonErr(err)
+		}
+		if err = fn(record); err != nil {
+			return err
+		}
+	}
+
+	return r.ConvertToCommonErr(rows.Err())
+}
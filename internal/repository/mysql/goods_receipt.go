@@ -0,0 +1,63 @@
+package mysql
+
+import (
+	"context"
+	"github.com/lazylex/watch-store-store/internal/dto"
+)
+
+// CreateGoodsReceipt сохраняет в БД принятую поставку вместе с её строками. Если поставка с таким номером документа уже
+// сохранена, возвращается repository.ErrDuplicate.
+func (r *Repository) CreateGoodsReceipt(ctx context.Context, data *dto.GoodsReceipt) error {
+	receiptStmt := `INSERT INTO goods_receipt (document_number, supplier, received_at) VALUES (?,?,?)`
+	lineStmt := `INSERT INTO goods_receipt_line (document_number, article, cost, amount) VALUES (?,?,?,?)`
+
+	return r.WithinTransaction(ctx, func(txCtx context.Context) error {
+		_, err := r.executor(txCtx).ExecContext(txCtx, receiptStmt, data.DocumentNumber, data.Supplier, data.ReceivedAt)
+		if err != nil {
+			return r.ConvertToCommonErr(err)
+		}
+
+		for _, line := range data.Lines {
+			if _, err = r.executor(txCtx).ExecContext(txCtx, lineStmt, data.DocumentNumber, line.Article, line.Cost,
+				line.Amount); err != nil {
+				return r.ConvertToCommonErr(err)
+			}
+		}
+		return nil
+	})
+}
+
+// ReadGoodsReceipt возвращает принятую поставку с переданным номером документа.
+func (r *Repository) ReadGoodsReceipt(ctx context.Context, data *dto.DocumentNumber) (dto.GoodsReceipt, error) {
+	var result dto.GoodsReceipt
+	receiptStmt := `SELECT document_number, supplier, received_at FROM goods_receipt WHERE document_number = ?`
+	lineStmt := `SELECT gl.article, COALESCE(s.name, ''), COALESCE(s.price, 0), gl.cost, gl.amount
+				 FROM goods_receipt_line gl
+				 LEFT JOIN stock s ON s.article = gl.article
+				 WHERE gl.document_number = ?`
+
+	row := r.executor(ctx).QueryRowContext(ctx, receiptStmt, data.DocumentNumber)
+	if err := row.Scan(&result.DocumentNumber, &result.Supplier, &result.ReceivedAt); err != nil {
+		return dto.GoodsReceipt{}, r.ConvertToCommonErr(err)
+	}
+
+	rows, err := r.executor(ctx).QueryContext(ctx, lineStmt, data.DocumentNumber)
+	if err != nil {
+		return dto.GoodsReceipt{}, r.ConvertToCommonErr(err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var line dto.GoodsReceiptLine
+		if err = rows.Scan(&line.Article, &line.Name, &line.Price, &line.Cost, &line.Amount); err != nil {
+			return dto.GoodsReceipt{}, r.ConvertToCommonErr(err)
+		}
+		result.Lines = append(result.Lines, line)
+	}
+
+	if err = rows.Err(); err != nil {
+		return dto.GoodsReceipt{}, r.ConvertToCommonErr(err)
+	}
+
+	return result, nil
+}
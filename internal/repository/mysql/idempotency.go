@@ -0,0 +1,66 @@
+package mysql
+
+import (
+	"context"
+	"github.com/lazylex/watch-store-store/internal/dto"
+	"time"
+)
+
+// CreateIdempotencyRecord сохраняет запись о начале обработки запроса с ключом идемпотентности. Если запись с таким
+// ключом уже существует, возвращается repository.ErrDuplicate.
+func (r *Repository) CreateIdempotencyRecord(ctx context.Context, data *dto.IdempotencyRecord) error {
+	stmt := `INSERT INTO idempotency_key
+			 (subject, idempotency_key, fingerprint, completed, status, content_type, body, expires_at)
+			 VALUES (?,?,?,?,?,?,?,?)`
+
+	_, err := r.executor(ctx).ExecContext(ctx, stmt, data.Subject, data.Key, data.Fingerprint, data.Completed,
+		data.Status, data.ContentType, data.Body, data.ExpiresAt)
+
+	return r.ConvertToCommonErr(err)
+}
+
+// ReadIdempotencyRecord возвращает запись с ключом идемпотентности и его субъектом, переданными в dto.IdempotencyKey.
+func (r *Repository) ReadIdempotencyRecord(ctx context.Context, data *dto.IdempotencyKey) (dto.IdempotencyRecord,
+	error) {
+	var result dto.IdempotencyRecord
+	stmt := `SELECT subject, idempotency_key, fingerprint, completed, status, content_type, body, expires_at
+			 FROM idempotency_key
+			 WHERE subject = ? AND idempotency_key = ?`
+
+	row := r.executor(ctx).QueryRowContext(ctx, stmt, data.Subject, data.Key)
+	if err := row.Scan(&result.Subject, &result.Key, &result.Fingerprint, &result.Completed, &result.Status,
+		&result.ContentType, &result.Body, &result.ExpiresAt); err != nil {
+		return dto.IdempotencyRecord{}, r.ConvertToCommonErr(err)
+	}
+
+	return result, nil
+}
+
+// UpdateIdempotencyRecord сохраняет результат обработки запроса с ключом идемпотентности.
+func (r *Repository) UpdateIdempotencyRecord(ctx context.Context, data *dto.IdempotencyRecord) error {
+	stmt := `UPDATE idempotency_key SET completed = ?, status = ?, content_type = ?, body = ?
+			 WHERE subject = ? AND idempotency_key = ?`
+
+	_, err := r.executor(ctx).ExecContext(ctx, stmt, data.Completed, data.Status, data.ContentType, data.Body,
+		data.Subject, data.Key)
+
+	return r.ConvertToCommonErr(err)
+}
+
+// DeleteIdempotencyRecord удаляет запись с ключом идемпотентности и его субъектом, переданными в dto.IdempotencyKey.
+func (r *Repository) DeleteIdempotencyRecord(ctx context.Context, data *dto.IdempotencyKey) error {
+	stmt := `DELETE FROM idempotency_key WHERE subject = ? AND idempotency_key = ?`
+
+	_, err := r.executor(ctx).ExecContext(ctx, stmt, data.Subject, data.Key)
+
+	return r.ConvertToCommonErr(err)
+}
+
+// DeleteExpiredIdempotencyRecords удаляет записи, срок действия которых истёк к моменту now.
+func (r *Repository) DeleteExpiredIdempotencyRecords(ctx context.Context, now time.Time) error {
+	stmt := `DELETE FROM idempotency_key WHERE expires_at <= ?`
+
+	_, err := r.executor(ctx).ExecContext(ctx, stmt, now)
+
+	return r.ConvertToCommonErr(err)
+}
@@ -256,7 +256,7 @@ func (r *Repository) ReadStockAmount(ctx context.Context, data *dto.Article) (ui
 // ReadStockPrice возвращает цену товара с артикулом, переданным в dto.Article, из находящегося в продаже.
 func (r *Repository) ReadStockPrice(ctx context.Context, data *dto.Article) (float64, error) {
 	var price float64
-	stmt := `SELECT amount FROM stock WHERE article = ?`
+	stmt := `SELECT price FROM stock WHERE article = ?`
 
 	row := r.executor(ctx).QueryRowContext(ctx, stmt, data.Article)
 	if err := row.Scan(&price); err != nil {
@@ -269,7 +269,7 @@ func (r *Repository) ReadStockPrice(ctx context.Context, data *dto.Article) (flo
 // UpdateStock обновляет запись о товаре в БД, в соответствии с переданными в dto.ArticlePriceNameAmount данными.
 func (r *Repository) UpdateStock(ctx context.Context, data *dto.ArticlePriceNameAmount) error {
 	var err error
-	stmt := `UPDATE stock SET name, price, amount = (?,?,?) WHERE article = ?`
+	stmt := `UPDATE stock SET name = ?, price = ?, amount = ? WHERE article = ?`
 
 	_, err = r.executor(ctx).ExecContext(ctx, stmt, data.Name, data.Price, data.Amount, data.Article)
 
@@ -324,18 +324,22 @@ func (r *Repository) CreateReservation(ctx context.Context, data *dto.NumberDate
 }
 
 // ReadReservation возвращает в виде dto.NumberDateStateProducts  данные о бронировании товаров с номером заказа, переданным в
-// dto.Number.
+// dto.Number. Если бронирования с таким номером нет, возвращается repository.ErrNoRecord. В транзакции записи (или,
+// при их отсутствии, место для них) блокируются до её завершения, поэтому проверка свободного номера заказа не
+// конкурирует с параллельным созданием заказа с тем же номером.
 func (r *Repository) ReadReservation(ctx context.Context, data *dto.Number) (dto.NumberDateStateProducts, error) {
 	stmt := `SELECT article, price, amount, date_of_reservation, order_number, status
     		 FROM on_processing 
-    		 WHERE order_number = ?`
+    		 WHERE order_number = ?
+    		 FOR UPDATE`
 
 	rows, err := r.executor(ctx).QueryContext(ctx, stmt, data.OrderNumber)
 	if err != nil {
 		return dto.NumberDateStateProducts{}, err
 	}
+	defer rows.Close()
 
-	var state uint
+	var state reservation.State
 	var date time.Time
 	var orderNumber reservation.OrderNumber
 	var products []dto.ArticlePriceAmount
@@ -353,6 +357,10 @@ func (r *Repository) ReadReservation(ctx context.Context, data *dto.Number) (dto
 		return dto.NumberDateStateProducts{}, r.ConvertToCommonErr(err)
 	}
 
+	if len(products) == 0 {
+		return dto.NumberDateStateProducts{}, repository.ErrNoRecord
+	}
+
 	return dto.NumberDateStateProducts{OrderNumber: orderNumber, Date: date, State: state, Products: products}, nil
 }
 
@@ -0,0 +1,24 @@
+package mysql
+
+import (
+	"context"
+	"github.com/lazylex/watch-store-store/internal/ports/repository"
+)
+
+// NextOrderNumber увеличивает значение последовательности номеров заказов и возвращает его. Значение возвращается
+// вместе с результатом запроса изменения (через LAST_INSERT_ID), поэтому выделенные разным запросам номера не
+// совпадают. Номер, выделенный в откатившейся транзакции, может быть выделен повторно.
+func (r *Repository) NextOrderNumber(ctx context.Context) (int64, error) {
+	stmt := `UPDATE order_number_sequence SET value = LAST_INSERT_ID(value + 1) WHERE id = 1`
+
+	result, err := r.executor(ctx).ExecContext(ctx, stmt)
+	if err != nil {
+		return 0, r.ConvertToCommonErr(err)
+	}
+	if affected, err := result.RowsAffected(); err != nil || affected == 0 {
+		return 0, repository.ErrNoRecord
+	}
+
+	value, err := result.LastInsertId()
+	return value, r.ConvertToCommonErr(err)
+}
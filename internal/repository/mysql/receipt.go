@@ -0,0 +1,152 @@
+package mysql
+
+import (
+	"context"
+	"database/sql"
+	"github.com/lazylex/watch-store-store/internal/domain/aggregates/receipt"
+	"github.com/lazylex/watch-store-store/internal/domain/aggregates/reservation"
+	"github.com/lazylex/watch-store-store/internal/domain/aggregates/shift"
+	"github.com/lazylex/watch-store-store/internal/domain/value_objects/tax"
+	"github.com/lazylex/watch-store-store/internal/dto"
+	"math"
+)
+
+// CreateReceipt сохраняет в БД чек и относящиеся к нему записи о проданных товарах с включённым в их стоимость НДС и
+// платежах. Если НДС строки не рассчитан, товар сохраняется с категорией tax.Default и нулевой ставкой. Запросы
+// выполняются в одной транзакции (внешней, если она содержится в контексте). Возвращает присвоенный чеку
+// идентификатор.
+func (r *Repository) CreateReceipt(ctx context.Context, data *dto.Receipt) (receipt.ID, error) {
+	var id receipt.ID
+	receiptStmt := `INSERT INTO receipt (cash_register, shift_id, order_number, payment_method, total, created_at)
+					VALUES (?,?,?,?,?,?)`
+	soldStmt := `INSERT INTO sold (article, price, amount, date_of_sale, receipt_id, tax_category, tax_rate, net_amount,
+				 tax_amount)
+				 VALUES (?,?,?,?,?,?,?,?,?)`
+	paymentStmt := `INSERT INTO receipt_payment (receipt_id, line, payment_method, amount, change_given)
+					VALUES (?,?,?,?,?)`
+
+	f := func(txCtx context.Context) error {
+		result, err := r.executor(txCtx).ExecContext(txCtx, receiptStmt, nullableNumber(data.CashRegister),
+			nullableID(int64(data.ShiftID)), nullableNumber(data.OrderNumber), data.PaymentMethod, data.Total, data.Date)
+		if err != nil {
+			return r.ConvertToCommonErr(err)
+		}
+
+		lastID, err := result.LastInsertId()
+		if err != nil {
+			return r.ConvertToCommonErr(err)
+		}
+		id = receipt.ID(lastID)
+
+		for i, p := range data.Products {
+			t := dto.NewLineTax(p, tax.Default, 0)
+			if i < len(data.Taxes) {
+				t = data.Taxes[i]
+			}
+			if _, err = r.executor(txCtx).ExecContext(txCtx, soldStmt,
+				p.Article, p.Price, p.Amount, data.Date, id, t.Category, t.Rate, t.Net, t.Tax); err != nil {
+				return r.ConvertToCommonErr(err)
+			}
+		}
+
+		for i, p := range data.Payments {
+			if _, err = r.executor(txCtx).ExecContext(txCtx, paymentStmt,
+				id, i+1, p.Method, p.Amount, p.Change); err != nil {
+				return r.ConvertToCommonErr(err)
+			}
+		}
+		return nil
+	}
+
+	if err := r.WithinTransaction(ctx, f); err != nil {
+		return 0, err
+	}
+
+	return id, nil
+}
+
+// ReadReceipt возвращает чек с идентификатором, переданным в dto.ReceiptID, вместе с проданными по нему товарами,
+// включённым в их стоимость НДС и платежами.
+func (r *Repository) ReadReceipt(ctx context.Context, data *dto.ReceiptID) (dto.Receipt, error) {
+	var result dto.Receipt
+	var cashRegister, shiftID, orderNumber sql.NullInt64
+	receiptStmt := `SELECT id, cash_register, shift_id, order_number, payment_method, total, created_at
+					FROM receipt
+					WHERE id = ?`
+	soldStmt := `SELECT article, price, amount, tax_category, tax_rate, net_amount, tax_amount
+				 FROM sold
+				 WHERE receipt_id = ?`
+
+	row := r.executor(ctx).QueryRowContext(ctx, receiptStmt, data.ID)
+	if err := row.Scan(&result.ID, &cashRegister, &shiftID, &orderNumber, &result.PaymentMethod, &result.Total,
+		&result.Date); err != nil {
+		return dto.Receipt{}, r.ConvertToCommonErr(err)
+	}
+	result.CashRegister = reservation.OrderNumber(cashRegister.Int64)
+	result.ShiftID = shift.ID(shiftID.Int64)
+	result.OrderNumber = reservation.OrderNumber(orderNumber.Int64)
+
+	rows, err := r.executor(ctx).QueryContext(ctx, soldStmt, data.ID)
+	if err != nil {
+		return dto.Receipt{}, r.ConvertToCommonErr(err)
+	}
+	defer rows.Close()
+
+	var taxTotal int64
+	for rows.Next() {
+		var product dto.ArticlePriceAmount
+		var t dto.LineTax
+		if err = rows.Scan(&product.Article, &product.Price, &product.Amount, &t.Category, &t.Rate, &t.Net,
+			&t.Tax); err != nil {
+			return dto.Receipt{}, r.ConvertToCommonErr(err)
+		}
+		t.Article = product.Article
+		taxTotal += int64(math.Round(t.Tax * 100))
+		result.Products = append(result.Products, product)
+		result.Taxes = append(result.Taxes, t)
+	}
+	result.Tax = float64(taxTotal) / 100
+
+	if err = rows.Err(); err != nil {
+		return dto.Receipt{}, r.ConvertToCommonErr(err)
+	}
+
+	if result.Payments, err = r.readReceiptPayments(ctx, data.ID); err != nil {
+		return dto.Receipt{}, err
+	}
+
+	return result, nil
+}
+
+// readReceiptPayments возвращает платежи чека с идентификатором id в порядке их внесения.
+func (r *Repository) readReceiptPayments(ctx context.Context, id receipt.ID) ([]dto.Payment, error) {
+	var result []dto.Payment
+	stmt := `SELECT payment_method, amount, change_given FROM receipt_payment WHERE receipt_id = ? ORDER BY line`
+
+	rows, err := r.executor(ctx).QueryContext(ctx, stmt, id)
+	if err != nil {
+		return result, r.ConvertToCommonErr(err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var p dto.Payment
+		if err = rows.Scan(&p.Method, &p.Amount, &p.Change); err != nil {
+			return result, r.ConvertToCommonErr(err)
+		}
+		result = append(result, p)
+	}
+
+	return result, r.ConvertToCommonErr(rows.Err())
+}
+
+// nullableNumber возвращает значение для записи в БД номера кассы или заказа. Нулевой номер сохраняется как NULL.
+func nullableNumber(number reservation.OrderNumber) sql.NullInt64 {
+	return sql.NullInt64{Int64: int64(number), Valid: number != 0}
+}
+
+// nullableID возвращает значение для записи в БД идентификатора связанной сущности. Нулевой идентификатор сохраняется
+// как NULL.
+func nullableID(id int64) sql.NullInt64 {
+	return sql.NullInt64{Int64: id, Valid: id != 0}
+}
@@ -0,0 +1,69 @@
+package mysql
+
+import (
+	"context"
+	"github.com/lazylex/watch-store-store/internal/domain/aggregates/refund"
+	"github.com/lazylex/watch-store-store/internal/dto"
+)
+
+// CreateRefund сохраняет в БД возврат товаров и возвращённые позиции. Запросы выполняются в одной транзакции (внешней,
+// если она содержится в контексте). Возвращает присвоенный возврату идентификатор.
+func (r *Repository) CreateRefund(ctx context.Context, data *dto.RefundRecord) (refund.ID, error) {
+	var id refund.ID
+	refundStmt := `INSERT INTO refund (receipt_id, shift_id, cash_register, payment_method, total, created_at)
+				   VALUES (?,?,?,?,?,?)`
+	itemStmt := `INSERT INTO refund_item (refund_id, article, price, amount) VALUES (?,?,?,?)`
+
+	f := func(txCtx context.Context) error {
+		result, err := r.executor(txCtx).ExecContext(txCtx, refundStmt, data.ReceiptID, data.ShiftID,
+			data.CashRegister, data.PaymentMethod, data.Total, data.Date)
+		if err != nil {
+			return r.ConvertToCommonErr(err)
+		}
+
+		lastID, err := result.LastInsertId()
+		if err != nil {
+			return r.ConvertToCommonErr(err)
+		}
+		id = refund.ID(lastID)
+
+		for _, p := range data.Products {
+			if _, err = r.executor(txCtx).ExecContext(txCtx, itemStmt, id, p.Article, p.Price, p.Amount); err != nil {
+				return r.ConvertToCommonErr(err)
+			}
+		}
+		return nil
+	}
+
+	if err := r.WithinTransaction(ctx, f); err != nil {
+		return 0, err
+	}
+
+	return id, nil
+}
+
+// ReadRefundedProducts возвращает суммарное количество уже возвращённых товаров по чеку, переданному в dto.ReceiptID.
+func (r *Repository) ReadRefundedProducts(ctx context.Context, data *dto.ReceiptID) ([]dto.ArticleAmount, error) {
+	var result []dto.ArticleAmount
+	stmt := `SELECT ri.article, SUM(ri.amount)
+			 FROM refund_item ri
+			 JOIN refund rf ON rf.id = ri.refund_id
+			 WHERE rf.receipt_id = ?
+			 GROUP BY ri.article`
+
+	rows, err := r.executor(ctx).QueryContext(ctx, stmt, data.ID)
+	if err != nil {
+		return result, r.ConvertToCommonErr(err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var product dto.ArticleAmount
+		if err = rows.Scan(&product.Article, &product.Amount); err != nil {
+			return result, r.ConvertToCommonErr(err)
+		}
+		result = append(result, product)
+	}
+
+	return result, r.ConvertToCommonErr(rows.Err())
+}
@@ -0,0 +1,64 @@
+package mysql
+
+import (
+	"context"
+	"database/sql"
+	"github.com/lazylex/watch-store-store/internal/dto"
+	"time"
+)
+
+// UpsertReplenishmentSetting сохраняет параметры пополнения запасов товара, заменяя ранее сохранённые.
+func (r *Repository) UpsertReplenishmentSetting(ctx context.Context, data *dto.ReplenishmentSetting) error {
+	stmt := `INSERT INTO replenishment_setting (article, lead_time_days, safety_stock) VALUES (?,?,?)
+			 ON DUPLICATE KEY UPDATE lead_time_days = VALUES(lead_time_days), safety_stock = VALUES(safety_stock)`
+
+	_, err := r.executor(ctx).ExecContext(ctx, stmt, data.Article, data.LeadTimeDays, data.SafetyStock)
+
+	return r.ConvertToCommonErr(err)
+}
+
+// ReadReplenishmentSetting возвращает параметры пополнения запасов товара.
+func (r *Repository) ReadReplenishmentSetting(ctx context.Context, data *dto.Article) (dto.ReplenishmentSetting, error) {
+	result := dto.ReplenishmentSetting{Article: data.Article}
+	stmt := `SELECT lead_time_days, safety_stock FROM replenishment_setting WHERE article = ?`
+
+	row := r.executor(ctx).QueryRowContext(ctx, stmt, data.Article)
+	if err := row.Scan(&result.LeadTimeDays, &result.SafetyStock); err != nil {
+		return dto.ReplenishmentSetting{}, r.ConvertToCommonErr(err)
+	}
+
+	return result, nil
+}
+
+// ReadStockSoldSince возвращает для каждого товара в продаже количество в наличии, количество проданных начиная с
+// момента since единиц и параметры пополнения запасов, если они заданы.
+func (r *Repository) ReadStockSoldSince(ctx context.Context, since time.Time) ([]dto.ArticleStockSold, error) {
+	var result []dto.ArticleStockSold
+	stmt := `SELECT st.article, st.amount, COALESCE(sd.units, 0), rs.lead_time_days, rs.safety_stock
+			 FROM stock st
+			 LEFT JOIN (SELECT article, SUM(amount) AS units FROM sold WHERE date_of_sale >= ? GROUP BY article) sd
+			     ON sd.article = st.article
+			 LEFT JOIN replenishment_setting rs ON rs.article = st.article
+			 ORDER BY st.article`
+
+	rows, err := r.executor(ctx).QueryContext(ctx, stmt, since)
+	if err != nil {
+		return result, r.ConvertToCommonErr(err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var record dto.ArticleStockSold
+		var leadTime, safetyStock sql.NullInt64
+		if err = rows.Scan(&record.Article, &record.InStock, &record.Sold, &leadTime, &safetyStock); err != nil {
+			return result, r.ConvertToCommonErr(err)
+		}
+		if leadTime.Valid {
+			record.Setting = &dto.ReplenishmentSetting{Article: record.Article, LeadTimeDays: uint(leadTime.Int64),
+				SafetyStock: uint(safetyStock.Int64)}
+		}
+		result = append(result, record)
+	}
+
+	return result, r.ConvertToCommonErr(rows.Err())
+}
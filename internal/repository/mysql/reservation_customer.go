@@ -0,0 +1,86 @@
+package mysql
+
+import (
+	"context"
+	"database/sql"
+	"github.com/lazylex/watch-store-store/internal/domain/aggregates/reservation"
+	"github.com/lazylex/watch-store-store/internal/domain/value_objects/phone"
+	"github.com/lazylex/watch-store-store/internal/dto"
+)
+
+// CreateReservationCustomer сохраняет данные покупателя и хэш кода получения заказа.
+func (r *Repository) CreateReservationCustomer(ctx context.Context, data *dto.ReservationCustomer) error {
+	var name, phoneNumber, email, hash sql.NullString
+	stmt := `INSERT INTO reservation_customer (order_number, name, phone, email, pickup_code_hash) VALUES (?,?,?,?,?)`
+
+	if data.Customer != nil {
+		name = sql.NullString{String: data.Customer.Name, Valid: true}
+		phoneNumber = sql.NullString{String: string(data.Customer.Phone), Valid: true}
+		email = sql.NullString{String: data.Customer.Email, Valid: data.Customer.Email != ""}
+	}
+	hash = sql.NullString{String: data.PickupCodeHash, Valid: data.PickupCodeHash != ""}
+
+	_, err := r.executor(ctx).ExecContext(ctx, stmt, data.OrderNumber, name, phoneNumber, email, hash)
+
+	return r.ConvertToCommonErr(err)
+}
+
+// ReadReservationCustomer возвращает данные покупателя и хэш кода получения заказа с номером, переданным в
+// dto.Number. Если они не сохранялись, возвращается repository.ErrNoRecord.
+func (r *Repository) ReadReservationCustomer(ctx context.Context, data *dto.Number) (dto.ReservationCustomer, error) {
+	var name, phoneNumber, email, hash sql.NullString
+	stmt := `SELECT name, phone, email, pickup_code_hash FROM reservation_customer WHERE order_number = ?`
+
+	row := r.executor(ctx).QueryRowContext(ctx, stmt, data.OrderNumber)
+	if err := row.Scan(&name, &phoneNumber, &email, &hash); err != nil {
+		return dto.ReservationCustomer{}, r.ConvertToCommonErr(err)
+	}
+
+	result := dto.ReservationCustomer{OrderNumber: data.OrderNumber, PickupCodeHash: hash.String}
+	if phoneNumber.Valid {
+		result.Customer = &dto.Customer{Name: name.String, Phone: phone.Phone(phoneNumber.String), Email: email.String}
+	}
+
+	return result, nil
+}
+
+// ReadOpenReservationsByPhone возвращает упорядоченные по номеру невыполненные и неотменённые заказы покупателя с
+// переданным в dto.Phone нормализованным номером телефона вместе с данными покупателя.
+func (r *Repository) ReadOpenReservationsByPhone(ctx context.Context, data *dto.Phone) (
+	[]dto.NumberDateStateProducts, error) {
+	var result []dto.NumberDateStateProducts
+	stmt := `SELECT op.order_number, op.status, op.date_of_reservation, op.article, op.price, op.amount,
+			        rc.name, rc.phone, rc.email
+			 FROM on_processing op
+			 JOIN reservation_customer rc ON rc.order_number = op.order_number
+			 WHERE rc.phone = ? AND op.status NOT IN (?,?)
+			 ORDER BY op.order_number, op.article`
+
+	rows, err := r.executor(ctx).QueryContext(ctx, stmt, data.Phone, reservation.Finished, reservation.Cancel)
+	if err != nil {
+		return result, r.ConvertToCommonErr(err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var order dto.NumberDateStateProducts
+		var product dto.ArticlePriceAmount
+		var name, email sql.NullString
+		customer := dto.Customer{}
+		if err = rows.Scan(&order.OrderNumber, &order.State, &order.Date, &product.Article, &product.Price,
+			&product.Amount, &name, &customer.Phone, &email); err != nil {
+			return result, r.ConvertToCommonErr(err)
+		}
+
+		if last := len(result) - 1; last >= 0 && result[last].OrderNumber == order.OrderNumber {
+			result[last].Products = append(result[last].Products, product)
+			continue
+		}
+		customer.Name, customer.Email = name.String, email.String
+		order.Customer = &customer
+		order.Products = []dto.ArticlePriceAmount{product}
+		result = append(result, order)
+	}
+
+	return result, r.ConvertToCommonErr(rows.Err())
+}
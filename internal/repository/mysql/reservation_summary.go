@@ -0,0 +1,73 @@
+package mysql
+
+import (
+	"context"
+	"github.com/lazylex/watch-store-store/internal/domain/aggregates/reservation"
+	"github.com/lazylex/watch-store-store/internal/dto"
+)
+
+// ReadOpenReservationsByState возвращает количество невыполненных и неотменённых заказов и зарезервированных под них
+// единиц товара по состояниям заказов. Состояния без заказов в результат не входят.
+func (r *Repository) ReadOpenReservationsByState(ctx context.Context) ([]dto.StateOrdersUnits, error) {
+	var result []dto.StateOrdersUnits
+	stmt := `SELECT status, COUNT(DISTINCT order_number), COALESCE(SUM(amount), 0)
+			 FROM on_processing
+			 WHERE status NOT IN (?,?)
+			 GROUP BY status
+			 ORDER BY status`
+
+	rows, err := r.executor(ctx).QueryContext(ctx, stmt, reservation.Finished, reservation.Cancel)
+	if err != nil {
+		return result, r.ConvertToCommonErr(err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var record dto.StateOrdersUnits
+		if err = rows.Scan(&record.State, &record.Orders, &record.Units); err != nil {
+			return result, r.ConvertToCommonErr(err)
+		}
+		result = append(result, record)
+	}
+
+	return result, r.ConvertToCommonErr(rows.Err())
+}
+
+// ReadReservedAmount возвращает количество единиц товара, зарезервированных под невыполненные и неотменённые заказы.
+func (r *Repository) ReadReservedAmount(ctx context.Context, data *dto.Article) (uint, error) {
+	var amount uint
+	stmt := `SELECT COALESCE(SUM(amount), 0) FROM on_processing WHERE article = ? AND status NOT IN (?,?)`
+
+	err := r.executor(ctx).QueryRowContext(ctx, stmt, data.Article, reservation.Finished, reservation.Cancel).
+		Scan(&amount)
+
+	return amount, r.ConvertToCommonErr(err)
+}
+
+// ReadReservedInStoreAmounts возвращает количество единиц товаров, зарезервированных под заказы, товар которых ещё
+// находится в магазине (заказ не выполнен, не отменён и не отправлен). Товары без таких заказов в результат не входят.
+func (r *Repository) ReadReservedInStoreAmounts(ctx context.Context) ([]dto.ArticleAmount, error) {
+	var result []dto.ArticleAmount
+	stmt := `SELECT article, SUM(amount)
+			 FROM on_processing
+			 WHERE status NOT IN (?,?,?)
+			 GROUP BY article
+			 ORDER BY article`
+
+	rows, err := r.executor(ctx).QueryContext(ctx, stmt, reservation.Finished, reservation.Cancel,
+		reservation.Shipped)
+	if err != nil {
+		return result, r.ConvertToCommonErr(err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var record dto.ArticleAmount
+		if err = rows.Scan(&record.Article, &record.Amount); err != nil {
+			return result, r.ConvertToCommonErr(err)
+		}
+		result = append(result, record)
+	}
+
+	return result, r.ConvertToCommonErr(rows.Err())
+}
@@ -0,0 +1,44 @@
+package mysql
+
+import (
+	"context"
+	"github.com/lazylex/watch-store-store/internal/dto"
+)
+
+// CreateReservationTransition сохраняет в истории запись о смене состояния бронирования.
+func (r *Repository) CreateReservationTransition(ctx context.Context, data *dto.ReservationTransition) error {
+	stmt := `INSERT INTO reservation_transition (order_number, from_state, to_state, actor, created_at)
+			 VALUES (?,?,?,?,?)`
+
+	_, err := r.executor(ctx).ExecContext(ctx, stmt, data.OrderNumber, data.From, data.To, data.Actor, data.Date)
+
+	return r.ConvertToCommonErr(err)
+}
+
+// ReadReservationTransitions возвращает в хронологическом порядке историю смены состояний бронирования с номером
+// заказа, переданным в dto.Number. Номера заказов, оформленных на кассе, повторяются, поэтому в истории содержатся
+// переходы всех оформленных на кассе заказов.
+func (r *Repository) ReadReservationTransitions(ctx context.Context, data *dto.Number) ([]dto.ReservationTransition,
+	error) {
+	var result []dto.ReservationTransition
+	stmt := `SELECT order_number, from_state, to_state, actor, created_at
+			 FROM reservation_transition
+			 WHERE order_number = ?
+			 ORDER BY created_at, id`
+
+	rows, err := r.executor(ctx).QueryContext(ctx, stmt, data.OrderNumber)
+	if err != nil {
+		return result, r.ConvertToCommonErr(err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var record dto.ReservationTransition
+		if err = rows.Scan(&record.OrderNumber, &record.From, &record.To, &record.Actor, &record.Date); err != nil {
+			return result, r.ConvertToCommonErr(err)
+		}
+		result = append(result, record)
+	}
+
+	return result, r.ConvertToCommonErr(rows.Err())
+}
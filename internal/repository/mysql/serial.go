@@ -0,0 +1,215 @@
+package mysql
+
+import (
+	"context"
+	"fmt"
+	"github.com/lazylex/watch-store-store/internal/domain/value_objects/article"
+	"github.com/lazylex/watch-store-store/internal/domain/value_objects/serial"
+	"github.com/lazylex/watch-store-store/internal/dto"
+	"strings"
+	"time"
+)
+
+// CreateSerialTracking включает учёт товара по серийным номерам. Повторное включение учёта не изменяет дату его
+// включения.
+func (r *Repository) CreateSerialTracking(ctx context.Context, data *dto.Article, date time.Time) error {
+	stmt := `INSERT INTO serial_tracked_article (article, tracked_at) VALUES (?,?)
+			 ON DUPLICATE KEY UPDATE article = article`
+
+	_, err := r.executor(ctx).ExecContext(ctx, stmt, data.Article, date)
+
+	return r.ConvertToCommonErr(err)
+}
+
+// DeleteSerialTracking отключает учёт товара по серийным номерам. Серийные номера и их история сохраняются.
+func (r *Repository) DeleteSerialTracking(ctx context.Context, data *dto.Article) error {
+	stmt := `DELETE FROM serial_tracked_article WHERE article = ?`
+
+	_, err := r.executor(ctx).ExecContext(ctx, stmt, data.Article)
+
+	return r.ConvertToCommonErr(err)
+}
+
+// ReadSerialTrackedArticles возвращает те из переданных артикулов, товары с которыми учитываются по серийным номерам.
+func (r *Repository) ReadSerialTrackedArticles(ctx context.Context, articles []article.Article) ([]article.Article,
+	error) {
+	var result []article.Article
+	if len(articles) == 0 {
+		return result, nil
+	}
+
+	args := make([]any, len(articles))
+	for i, a := range articles {
+		args[i] = a
+	}
+	stmt := fmt.Sprintf(`SELECT article FROM serial_tracked_article WHERE article IN (%s)`,
+		strings.TrimSuffix(strings.Repeat("?,", len(articles)), ","))
+
+	rows, err := r.executor(ctx).QueryContext(ctx, stmt, args...)
+	if err != nil {
+		return result, r.ConvertToCommonErr(err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var a article.Article
+		if err = rows.Scan(&a); err != nil {
+			return result, r.ConvertToCommonErr(err)
+		}
+		result = append(result, a)
+	}
+
+	return result, r.ConvertToCommonErr(rows.Err())
+}
+
+// CreateSerials регистрирует экземпляры товаров с серийными номерами. Если хотя бы один из номеров уже
+// зарегистрирован, возвращается repository.ErrDuplicate.
+func (r *Repository) CreateSerials(ctx context.Context, data []dto.SerialRecord) error {
+	if len(data) == 0 {
+		return nil
+	}
+
+	args := make([]any, 0, 5*len(data))
+	for _, record := range data {
+		args = append(args, record.Serial, record.Article, record.State, record.OrderNumber, record.ReceiptID)
+	}
+	stmt := fmt.Sprintf(`INSERT INTO serial_number (serial, article, state, order_number, receipt_id) VALUES %s`,
+		strings.TrimSuffix(strings.Repeat("(?,?,?,?,?),", len(data)), ","))
+
+	_, err := r.executor(ctx).ExecContext(ctx, stmt, args...)
+
+	return r.ConvertToCommonErr(err)
+}
+
+// ReadSerials возвращает экземпляры товаров с переданными серийными номерами. Не зарегистрированные номера в результат
+// не попадают. Найденные записи блокируются до завершения транзакции.
+func (r *Repository) ReadSerials(ctx context.Context, numbers []serial.Number) ([]dto.SerialRecord, error) {
+	var result []dto.SerialRecord
+	if len(numbers) == 0 {
+		return result, nil
+	}
+
+	args := make([]any, len(numbers))
+	for i, n := range numbers {
+		args[i] = n
+	}
+	stmt := fmt.Sprintf(`SELECT serial, article, state, order_number, receipt_id
+			 FROM serial_number
+			 WHERE serial IN (%s)
+			 FOR UPDATE`, strings.TrimSuffix(strings.Repeat("?,", len(numbers)), ","))
+
+	return r.readSerialRecords(ctx, stmt, args...)
+}
+
+// ReadSerialsByOrder возвращает экземпляры товаров, зарезервированные под заказ с номером, переданным в dto.Number.
+func (r *Repository) ReadSerialsByOrder(ctx context.Context, data *dto.Number) ([]dto.SerialRecord, error) {
+	stmt := `SELECT serial, article, state, order_number, receipt_id
+			 FROM serial_number
+			 WHERE order_number = ? AND state = ?
+			 ORDER BY serial`
+
+	return r.readSerialRecords(ctx, stmt, data.OrderNumber, serial.Reserved)
+}
+
+// ReadSerialsByReceipt возвращает проданные по чеку экземпляры товаров, которые ещё не были возвращены.
+func (r *Repository) ReadSerialsByReceipt(ctx context.Context, data *dto.ReceiptID) ([]dto.SerialRecord, error) {
+	stmt := `SELECT serial, article, state, order_number, receipt_id
+			 FROM serial_number
+			 WHERE receipt_id = ? AND state = ?
+			 ORDER BY serial`
+
+	return r.readSerialRecords(ctx, stmt, data.ID, serial.Sold)
+}
+
+// ReadAvailableSerials возвращает доступные для продажи экземпляры товара с артикулом, переданным в dto.Article.
+func (r *Repository) ReadAvailableSerials(ctx context.Context, data *dto.Article) ([]dto.SerialRecord, error) {
+	stmt := `SELECT serial, article, state, order_number, receipt_id
+			 FROM serial_number
+			 WHERE article = ? AND state = ?
+			 ORDER BY serial`
+
+	return r.readSerialRecords(ctx, stmt, data.Article, serial.InStock)
+}
+
+// UpdateSerials сохраняет состояние, номер заказа и идентификатор чека экземпляров товаров с серийными номерами.
+func (r *Repository) UpdateSerials(ctx context.Context, data []dto.SerialRecord) error {
+	stmt := `UPDATE serial_number SET state = ?, order_number = ?, receipt_id = ? WHERE serial = ?`
+
+	for _, record := range data {
+		if _, err := r.executor(ctx).ExecContext(ctx, stmt, record.State, record.OrderNumber, record.ReceiptID,
+			record.Serial); err != nil {
+			return r.ConvertToCommonErr(err)
+		}
+	}
+
+	return nil
+}
+
+// CreateSerialEvents сохраняет в истории записи о событиях экземпляров товаров с серийными номерами.
+func (r *Repository) CreateSerialEvents(ctx context.Context, data []dto.SerialEvent) error {
+	if len(data) == 0 {
+		return nil
+	}
+
+	args := make([]any, 0, 9*len(data))
+	for _, e := range data {
+		args = append(args, e.Serial, e.Article, e.State, e.DocumentNumber, e.OrderNumber, e.ReceiptID, e.RefundID,
+			e.Date)
+	}
+	stmt := fmt.Sprintf(`INSERT INTO serial_event
+			 (serial, article, state, document_number, order_number, receipt_id, refund_id, created_at)
+			 VALUES %s`, strings.TrimSuffix(strings.Repeat("(?,?,?,?,?,?,?,?),", len(data)), ","))
+
+	_, err := r.executor(ctx).ExecContext(ctx, stmt, args...)
+
+	return r.ConvertToCommonErr(err)
+}
+
+// ReadSerialEvents возвращает в хронологическом порядке историю экземпляра товара с серийным номером, переданным в
+// dto.Serial.
+func (r *Repository) ReadSerialEvents(ctx context.Context, data *dto.Serial) ([]dto.SerialEvent, error) {
+	var result []dto.SerialEvent
+	stmt := `SELECT serial, article, state, document_number, order_number, receipt_id, refund_id, created_at
+			 FROM serial_event
+			 WHERE serial = ?
+			 ORDER BY created_at, id`
+
+	rows, err := r.executor(ctx).QueryContext(ctx, stmt, data.Serial)
+	if err != nil {
+		return result, r.ConvertToCommonErr(err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var e dto.SerialEvent
+		if err = rows.Scan(&e.Serial, &e.Article, &e.State, &e.DocumentNumber, &e.OrderNumber, &e.ReceiptID,
+			&e.RefundID, &e.Date); err != nil {
+			return result, r.ConvertToCommonErr(err)
+		}
+		result = append(result, e)
+	}
+
+	return result, r.ConvertToCommonErr(rows.Err())
+}
+
+// readSerialRecords выполняет запрос stmt, возвращающий записи об экземплярах товаров с серийными номерами.
+func (r *Repository) readSerialRecords(ctx context.Context, stmt string, args ...any) ([]dto.SerialRecord, error) {
+	var result []dto.SerialRecord
+
+	rows, err := r.executor(ctx).QueryContext(ctx, stmt, args...)
+	if err != nil {
+		return result, r.ConvertToCommonErr(err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var record dto.SerialRecord
+		if err = rows.Scan(&record.Serial, &record.Article, &record.State, &record.OrderNumber,
+			&record.ReceiptID); err != nil {
+			return result, r.ConvertToCommonErr(err)
+		}
+		result = append(result, record)
+	}
+
+	return result, r.ConvertToCommonErr(rows.Err())
+}
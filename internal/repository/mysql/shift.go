@@ -0,0 +1,177 @@
+package mysql
+
+import (
+	"context"
+	"github.com/lazylex/watch-store-store/internal/domain/aggregates/shift"
+	"github.com/lazylex/watch-store-store/internal/dto"
+)
+
+const (
+	operationSale   = "sale"
+	operationRefund = "refund"
+)
+
+// CreateShift сохраняет в БД открытую кассовую смену и возвращает присвоенный ей идентификатор. Если на кассе уже
+// открыта смена, возвращается repository.ErrDuplicate (за это отвечает уникальный индекс таблицы shift).
+func (r *Repository) CreateShift(ctx context.Context, data *dto.Shift) (shift.ID, error) {
+	stmt := `INSERT INTO shift (cash_register, cashier_id, opening_float, opened_at) VALUES (?,?,?,?)`
+
+	result, err := r.executor(ctx).ExecContext(ctx, stmt, data.CashRegister, data.CashierID, data.OpeningFloat,
+		data.OpenedAt)
+	if err != nil {
+		return 0, r.ConvertToCommonErr(err)
+	}
+
+	id, err := result.LastInsertId()
+	if err != nil {
+		return 0, r.ConvertToCommonErr(err)
+	}
+
+	return shift.ID(id), nil
+}
+
+// ReadOpenShift возвращает открытую смену на кассе, номер которой передан в dto.CashRegister. Если открытой смены нет,
+// возвращается repository.ErrNoRecord.
+func (r *Repository) ReadOpenShift(ctx context.Context, data *dto.CashRegister) (dto.Shift, error) {
+	var result dto.Shift
+	stmt := `SELECT id, cash_register, cashier_id, opening_float, opened_at
+			 FROM shift
+			 WHERE cash_register = ? AND closed_at IS NULL`
+
+	row := r.executor(ctx).QueryRowContext(ctx, stmt, data.CashRegister)
+	err := row.Scan(&result.ID, &result.CashRegister, &result.CashierID, &result.OpeningFloat, &result.OpenedAt)
+
+	return result, r.ConvertToCommonErr(err)
+}
+
+// CloseShift сохраняет время закрытия смены, переданное в dto.Shift.
+func (r *Repository) CloseShift(ctx context.Context, data *dto.Shift) error {
+	stmt := `UPDATE shift SET closed_at = ? WHERE id = ? AND closed_at IS NULL`
+
+	_, err := r.executor(ctx).ExecContext(ctx, stmt, data.ClosedAt, data.ID)
+
+	return r.ConvertToCommonErr(err)
+}
+
+// ReadShiftSales возвращает количество платежей по чекам смены и их сумму за вычетом выданной сдачи, сгруппированные по
+// способу оплаты.
+func (r *Repository) ReadShiftSales(ctx context.Context, data *dto.ShiftID) ([]dto.PaymentCountTotal, error) {
+	stmt := `SELECT rp.payment_method, COUNT(*), SUM(rp.amount - rp.change_given)
+			 FROM receipt_payment rp
+			 JOIN receipt r ON r.id = rp.receipt_id
+			 WHERE r.shift_id = ?
+			 GROUP BY rp.payment_method`
+
+	return r.readPaymentCountTotals(ctx, stmt, data.ID)
+}
+
+// ReadShiftReceiptsCount возвращает количество чеков смены.
+func (r *Repository) ReadShiftReceiptsCount(ctx context.Context, data *dto.ShiftID) (uint, error) {
+	var count uint
+	stmt := `SELECT COUNT(*) FROM receipt WHERE shift_id = ?`
+
+	err := r.executor(ctx).QueryRowContext(ctx, stmt, data.ID).Scan(&count)
+
+	return count, r.ConvertToCommonErr(err)
+}
+
+// ReadShiftRefunds возвращает количество и сумму возвратов смены, сгруппированные по способу оплаты.
+func (r *Repository) ReadShiftRefunds(ctx context.Context, data *dto.ShiftID) ([]dto.PaymentCountTotal, error) {
+	stmt := `SELECT payment_method, COUNT(*), SUM(total) FROM refund WHERE shift_id = ? GROUP BY payment_method`
+
+	return r.readPaymentCountTotals(ctx, stmt, data.ID)
+}
+
+// readPaymentCountTotals выполняет запрос stmt, возвращающий способ оплаты, количество операций и их сумму.
+func (r *Repository) readPaymentCountTotals(ctx context.Context, stmt string, args ...any) ([]dto.PaymentCountTotal, error) {
+	var result []dto.PaymentCountTotal
+
+	rows, err := r.executor(ctx).QueryContext(ctx, stmt, args...)
+	if err != nil {
+		return result, r.ConvertToCommonErr(err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var record dto.PaymentCountTotal
+		if err = rows.Scan(&record.Method, &record.Count, &record.Total); err != nil {
+			return result, r.ConvertToCommonErr(err)
+		}
+		result = append(result, record)
+	}
+
+	return result, r.ConvertToCommonErr(rows.Err())
+}
+
+// CreateZReport сохраняет в БД отчёт о закрытии смены вместе с продажами и возвратами по способам оплаты.
+func (r *Repository) CreateZReport(ctx context.Context, data *dto.ZReport) error {
+	reportStmt := `INSERT INTO z_report (shift_id, cash_register, cashier_id, opened_at, closed_at, opening_float,
+				   sales_count, sales_total, refunds_count, refunds_total, cash_in_drawer)
+				   VALUES (?,?,?,?,?,?,?,?,?,?,?)`
+	paymentStmt := `INSERT INTO z_report_payment (shift_id, operation, payment_method, count, total) VALUES (?,?,?,?,?)`
+
+	return r.WithinTransaction(ctx, func(txCtx context.Context) error {
+		_, err := r.executor(txCtx).ExecContext(txCtx, reportStmt, data.ShiftID, data.CashRegister, data.CashierID,
+			data.OpenedAt, data.ClosedAt, data.OpeningFloat, data.SalesCount, data.SalesTotal, data.RefundsCount,
+			data.RefundsTotal, data.CashInDrawer)
+		if err != nil {
+			return r.ConvertToCommonErr(err)
+		}
+
+		for operation, payments := range map[string][]dto.PaymentCountTotal{
+			operationSale:   data.Sales,
+			operationRefund: data.Refunds,
+		} {
+			for _, p := range payments {
+				if _, err = r.executor(txCtx).ExecContext(txCtx, paymentStmt, data.ShiftID, operation, p.Method,
+					p.Count, p.Total); err != nil {
+					return r.ConvertToCommonErr(err)
+				}
+			}
+		}
+
+		return nil
+	})
+}
+
+// ReadZReport возвращает отчёт о закрытии смены, идентификатор которой передан в dto.ShiftID.
+func (r *Repository) ReadZReport(ctx context.Context, data *dto.ShiftID) (dto.ZReport, error) {
+	var result dto.ZReport
+	reportStmt := `SELECT shift_id, cash_register, cashier_id, opened_at, closed_at, opening_float, sales_count,
+				   sales_total, refunds_count, refunds_total, cash_in_drawer
+				   FROM z_report
+				   WHERE shift_id = ?`
+	paymentStmt := `SELECT operation, payment_method, count, total FROM z_report_payment WHERE shift_id = ?`
+
+	row := r.executor(ctx).QueryRowContext(ctx, reportStmt, data.ID)
+	if err := row.Scan(&result.ShiftID, &result.CashRegister, &result.CashierID, &result.OpenedAt, &result.ClosedAt,
+		&result.OpeningFloat, &result.SalesCount, &result.SalesTotal, &result.RefundsCount, &result.RefundsTotal,
+		&result.CashInDrawer); err != nil {
+		return dto.ZReport{}, r.ConvertToCommonErr(err)
+	}
+
+	rows, err := r.executor(ctx).QueryContext(ctx, paymentStmt, data.ID)
+	if err != nil {
+		return dto.ZReport{}, r.ConvertToCommonErr(err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var operation string
+		var record dto.PaymentCountTotal
+		if err = rows.Scan(&operation, &record.Method, &record.Count, &record.Total); err != nil {
+			return dto.ZReport{}, r.ConvertToCommonErr(err)
+		}
+		if operation == operationRefund {
+			result.Refunds = append(result.Refunds, record)
+		} else {
+			result.Sales = append(result.Sales, record)
+		}
+	}
+
+	if err = rows.Err(); err != nil {
+		return dto.ZReport{}, r.ConvertToCommonErr(err)
+	}
+
+	return result, nil
+}
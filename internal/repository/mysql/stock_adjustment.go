@@ -0,0 +1,17 @@
+package mysql
+
+import (
+	"context"
+	"github.com/lazylex/watch-store-store/internal/dto"
+)
+
+// CreateStockAdjustment сохраняет в журнале корректировок запись о переданной в dto.StockAdjustment корректировке.
+func (r *Repository) CreateStockAdjustment(ctx context.Context, data *dto.StockAdjustment) error {
+	stmt := `INSERT INTO stock_adjustment (article, delta, reason, comment, amount_after, created_at)
+			 VALUES (?,?,?,?,?,?)`
+
+	_, err := r.executor(ctx).ExecContext(ctx, stmt, data.Article, data.Delta, data.Reason, data.Comment,
+		data.AmountAfter, data.Date)
+
+	return r.ConvertToCommonErr(err)
+}
@@ -0,0 +1,38 @@
+package mysql
+
+import (
+	"context"
+	"github.com/lazylex/watch-store-store/internal/dto"
+)
+
+// ReadStockLocations возвращает сохранённые количества товара на местах хранения, отличных от места по умолчанию.
+func (r *Repository) ReadStockLocations(ctx context.Context, data *dto.Article) ([]dto.LocationAmount, error) {
+	var result []dto.LocationAmount
+	stmt := `SELECT location, amount FROM stock_location WHERE article = ? AND amount > 0`
+
+	rows, err := r.executor(ctx).QueryContext(ctx, stmt, data.Article)
+	if err != nil {
+		return result, r.ConvertToCommonErr(err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var record dto.LocationAmount
+		if err = rows.Scan(&record.Location, &record.Amount); err != nil {
+			return result, r.ConvertToCommonErr(err)
+		}
+		result = append(result, record)
+	}
+
+	return result, r.ConvertToCommonErr(rows.Err())
+}
+
+// UpsertStockLocationAmount сохраняет количество товара на месте хранения, заменяя ранее сохранённое.
+func (r *Repository) UpsertStockLocationAmount(ctx context.Context, data *dto.ArticleLocationAmount) error {
+	stmt := `INSERT INTO stock_location (article, location, amount) VALUES (?,?,?)
+			 ON DUPLICATE KEY UPDATE amount = VALUES(amount)`
+
+	_, err := r.executor(ctx).ExecContext(ctx, stmt, data.Article, data.Location, data.Amount)
+
+	return r.ConvertToCommonErr(err)
+}
@@ -0,0 +1,36 @@
+package mysql
+
+import (
+	"context"
+	"github.com/lazylex/watch-store-store/internal/domain/value_objects/article"
+	"github.com/lazylex/watch-store-store/internal/dto"
+	"strings"
+)
+
+// likeEscaper экранирует специальные символы шаблона LIKE.
+var likeEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
+// ReadStockPricesByBase возвращает цены товара с переданным артикулом без дефектов и всех товаров, артикулы которых
+// образованы от него добавлением кода дефектов. Строки блокируются до конца транзакции.
+func (r *Repository) ReadStockPricesByBase(ctx context.Context, base article.Article) ([]dto.ArticlePrice, error) {
+	var result []dto.ArticlePrice
+	stmt := `SELECT article, price FROM stock WHERE article = ? OR article LIKE ? ORDER BY article FOR UPDATE`
+
+	rows, err := r.executor(ctx).QueryContext(ctx, stmt, base, likeEscaper.Replace(string(base))+".____")
+	if err != nil {
+		return result, r.ConvertToCommonErr(err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var record dto.ArticlePrice
+		if err = rows.Scan(&record.Article, &record.Price); err != nil {
+			return result, r.ConvertToCommonErr(err)
+		}
+		if record.Article.Base() == base {
+			result = append(result, record)
+		}
+	}
+
+	return result, r.ConvertToCommonErr(rows.Err())
+}
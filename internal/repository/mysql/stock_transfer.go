@@ -0,0 +1,204 @@
+package mysql
+
+import (
+	"context"
+	"database/sql"
+	"github.com/lazylex/watch-store-store/internal/domain/aggregates/transfer"
+	"github.com/lazylex/watch-store-store/internal/dto"
+	"strings"
+)
+
+// CreateTransfer сохраняет в БД перемещение товара между магазинами вместе с перемещаемыми товарами. Возвращает
+// присвоенный перемещению идентификатор. Повторное сохранение входящего перемещения с тем же отправителем и
+// идентификатором у отправителя возвращает repository.ErrDuplicate.
+func (r *Repository) CreateTransfer(ctx context.Context, data *dto.Transfer) (transfer.ID, error) {
+	var id transfer.ID
+	transferStmt := `INSERT INTO stock_transfer (direction, counterpart, source_id, state, notified, created_at, updated_at)
+					 VALUES (?,?,?,?,?,?,?)`
+	itemStmt := `INSERT INTO stock_transfer_item (transfer_id, article, name, price, amount, received)
+				 VALUES (?,?,?,?,?,?)`
+
+	f := func(txCtx context.Context) error {
+		sourceID := sql.NullInt64{Int64: int64(data.SourceID), Valid: data.SourceID != 0}
+		result, err := r.executor(txCtx).ExecContext(txCtx, transferStmt, data.Direction, data.Counterpart, sourceID,
+			data.State, data.Notified, data.CreatedAt, data.UpdatedAt)
+		if err != nil {
+			return r.ConvertToCommonErr(err)
+		}
+
+		lastID, err := result.LastInsertId()
+		if err != nil {
+			return r.ConvertToCommonErr(err)
+		}
+		id = transfer.ID(lastID)
+
+		for _, item := range data.Items {
+			if _, err = r.executor(txCtx).ExecContext(txCtx, itemStmt, id, item.Article, item.Name, item.Price,
+				item.Amount, item.Received); err != nil {
+				return r.ConvertToCommonErr(err)
+			}
+		}
+		return nil
+	}
+
+	if err := r.WithinTransaction(ctx, f); err != nil {
+		return 0, err
+	}
+
+	return id, nil
+}
+
+// ReadTransfer возвращает перемещение товара с переданным идентификатором вместе с перемещаемыми товарами.
+func (r *Repository) ReadTransfer(ctx context.Context, data *dto.TransferID) (dto.Transfer, error) {
+	stmt := `SELECT id, direction, counterpart, source_id, state, notified, created_at, updated_at
+			 FROM stock_transfer
+			 WHERE id = ?`
+
+	result, err := r.scanTransfer(r.executor(ctx).QueryRowContext(ctx, stmt, data.ID))
+	if err != nil {
+		return dto.Transfer{}, r.ConvertToCommonErr(err)
+	}
+
+	if result.Items, err = r.readTransferItems(ctx, result.ID); err != nil {
+		return dto.Transfer{}, err
+	}
+
+	return result, nil
+}
+
+// ReadTransfers возвращает удовлетворяющие фильтру перемещения товара без перемещаемых товаров. Последние созданные
+// перемещения возвращаются первыми.
+func (r *Repository) ReadTransfers(ctx context.Context, data *dto.TransferFilter) ([]dto.Transfer, error) {
+	var conditions []string
+	var args []any
+	if data.Direction != "" {
+		conditions = append(conditions, "direction = ?")
+		args = append(args, data.Direction)
+	}
+	if data.State != 0 {
+		conditions = append(conditions, "state = ?")
+		args = append(args, data.State)
+	}
+
+	stmt := `SELECT id, direction, counterpart, source_id, state, notified, created_at, updated_at FROM stock_transfer`
+	if len(conditions) > 0 {
+		stmt += " WHERE " + strings.Join(conditions, " AND ")
+	}
+	stmt += " ORDER BY id DESC"
+
+	return r.queryTransfers(ctx, stmt, args...)
+}
+
+// ReadUnnotifiedTransfers возвращает вместе с перемещаемыми товарами перемещения, о которых ещё не отправлено
+// сообщение второй стороне перемещения.
+func (r *Repository) ReadUnnotifiedTransfers(ctx context.Context) ([]dto.Transfer, error) {
+	stmt := `SELECT id, direction, counterpart, source_id, state, notified, created_at, updated_at
+			 FROM stock_transfer
+			 WHERE notified = FALSE
+			 ORDER BY id`
+
+	result, err := r.queryTransfers(ctx, stmt)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range result {
+		if result[i].Items, err = r.readTransferItems(ctx, result[i].ID); err != nil {
+			return nil, err
+		}
+	}
+
+	return result, nil
+}
+
+// UpdateTransfer сохраняет состояние перемещения, признак отправки сообщения второй стороне и принятое количество
+// перемещаемых товаров.
+func (r *Repository) UpdateTransfer(ctx context.Context, data *dto.Transfer) error {
+	transferStmt := `UPDATE stock_transfer SET state = ?, notified = ?, updated_at = ? WHERE id = ?`
+	itemStmt := `UPDATE stock_transfer_item SET received = ? WHERE transfer_id = ? AND article = ?`
+
+	return r.WithinTransaction(ctx, func(txCtx context.Context) error {
+		result, err := r.executor(txCtx).ExecContext(txCtx, transferStmt, data.State, data.Notified, data.UpdatedAt,
+			data.ID)
+		if err != nil {
+			return r.ConvertToCommonErr(err)
+		}
+		if affected, err := result.RowsAffected(); err == nil && affected == 0 {
+			return r.ConvertToCommonErr(sql.ErrNoRows)
+		}
+
+		for _, item := range data.Items {
+			if _, err = r.executor(txCtx).ExecContext(txCtx, itemStmt, item.Received, data.ID,
+				item.Article); err != nil {
+				return r.ConvertToCommonErr(err)
+			}
+		}
+		return nil
+	})
+}
+
+// UpdateTransferNotified отмечает, что второй стороне перемещения отправлено сообщение о нём.
+func (r *Repository) UpdateTransferNotified(ctx context.Context, data *dto.TransferID) error {
+	stmt := `UPDATE stock_transfer SET notified = TRUE WHERE id = ?`
+
+	_, err := r.executor(ctx).ExecContext(ctx, stmt, data.ID)
+
+	return r.ConvertToCommonErr(err)
+}
+
+// queryTransfers выполняет запрос stmt, возвращающий перемещения товара без перемещаемых товаров.
+func (r *Repository) queryTransfers(ctx context.Context, stmt string, args ...any) ([]dto.Transfer, error) {
+	var result []dto.Transfer
+
+	rows, err := r.executor(ctx).QueryContext(ctx, stmt, args...)
+	if err != nil {
+		return nil, r.ConvertToCommonErr(err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var record dto.Transfer
+		if record, err = r.scanTransfer(rows); err != nil {
+			return nil, r.ConvertToCommonErr(err)
+		}
+		result = append(result, record)
+	}
+
+	return result, r.ConvertToCommonErr(rows.Err())
+}
+
+// scanTransfer считывает перемещение товара из строки результата запроса.
+func (r *Repository) scanTransfer(row interface{ Scan(...any) error }) (dto.Transfer, error) {
+	var result dto.Transfer
+	var sourceID sql.NullInt64
+
+	if err := row.Scan(&result.ID, &result.Direction, &result.Counterpart, &sourceID, &result.State,
+		&result.Notified, &result.CreatedAt, &result.UpdatedAt); err != nil {
+		return dto.Transfer{}, err
+	}
+	result.SourceID = transfer.ID(sourceID.Int64)
+
+	return result, nil
+}
+
+// readTransferItems возвращает товары перемещения с переданным идентификатором.
+func (r *Repository) readTransferItems(ctx context.Context, id transfer.ID) ([]dto.TransferItem, error) {
+	var result []dto.TransferItem
+	stmt := `SELECT article, name, price, amount, received FROM stock_transfer_item WHERE transfer_id = ? ORDER BY article`
+
+	rows, err := r.executor(ctx).QueryContext(ctx, stmt, id)
+	if err != nil {
+		return nil, r.ConvertToCommonErr(err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var item dto.TransferItem
+		if err = rows.Scan(&item.Article, &item.Name, &item.Price, &item.Amount, &item.Received); err != nil {
+			return nil, r.ConvertToCommonErr(err)
+		}
+		result = append(result, item)
+	}
+
+	return result, r.ConvertToCommonErr(rows.Err())
+}
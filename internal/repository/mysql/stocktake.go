@@ -0,0 +1,139 @@
+package mysql
+
+import (
+	"context"
+	"database/sql"
+	"github.com/lazylex/watch-store-store/internal/domain/aggregates/stocktake"
+	"github.com/lazylex/watch-store-store/internal/dto"
+)
+
+// ReadStockAmounts возвращает количество всех имеющихся в ассортименте товаров.
+func (r *Repository) ReadStockAmounts(ctx context.Context) ([]dto.ArticleAmount, error) {
+	var result []dto.ArticleAmount
+	stmt := `SELECT article, amount FROM stock ORDER BY article`
+
+	rows, err := r.executor(ctx).QueryContext(ctx, stmt)
+	if err != nil {
+		return result, r.ConvertToCommonErr(err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var record dto.ArticleAmount
+		if err = rows.Scan(&record.Article, &record.Amount); err != nil {
+			return result, r.ConvertToCommonErr(err)
+		}
+		result = append(result, record)
+	}
+
+	return result, r.ConvertToCommonErr(rows.Err())
+}
+
+// CreateStocktake сохраняет в БД начатую инвентаризацию вместе с количеством подсчитываемых товаров в учёте (и
+// зарезервированным из него) на момент её начала. Возвращает присвоенный инвентаризации идентификатор.
+func (r *Repository) CreateStocktake(ctx context.Context, data *dto.Stocktake) (stocktake.ID, error) {
+	var id stocktake.ID
+	stocktakeStmt := `INSERT INTO stocktake (state, started_at) VALUES (?,?)`
+	itemStmt := `INSERT INTO stocktake_item (stocktake_id, article, system_amount, reserved) VALUES (?,?,?,?)`
+
+	f := func(txCtx context.Context) error {
+		result, err := r.executor(txCtx).ExecContext(txCtx, stocktakeStmt, data.State, data.StartedAt)
+		if err != nil {
+			return r.ConvertToCommonErr(err)
+		}
+
+		lastID, err := result.LastInsertId()
+		if err != nil {
+			return r.ConvertToCommonErr(err)
+		}
+		id = stocktake.ID(lastID)
+
+		for _, item := range data.Items {
+			if _, err = r.executor(txCtx).ExecContext(txCtx, itemStmt, id, item.Article, item.SystemAmount,
+				item.Reserved); err != nil {
+				return r.ConvertToCommonErr(err)
+			}
+		}
+		return nil
+	}
+
+	if err := r.WithinTransaction(ctx, f); err != nil {
+		return 0, err
+	}
+
+	return id, nil
+}
+
+// ReadStocktake возвращает инвентаризацию с переданным идентификатором вместе с подсчитываемыми товарами.
+func (r *Repository) ReadStocktake(ctx context.Context, data *dto.StocktakeID) (dto.Stocktake, error) {
+	var result dto.Stocktake
+	var appliedAt sql.NullTime
+	stocktakeStmt := `SELECT id, state, started_at, applied_at FROM stocktake WHERE id = ?`
+	itemStmt := `SELECT article, system_amount, reserved, counted, is_counted, movement, expected, difference
+				 FROM stocktake_item
+				 WHERE stocktake_id = ?
+				 ORDER BY article`
+
+	row := r.executor(ctx).QueryRowContext(ctx, stocktakeStmt, data.ID)
+	if err := row.Scan(&result.ID, &result.State, &result.StartedAt, &appliedAt); err != nil {
+		return dto.Stocktake{}, r.ConvertToCommonErr(err)
+	}
+	result.AppliedAt = appliedAt.Time
+
+	rows, err := r.executor(ctx).QueryContext(ctx, itemStmt, data.ID)
+	if err != nil {
+		return dto.Stocktake{}, r.ConvertToCommonErr(err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var item dto.StocktakeItem
+		if err = rows.Scan(&item.Article, &item.SystemAmount, &item.Reserved, &item.Counted, &item.IsCounted,
+			&item.Movement, &item.Expected, &item.Difference); err != nil {
+			return dto.Stocktake{}, r.ConvertToCommonErr(err)
+		}
+		result.Items = append(result.Items, item)
+	}
+
+	if err = rows.Err(); err != nil {
+		return dto.Stocktake{}, r.ConvertToCommonErr(err)
+	}
+
+	return result, nil
+}
+
+// UpdateStocktakeCounts прибавляет переданное количество подсчитанного товара к уже подсчитанному.
+func (r *Repository) UpdateStocktakeCounts(ctx context.Context, data *dto.StocktakeCounts) error {
+	stmt := `UPDATE stocktake_item SET counted = counted + ?, is_counted = TRUE WHERE stocktake_id = ? AND article = ?`
+
+	return r.WithinTransaction(ctx, func(txCtx context.Context) error {
+		for _, c := range data.Counts {
+			if _, err := r.executor(txCtx).ExecContext(txCtx, stmt, c.Amount, data.ID, c.Article); err != nil {
+				return r.ConvertToCommonErr(err)
+			}
+		}
+		return nil
+	})
+}
+
+// UpdateStocktake сохраняет состояние инвентаризации и рассчитанные по каждому товару расхождения.
+func (r *Repository) UpdateStocktake(ctx context.Context, data *dto.Stocktake) error {
+	stocktakeStmt := `UPDATE stocktake SET state = ?, applied_at = ? WHERE id = ?`
+	itemStmt := `UPDATE stocktake_item SET movement = ?, expected = ?, difference = ?
+				 WHERE stocktake_id = ? AND article = ?`
+
+	return r.WithinTransaction(ctx, func(txCtx context.Context) error {
+		appliedAt := sql.NullTime{Time: data.AppliedAt, Valid: !data.AppliedAt.IsZero()}
+		if _, err := r.executor(txCtx).ExecContext(txCtx, stocktakeStmt, data.State, appliedAt, data.ID); err != nil {
+			return r.ConvertToCommonErr(err)
+		}
+
+		for _, item := range data.Items {
+			if _, err := r.executor(txCtx).ExecContext(txCtx, itemStmt, item.Movement, item.Expected,
+				item.Difference, data.ID, item.Article); err != nil {
+				return r.ConvertToCommonErr(err)
+			}
+		}
+		return nil
+	})
+}
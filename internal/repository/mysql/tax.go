@@ -0,0 +1,141 @@
+package mysql
+
+import (
+	"context"
+	"fmt"
+	"github.com/lazylex/watch-store-store/internal/domain/value_objects/article"
+	"github.com/lazylex/watch-store-store/internal/domain/value_objects/tax"
+	"github.com/lazylex/watch-store-store/internal/dto"
+	"strings"
+	"time"
+)
+
+// UpsertTaxRate сохраняет ставку НДС категории товаров, заменяя ставку, действующую с того же момента.
+func (r *Repository) UpsertTaxRate(ctx context.Context, data *dto.TaxRate) error {
+	stmt := `INSERT INTO tax_rate (category, effective_from, rate) VALUES (?,?,?)
+			 ON DUPLICATE KEY UPDATE rate = VALUES(rate)`
+
+	_, err := r.executor(ctx).ExecContext(ctx, stmt, data.Category, data.EffectiveFrom, data.Rate)
+
+	return r.ConvertToCommonErr(err)
+}
+
+// ReadTaxRates возвращает все ставки НДС, упорядоченные по категории и дате начала действия.
+func (r *Repository) ReadTaxRates(ctx context.Context) ([]dto.TaxRate, error) {
+	var result []dto.TaxRate
+	stmt := `SELECT category, rate, effective_from FROM tax_rate ORDER BY category, effective_from`
+
+	rows, err := r.executor(ctx).QueryContext(ctx, stmt)
+	if err != nil {
+		return result, r.ConvertToCommonErr(err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var record dto.TaxRate
+		if err = rows.Scan(&record.Category, &record.Rate, &record.EffectiveFrom); err != nil {
+			return result, r.ConvertToCommonErr(err)
+		}
+		result = append(result, record)
+	}
+
+	return result, r.ConvertToCommonErr(rows.Err())
+}
+
+// UpsertArticleTaxCategory сохраняет налоговую категорию товара, заменяя ранее сохранённую.
+func (r *Repository) UpsertArticleTaxCategory(ctx context.Context, data *dto.ArticleTaxCategory) error {
+	stmt := `INSERT INTO article_tax_category (article, category) VALUES (?,?)
+			 ON DUPLICATE KEY UPDATE category = VALUES(category)`
+
+	_, err := r.executor(ctx).ExecContext(ctx, stmt, data.Article, data.Category)
+
+	return r.ConvertToCommonErr(err)
+}
+
+// ReadArticlesTaxRates возвращает для каждого из переданных товаров налоговую категорию и ставку НДС, действующую для
+// неё в момент date. Товары без сохранённой категории относятся к категории tax.Default. Если для категории нет
+// ставки, действующей в момент date, ставка равна нулю.
+func (r *Repository) ReadArticlesTaxRates(ctx context.Context, articles []article.Article, date time.Time) (
+	[]dto.ArticleTaxRate, error) {
+	result := make([]dto.ArticleTaxRate, 0, len(articles))
+	if len(articles) == 0 {
+		return result, nil
+	}
+
+	categories, err := r.readArticlesTaxCategories(ctx, articles)
+	if err != nil {
+		return result, err
+	}
+
+	rates, err := r.readEffectiveTaxRates(ctx, date)
+	if err != nil {
+		return result, err
+	}
+
+	for _, a := range articles {
+		category, ok := categories[a]
+		if !ok {
+			category = tax.Default
+		}
+		result = append(result, dto.ArticleTaxRate{Article: a, Category: category, Rate: rates[category]})
+	}
+
+	return result, nil
+}
+
+// readArticlesTaxCategories возвращает сохранённые налоговые категории товаров с переданными артикулами.
+func (r *Repository) readArticlesTaxCategories(ctx context.Context, articles []article.Article) (
+	map[article.Article]tax.Category, error) {
+	result := make(map[article.Article]tax.Category)
+
+	args := make([]any, len(articles))
+	for i, a := range articles {
+		args[i] = a
+	}
+	stmt := fmt.Sprintf(`SELECT article, category FROM article_tax_category WHERE article IN (%s)`,
+		strings.TrimSuffix(strings.Repeat("?,", len(articles)), ","))
+
+	rows, err := r.executor(ctx).QueryContext(ctx, stmt, args...)
+	if err != nil {
+		return result, r.ConvertToCommonErr(err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var a article.Article
+		var category tax.Category
+		if err = rows.Scan(&a, &category); err != nil {
+			return result, r.ConvertToCommonErr(err)
+		}
+		result[a] = category
+	}
+
+	return result, r.ConvertToCommonErr(rows.Err())
+}
+
+// readEffectiveTaxRates возвращает ставки НДС категорий, действующие в момент date.
+func (r *Repository) readEffectiveTaxRates(ctx context.Context, date time.Time) (map[tax.Category]float64, error) {
+	result := make(map[tax.Category]float64)
+	stmt := `SELECT tr.category, tr.rate
+			 FROM tax_rate tr
+			 WHERE tr.effective_from = (SELECT MAX(t.effective_from)
+			                            FROM tax_rate t
+			                            WHERE t.category = tr.category AND t.effective_from <= ?)`
+
+	rows, err := r.executor(ctx).QueryContext(ctx, stmt, date)
+	if err != nil {
+		return result, r.ConvertToCommonErr(err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var category tax.Category
+		var rate float64
+		if err = rows.Scan(&category, &rate); err != nil {
+			return result, r.ConvertToCommonErr(err)
+		}
+		result[category] = rate
+	}
+
+	return result, r.ConvertToCommonErr(rows.Err())
+}
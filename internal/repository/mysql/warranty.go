@@ -0,0 +1,272 @@
+package mysql
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"github.com/lazylex/watch-store-store/internal/domain/aggregates/warranty"
+	"github.com/lazylex/watch-store-store/internal/domain/value_objects/article"
+	"github.com/lazylex/watch-store-store/internal/domain/value_objects/phone"
+	"github.com/lazylex/watch-store-store/internal/dto"
+	"github.com/lazylex/watch-store-store/internal/ports/repository"
+	"strings"
+)
+
+// UpsertWarrantyTerm сохраняет гарантийный срок товара, заменяя ранее сохранённый.
+func (r *Repository) UpsertWarrantyTerm(ctx context.Context, data *dto.WarrantyTerm) error {
+	stmt := `INSERT INTO warranty_term (article, months) VALUES (?,?) ON DUPLICATE KEY UPDATE months = VALUES(months)`
+
+	_, err := r.executor(ctx).ExecContext(ctx, stmt, data.Article, data.Months)
+
+	return r.ConvertToCommonErr(err)
+}
+
+// DeleteWarrantyTerm удаляет гарантийный срок товара. Выданные ранее гарантии сохраняются.
+func (r *Repository) DeleteWarrantyTerm(ctx context.Context, data *dto.Article) error {
+	stmt := `DELETE FROM warranty_term WHERE article = ?`
+
+	_, err := r.executor(ctx).ExecContext(ctx, stmt, data.Article)
+
+	return r.ConvertToCommonErr(err)
+}
+
+// ReadWarrantyTerms возвращает гарантийные сроки тех из переданных товаров, для которых они сохранены.
+func (r *Repository) ReadWarrantyTerms(ctx context.Context, articles []article.Article) ([]dto.WarrantyTerm, error) {
+	var result []dto.WarrantyTerm
+	if len(articles) == 0 {
+		return result, nil
+	}
+
+	args := make([]any, len(articles))
+	for i, a := range articles {
+		args[i] = a
+	}
+	stmt := fmt.Sprintf(`SELECT article, months FROM warranty_term WHERE article IN (%s)`,
+		strings.TrimSuffix(strings.Repeat("?,", len(articles)), ","))
+
+	rows, err := r.executor(ctx).QueryContext(ctx, stmt, args...)
+	if err != nil {
+		return result, r.ConvertToCommonErr(err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var record dto.WarrantyTerm
+		if err = rows.Scan(&record.Article, &record.Months); err != nil {
+			return result, r.ConvertToCommonErr(err)
+		}
+		result = append(result, record)
+	}
+
+	return result, r.ConvertToCommonErr(rows.Err())
+}
+
+// CreateWarranties сохраняет выданные гарантии.
+func (r *Repository) CreateWarranties(ctx context.Context, data []dto.Warranty) error {
+	if len(data) == 0 {
+		return nil
+	}
+
+	args := make([]any, 0, 8*len(data))
+	for _, w := range data {
+		var phoneNumber sql.NullString
+		if w.Phone != "" {
+			phoneNumber = sql.NullString{String: string(w.Phone), Valid: true}
+		}
+		args = append(args, w.Article, w.Serial, w.Amount, w.ReceiptID, w.OrderNumber, phoneNumber, w.SoldAt,
+			w.ExpiresAt)
+	}
+	stmt := fmt.Sprintf(`INSERT INTO warranty
+			 (article, serial, amount, receipt_id, order_number, phone, sold_at, expires_at) VALUES %s`,
+		strings.TrimSuffix(strings.Repeat("(?,?,?,?,?,?,?,?),", len(data)), ","))
+
+	_, err := r.executor(ctx).ExecContext(ctx, stmt, args...)
+
+	return r.ConvertToCommonErr(err)
+}
+
+// ReadWarranty возвращает гарантию с идентификатором, переданным в dto.WarrantyID, блокируя её запись до конца
+// транзакции. Если гарантии нет, возвращается repository.ErrNoRecord.
+func (r *Repository) ReadWarranty(ctx context.Context, data *dto.WarrantyID) (dto.Warranty, error) {
+	result, err := r.readWarranties(ctx, `id = ? FOR UPDATE`, data.ID)
+	if err != nil {
+		return dto.Warranty{}, err
+	}
+	if len(result) == 0 {
+		return dto.Warranty{}, repository.ErrNoRecord
+	}
+
+	return result[0], nil
+}
+
+// ReadWarrantiesByReceipt возвращает гарантии, выданные по чеку с идентификатором, переданным в dto.ReceiptID. В
+// транзакции записи гарантий блокируются до её завершения.
+func (r *Repository) ReadWarrantiesByReceipt(ctx context.Context, data *dto.ReceiptID) ([]dto.Warranty, error) {
+	return r.readWarranties(ctx, `receipt_id = ? ORDER BY id FOR UPDATE`, data.ID)
+}
+
+// UpdateWarrantyRefund сохраняет количество товара, гарантия на который аннулирована при возврате, и момент
+// аннулирования гарантии целиком.
+func (r *Repository) UpdateWarrantyRefund(ctx context.Context, data *dto.Warranty) error {
+	stmt := `UPDATE warranty SET refunded = ?, voided_at = ? WHERE id = ?`
+
+	var voidedAt sql.NullTime
+	if data.VoidedAt != nil {
+		voidedAt = sql.NullTime{Time: *data.VoidedAt, Valid: true}
+	}
+	_, err := r.executor(ctx).ExecContext(ctx, stmt, data.Refunded, voidedAt, data.ID)
+
+	return r.ConvertToCommonErr(err)
+}
+
+// ReadWarrantiesBySerial возвращает гарантии на экземпляр товара с серийным номером, переданным в dto.Serial. Если
+// экземпляр продавался повторно после возврата, гарантий несколько.
+func (r *Repository) ReadWarrantiesBySerial(ctx context.Context, data *dto.Serial) ([]dto.Warranty, error) {
+	return r.readWarranties(ctx, `serial = ? ORDER BY sold_at, id`, data.Serial)
+}
+
+// ReadWarrantiesByPhone возвращает гарантии, выданные покупателю с переданным в dto.Phone нормализованным номером
+// телефона.
+func (r *Repository) ReadWarrantiesByPhone(ctx context.Context, data *dto.Phone) ([]dto.Warranty, error) {
+	return r.readWarranties(ctx, `phone = ? ORDER BY sold_at, id`, data.Phone)
+}
+
+// readWarranties возвращает гарантии, удовлетворяющие условию condition с параметром arg.
+func (r *Repository) readWarranties(ctx context.Context, condition string, arg any) ([]dto.Warranty, error) {
+	var result []dto.Warranty
+	stmt := `SELECT id, article, serial, amount, refunded, receipt_id, order_number, phone, sold_at, expires_at,
+			        voided_at
+			 FROM warranty
+			 WHERE ` + condition
+
+	rows, err := r.executor(ctx).QueryContext(ctx, stmt, arg)
+	if err != nil {
+		return result, r.ConvertToCommonErr(err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var record dto.Warranty
+		var phoneNumber sql.NullString
+		var voidedAt sql.NullTime
+		if err = rows.Scan(&record.ID, &record.Article, &record.Serial, &record.Amount, &record.Refunded,
+			&record.ReceiptID, &record.OrderNumber, &phoneNumber, &record.SoldAt, &record.ExpiresAt,
+			&voidedAt); err != nil {
+			return result, r.ConvertToCommonErr(err)
+		}
+		record.Phone = phone.Phone(phoneNumber.String)
+		if voidedAt.Valid {
+			record.VoidedAt = &voidedAt.Time
+		}
+		result = append(result, record)
+	}
+
+	return result, r.ConvertToCommonErr(rows.Err())
+}
+
+// CreateWarrantyClaim сохраняет гарантийное обращение и возвращает присвоенный ему идентификатор.
+func (r *Repository) CreateWarrantyClaim(ctx context.Context, data *dto.WarrantyClaim) (warranty.ClaimID, error) {
+	stmt := `INSERT INTO warranty_claim (warranty_id, description, status, created_at) VALUES (?,?,?,?)`
+
+	result, err := r.executor(ctx).ExecContext(ctx, stmt, data.WarrantyID, data.Description, data.Status,
+		data.CreatedAt)
+	if err != nil {
+		return 0, r.ConvertToCommonErr(err)
+	}
+
+	id, err := result.LastInsertId()
+	if err != nil {
+		return 0, r.ConvertToCommonErr(err)
+	}
+
+	return warranty.ClaimID(id), nil
+}
+
+// ReadWarrantyClaim возвращает гарантийное обращение с идентификатором, переданным в dto.WarrantyClaimID, блокируя его
+// запись до конца транзакции. Если обращения нет, возвращается repository.ErrNoRecord.
+func (r *Repository) ReadWarrantyClaim(ctx context.Context, data *dto.WarrantyClaimID) (dto.WarrantyClaim, error) {
+	var result dto.WarrantyClaim
+	stmt := `SELECT id, warranty_id, description, status, created_at FROM warranty_claim WHERE id = ? FOR UPDATE`
+
+	row := r.executor(ctx).QueryRowContext(ctx, stmt, data.ID)
+	if err := row.Scan(&result.ID, &result.WarrantyID, &result.Description, &result.Status,
+		&result.CreatedAt); err != nil {
+		return dto.WarrantyClaim{}, r.ConvertToCommonErr(err)
+	}
+
+	return result, nil
+}
+
+// ReadWarrantyClaims возвращает в хронологическом порядке гарантийные обращения по гарантии с идентификатором,
+// переданным в dto.WarrantyID. История смены состояний обращений не заполняется.
+func (r *Repository) ReadWarrantyClaims(ctx context.Context, data *dto.WarrantyID) ([]dto.WarrantyClaim, error) {
+	var result []dto.WarrantyClaim
+	stmt := `SELECT id, warranty_id, description, status, created_at
+			 FROM warranty_claim
+			 WHERE warranty_id = ?
+			 ORDER BY created_at, id`
+
+	rows, err := r.executor(ctx).QueryContext(ctx, stmt, data.ID)
+	if err != nil {
+		return result, r.ConvertToCommonErr(err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var record dto.WarrantyClaim
+		if err = rows.Scan(&record.ID, &record.WarrantyID, &record.Description, &record.Status,
+			&record.CreatedAt); err != nil {
+			return result, r.ConvertToCommonErr(err)
+		}
+		result = append(result, record)
+	}
+
+	return result, r.ConvertToCommonErr(rows.Err())
+}
+
+// UpdateWarrantyClaimStatus сохраняет новое состояние гарантийного обращения.
+func (r *Repository) UpdateWarrantyClaimStatus(ctx context.Context, data *dto.ClaimIDStatus) error {
+	stmt := `UPDATE warranty_claim SET status = ? WHERE id = ?`
+
+	_, err := r.executor(ctx).ExecContext(ctx, stmt, data.Status, data.ClaimID)
+
+	return r.ConvertToCommonErr(err)
+}
+
+// CreateWarrantyClaimTransition сохраняет запись истории смены состояния гарантийного обращения.
+func (r *Repository) CreateWarrantyClaimTransition(ctx context.Context, data *dto.WarrantyClaimTransition) error {
+	stmt := `INSERT INTO warranty_claim_transition (claim_id, from_status, to_status, actor, created_at)
+			 VALUES (?,?,?,?,?)`
+
+	_, err := r.executor(ctx).ExecContext(ctx, stmt, data.ClaimID, data.From, data.To, data.Actor, data.Date)
+
+	return r.ConvertToCommonErr(err)
+}
+
+// ReadWarrantyClaimTransitions возвращает в хронологическом порядке историю смены состояний всех гарантийных
+// обращений по гарантии с идентификатором, переданным в dto.WarrantyID.
+func (r *Repository) ReadWarrantyClaimTransitions(ctx context.Context, data *dto.WarrantyID) (
+	[]dto.WarrantyClaimTransition, error) {
+	var result []dto.WarrantyClaimTransition
+	stmt := `SELECT t.claim_id, t.from_status, t.to_status, t.actor, t.created_at
+			 FROM warranty_claim_transition t
+			 JOIN warranty_claim c ON c.id = t.claim_id
+			 WHERE c.warranty_id = ?
+			 ORDER BY t.created_at, t.id`
+
+	rows, err := r.executor(ctx).QueryContext(ctx, stmt, data.ID)
+	if err != nil {
+		return result, r.ConvertToCommonErr(err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var record dto.WarrantyClaimTransition
+		if err = rows.Scan(&record.ClaimID, &record.From, &record.To, &record.Actor, &record.Date); err != nil {
+			return result, r.ConvertToCommonErr(err)
+		}
+		result = append(result, record)
+	}
+
+	return result, r.ConvertToCommonErr(rows.Err())
+}
@@ -0,0 +1,105 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"github.com/lazylex/watch-store-store/internal/dto"
+	"github.com/lazylex/watch-store-store/internal/helpers/constants/various"
+	"github.com/lazylex/watch-store-store/internal/logger"
+	"log/slog"
+)
+
+// SalesByPeriod возвращает количество проданных единиц и выручку за период с разбивкой по дням, неделям или месяцам.
+// Количество и выручка рассчитываются за вычетом возвратов, сделанных в том же интервале.
+func (s *Service) SalesByPeriod(ctx context.Context, data dto.FromToBucket) ([]dto.PeriodSales, error) {
+	if err := data.Validate(); err != nil {
+		return nil, err
+	}
+
+	result, err := s.Repository.ReadSalesByPeriod(ctx, &data)
+	if err != nil {
+		return nil, err
+	}
+
+	logger.LogWithCtxData(ctx, slog.With(logger.OPLabel, "service.SalesByPeriod")).Info(
+		fmt.Sprintf("requested sales from %s to %s by %s", data.From.Format(various.DateLayout),
+			data.To.Format(various.DateLayout), data.Bucket))
+
+	return result, nil
+}
+
+// TopArticles возвращает самые продаваемые за период товары по количеству единиц или выручке. Для каждого товара
+// рассчитывается средняя цена продажи.
+func (s *Service) TopArticles(ctx context.Context, data dto.FromToTop) ([]dto.ArticleSales, error) {
+	if err := data.Validate(); err != nil {
+		return nil, err
+	}
+
+	result, err := s.Repository.ReadTopArticles(ctx, &data)
+	if err != nil {
+		return nil, err
+	}
+	for i := range result {
+		result[i].CalculateAveragePrice()
+	}
+
+	logger.LogWithCtxData(ctx, slog.With(logger.OPLabel, "service.TopArticles")).Info(
+		fmt.Sprintf("requested top %d articles by %s", data.Limit, data.By))
+
+	return result, nil
+}
+
+// AverageSalePrice возвращает среднюю цену продажи товара за период.
+func (s *Service) AverageSalePrice(ctx context.Context, data dto.ArticleFromTo) (dto.ArticleSales, error) {
+	if err := data.Validate(); err != nil {
+		return dto.ArticleSales{}, err
+	}
+
+	result, err := s.Repository.ReadArticleSales(ctx, &data)
+	if err != nil {
+		return dto.ArticleSales{}, err
+	}
+	result.CalculateAveragePrice()
+
+	logger.LogWithCtxData(ctx, slog.With(logger.OPLabel, "service.AverageSalePrice")).Info(
+		fmt.Sprintf("requested average sale price of article %s", data.Article))
+
+	return result, nil
+}
+
+// AverageSalePrices возвращает среднюю цену продажи каждого проданного за период товара.
+func (s *Service) AverageSalePrices(ctx context.Context, data dto.FromTo) ([]dto.ArticleSales, error) {
+	if err := data.Validate(); err != nil {
+		return nil, err
+	}
+
+	result, err := s.Repository.ReadArticlesSales(ctx, &data)
+	if err != nil {
+		return nil, err
+	}
+	for i := range result {
+		result[i].CalculateAveragePrice()
+	}
+
+	logger.LogWithCtxData(ctx, slog.With(logger.OPLabel, "service.AverageSalePrices")).Info(
+		"requested average sale prices")
+
+	return result, nil
+}
+
+// SalesByChannel возвращает продажи за период по каналам продаж (касса или заказ).
+func (s *Service) SalesByChannel(ctx context.Context, data dto.FromTo) ([]dto.ChannelSales, error) {
+	if err := data.Validate(); err != nil {
+		return nil, err
+	}
+
+	result, err := s.Repository.ReadSalesByChannel(ctx, &data)
+	if err != nil {
+		return nil, err
+	}
+
+	logger.LogWithCtxData(ctx, slog.With(logger.OPLabel, "service.SalesByChannel")).Info(
+		"requested sales by channel")
+
+	return result, nil
+}
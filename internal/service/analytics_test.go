@@ -0,0 +1,137 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"github.com/golang/mock/gomock"
+	"github.com/lazylex/watch-store-store/internal/domain/value_objects/bucket"
+	"github.com/lazylex/watch-store-store/internal/domain/value_objects/measure"
+	"github.com/lazylex/watch-store-store/internal/dto"
+	"github.com/lazylex/watch-store-store/internal/dto/validators"
+	mockrepository "github.com/lazylex/watch-store-store/internal/ports/repository/mocks"
+	"testing"
+	"time"
+)
+
+func TestService_SalesByPeriodIncorrectBucket(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+
+	mockRepo := mockrepository.NewMockInterface(ctrl)
+	s := Service{Repository: mockRepo}
+	data := dto.FromToBucket{FromTo: dto.FromTo{From: time.Now().Add(-time.Hour), To: time.Now()}, Bucket: "year"}
+
+	mockRepo.EXPECT().ReadSalesByPeriod(gomock.Any(), gomock.Any()).Times(0)
+
+	if _, err := s.SalesByPeriod(context.Background(), data); !errors.Is(err, validators.ErrIncorrectBucket) {
+		t.Fail()
+	}
+}
+
+func TestService_SalesByPeriod(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+
+	mockRepo := mockrepository.NewMockInterface(ctrl)
+	s := Service{Repository: mockRepo}
+	data := dto.FromToBucket{FromTo: dto.FromTo{From: time.Now().Add(-time.Hour), To: time.Now()}, Bucket: bucket.Week}
+	expected := []dto.PeriodSales{{Period: time.Now(), Units: 3, Revenue: 1500}}
+
+	mockRepo.EXPECT().ReadSalesByPeriod(gomock.Any(), &data).Times(1).Return(expected, nil)
+
+	result, err := s.SalesByPeriod(context.Background(), data)
+	if err != nil || len(result) != 1 || result[0].Units != 3 {
+		t.Fail()
+	}
+}
+
+func TestService_TopArticlesCalculatesAveragePrice(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+
+	mockRepo := mockrepository.NewMockInterface(ctrl)
+	s := Service{Repository: mockRepo}
+	data := dto.FromToTop{FromTo: dto.FromTo{From: time.Now().Add(-time.Hour), To: time.Now()},
+		By: measure.Revenue, Limit: 5}
+
+	mockRepo.EXPECT().ReadTopArticles(gomock.Any(), &data).Times(1).Return([]dto.ArticleSales{
+		{Article: "test-1", Units: 4, Revenue: 1000},
+		{Article: "test-2", Units: 1, Revenue: 300},
+	}, nil)
+
+	result, err := s.TopArticles(context.Background(), data)
+	if err != nil || len(result) != 2 || result[0].AveragePrice != 250 || result[1].AveragePrice != 300 {
+		t.Fail()
+	}
+}
+
+func TestService_TopArticlesIncorrectLimit(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+
+	mockRepo := mockrepository.NewMockInterface(ctrl)
+	s := Service{Repository: mockRepo}
+	data := dto.FromToTop{FromTo: dto.FromTo{From: time.Now().Add(-time.Hour), To: time.Now()},
+		By: measure.Units, Limit: 0}
+
+	mockRepo.EXPECT().ReadTopArticles(gomock.Any(), gomock.Any()).Times(0)
+
+	if _, err := s.TopArticles(context.Background(), data); !errors.Is(err, validators.ErrIncorrectLimit) {
+		t.Fail()
+	}
+}
+
+func TestService_AverageSalePriceNoSales(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+
+	mockRepo := mockrepository.NewMockInterface(ctrl)
+	s := Service{Repository: mockRepo}
+	data := dto.ArticleFromTo{Article: "test-1", From: time.Now().Add(-time.Hour), To: time.Now()}
+
+	mockRepo.EXPECT().ReadArticleSales(gomock.Any(), &data).Times(1).Return(dto.ArticleSales{Article: "test-1"}, nil)
+
+	result, err := s.AverageSalePrice(context.Background(), data)
+	if err != nil || result.AveragePrice != 0 {
+		t.Fail()
+	}
+}
+
+func TestService_TopArticlesWithPartialRefund(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+
+	mockRepo := mockrepository.NewMockInterface(ctrl)
+	s := Service{Repository: mockRepo}
+	data := dto.FromToTop{FromTo: dto.FromTo{From: time.Now().Add(-time.Hour), To: time.Now()},
+		By: measure.Units, Limit: 5}
+
+	// продано 4 единицы по 250, одна возвращена
+	mockRepo.EXPECT().ReadTopArticles(gomock.Any(), &data).Times(1).Return([]dto.ArticleSales{
+		{Article: "test-1", Units: 3, Revenue: 750, RefundedUnits: 1, Refunded: 250},
+	}, nil)
+
+	result, err := s.TopArticles(context.Background(), data)
+	if err != nil || len(result) != 1 || result[0].Units != 3 || result[0].RefundedUnits != 1 ||
+		result[0].AveragePrice != 250 {
+		t.Fail()
+	}
+}
+
+func TestService_AverageSalePriceAllRefunded(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+
+	mockRepo := mockrepository.NewMockInterface(ctrl)
+	s := Service{Repository: mockRepo}
+	data := dto.ArticleFromTo{Article: "test-1", From: time.Now().Add(-time.Hour), To: time.Now()}
+
+	// в периоде возвращены две единицы, проданные ранее
+	mockRepo.EXPECT().ReadArticleSales(gomock.Any(), &data).Times(1).Return(dto.ArticleSales{Article: "test-1",
+		Units: -2, Revenue: -500, RefundedUnits: 2, Refunded: 500}, nil)
+
+	result, err := s.AverageSalePrice(context.Background(), data)
+	if err != nil || result.AveragePrice != 0 || result.Units != -2 {
+		t.Fail()
+	}
+}
@@ -0,0 +1,185 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"github.com/lazylex/watch-store-store/internal/domain/event"
+	"github.com/lazylex/watch-store-store/internal/domain/value_objects/article"
+	"github.com/lazylex/watch-store-store/internal/domain/value_objects/attribute"
+	"github.com/lazylex/watch-store-store/internal/dto"
+	"github.com/lazylex/watch-store-store/internal/dto/validators"
+	"github.com/lazylex/watch-store-store/internal/logger"
+	"github.com/lazylex/watch-store-store/internal/ports/service"
+	"log/slog"
+)
+
+// SetAttributeDefinition сохраняет определение атрибута товаров. Тип уже существующего атрибута изменить нельзя, так
+// как сохранённые значения могут ему не соответствовать. Допустимые значения сохраняются только для перечислимого
+// типа. Значения товаров, исключённые из списка допустимых, не удаляются.
+func (s *Service) SetAttributeDefinition(ctx context.Context, data dto.AttributeDefinition) error {
+	if err := data.Validate(); err != nil {
+		return err
+	}
+
+	definitions, err := s.attributeDefinitions(ctx)
+	if err != nil {
+		return err
+	}
+	if existing, ok := definitions[data.Code]; ok && existing.Type != data.Type {
+		return service.ErrAttributeTypeChange
+	}
+	if data.Type != attribute.Enum {
+		data.Values = nil
+	}
+
+	if err = s.Repository.UpsertAttributeDefinition(ctx, &data); err != nil {
+		return err
+	}
+
+	logger.LogWithCtxData(ctx, slog.With(logger.OPLabel, "service.SetAttributeDefinition")).Info(
+		fmt.Sprintf("attribute %s of type %s saved", data.Code, data.Type))
+	s.emit(ctx, event.AttributeDefined, string(data.Code), data)
+
+	return nil
+}
+
+// AttributeDefinitions возвращает определения всех атрибутов товаров.
+func (s *Service) AttributeDefinitions(ctx context.Context) ([]dto.AttributeDefinition, error) {
+	return s.Repository.ReadAttributeDefinitions(ctx)
+}
+
+// SetArticleAttributes сохраняет значения атрибутов товара, находящегося в продаже. Значения остальных атрибутов
+// товара не изменяются. Атрибуты должны быть определены, а значения - соответствовать их типам.
+func (s *Service) SetArticleAttributes(ctx context.Context, data dto.ArticleAttributes) error {
+	if err := data.Validate(); err != nil {
+		return err
+	}
+
+	definitions, err := s.attributeDefinitions(ctx)
+	if err != nil {
+		return err
+	}
+	for code, value := range data.Attributes {
+		definition, ok := definitions[code]
+		if !ok {
+			return fmt.Errorf("%w %s", validators.ErrUnknownAttribute, code)
+		}
+		if err = validators.AttributeValue(definition.Type, definition.Values, value); err != nil {
+			return fmt.Errorf("%w of %s", err, code)
+		}
+	}
+
+	if _, err = s.Stock(ctx, dto.Article{Article: data.Article}); err != nil {
+		return err
+	}
+
+	if err = s.Repository.UpsertArticleAttributes(ctx, &data); err != nil {
+		return err
+	}
+
+	logger.LogWithCtxData(ctx, slog.With(logger.OPLabel, "service.SetArticleAttributes")).Info(
+		fmt.Sprintf("%d attributes of article %s saved", len(data.Attributes), data.Article))
+	s.emit(ctx, event.ArticleAttributesChanged, string(data.Article), data)
+
+	return nil
+}
+
+// DeleteArticleAttribute удаляет значение атрибута товара.
+func (s *Service) DeleteArticleAttribute(ctx context.Context, data dto.ArticleAttributeCode) error {
+	if err := data.Validate(); err != nil {
+		return err
+	}
+
+	if err := s.Repository.DeleteArticleAttribute(ctx, &data); err != nil {
+		return err
+	}
+
+	logger.LogWithCtxData(ctx, slog.With(logger.OPLabel, "service.DeleteArticleAttribute")).Info(
+		fmt.Sprintf("attribute %s of article %s deleted", data.Code, data.Article))
+	s.emit(ctx, event.ArticleAttributeDeleted, string(data.Article), data)
+
+	return nil
+}
+
+// StockWithAttributes возвращает информацию о товаре, доступном для продажи, вместе со значениями его атрибутов.
+func (s *Service) StockWithAttributes(ctx context.Context, data dto.Article) (dto.StockAttributes, error) {
+	stock, err := s.Stock(ctx, data)
+	if err != nil {
+		return dto.StockAttributes{}, err
+	}
+
+	attributes, err := s.Repository.ReadArticlesAttributes(ctx, []article.Article{data.Article})
+	if err != nil {
+		return dto.StockAttributes{}, err
+	}
+
+	return dto.StockAttributes{ArticlePriceNameAmount: stock, Attributes: attributes[data.Article]}, nil
+}
+
+// FindStock возвращает товары в продаже, удовлетворяющие всем условиям на значения атрибутов. Условие на равенство
+// должно соответствовать типу атрибута, условие на диапазон допустимо только для числовых атрибутов.
+func (s *Service) FindStock(ctx context.Context, data dto.StockFilter) ([]dto.StockAttributes, error) {
+	if err := data.Validate(); err != nil {
+		return nil, err
+	}
+
+	definitions, err := s.attributeDefinitions(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, f := range data.Filters {
+		definition, ok := definitions[f.Code]
+		switch {
+		case !ok:
+			return nil, fmt.Errorf("%w %s", validators.ErrUnknownAttribute, f.Code)
+		case f.Value != nil:
+			if err = validators.AttributeValue(definition.Type, definition.Values, *f.Value); err != nil {
+				return nil, fmt.Errorf("%w of %s", err, f.Code)
+			}
+		case definition.Type != attribute.Number:
+			return nil, fmt.Errorf("%w: range on non-numeric attribute %s", validators.ErrIncorrectAttributeFilter,
+				f.Code)
+		}
+	}
+
+	stock, err := s.Repository.ReadStockByFilter(ctx, &data)
+	if err != nil {
+		return nil, err
+	}
+
+	var attributes map[article.Article]map[attribute.Code]string
+	if data.WithAttributes {
+		articles := make([]article.Article, len(stock))
+		for i := range stock {
+			articles[i] = stock[i].Article
+		}
+		if attributes, err = s.Repository.ReadArticlesAttributes(ctx, articles); err != nil {
+			return nil, err
+		}
+	}
+
+	result := make([]dto.StockAttributes, len(stock))
+	for i := range stock {
+		result[i] = dto.StockAttributes{ArticlePriceNameAmount: stock[i], Attributes: attributes[stock[i].Article]}
+	}
+
+	logger.LogWithCtxData(ctx, slog.With(logger.OPLabel, "service.FindStock")).Info(
+		fmt.Sprintf("found %d articles by %d attribute filters", len(result), len(data.Filters)))
+
+	return result, nil
+}
+
+// attributeDefinitions возвращает определения атрибутов товаров по их кодам.
+func (s *Service) attributeDefinitions(ctx context.Context) (map[attribute.Code]dto.AttributeDefinition, error) {
+	definitions, err := s.Repository.ReadAttributeDefinitions(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make(map[attribute.Code]dto.AttributeDefinition, len(definitions))
+	for _, d := range definitions {
+		result[d.Code] = d
+	}
+
+	return result, nil
+}
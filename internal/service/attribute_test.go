@@ -0,0 +1,179 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"github.com/golang/mock/gomock"
+	"github.com/lazylex/watch-store-store/internal/domain/value_objects/article"
+	"github.com/lazylex/watch-store-store/internal/domain/value_objects/attribute"
+	"github.com/lazylex/watch-store-store/internal/dto"
+	"github.com/lazylex/watch-store-store/internal/dto/validators"
+	"github.com/lazylex/watch-store-store/internal/ports/repository"
+	mockrepository "github.com/lazylex/watch-store-store/internal/ports/repository/mocks"
+	"github.com/lazylex/watch-store-store/internal/ports/service"
+	"testing"
+)
+
+var testAttributeDefinitions = []dto.AttributeDefinition{
+	{Code: "case_material", Name: "Материал корпуса", Type: attribute.Enum, Values: []string{"steel", "resin"}},
+	{Code: "water_resistance", Name: "Водозащита", Type: attribute.Number},
+}
+
+func TestService_SetAttributeDefinitionTypeChange(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+
+	mockRepo := mockrepository.NewMockInterface(ctrl)
+	s := Service{Repository: mockRepo}
+
+	mockRepo.EXPECT().ReadAttributeDefinitions(gomock.Any()).Times(1).Return(testAttributeDefinitions, nil)
+	mockRepo.EXPECT().UpsertAttributeDefinition(gomock.Any(), gomock.Any()).Times(0)
+
+	err := s.SetAttributeDefinition(context.Background(),
+		dto.AttributeDefinition{Code: "water_resistance", Name: "Водозащита", Type: attribute.String})
+	if !errors.Is(err, service.ErrAttributeTypeChange) {
+		t.Fatal(err)
+	}
+}
+
+func TestService_SetAttributeDefinitionDropsValuesOfNonEnum(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+
+	mockRepo := mockrepository.NewMockInterface(ctrl)
+	s := Service{Repository: mockRepo}
+
+	mockRepo.EXPECT().ReadAttributeDefinitions(gomock.Any()).Times(1).Return(testAttributeDefinitions, nil)
+	mockRepo.EXPECT().UpsertAttributeDefinition(gomock.Any(), &dto.AttributeDefinition{Code: "brand",
+		Name: "Бренд", Type: attribute.String}).Times(1).Return(nil)
+
+	err := s.SetAttributeDefinition(context.Background(),
+		dto.AttributeDefinition{Code: "brand", Name: "Бренд", Type: attribute.String, Values: []string{"Casio"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestService_SetArticleAttributes(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+
+	mockRepo := mockrepository.NewMockInterface(ctrl)
+	s := Service{Repository: mockRepo}
+	data := dto.ArticleAttributes{Article: "CA-F91W",
+		Attributes: map[attribute.Code]string{"case_material": "resin", "water_resistance": "30"}}
+
+	mockRepo.EXPECT().ReadAttributeDefinitions(gomock.Any()).Times(1).Return(testAttributeDefinitions, nil)
+	mockRepo.EXPECT().ReadStock(gomock.Any(), &dto.Article{Article: "CA-F91W"}).Times(1).Return(
+		dto.ArticlePriceNameAmount{Article: "CA-F91W"}, nil)
+	mockRepo.EXPECT().UpsertArticleAttributes(gomock.Any(), &data).Times(1).Return(nil)
+
+	if err := s.SetArticleAttributes(context.Background(), data); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestService_SetArticleAttributesIncorrectValues(t *testing.T) {
+	t.Parallel()
+	testCases := []struct {
+		testName    string
+		attributes  map[attribute.Code]string
+		expectedErr error
+	}{
+		{
+			testName:    "unknown attribute",
+			attributes:  map[attribute.Code]string{"brand": "Casio"},
+			expectedErr: validators.ErrUnknownAttribute,
+		},
+		{
+			testName:    "not allowed enum value",
+			attributes:  map[attribute.Code]string{"case_material": "gold"},
+			expectedErr: validators.ErrIncorrectAttributeValue,
+		},
+		{
+			testName:    "not a number",
+			attributes:  map[attribute.Code]string{"water_resistance": "WR30"},
+			expectedErr: validators.ErrIncorrectAttributeValue,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.testName, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockRepo := mockrepository.NewMockInterface(ctrl)
+			s := Service{Repository: mockRepo}
+
+			mockRepo.EXPECT().ReadAttributeDefinitions(gomock.Any()).Times(1).Return(testAttributeDefinitions, nil)
+			mockRepo.EXPECT().UpsertArticleAttributes(gomock.Any(), gomock.Any()).Times(0)
+
+			err := s.SetArticleAttributes(context.Background(),
+				dto.ArticleAttributes{Article: "CA-F91W", Attributes: tc.attributes})
+			if !errors.Is(err, tc.expectedErr) {
+				t.Fatal(err)
+			}
+		})
+	}
+}
+
+func TestService_SetArticleAttributesUnknownArticle(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+
+	mockRepo := mockrepository.NewMockInterface(ctrl)
+	s := Service{Repository: mockRepo}
+
+	mockRepo.EXPECT().ReadAttributeDefinitions(gomock.Any()).Times(1).Return(testAttributeDefinitions, nil)
+	mockRepo.EXPECT().ReadStock(gomock.Any(), gomock.Any()).Times(1).Return(dto.ArticlePriceNameAmount{},
+		repository.ErrNoRecord)
+	mockRepo.EXPECT().UpsertArticleAttributes(gomock.Any(), gomock.Any()).Times(0)
+
+	err := s.SetArticleAttributes(context.Background(),
+		dto.ArticleAttributes{Article: "CA-F91W", Attributes: map[attribute.Code]string{"case_material": "resin"}})
+	if !errors.Is(err, repository.ErrNoRecord) {
+		t.Fatal(err)
+	}
+}
+
+func TestService_FindStock(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+
+	mockRepo := mockrepository.NewMockInterface(ctrl)
+	s := Service{Repository: mockRepo}
+	value, low := "resin", 30.0
+	filter := dto.StockFilter{Limit: 10, WithAttributes: true, Filters: []dto.AttributeFilter{
+		{Code: "case_material", Value: &value}, {Code: "water_resistance", Min: &low}}}
+
+	mockRepo.EXPECT().ReadAttributeDefinitions(gomock.Any()).Times(1).Return(testAttributeDefinitions, nil)
+	mockRepo.EXPECT().ReadStockByFilter(gomock.Any(), &filter).Times(1).Return(
+		[]dto.ArticlePriceNameAmount{{Article: "CA-F91W", Amount: 3}, {Article: "CA-W800H", Amount: 1}}, nil)
+	mockRepo.EXPECT().ReadArticlesAttributes(gomock.Any(), []article.Article{"CA-F91W", "CA-W800H"}).Times(1).
+		Return(map[article.Article]map[attribute.Code]string{
+			"CA-F91W": {"case_material": "resin", "water_resistance": "30"}}, nil)
+
+	result, err := s.FindStock(context.Background(), filter)
+	if err != nil || len(result) != 2 {
+		t.Fatal(result, err)
+	}
+	if result[0].Attributes["water_resistance"] != "30" || result[1].Attributes != nil {
+		t.Errorf("unexpected %+v", result)
+	}
+}
+
+func TestService_FindStockRangeOnEnum(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+
+	mockRepo := mockrepository.NewMockInterface(ctrl)
+	s := Service{Repository: mockRepo}
+	low := 1.0
+
+	mockRepo.EXPECT().ReadAttributeDefinitions(gomock.Any()).Times(1).Return(testAttributeDefinitions, nil)
+	mockRepo.EXPECT().ReadStockByFilter(gomock.Any(), gomock.Any()).Times(0)
+
+	_, err := s.FindStock(context.Background(), dto.StockFilter{Limit: 10,
+		Filters: []dto.AttributeFilter{{Code: "case_material", Min: &low}}})
+	if !errors.Is(err, validators.ErrIncorrectAttributeFilter) {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,123 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/lazylex/watch-store-store/internal/domain/event"
+	"github.com/lazylex/watch-store-store/internal/domain/value_objects/article"
+	"github.com/lazylex/watch-store-store/internal/domain/value_objects/barcode"
+	"github.com/lazylex/watch-store-store/internal/dto"
+	"github.com/lazylex/watch-store-store/internal/logger"
+	"github.com/lazylex/watch-store-store/internal/ports/repository"
+	"github.com/lazylex/watch-store-store/internal/ports/service"
+	"log/slog"
+)
+
+// ImportBarcodes назначает товарам штрихкоды. Загрузка выполняется целиком в одной транзакции: если какой-либо
+// штрихкод уже назначен другому товару (service.ErrBarcodeTaken) или товар отсутствует в ассортименте, ни один
+// штрихкод не сохраняется. Повторное назначение штрихкода тому же товару ошибкой не является.
+func (s *Service) ImportBarcodes(ctx context.Context, data dto.BarcodeImport) error {
+	if err := data.Validate(); err != nil {
+		return err
+	}
+
+	err := s.Repository.WithinTransaction(ctx, func(txCtx context.Context) error {
+		codes := make([]barcode.Barcode, len(data.Barcodes))
+		for i := range data.Barcodes {
+			codes[i] = data.Barcodes[i].Barcode
+		}
+
+		assigned, err := s.Repository.ReadBarcodesArticles(txCtx, codes)
+		if err != nil {
+			return err
+		}
+
+		checked := make(map[article.Article]struct{})
+		for _, record := range data.Barcodes {
+			if art, ok := assigned[record.Barcode.EAN13()]; ok && art != record.Article {
+				return fmt.Errorf("%w: %s (%s)", service.ErrBarcodeTaken, record.Barcode, art)
+			}
+			if _, ok := checked[record.Article]; ok {
+				continue
+			}
+			if _, err = s.Repository.ReadStock(txCtx, &dto.Article{Article: record.Article}); err != nil {
+				return fmt.Errorf("%w: %s", err, record.Article)
+			}
+			checked[record.Article] = struct{}{}
+		}
+
+		return s.Repository.CreateArticleBarcodes(txCtx, data.Barcodes)
+	})
+	if err != nil {
+		return err
+	}
+
+	logger.LogWithCtxData(ctx, slog.With(logger.OPLabel, "service.ImportBarcodes")).Info(
+		fmt.Sprintf("%d barcodes imported", len(data.Barcodes)))
+	for _, record := range data.Barcodes {
+		s.emit(ctx, event.BarcodeAssigned, string(record.Article), record)
+	}
+
+	return nil
+}
+
+// ResolveArticle возвращает артикул товара, которому назначен переданный вместо артикула штрихкод. Если переданное
+// значение не является штрихкодом или штрихкод никому не назначен, оно возвращается без изменений и далее
+// используется как артикул.
+func (s *Service) ResolveArticle(ctx context.Context, art article.Article) (article.Article, error) {
+	code := barcode.Barcode(art)
+	if !code.IsValid() {
+		return art, nil
+	}
+
+	resolved, err := s.Repository.ReadArticleByBarcode(ctx, &dto.Barcode{Barcode: code})
+	switch {
+	case errors.Is(err, repository.ErrNoRecord):
+		return art, nil
+	case err != nil:
+		return art, err
+	}
+
+	return resolved, nil
+}
+
+// StockByBarcode возвращает информацию о товаре, которому назначен штрихкод.
+func (s *Service) StockByBarcode(ctx context.Context, data dto.Barcode) (dto.ArticlePriceNameAmount, error) {
+	if err := data.Validate(); err != nil {
+		return dto.ArticlePriceNameAmount{}, err
+	}
+
+	art, err := s.Repository.ReadArticleByBarcode(ctx, &data)
+	if err != nil {
+		return dto.ArticlePriceNameAmount{}, err
+	}
+
+	return s.Stock(ctx, dto.Article{Article: art})
+}
+
+// ArticleBarcodes возвращает штрихкоды товара в формате EAN-13.
+func (s *Service) ArticleBarcodes(ctx context.Context, data dto.Article) ([]barcode.Barcode, error) {
+	if err := data.Validate(); err != nil {
+		return nil, err
+	}
+
+	return s.Repository.ReadArticleBarcodes(ctx, &data)
+}
+
+// DeleteBarcode удаляет штрихкод.
+func (s *Service) DeleteBarcode(ctx context.Context, data dto.Barcode) error {
+	if err := data.Validate(); err != nil {
+		return err
+	}
+
+	if err := s.Repository.DeleteBarcode(ctx, &data); err != nil {
+		return err
+	}
+
+	logger.LogWithCtxData(ctx, slog.With(logger.OPLabel, "service.DeleteBarcode")).Info(
+		fmt.Sprintf("barcode %s deleted", data.Barcode))
+	s.emit(ctx, event.BarcodeDeleted, string(data.Barcode), data)
+
+	return nil
+}
@@ -0,0 +1,132 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"github.com/golang/mock/gomock"
+	"github.com/lazylex/watch-store-store/internal/domain/value_objects/article"
+	"github.com/lazylex/watch-store-store/internal/domain/value_objects/barcode"
+	"github.com/lazylex/watch-store-store/internal/dto"
+	"github.com/lazylex/watch-store-store/internal/ports/repository"
+	mockrepository "github.com/lazylex/watch-store-store/internal/ports/repository/mocks"
+	"github.com/lazylex/watch-store-store/internal/ports/service"
+	"testing"
+)
+
+func TestService_ImportBarcodes(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+
+	mockRepo := mockrepository.NewMockInterface(ctrl)
+	s := Service{Repository: mockRepo}
+	data := dto.BarcodeImport{Barcodes: []dto.ArticleBarcode{
+		{Article: "CA-F91W", Barcode: "036000291452"},
+		{Article: "CA-F91W", Barcode: "4006381333931"},
+	}}
+
+	ctx := context.WithValue(context.Background(), mockrepository.ExecuteKey{}, "✅")
+
+	mockRepo.EXPECT().ReadBarcodesArticles(gomock.Any(),
+		[]barcode.Barcode{"036000291452", "4006381333931"}).Times(1).Return(
+		map[barcode.Barcode]article.Article{"0036000291452": "CA-F91W"}, nil)
+	mockRepo.EXPECT().ReadStock(gomock.Any(), &dto.Article{Article: "CA-F91W"}).Times(1).Return(
+		dto.ArticlePriceNameAmount{Article: "CA-F91W"}, nil)
+	mockRepo.EXPECT().CreateArticleBarcodes(gomock.Any(), data.Barcodes).Times(1).Return(nil)
+
+	if err := s.ImportBarcodes(ctx, data); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestService_ImportBarcodesTaken(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+
+	mockRepo := mockrepository.NewMockInterface(ctrl)
+	s := Service{Repository: mockRepo}
+
+	ctx := context.WithValue(context.Background(), mockrepository.ExecuteKey{}, "✅")
+
+	mockRepo.EXPECT().ReadBarcodesArticles(gomock.Any(), gomock.Any()).Times(1).Return(
+		map[barcode.Barcode]article.Article{"0036000291452": "CA-W800H"}, nil)
+	mockRepo.EXPECT().CreateArticleBarcodes(gomock.Any(), gomock.Any()).Times(0)
+
+	err := s.ImportBarcodes(ctx, dto.BarcodeImport{Barcodes: []dto.ArticleBarcode{
+		{Article: "CA-F91W", Barcode: "036000291452"}}})
+	if !errors.Is(err, service.ErrBarcodeTaken) {
+		t.Fatal(err)
+	}
+}
+
+func TestService_ImportBarcodesUnknownArticle(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+
+	mockRepo := mockrepository.NewMockInterface(ctrl)
+	s := Service{Repository: mockRepo}
+
+	ctx := context.WithValue(context.Background(), mockrepository.ExecuteKey{}, "✅")
+
+	mockRepo.EXPECT().ReadBarcodesArticles(gomock.Any(), gomock.Any()).Times(1).Return(
+		map[barcode.Barcode]article.Article{}, nil)
+	mockRepo.EXPECT().ReadStock(gomock.Any(), gomock.Any()).Times(1).Return(dto.ArticlePriceNameAmount{},
+		repository.ErrNoRecord)
+	mockRepo.EXPECT().CreateArticleBarcodes(gomock.Any(), gomock.Any()).Times(0)
+
+	err := s.ImportBarcodes(ctx, dto.BarcodeImport{Barcodes: []dto.ArticleBarcode{
+		{Article: "CA-F91W", Barcode: "036000291452"}}})
+	if !errors.Is(err, repository.ErrNoRecord) {
+		t.Fatal(err)
+	}
+}
+
+func TestService_ResolveArticle(t *testing.T) {
+	t.Parallel()
+	testCases := []struct {
+		testName string
+		input    article.Article
+		lookups  int
+		found    article.Article
+		lookErr  error
+		expected article.Article
+	}{
+		{testName: "article", input: "CA-F91W", lookups: 0, expected: "CA-F91W"},
+		{testName: "assigned barcode", input: "4006381333931", lookups: 1, found: "CA-F91W", expected: "CA-F91W"},
+		{testName: "unassigned barcode", input: "4006381333931", lookups: 1, lookErr: repository.ErrNoRecord,
+			expected: "4006381333931"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.testName, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockRepo := mockrepository.NewMockInterface(ctrl)
+			s := Service{Repository: mockRepo}
+
+			mockRepo.EXPECT().ReadArticleByBarcode(gomock.Any(), gomock.Any()).Times(tc.lookups).Return(tc.found,
+				tc.lookErr)
+
+			result, err := s.ResolveArticle(context.Background(), tc.input)
+			if err != nil || result != tc.expected {
+				t.Fatal(result, err)
+			}
+		})
+	}
+}
+
+func TestService_StockByBarcode(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+
+	mockRepo := mockrepository.NewMockInterface(ctrl)
+	s := Service{Repository: mockRepo}
+
+	mockRepo.EXPECT().ReadArticleByBarcode(gomock.Any(), &dto.Barcode{Barcode: "036000291452"}).Times(1).Return(
+		article.Article("CA-F91W"), nil)
+	mockRepo.EXPECT().ReadStock(gomock.Any(), &dto.Article{Article: "CA-F91W"}).Times(1).Return(
+		dto.ArticlePriceNameAmount{Article: "CA-F91W", Amount: 5}, nil)
+
+	result, err := s.StockByBarcode(context.Background(), dto.Barcode{Barcode: "036000291452"})
+	if err != nil || result.Article != "CA-F91W" || result.Amount != 5 {
+		t.Fatal(result, err)
+	}
+}
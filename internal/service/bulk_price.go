@@ -0,0 +1,118 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/lazylex/watch-store-store/internal/domain/event"
+	"github.com/lazylex/watch-store-store/internal/dto"
+	"github.com/lazylex/watch-store-store/internal/dto/validators"
+	"github.com/lazylex/watch-store-store/internal/logger"
+	"github.com/lazylex/watch-store-store/internal/ports/repository"
+	"log/slog"
+	"math"
+)
+
+// UpdatePrices изменяет цены группы товаров в одной транзакции и возвращает отчёт с результатом для каждого товара.
+// Цены устанавливаются списком или изменяются на процент для товара без дефектов и всех его вариантов с дефектами
+// (новая цена округляется до копеек). Если цену хотя бы одного товара изменить невозможно (товар не найден или цена
+// после изменения некорректна), ни одна цена не изменяется, а отчёт возвращается с Applied, равным false. Остальные
+// товары отмечаются в нём как не изменённые (dto.PriceNotApplied).
+func (s *Service) UpdatePrices(ctx context.Context, data dto.BulkPriceUpdate) (dto.BulkPriceReport, error) {
+	var report dto.BulkPriceReport
+	if err := data.Validate(); err != nil {
+		return report, err
+	}
+
+	err := s.Repository.WithinTransaction(ctx, func(txCtx context.Context) error {
+		var err error
+		if data.ByPercent() {
+			report.Items, err = s.pricesByPercent(txCtx, data)
+		} else {
+			report.Items, err = s.pricesByList(txCtx, data.Prices)
+		}
+		if err != nil {
+			return err
+		}
+
+		report.Count()
+		if report.Failed > 0 {
+			report.Discard()
+			return nil
+		}
+
+		for _, item := range report.Items {
+			if err = s.Repository.UpdateStockPrice(txCtx, &dto.ArticlePrice{
+				Article: item.Article, Price: item.NewPrice}); err != nil {
+				return err
+			}
+		}
+		report.Applied = true
+
+		return nil
+	})
+	if err != nil {
+		return dto.BulkPriceReport{}, err
+	}
+
+	if report.Applied {
+		for _, item := range report.Items {
+			s.emit(ctx, event.StockPriceChanged, string(item.Article),
+				dto.ArticlePrice{Article: item.Article, Price: item.NewPrice})
+		}
+	}
+
+	logger.LogWithCtxData(ctx, slog.With(logger.OPLabel, "service.UpdatePrices")).Info(
+		fmt.Sprintf("bulk price update of %d articles processed (applied: %t, failed: %d)", len(report.Items),
+			report.Applied, report.Failed))
+
+	return report, nil
+}
+
+// pricesByList возвращает результаты установки цен товаров из списка. Товары, отсутствующие в ассортименте, отмечаются
+// как ошибочные.
+func (s *Service) pricesByList(ctx context.Context, prices []dto.ArticlePrice) ([]dto.BulkPriceItemResult, error) {
+	items := make([]dto.BulkPriceItemResult, len(prices))
+	for i, price := range prices {
+		items[i] = dto.BulkPriceItemResult{Article: price.Article, NewPrice: price.Price, Status: dto.PriceUpdated}
+
+		old, err := s.Repository.ReadStockPrice(ctx, &dto.Article{Article: price.Article})
+		switch {
+		case errors.Is(err, repository.ErrNoRecord):
+			items[i].Status, items[i].Error = dto.PriceFailed, err.Error()
+		case err != nil:
+			return nil, err
+		default:
+			items[i].OldPrice = old
+		}
+	}
+
+	return items, nil
+}
+
+// pricesByPercent возвращает результаты изменения на процент цен товара без дефектов и всех его вариантов с
+// дефектами. Если ни одного такого товара нет в ассортименте, возвращается repository.ErrNoRecord.
+func (s *Service) pricesByPercent(ctx context.Context, data dto.BulkPriceUpdate) ([]dto.BulkPriceItemResult, error) {
+	prices, err := s.Repository.ReadStockPricesByBase(ctx, data.Article)
+	if err != nil {
+		return nil, err
+	}
+	if len(prices) == 0 {
+		return nil, repository.ErrNoRecord
+	}
+
+	items := make([]dto.BulkPriceItemResult, len(prices))
+	for i, price := range prices {
+		items[i] = dto.BulkPriceItemResult{
+			Article:  price.Article,
+			OldPrice: price.Price,
+			NewPrice: math.Round(price.Price*(100+data.Percent)) / 100,
+			Status:   dto.PriceUpdated,
+		}
+		if err = validators.Price(items[i].NewPrice); err != nil {
+			items[i].Status, items[i].Error = dto.PriceFailed, err.Error()
+		}
+	}
+
+	return items, nil
+}
@@ -0,0 +1,115 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"github.com/golang/mock/gomock"
+	"github.com/lazylex/watch-store-store/internal/dto"
+	"github.com/lazylex/watch-store-store/internal/ports/repository"
+	mockrepository "github.com/lazylex/watch-store-store/internal/ports/repository/mocks"
+	"testing"
+)
+
+func TestService_UpdatePricesByList(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+
+	mockRepo := mockrepository.NewMockInterface(ctrl)
+	s := Service{Repository: mockRepo}
+	ctx := context.WithValue(context.Background(), mockrepository.ExecuteKey{}, "✅")
+	data := dto.BulkPriceUpdate{Prices: []dto.ArticlePrice{
+		{Article: "CA-F91W", Price: 3590}, {Article: "CA-F91W.1000", Price: 2990}}}
+
+	mockRepo.EXPECT().ReadStockPrice(ctx, &dto.Article{Article: "CA-F91W"}).Times(1).Return(3490.0, nil)
+	mockRepo.EXPECT().ReadStockPrice(ctx, &dto.Article{Article: "CA-F91W.1000"}).Times(1).Return(2790.0, nil)
+	mockRepo.EXPECT().UpdateStockPrice(ctx, &data.Prices[0]).Times(1).Return(nil)
+	mockRepo.EXPECT().UpdateStockPrice(ctx, &data.Prices[1]).Times(1).Return(nil)
+
+	report, err := s.UpdatePrices(ctx, data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !report.Applied || report.Updated != 2 || report.Items[0].OldPrice != 3490 || report.Items[1].NewPrice != 2990 {
+		t.Errorf("unexpected report %+v", report)
+	}
+}
+
+func TestService_UpdatePricesNotFound(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+
+	mockRepo := mockrepository.NewMockInterface(ctrl)
+	s := Service{Repository: mockRepo}
+	ctx := context.WithValue(context.Background(), mockrepository.ExecuteKey{}, "✅")
+	data := dto.BulkPriceUpdate{Prices: []dto.ArticlePrice{
+		{Article: "CA-F91W", Price: 3590}, {Article: "CA-F91W.1000", Price: 2990}}}
+
+	mockRepo.EXPECT().ReadStockPrice(ctx, &dto.Article{Article: "CA-F91W"}).Times(1).Return(3490.0, nil)
+	mockRepo.EXPECT().ReadStockPrice(ctx, &dto.Article{Article: "CA-F91W.1000"}).Times(1).
+		Return(0.0, repository.ErrNoRecord)
+	mockRepo.EXPECT().UpdateStockPrice(gomock.Any(), gomock.Any()).Times(0)
+
+	report, err := s.UpdatePrices(ctx, data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if report.Applied || report.Failed != 1 || report.Updated != 0 || report.NotApplied != 1 ||
+		report.Items[0].Status != dto.PriceNotApplied || report.Items[1].Status != dto.PriceFailed ||
+		report.Items[1].Error != repository.ErrNoRecord.Error() {
+		t.Errorf("unexpected report %+v", report)
+	}
+}
+
+func TestService_UpdatePricesByPercent(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+
+	mockRepo := mockrepository.NewMockInterface(ctrl)
+	s := Service{Repository: mockRepo}
+	ctx := context.WithValue(context.Background(), mockrepository.ExecuteKey{}, "✅")
+	data := dto.BulkPriceUpdate{Article: "CA-F91W", Percent: -10}
+
+	mockRepo.EXPECT().ReadStockPricesByBase(ctx, data.Article).Times(1).Return(
+		[]dto.ArticlePrice{{Article: "CA-F91W", Price: 3490}, {Article: "CA-F91W.1000", Price: 2999.99}}, nil)
+	mockRepo.EXPECT().UpdateStockPrice(ctx, &dto.ArticlePrice{Article: "CA-F91W", Price: 3141}).Times(1).Return(nil)
+	mockRepo.EXPECT().UpdateStockPrice(ctx, &dto.ArticlePrice{Article: "CA-F91W.1000", Price: 2699.99}).Times(1).
+		Return(nil)
+
+	report, err := s.UpdatePrices(ctx, data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !report.Applied || report.Updated != 2 {
+		t.Errorf("unexpected report %+v", report)
+	}
+}
+
+func TestService_UpdatePricesByPercentNoArticle(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+
+	mockRepo := mockrepository.NewMockInterface(ctrl)
+	s := Service{Repository: mockRepo}
+	ctx := context.WithValue(context.Background(), mockrepository.ExecuteKey{}, "✅")
+
+	mockRepo.EXPECT().ReadStockPricesByBase(ctx, gomock.Any()).Times(1).Return(nil, nil)
+
+	if _, err := s.UpdatePrices(ctx, dto.BulkPriceUpdate{Article: "CA-F91W", Percent: 5}); !errors.Is(err,
+		repository.ErrNoRecord) {
+		t.Errorf("expected %v, got %v", repository.ErrNoRecord, err)
+	}
+}
+
+func TestService_UpdatePricesIncorrectDTO(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+
+	mockRepo := mockrepository.NewMockInterface(ctrl)
+	s := Service{Repository: mockRepo}
+
+	mockRepo.EXPECT().WithinTransaction(gomock.Any(), gomock.Any()).Times(0)
+
+	if _, err := s.UpdatePrices(context.Background(), dto.BulkPriceUpdate{}); err == nil {
+		t.Fail()
+	}
+}
@@ -0,0 +1,101 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	rs "github.com/lazylex/watch-store-store/internal/domain/aggregates/reservation"
+	"github.com/lazylex/watch-store-store/internal/domain/event"
+	"github.com/lazylex/watch-store-store/internal/dto"
+	"github.com/lazylex/watch-store-store/internal/logger"
+	"github.com/lazylex/watch-store-store/internal/ports/repository"
+	"github.com/lazylex/watch-store-store/internal/ports/service"
+	"log/slog"
+	"time"
+)
+
+// CreateCashRegister регистрирует кассу в реестре касс. Номер кассы является номером оформляемых на ней заказов,
+// поэтому он не должен совпадать с номером уже существующего заказа.
+func (s *Service) CreateCashRegister(ctx context.Context, data dto.CashRegisterRecord) error {
+	if err := data.Validate(); err != nil {
+		return err
+	}
+	data.LastSeenAt = nil
+
+	err := s.Repository.WithinTransaction(ctx, func(txCtx context.Context) error {
+		_, err := s.Repository.ReadReservation(txCtx, &dto.Number{OrderNumber: data.CashRegister})
+		if err == nil {
+			return service.ErrOrderNumberTaken
+		}
+		if !errors.Is(err, repository.ErrNoRecord) {
+			return err
+		}
+
+		if err = s.Repository.CreateCashRegister(txCtx, &data); err != nil {
+			if errors.Is(err, repository.ErrDuplicate) {
+				return service.ErrCashRegisterExists
+			}
+			return err
+		}
+
+		logger.LogWithCtxData(txCtx, slog.With(logger.OPLabel, "service.CreateCashRegister")).Info(
+			fmt.Sprintf("cash register %d registered", data.CashRegister))
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	s.emit(ctx, event.CashRegisterCreated, numberKey(data.CashRegister), data)
+	return nil
+}
+
+// UpdateCashRegister изменяет название, место хранения и признак включения зарегистрированной кассы. Выключение кассы
+// не закрывает открытую на ней смену, но запрещает продажи, возвраты и резервирование товара на кассе.
+func (s *Service) UpdateCashRegister(ctx context.Context, data dto.CashRegisterRecord) error {
+	if err := data.Validate(); err != nil {
+		return err
+	}
+
+	if err := s.Repository.UpdateCashRegister(ctx, &data); err != nil {
+		return err
+	}
+
+	logger.LogWithCtxData(ctx, slog.With(logger.OPLabel, "service.UpdateCashRegister")).Info(
+		fmt.Sprintf("cash register %d updated, enabled: %t", data.CashRegister, data.Enabled))
+
+	s.emit(ctx, event.CashRegisterUpdated, numberKey(data.CashRegister), data)
+	return nil
+}
+
+// CashRegister возвращает кассу из реестра касс.
+func (s *Service) CashRegister(ctx context.Context, data dto.CashRegister) (dto.CashRegisterRecord, error) {
+	if err := data.Validate(); err != nil {
+		return dto.CashRegisterRecord{}, err
+	}
+
+	return s.Repository.ReadCashRegister(ctx, &data)
+}
+
+// CashRegisters возвращает все зарегистрированные кассы.
+func (s *Service) CashRegisters(ctx context.Context) ([]dto.CashRegisterRecord, error) {
+	return s.Repository.ReadCashRegisters(ctx)
+}
+
+// useCashRegister проверяет, что касса зарегистрирована и включена, и сохраняет время выполнения на ней операции.
+// Возвращается касса из реестра.
+func (s *Service) useCashRegister(ctx context.Context, number rs.OrderNumber) (dto.CashRegisterRecord, error) {
+	cashRegister := dto.CashRegister{CashRegister: number}
+	record, err := s.Repository.ReadCashRegister(ctx, &cashRegister)
+	if err != nil {
+		if errors.Is(err, repository.ErrNoRecord) {
+			return dto.CashRegisterRecord{}, service.ErrUnknownCashRegister
+		}
+		return dto.CashRegisterRecord{}, err
+	}
+	if !record.Enabled {
+		return dto.CashRegisterRecord{}, service.ErrCashRegisterDisabled
+	}
+
+	return record, s.Repository.TouchCashRegister(ctx, &cashRegister, time.Now())
+}
@@ -0,0 +1,209 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"github.com/golang/mock/gomock"
+	"github.com/lazylex/watch-store-store/internal/domain/aggregates/reservation"
+	"github.com/lazylex/watch-store-store/internal/domain/event"
+	"github.com/lazylex/watch-store-store/internal/domain/value_objects/location"
+	"github.com/lazylex/watch-store-store/internal/dto"
+	"github.com/lazylex/watch-store-store/internal/dto/validators"
+	mockevents "github.com/lazylex/watch-store-store/internal/ports/events/mocks"
+	"github.com/lazylex/watch-store-store/internal/ports/repository"
+	mockrepository "github.com/lazylex/watch-store-store/internal/ports/repository/mocks"
+	"github.com/lazylex/watch-store-store/internal/ports/service"
+	"testing"
+	"time"
+)
+
+// expectCashRegister добавляет ожидание проверки зарегистрированной и включённой кассы number.
+func expectCashRegister(mockRepo *mockrepository.MockInterface, ctx context.Context, number reservation.OrderNumber) {
+	cashRegister := &dto.CashRegister{CashRegister: number}
+	mockRepo.EXPECT().ReadCashRegister(ctx, cashRegister).Times(1).Return(
+		dto.CashRegisterRecord{CashRegister: number, Name: "test", Enabled: true}, nil)
+	mockRepo.EXPECT().TouchCashRegister(ctx, cashRegister, gomock.Any()).Times(1).Return(nil)
+}
+
+// expectNoCashRegister добавляет ожидание проверки того, что касса с номером number не зарегистрирована.
+func expectNoCashRegister(mockRepo *mockrepository.MockInterface, ctx context.Context, number reservation.OrderNumber) {
+	mockRepo.EXPECT().ReadCashRegister(ctx, &dto.CashRegister{CashRegister: number}).Times(1).Return(
+		dto.CashRegisterRecord{}, repository.ErrNoRecord)
+}
+
+func TestService_CreateCashRegisterSuccess(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+
+	mockRepo := mockrepository.NewMockInterface(ctrl)
+	mockEvents := mockevents.NewMockInterface(ctrl)
+	data := dto.CashRegisterRecord{CashRegister: 11, Name: "Касса у входа", Enabled: true}
+	s := Service{Repository: mockRepo, Events: mockEvents}
+
+	ctx := context.WithValue(context.Background(), mockrepository.ExecuteKey{}, "✅")
+	mockRepo.EXPECT().ReadReservation(ctx, &dto.Number{OrderNumber: 11}).Times(1).Return(
+		dto.NumberDateStateProducts{}, repository.ErrNoRecord)
+	mockRepo.EXPECT().CreateCashRegister(ctx, &data).Times(1).Return(nil)
+	mockEvents.EXPECT().Publish(ctx, gomock.Any()).Times(1).Do(func(_ context.Context, e event.Event) {
+		if e.Type != event.CashRegisterCreated || e.Key != "11" {
+			t.Errorf("unexpected event %+v", e)
+		}
+	})
+
+	if err := s.CreateCashRegister(ctx, data); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestService_UpdateCashRegisterEmitsEvent(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+
+	mockRepo := mockrepository.NewMockInterface(ctrl)
+	mockEvents := mockevents.NewMockInterface(ctrl)
+	data := dto.CashRegisterRecord{CashRegister: 3, Name: "Касса у входа"}
+	s := Service{Repository: mockRepo, Events: mockEvents}
+
+	mockRepo.EXPECT().UpdateCashRegister(context.Background(), &data).Times(1).Return(nil)
+	mockEvents.EXPECT().Publish(context.Background(), gomock.Any()).Times(1).Do(
+		func(_ context.Context, e event.Event) {
+			if e.Type != event.CashRegisterUpdated || e.Key != "3" || e.Payload != data {
+				t.Errorf("unexpected event %+v", e)
+			}
+		})
+
+	if err := s.UpdateCashRegister(context.Background(), data); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestService_CreateCashRegisterExists(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+
+	mockRepo := mockrepository.NewMockInterface(ctrl)
+	data := dto.CashRegisterRecord{CashRegister: 1, Name: "Касса 1", Enabled: true}
+	s := Service{Repository: mockRepo}
+
+	ctx := context.WithValue(context.Background(), mockrepository.ExecuteKey{}, "✅")
+	mockRepo.EXPECT().ReadReservation(ctx, &dto.Number{OrderNumber: 1}).Times(1).Return(
+		dto.NumberDateStateProducts{}, repository.ErrNoRecord)
+	mockRepo.EXPECT().CreateCashRegister(ctx, &data).Times(1).Return(repository.ErrDuplicate)
+
+	if err := s.CreateCashRegister(ctx, data); !errors.Is(err, service.ErrCashRegisterExists) {
+		t.Errorf("expected %v, got %v", service.ErrCashRegisterExists, err)
+	}
+}
+
+func TestService_CreateCashRegisterNumberOfOrder(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+
+	mockRepo := mockrepository.NewMockInterface(ctrl)
+	data := dto.CashRegisterRecord{CashRegister: 11, Name: "Касса у входа", Enabled: true}
+	s := Service{Repository: mockRepo}
+
+	ctx := context.WithValue(context.Background(), mockrepository.ExecuteKey{}, "✅")
+	mockRepo.EXPECT().ReadReservation(ctx, &dto.Number{OrderNumber: 11}).Times(1).Return(
+		dto.NumberDateStateProducts{OrderNumber: 11, State: reservation.Finished}, nil)
+	mockRepo.EXPECT().CreateCashRegister(gomock.Any(), gomock.Any()).Times(0)
+
+	if err := s.CreateCashRegister(ctx, data); !errors.Is(err, service.ErrOrderNumberTaken) {
+		t.Errorf("expected %v, got %v", service.ErrOrderNumberTaken, err)
+	}
+}
+
+func TestService_OpenShiftUnknownCashRegister(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+
+	mockRepo := mockrepository.NewMockInterface(ctrl)
+	s := Service{Repository: mockRepo}
+
+	ctx := context.WithValue(context.Background(), mockrepository.ExecuteKey{}, "✅")
+	expectNoCashRegister(mockRepo, ctx, 42)
+	mockRepo.EXPECT().CreateShift(gomock.Any(), gomock.Any()).Times(0)
+
+	_, err := s.OpenShift(ctx, dto.Shift{CashRegister: 42, CashierID: "cashier-1"})
+	if !errors.Is(err, service.ErrUnknownCashRegister) {
+		t.Errorf("expected %v, got %v", service.ErrUnknownCashRegister, err)
+	}
+}
+
+func TestService_MakeSaleDisabledCashRegister(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+
+	mockRepo := mockrepository.NewMockInterface(ctrl)
+	s := Service{Repository: mockRepo}
+
+	ctx := context.WithValue(context.Background(), mockrepository.ExecuteKey{}, "✅")
+	mockRepo.EXPECT().ReadCashRegister(ctx, &dto.CashRegister{CashRegister: 2}).Times(1).Return(
+		dto.CashRegisterRecord{CashRegister: 2, Name: "Касса 2", Enabled: false}, nil)
+	mockRepo.EXPECT().TouchCashRegister(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+	mockRepo.EXPECT().ReadStockAmount(gomock.Any(), gomock.Any()).Times(0)
+
+	_, err := s.MakeSale(ctx, dto.CashRegisterProducts{CashRegister: 2, PaymentMethod: "cash",
+		Products: []dto.ArticlePriceAmount{{Article: "test-9", Price: 698, Amount: 1}}})
+	if !errors.Is(err, service.ErrCashRegisterDisabled) {
+		t.Errorf("expected %v, got %v", service.ErrCashRegisterDisabled, err)
+	}
+}
+
+func TestService_MakeReservationFromCashRegisterLocation(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+
+	mockRepo := mockrepository.NewMockInterface(ctrl)
+	data := dto.NumberDateStateProducts{
+		Products:    []dto.ArticlePriceAmount{{Article: "test-1", Amount: 1, Price: 698}},
+		OrderNumber: 3,
+		Date:        time.Now(),
+		State:       reservation.NewForCashRegister,
+	}
+	s := Service{Repository: mockRepo}
+
+	ctx := context.WithValue(context.Background(), mockrepository.ExecuteKey{}, "✅")
+	expectNoSerials(mockRepo, ctx)
+	mockRepo.EXPECT().ReadCashRegister(ctx, &dto.CashRegister{CashRegister: 3}).Times(1).Return(
+		dto.CashRegisterRecord{CashRegister: 3, Name: "Касса 3", Location: location.RepairDesk, Enabled: true}, nil)
+	mockRepo.EXPECT().TouchCashRegister(ctx, &dto.CashRegister{CashRegister: 3}, gomock.Any()).Times(1).Return(nil)
+	mockRepo.EXPECT().ReadStockAmount(ctx, &dto.Article{Article: "test-1"}).Times(1).Return(uint(5), nil)
+	mockRepo.EXPECT().UpdateStockAmount(ctx, &dto.ArticleAmount{Article: "test-1", Amount: 4}).Times(1).Return(nil)
+	mockRepo.EXPECT().ReadStockLocations(ctx, &dto.Article{Article: "test-1"}).Times(1).Return(
+		[]dto.LocationAmount{{Location: location.RepairDesk, Amount: 1}}, nil)
+	mockRepo.EXPECT().UpsertStockLocationAmount(ctx, &dto.ArticleLocationAmount{Article: "test-1",
+		Location: location.RepairDesk, Amount: 0}).Times(1).Return(nil)
+	mockRepo.EXPECT().CreateReservation(ctx, gomock.Any()).Times(1).DoAndReturn(
+		func(_ context.Context, r *dto.NumberDateStateProducts) error {
+			if r.Location != location.RepairDesk {
+				t.Errorf("reserved from %s", r.Location)
+			}
+			return nil
+		})
+	mockRepo.EXPECT().CreateReservationTransition(ctx, gomock.Any()).Times(1).Return(nil)
+
+	if _, err := s.MakeReservation(ctx, data); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestService_FinishCashRegisterOrderWithoutPaymentMethod(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+
+	mockRepo := mockrepository.NewMockInterface(ctrl)
+	s := Service{Repository: mockRepo}
+
+	ctx := context.WithValue(context.Background(), mockrepository.ExecuteKey{}, "✅")
+	mockRepo.EXPECT().ReadReservation(ctx, &dto.Number{OrderNumber: 4}).Times(1).Return(
+		dto.NumberDateStateProducts{OrderNumber: 4, State: reservation.NewForCashRegister,
+			Products: []dto.ArticlePriceAmount{{Article: "test-9", Price: 698, Amount: 1}}}, nil)
+	mockRepo.EXPECT().CreateReservationTransition(ctx, gomock.Any()).AnyTimes().Return(nil)
+	mockRepo.EXPECT().CreateReceipt(gomock.Any(), gomock.Any()).Times(0)
+
+	_, err := s.FinishOrder(ctx, dto.NumberPaymentMethod{OrderNumber: 4})
+	if !errors.Is(err, validators.ErrIncorrectPaymentMethod) {
+		t.Errorf("expected %v, got %v", validators.ErrIncorrectPaymentMethod, err)
+	}
+}
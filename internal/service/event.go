@@ -0,0 +1,22 @@
+package service
+
+import (
+	"context"
+	"github.com/lazylex/watch-store-store/internal/domain/event"
+	"strconv"
+)
+
+// emit передаёт получателю доменное событие указанного типа. Вызывается только после успешного завершения изменения
+// данных (после фиксации транзакции), чтобы не публиковать события об откаченных изменениях. Если получатель событий
+// не задан, ничего не делает.
+func (s *Service) emit(ctx context.Context, t event.Type, key string, payload any) {
+	if s.Events == nil {
+		return
+	}
+	s.Events.Publish(ctx, event.New(t, key, payload))
+}
+
+// numberKey возвращает ключ события для номера заказа или идентификатора документа.
+func numberKey[T ~int | ~int64](number T) string {
+	return strconv.FormatInt(int64(number), 10)
+}
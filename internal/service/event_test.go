@@ -0,0 +1,133 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"github.com/golang/mock/gomock"
+	"github.com/lazylex/watch-store-store/internal/domain/aggregates/reservation"
+	"github.com/lazylex/watch-store-store/internal/domain/event"
+	"github.com/lazylex/watch-store-store/internal/dto"
+	"github.com/lazylex/watch-store-store/internal/metrics"
+	mockevents "github.com/lazylex/watch-store-store/internal/ports/events/mocks"
+	mockService "github.com/lazylex/watch-store-store/internal/ports/metrics/service/mocks"
+	"github.com/lazylex/watch-store-store/internal/ports/repository"
+	mockrepository "github.com/lazylex/watch-store-store/internal/ports/repository/mocks"
+	"strconv"
+	"testing"
+	"time"
+)
+
+func TestService_ChangePriceInStockEmitsEvent(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+
+	mockRepo := mockrepository.NewMockInterface(ctrl)
+	mockEvents := mockevents.NewMockInterface(ctrl)
+	data := dto.ArticlePrice{Article: "test-9", Price: 10}
+	s := Service{Repository: mockRepo, Events: mockEvents}
+
+	var published event.Event
+	mockRepo.EXPECT().ReadStock(gomock.Any(), gomock.Any()).Times(1)
+	mockRepo.EXPECT().UpdateStockPrice(context.Background(), &data).Times(1).Return(nil)
+	mockEvents.EXPECT().Publish(context.Background(), gomock.Any()).Times(1).Do(
+		func(_ context.Context, e event.Event) { published = e })
+
+	if err := s.ChangePriceInStock(context.Background(), data); err != nil {
+		t.Fatal(err)
+	}
+
+	if published.Type != event.StockPriceChanged || published.Version != event.StockPriceChanged.Version() ||
+		published.Key != string(data.Article) || published.Payload != data || published.OccurredAt.IsZero() {
+		t.Errorf("unexpected event %+v", published)
+	}
+}
+
+func TestService_ChangePriceInStockErrNoEvent(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+
+	mockRepo := mockrepository.NewMockInterface(ctrl)
+	mockEvents := mockevents.NewMockInterface(ctrl)
+	data := dto.ArticlePrice{Article: "test-9", Price: 10}
+	s := Service{Repository: mockRepo, Events: mockEvents}
+
+	mockRepo.EXPECT().ReadStock(gomock.Any(), gomock.Any()).Times(1)
+	mockRepo.EXPECT().UpdateStockPrice(context.Background(), &data).Times(1).Return(errors.New(""))
+	mockEvents.EXPECT().Publish(gomock.Any(), gomock.Any()).Times(0)
+
+	if err := s.ChangePriceInStock(context.Background(), data); err == nil {
+		t.Fail()
+	}
+}
+
+func TestService_MakeReservationEmitsEventAfterCommit(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+
+	mockRepo := mockrepository.NewMockInterface(ctrl)
+	mockEvents := mockevents.NewMockInterface(ctrl)
+	mockServiceMetrics := mockService.NewMockMetricsInterface(ctrl)
+	data := dto.NumberDateStateProducts{
+		Products:    []dto.ArticlePriceAmount{{Article: "test-9", Amount: 1, Price: 698}},
+		OrderNumber: 11,
+		Date:        time.Now(),
+		State:       reservation.NewForInternetCustomer,
+		Customer:    &dto.Customer{Name: "Иван", Phone: "79123456789", Email: "ivan@example.com"},
+	}
+	s := Service{Repository: mockRepo, Events: mockEvents, Metrics: &metrics.Metrics{Service: mockServiceMetrics}}
+
+	var published event.Event
+	ctx := context.WithValue(context.Background(), mockrepository.ExecuteKey{}, "✅")
+	expectNoSerials(mockRepo, ctx)
+	mockRepo.EXPECT().ReadReservation(ctx, &dto.Number{OrderNumber: data.OrderNumber}).Times(1).Return(
+		dto.NumberDateStateProducts{}, repository.ErrNoRecord)
+	expectNoCashRegister(mockRepo, ctx, data.OrderNumber)
+	mockRepo.EXPECT().ReadStockAmount(ctx, &dto.Article{Article: "test-9"}).Times(1).Return(uint(5), nil)
+	mockRepo.EXPECT().UpdateStockAmount(ctx,
+		&dto.ArticleAmount{Article: "test-9", Amount: uint(4)}).Times(1).Return(nil)
+	mockRepo.EXPECT().ReadStockLocations(ctx, &dto.Article{Article: "test-9"}).Times(1).Return(nil, nil)
+	createReservation := mockRepo.EXPECT().CreateReservation(ctx, &data).Times(1).Return(nil)
+	mockRepo.EXPECT().CreateReservationTransition(ctx, gomock.Any()).Times(1).Return(nil)
+	mockRepo.EXPECT().CreateReservationCustomer(ctx, &dto.ReservationCustomer{OrderNumber: 11,
+		Customer: data.Customer}).Times(1).Return(nil)
+	mockServiceMetrics.EXPECT().PlacedInternetOrdersInc().Times(1)
+	mockEvents.EXPECT().Publish(ctx, gomock.Any()).Times(1).After(createReservation).Do(
+		func(_ context.Context, e event.Event) { published = e })
+
+	if _, err := s.MakeReservation(ctx, data); err != nil {
+		t.Fatal(err)
+	}
+
+	if published.Type != event.ReservationMade || published.Key != strconv.FormatInt(int64(data.OrderNumber), 10) {
+		t.Errorf("unexpected event %+v", published)
+	}
+	if made, ok := published.Payload.(dto.NumberDateStateProducts); !ok || made.Customer != nil {
+		t.Errorf("customer data in event payload %+v", published.Payload)
+	}
+}
+
+func TestService_MakeReservationErrNoEvent(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+
+	mockRepo := mockrepository.NewMockInterface(ctrl)
+	mockEvents := mockevents.NewMockInterface(ctrl)
+	data := dto.NumberDateStateProducts{
+		Products:    []dto.ArticlePriceAmount{{Article: "test-9", Amount: 1, Price: 698}},
+		OrderNumber: 11,
+		Date:        time.Now(),
+		State:       reservation.NewForInternetCustomer,
+	}
+	s := Service{Repository: mockRepo, Events: mockEvents}
+
+	ctx := context.WithValue(context.Background(), mockrepository.ExecuteKey{}, "✅")
+	mockRepo.EXPECT().ReadReservation(ctx, &dto.Number{OrderNumber: data.OrderNumber}).Times(1).Return(
+		dto.NumberDateStateProducts{}, repository.ErrNoRecord)
+	expectNoCashRegister(mockRepo, ctx, data.OrderNumber)
+	mockRepo.EXPECT().ReadStockAmount(ctx, &dto.Article{Article: "test-9"}).Times(1).Return(uint(0), nil)
+	mockEvents.EXPECT().Publish(gomock.Any(), gomock.Any()).Times(0)
+
+	if _, err := s.MakeReservation(ctx, data); err == nil {
+		t.Fail()
+	}
+}
@@ -0,0 +1,84 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"github.com/lazylex/watch-store-store/internal/domain/value_objects/bucket"
+	"github.com/lazylex/watch-store-store/internal/domain/value_objects/report"
+	"github.com/lazylex/watch-store-store/internal/dto"
+	"github.com/lazylex/watch-store-store/internal/helpers/constants/various"
+	"github.com/lazylex/watch-store-store/internal/logger"
+	"github.com/lazylex/watch-store-store/internal/ports/export"
+	"log/slog"
+)
+
+// ExportSales построчно записывает в w отчёт о продажах за период. Первой строкой записываются названия столбцов.
+// Сводные отчёты (по товарам, дням и платежам) содержат столбцы с возвратами, а продажи в них указываются за вычетом
+// возвратов, как в Z-отчётах. Отчёт с записями о проданных товарах возвратов не содержит.
+// Записи о продажах и продажи по товарам передаются в w по мере чтения из хранилища, не накапливаясь в памяти.
+func (s *Service) ExportSales(ctx context.Context, data dto.FromToReport, w export.Interface) error {
+	var err error
+	var rows int
+
+	if err = data.Validate(); err != nil {
+		return err
+	}
+
+	switch data.Report {
+	case report.Sold:
+		if err = w.WriteRow("article", "price", "amount", "total", "date", "receipt_id", "tax_category", "tax_rate",
+			"net", "tax"); err != nil {
+			return err
+		}
+		err = s.Repository.IterateSoldRecords(ctx, &data.FromTo, func(record dto.SoldRecord) error {
+			rows++
+			return w.WriteRow(string(record.Article), record.Price, record.Amount,
+				record.Price*float64(record.Amount), record.Date, int64(record.ReceiptID), string(record.TaxCategory),
+				record.TaxRate, record.Net, record.Tax)
+		})
+	case report.Articles:
+		if err = w.WriteRow("article", "units", "revenue", "refunded_units", "refunded", "average_price"); err != nil {
+			return err
+		}
+		err = s.Repository.IterateArticlesSales(ctx, &data.FromTo, func(record dto.ArticleSales) error {
+			rows++
+			record.CalculateAveragePrice()
+			return w.WriteRow(string(record.Article), record.Units, record.Revenue, record.RefundedUnits,
+				record.Refunded, record.AveragePrice)
+		})
+	case report.Days:
+		var sales []dto.PeriodSales
+		if err = w.WriteRow("date", "units", "revenue", "net", "tax", "refunded_units", "refunded"); err != nil {
+			return err
+		}
+		sales, err = s.Repository.ReadSalesByPeriod(ctx, &dto.FromToBucket{FromTo: data.FromTo, Bucket: bucket.Day})
+		for i := 0; err == nil && i < len(sales); i++ {
+			rows++
+			err = w.WriteRow(sales[i].Period.Format(various.DateLayout), sales[i].Units, sales[i].Revenue,
+				sales[i].Net, sales[i].Tax, sales[i].RefundedUnits, sales[i].Refunded)
+		}
+	case report.Payments:
+		var payments []dto.DailyPayments
+		if err = w.WriteRow("date", "method", "count", "amount", "change", "refunds", "refunded",
+			"total"); err != nil {
+			return err
+		}
+		payments, err = s.Repository.ReadPaymentsByDay(ctx, &data.FromTo)
+		for i := 0; err == nil && i < len(payments); i++ {
+			rows++
+			err = w.WriteRow(payments[i].Date.Format(various.DateLayout), string(payments[i].Method),
+				payments[i].Count, payments[i].Amount, payments[i].Change, payments[i].Refunds, payments[i].Refunded,
+				payments[i].Net())
+		}
+	}
+
+	if err != nil {
+		return err
+	}
+
+	logger.LogWithCtxData(ctx, slog.With(logger.OPLabel, "service.ExportSales")).Info(
+		fmt.Sprintf("exported %d rows of %s report from %s to %s", rows, data.Report,
+			data.From.Format(various.DateLayout), data.To.Format(various.DateLayout)))
+
+	return nil
+}
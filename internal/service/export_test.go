@@ -0,0 +1,142 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"github.com/golang/mock/gomock"
+	"github.com/lazylex/watch-store-store/internal/domain/value_objects/bucket"
+	"github.com/lazylex/watch-store-store/internal/domain/value_objects/payment"
+	"github.com/lazylex/watch-store-store/internal/domain/value_objects/report"
+	"github.com/lazylex/watch-store-store/internal/dto"
+	"github.com/lazylex/watch-store-store/internal/dto/validators"
+	mockrepository "github.com/lazylex/watch-store-store/internal/ports/repository/mocks"
+	"testing"
+	"time"
+)
+
+// rowsRecorder сохраняет записанные строки отчёта.
+type rowsRecorder struct {
+	rows [][]any
+}
+
+func (r *rowsRecorder) WriteRow(cells ...any) error {
+	r.rows = append(r.rows, cells)
+	return nil
+}
+
+func TestService_ExportSalesSold(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+
+	mockRepo := mockrepository.NewMockInterface(ctrl)
+	s := Service{Repository: mockRepo}
+	data := dto.FromToReport{FromTo: dto.FromTo{From: time.Now().Add(-time.Hour), To: time.Now()}, Report: report.Sold}
+	recorder := &rowsRecorder{}
+
+	mockRepo.EXPECT().IterateSoldRecords(gomock.Any(), &data.FromTo, gomock.Any()).Times(1).DoAndReturn(
+		func(_ context.Context, _ *dto.FromTo, fn func(dto.SoldRecord) error) error {
+			for i := 0; i < 2; i++ {
+				record := dto.SoldRecord{ArticlePriceAmountDate: dto.ArticlePriceAmountDate{
+					Article: "test-1", Price: 100, Amount: 3, Date: time.Now()}, ReceiptID: 15,
+					TaxCategory: "standard", TaxRate: 20, Net: 250, Tax: 50}
+				if err := fn(record); err != nil {
+					return err
+				}
+			}
+			return nil
+		})
+
+	if err := s.ExportSales(context.Background(), data, recorder); err != nil {
+		t.Fatal(err)
+	}
+	if len(recorder.rows) != 3 || recorder.rows[1][3] != float64(300) || recorder.rows[1][5] != int64(15) ||
+		recorder.rows[1][6] != "standard" || recorder.rows[1][9] != float64(50) {
+		t.Fail()
+	}
+}
+
+func TestService_ExportSalesArticles(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+
+	mockRepo := mockrepository.NewMockInterface(ctrl)
+	s := Service{Repository: mockRepo}
+	data := dto.FromToReport{FromTo: dto.FromTo{From: time.Now().Add(-time.Hour), To: time.Now()},
+		Report: report.Articles}
+	recorder := &rowsRecorder{}
+
+	mockRepo.EXPECT().IterateArticlesSales(gomock.Any(), &data.FromTo, gomock.Any()).Times(1).DoAndReturn(
+		func(_ context.Context, _ *dto.FromTo, fn func(dto.ArticleSales) error) error {
+			return fn(dto.ArticleSales{Article: "test-1", Units: 3, Revenue: 750, RefundedUnits: 1, Refunded: 250})
+		})
+
+	if err := s.ExportSales(context.Background(), data, recorder); err != nil {
+		t.Fatal(err)
+	}
+	if len(recorder.rows) != 2 || recorder.rows[1][1] != 3 || recorder.rows[1][3] != uint(1) ||
+		recorder.rows[1][4] != float64(250) || recorder.rows[1][5] != float64(250) {
+		t.Fail()
+	}
+}
+
+func TestService_ExportSalesDaysWithRefund(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+
+	mockRepo := mockrepository.NewMockInterface(ctrl)
+	s := Service{Repository: mockRepo}
+	data := dto.FromToReport{FromTo: dto.FromTo{From: time.Now().Add(-time.Hour), To: time.Now()}, Report: report.Days}
+	recorder := &rowsRecorder{}
+
+	mockRepo.EXPECT().ReadSalesByPeriod(gomock.Any(), &dto.FromToBucket{FromTo: data.FromTo, Bucket: bucket.Day}).
+		Times(1).Return([]dto.PeriodSales{{Period: time.Now(), Units: 2, Revenue: 500, Net: 400, Tax: 100,
+		RefundedUnits: 1, Refunded: 250}}, nil)
+
+	if err := s.ExportSales(context.Background(), data, recorder); err != nil {
+		t.Fatal(err)
+	}
+	if len(recorder.rows) != 2 || recorder.rows[0][5] != "refunded_units" || recorder.rows[1][1] != 2 ||
+		recorder.rows[1][5] != uint(1) || recorder.rows[1][6] != float64(250) {
+		t.Fail()
+	}
+}
+
+func TestService_ExportSalesPayments(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+
+	mockRepo := mockrepository.NewMockInterface(ctrl)
+	s := Service{Repository: mockRepo}
+	data := dto.FromToReport{FromTo: dto.FromTo{From: time.Now().Add(-time.Hour), To: time.Now()},
+		Report: report.Payments}
+	recorder := &rowsRecorder{}
+
+	mockRepo.EXPECT().ReadPaymentsByDay(gomock.Any(), &data.FromTo).Times(1).Return([]dto.DailyPayments{
+		{Date: time.Now(), Method: payment.Cash, Count: 2, Amount: 1000, Change: 150, Refunds: 1, Refunded: 200},
+		{Date: time.Now(), Method: payment.Card, Count: 1, Amount: 300},
+	}, nil)
+
+	if err := s.ExportSales(context.Background(), data, recorder); err != nil {
+		t.Fatal(err)
+	}
+	if len(recorder.rows) != 3 || recorder.rows[1][1] != "cash" || recorder.rows[1][5] != uint(1) ||
+		recorder.rows[1][6] != float64(200) || recorder.rows[1][7] != float64(650) ||
+		recorder.rows[2][7] != float64(300) {
+		t.Fail()
+	}
+}
+
+func TestService_ExportSalesIncorrectReport(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+
+	mockRepo := mockrepository.NewMockInterface(ctrl)
+	s := Service{Repository: mockRepo}
+	data := dto.FromToReport{FromTo: dto.FromTo{From: time.Now().Add(-time.Hour), To: time.Now()}, Report: "all"}
+	recorder := &rowsRecorder{}
+
+	if err := s.ExportSales(context.Background(), data, recorder); !errors.Is(err, validators.ErrIncorrectReport) ||
+		len(recorder.rows) != 0 {
+		t.Fail()
+	}
+}
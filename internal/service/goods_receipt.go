@@ -0,0 +1,122 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/lazylex/watch-store-store/internal/domain/event"
+	"github.com/lazylex/watch-store-store/internal/domain/value_objects/article"
+	"github.com/lazylex/watch-store-store/internal/dto"
+	"github.com/lazylex/watch-store-store/internal/logger"
+	"github.com/lazylex/watch-store-store/internal/ports/repository"
+	"github.com/lazylex/watch-store-store/internal/ports/service"
+	"log/slog"
+	"time"
+)
+
+// ReceiveGoods принимает поставку товаров: увеличивает количество имеющихся товаров и создаёт записи о товарах,
+// отсутствующих в ассортименте. Приёмка идемпотентна по номеру документа - если документ уже принят, остатки не
+// изменяются и возвращается false. При успешной приёмке возвращается true. Для товаров, учитываемых по серийным
+// номерам, регистрируются серийные номера поступивших экземпляров.
+func (s *Service) ReceiveGoods(ctx context.Context, data dto.GoodsReceipt) (bool, error) {
+	if err := data.Validate(); err != nil {
+		return false, err
+	}
+
+	log := logger.LogWithCtxData(ctx, slog.With(logger.OPLabel, "service.ReceiveGoods"))
+	received, duplicate := false, false
+
+	err := s.Repository.WithinTransaction(ctx, func(txCtx context.Context) error {
+		var amount uint
+
+		_, err := s.Repository.ReadGoodsReceipt(txCtx, &dto.DocumentNumber{DocumentNumber: data.DocumentNumber})
+		if err == nil {
+			return nil
+		}
+		if !errors.Is(err, repository.ErrNoRecord) {
+			return err
+		}
+
+		articles := make([]article.Article, len(data.Lines))
+		for i, line := range data.Lines {
+			articles[i] = line.Article
+		}
+		tracked, err := s.trackedArticles(txCtx, articles)
+		if err != nil {
+			return err
+		}
+
+		for _, line := range data.Lines {
+			if _, ok := tracked[line.Article]; ok != (len(line.Serials) > 0) {
+				return service.ErrSerialsDontMatch
+			}
+
+			amount, err = s.Repository.ReadStockAmount(txCtx, &dto.Article{Article: line.Article})
+			switch {
+			case err == nil:
+				err = s.Repository.UpdateStockAmount(txCtx,
+					&dto.ArticleAmount{Article: line.Article, Amount: amount + line.Amount})
+			case errors.Is(err, repository.ErrNoRecord):
+				record := line.NewStockRecord()
+				if err = record.Validate(); err != nil {
+					return err
+				}
+				err = s.Repository.CreateStock(txCtx, &record)
+			}
+			if err != nil {
+				return err
+			}
+
+			if err = s.registerSerials(txCtx, line.Article, line.Serials,
+				dto.SerialEvent{DocumentNumber: data.DocumentNumber}); err != nil {
+				return err
+			}
+		}
+
+		data.ReceivedAt = time.Now()
+		if err = s.Repository.CreateGoodsReceipt(txCtx, &data); err != nil {
+			duplicate = errors.Is(err, repository.ErrDuplicate)
+			return err
+		}
+
+		received = true
+		return nil
+	})
+
+	// одновременная приёмка одного и того же документа: его уже принял параллельный запрос. Дубликаты при создании
+	// записей о товарах (одновременная приёмка разных документов с одним новым товаром) возвращаются как ошибка, чтобы
+	// поставку можно было принять повторно
+	if duplicate {
+		err, received = nil, false
+	}
+	if err != nil {
+		return false, err
+	}
+
+	if received {
+		log.Info(fmt.Sprintf("goods received by document %s from %s, %d lines", data.DocumentNumber, data.Supplier,
+			len(data.Lines)))
+		s.emit(ctx, event.GoodsReceived, string(data.DocumentNumber), data)
+	} else {
+		log.Info(fmt.Sprintf("document %s already received", data.DocumentNumber))
+	}
+
+	return received, nil
+}
+
+// GoodsReceipt возвращает принятую поставку по номеру документа.
+func (s *Service) GoodsReceipt(ctx context.Context, data dto.DocumentNumber) (dto.GoodsReceipt, error) {
+	if err := data.Validate(); err != nil {
+		return dto.GoodsReceipt{}, err
+	}
+
+	result, err := s.Repository.ReadGoodsReceipt(ctx, &data)
+	if err != nil {
+		return dto.GoodsReceipt{}, err
+	}
+
+	logger.LogWithCtxData(ctx, slog.With(logger.OPLabel, "service.GoodsReceipt")).Info(
+		fmt.Sprintf("requested goods receipt %s", data.DocumentNumber))
+
+	return result, nil
+}
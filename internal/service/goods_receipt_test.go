@@ -0,0 +1,139 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"github.com/golang/mock/gomock"
+	"github.com/lazylex/watch-store-store/internal/dto"
+	"github.com/lazylex/watch-store-store/internal/dto/validators"
+	"github.com/lazylex/watch-store-store/internal/ports/repository"
+	mockrepository "github.com/lazylex/watch-store-store/internal/ports/repository/mocks"
+	"testing"
+)
+
+func TestService_ReceiveGoodsIncorrectDTO(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+
+	mockRepo := mockrepository.NewMockInterface(ctrl)
+	s := Service{Repository: mockRepo}
+
+	_, err := s.ReceiveGoods(context.Background(), dto.GoodsReceipt{Supplier: "casio"})
+	if !errors.Is(err, validators.ErrEmptyDocumentNumber) {
+		t.Fail()
+	}
+}
+
+func TestService_ReceiveGoodsSuccess(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+
+	mockRepo := mockrepository.NewMockInterface(ctrl)
+	s := Service{Repository: mockRepo}
+	ctx := context.WithValue(context.Background(), mockrepository.ExecuteKey{}, "✅")
+	expectNoSerials(mockRepo, ctx)
+	data := dto.GoodsReceipt{DocumentNumber: "TN-1", Supplier: "casio", Lines: []dto.GoodsReceiptLine{
+		{Article: "test-1", Cost: 100, Amount: 5},
+		{Article: "test-2", Name: "CASIO", Price: 300, Cost: 150, Amount: 2},
+	}}
+
+	mockRepo.EXPECT().ReadGoodsReceipt(ctx, &dto.DocumentNumber{DocumentNumber: "TN-1"}).Times(1).Return(
+		dto.GoodsReceipt{}, repository.ErrNoRecord)
+	mockRepo.EXPECT().ReadStockAmount(ctx, &dto.Article{Article: "test-1"}).Times(1).Return(uint(3), nil)
+	mockRepo.EXPECT().UpdateStockAmount(ctx, &dto.ArticleAmount{Article: "test-1", Amount: 8}).Times(1).Return(nil)
+	mockRepo.EXPECT().ReadStockAmount(ctx, &dto.Article{Article: "test-2"}).Times(1).Return(uint(0),
+		repository.ErrNoRecord)
+	mockRepo.EXPECT().CreateStock(ctx, &dto.ArticlePriceNameAmount{Article: "test-2", Name: "CASIO", Price: 300,
+		Amount: 2}).Times(1).Return(nil)
+	mockRepo.EXPECT().CreateGoodsReceipt(ctx, gomock.Any()).Times(1).Return(nil)
+
+	received, err := s.ReceiveGoods(ctx, data)
+	if err != nil || !received {
+		t.Fail()
+	}
+}
+
+func TestService_ReceiveGoodsAlreadyReceived(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+
+	mockRepo := mockrepository.NewMockInterface(ctrl)
+	s := Service{Repository: mockRepo}
+	ctx := context.WithValue(context.Background(), mockrepository.ExecuteKey{}, "✅")
+	data := dto.GoodsReceipt{DocumentNumber: "TN-1", Supplier: "casio",
+		Lines: []dto.GoodsReceiptLine{{Article: "test-1", Cost: 100, Amount: 5}}}
+
+	mockRepo.EXPECT().ReadGoodsReceipt(ctx, &dto.DocumentNumber{DocumentNumber: "TN-1"}).Times(1).Return(data, nil)
+	mockRepo.EXPECT().UpdateStockAmount(gomock.Any(), gomock.Any()).Times(0)
+	mockRepo.EXPECT().CreateGoodsReceipt(gomock.Any(), gomock.Any()).Times(0)
+
+	received, err := s.ReceiveGoods(ctx, data)
+	if err != nil || received {
+		t.Fail()
+	}
+}
+
+func TestService_ReceiveGoodsConcurrentDuplicate(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+
+	mockRepo := mockrepository.NewMockInterface(ctrl)
+	s := Service{Repository: mockRepo}
+	ctx := context.WithValue(context.Background(), mockrepository.ExecuteKey{}, "✅")
+	expectNoSerials(mockRepo, ctx)
+	data := dto.GoodsReceipt{DocumentNumber: "TN-1", Supplier: "casio",
+		Lines: []dto.GoodsReceiptLine{{Article: "test-1", Cost: 100, Amount: 5}}}
+
+	mockRepo.EXPECT().ReadGoodsReceipt(ctx, gomock.Any()).Times(1).Return(dto.GoodsReceipt{}, repository.ErrNoRecord)
+	mockRepo.EXPECT().ReadStockAmount(ctx, gomock.Any()).Times(1).Return(uint(3), nil)
+	mockRepo.EXPECT().UpdateStockAmount(ctx, gomock.Any()).Times(1).Return(nil)
+	mockRepo.EXPECT().CreateGoodsReceipt(ctx, gomock.Any()).Times(1).Return(repository.ErrDuplicate)
+
+	received, err := s.ReceiveGoods(ctx, data)
+	if err != nil || received {
+		t.Fail()
+	}
+}
+
+func TestService_ReceiveGoodsConcurrentNewArticle(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+
+	mockRepo := mockrepository.NewMockInterface(ctrl)
+	s := Service{Repository: mockRepo}
+	ctx := context.WithValue(context.Background(), mockrepository.ExecuteKey{}, "✅")
+	expectNoSerials(mockRepo, ctx)
+	data := dto.GoodsReceipt{DocumentNumber: "TN-2", Supplier: "casio",
+		Lines: []dto.GoodsReceiptLine{{Article: "test-2", Name: "CASIO", Price: 300, Cost: 150, Amount: 2}}}
+
+	mockRepo.EXPECT().ReadGoodsReceipt(ctx, gomock.Any()).Times(1).Return(dto.GoodsReceipt{}, repository.ErrNoRecord)
+	mockRepo.EXPECT().ReadStockAmount(ctx, gomock.Any()).Times(1).Return(uint(0), repository.ErrNoRecord)
+	mockRepo.EXPECT().CreateStock(ctx, gomock.Any()).Times(1).Return(repository.ErrDuplicate)
+	mockRepo.EXPECT().CreateGoodsReceipt(gomock.Any(), gomock.Any()).Times(0)
+
+	received, err := s.ReceiveGoods(ctx, data)
+	if !errors.Is(err, repository.ErrDuplicate) || received {
+		t.Fail()
+	}
+}
+
+func TestService_ReceiveGoodsNewArticleWithoutName(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+
+	mockRepo := mockrepository.NewMockInterface(ctrl)
+	s := Service{Repository: mockRepo}
+	ctx := context.WithValue(context.Background(), mockrepository.ExecuteKey{}, "✅")
+	expectNoSerials(mockRepo, ctx)
+	data := dto.GoodsReceipt{DocumentNumber: "TN-1", Supplier: "casio",
+		Lines: []dto.GoodsReceiptLine{{Article: "test-1", Price: 300, Cost: 100, Amount: 5}}}
+
+	mockRepo.EXPECT().ReadGoodsReceipt(ctx, gomock.Any()).Times(1).Return(dto.GoodsReceipt{}, repository.ErrNoRecord)
+	mockRepo.EXPECT().ReadStockAmount(ctx, gomock.Any()).Times(1).Return(uint(0), repository.ErrNoRecord)
+	mockRepo.EXPECT().CreateStock(gomock.Any(), gomock.Any()).Times(0)
+
+	_, err := s.ReceiveGoods(ctx, data)
+	if !errors.Is(err, validators.ErrEmptyName) {
+		t.Fail()
+	}
+}
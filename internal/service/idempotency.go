@@ -0,0 +1,80 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/lazylex/watch-store-store/internal/dto"
+	"github.com/lazylex/watch-store-store/internal/logger"
+	"github.com/lazylex/watch-store-store/internal/ports/repository"
+	"github.com/lazylex/watch-store-store/internal/ports/service"
+	"log/slog"
+	"time"
+)
+
+// BeginIdempotentRequest регистрирует начало обработки запроса с ключом идемпотентности. Перед регистрацией удаляются
+// записи с истёкшим сроком действия. Если запрос с этим ключом уже выполнен, возвращается сохранённый результат и
+// true. Если запрос с этим ключом ещё выполняется, возвращается ErrRequestInProgress, а если ключ использован для
+// другого запроса (не совпадает отпечаток) - ErrIdempotencyKeyReused. Одновременные запросы с одним ключом различаются
+// уникальностью ключа в хранилище.
+func (s *Service) BeginIdempotentRequest(ctx context.Context, data dto.IdempotencyRecord) (dto.IdempotencyRecord,
+	bool, error) {
+	if err := data.Validate(); err != nil {
+		return dto.IdempotencyRecord{}, false, err
+	}
+
+	if err := s.Repository.DeleteExpiredIdempotencyRecords(ctx, time.Now()); err != nil {
+		return dto.IdempotencyRecord{}, false, err
+	}
+
+	data.Completed, data.Status, data.ContentType, data.Body = false, 0, "", nil
+	err := s.Repository.CreateIdempotencyRecord(ctx, &data)
+	if err == nil {
+		return dto.IdempotencyRecord{}, false, nil
+	}
+	if !errors.Is(err, repository.ErrDuplicate) {
+		return dto.IdempotencyRecord{}, false, err
+	}
+
+	stored, err := s.Repository.ReadIdempotencyRecord(ctx, &data.IdempotencyKey)
+	if errors.Is(err, repository.ErrNoRecord) {
+		// выполнявшийся запрос только что завершился неудачно и его запись удалена
+		return dto.IdempotencyRecord{}, false, service.ErrRequestInProgress
+	}
+	if err != nil {
+		return dto.IdempotencyRecord{}, false, err
+	}
+
+	if stored.Fingerprint != data.Fingerprint {
+		return dto.IdempotencyRecord{}, false, service.ErrIdempotencyKeyReused
+	}
+	if !stored.Completed {
+		return dto.IdempotencyRecord{}, false, service.ErrRequestInProgress
+	}
+
+	logger.LogWithCtxData(ctx, slog.With(logger.OPLabel, "service.BeginIdempotentRequest")).Info(
+		fmt.Sprintf("replay result of request with idempotency key %s", data.Key))
+
+	return stored, true, nil
+}
+
+// CompleteIdempotentRequest сохраняет результат обработки запроса с ключом идемпотентности для его последующей выдачи
+// на повторные запросы.
+func (s *Service) CompleteIdempotentRequest(ctx context.Context, data dto.IdempotencyRecord) error {
+	if err := data.Validate(); err != nil {
+		return err
+	}
+
+	data.Completed = true
+	return s.Repository.UpdateIdempotencyRecord(ctx, &data)
+}
+
+// AbortIdempotentRequest удаляет запись о запросе с ключом идемпотентности. Используется, если запрос не был выполнен
+// (например, из-за внутренней ошибки или таймаута) и может быть повторён с тем же ключом.
+func (s *Service) AbortIdempotentRequest(ctx context.Context, data dto.IdempotencyKey) error {
+	if err := data.Validate(); err != nil {
+		return err
+	}
+
+	return s.Repository.DeleteIdempotencyRecord(ctx, &data)
+}
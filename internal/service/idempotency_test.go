@@ -0,0 +1,128 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"github.com/golang/mock/gomock"
+	"github.com/lazylex/watch-store-store/internal/dto"
+	"github.com/lazylex/watch-store-store/internal/dto/validators"
+	"github.com/lazylex/watch-store-store/internal/ports/repository"
+	mockrepository "github.com/lazylex/watch-store-store/internal/ports/repository/mocks"
+	"github.com/lazylex/watch-store-store/internal/ports/service"
+	"net/http"
+	"testing"
+)
+
+func idempotencyRecord() dto.IdempotencyRecord {
+	return dto.IdempotencyRecord{IdempotencyKey: dto.IdempotencyKey{Key: "key-1"}, Fingerprint: "abc"}
+}
+
+func TestService_BeginIdempotentRequestIncorrectKey(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+
+	mockRepo := mockrepository.NewMockInterface(ctrl)
+	s := Service{Repository: mockRepo}
+
+	_, _, err := s.BeginIdempotentRequest(context.Background(), dto.IdempotencyRecord{})
+	if !errors.Is(err, validators.ErrIncorrectIdempotencyKey) {
+		t.Fail()
+	}
+}
+
+func TestService_BeginIdempotentRequestFirst(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+
+	mockRepo := mockrepository.NewMockInterface(ctrl)
+	s := Service{Repository: mockRepo}
+	data := idempotencyRecord()
+
+	mockRepo.EXPECT().DeleteExpiredIdempotencyRecords(context.Background(), gomock.Any()).Times(1).Return(nil)
+	mockRepo.EXPECT().CreateIdempotencyRecord(context.Background(), &data).Times(1).Return(nil)
+
+	_, replay, err := s.BeginIdempotentRequest(context.Background(), data)
+	if err != nil || replay {
+		t.Fail()
+	}
+}
+
+func TestService_BeginIdempotentRequestReplay(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+
+	mockRepo := mockrepository.NewMockInterface(ctrl)
+	s := Service{Repository: mockRepo}
+	data := idempotencyRecord()
+	stored := data
+	stored.Completed, stored.Status, stored.Body = true, http.StatusCreated, []byte("{\"receipt_id\":5}")
+
+	mockRepo.EXPECT().DeleteExpiredIdempotencyRecords(gomock.Any(), gomock.Any()).Times(1).Return(nil)
+	mockRepo.EXPECT().CreateIdempotencyRecord(gomock.Any(), gomock.Any()).Times(1).Return(repository.ErrDuplicate)
+	mockRepo.EXPECT().ReadIdempotencyRecord(context.Background(), &data.IdempotencyKey).Times(1).Return(stored, nil)
+
+	result, replay, err := s.BeginIdempotentRequest(context.Background(), data)
+	if err != nil || !replay || result.Status != http.StatusCreated || string(result.Body) != string(stored.Body) {
+		t.Fail()
+	}
+}
+
+func TestService_BeginIdempotentRequestInProgress(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+
+	mockRepo := mockrepository.NewMockInterface(ctrl)
+	s := Service{Repository: mockRepo}
+	data := idempotencyRecord()
+
+	mockRepo.EXPECT().DeleteExpiredIdempotencyRecords(gomock.Any(), gomock.Any()).Times(1).Return(nil)
+	mockRepo.EXPECT().CreateIdempotencyRecord(gomock.Any(), gomock.Any()).Times(1).Return(repository.ErrDuplicate)
+	mockRepo.EXPECT().ReadIdempotencyRecord(gomock.Any(), gomock.Any()).Times(1).Return(data, nil)
+
+	_, _, err := s.BeginIdempotentRequest(context.Background(), data)
+	if !errors.Is(err, service.ErrRequestInProgress) {
+		t.Fail()
+	}
+}
+
+func TestService_BeginIdempotentRequestKeyReused(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+
+	mockRepo := mockrepository.NewMockInterface(ctrl)
+	s := Service{Repository: mockRepo}
+	data := idempotencyRecord()
+	stored := data
+	stored.Fingerprint, stored.Completed = "def", true
+
+	mockRepo.EXPECT().DeleteExpiredIdempotencyRecords(gomock.Any(), gomock.Any()).Times(1).Return(nil)
+	mockRepo.EXPECT().CreateIdempotencyRecord(gomock.Any(), gomock.Any()).Times(1).Return(repository.ErrDuplicate)
+	mockRepo.EXPECT().ReadIdempotencyRecord(gomock.Any(), gomock.Any()).Times(1).Return(stored, nil)
+
+	_, _, err := s.BeginIdempotentRequest(context.Background(), data)
+	if !errors.Is(err, service.ErrIdempotencyKeyReused) {
+		t.Fail()
+	}
+}
+
+func TestService_CompleteIdempotentRequest(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+
+	mockRepo := mockrepository.NewMockInterface(ctrl)
+	s := Service{Repository: mockRepo}
+	data := idempotencyRecord()
+	data.Status = http.StatusCreated
+
+	mockRepo.EXPECT().UpdateIdempotencyRecord(context.Background(), gomock.Any()).Times(1).DoAndReturn(
+		func(_ context.Context, record *dto.IdempotencyRecord) error {
+			if !record.Completed || record.Status != http.StatusCreated {
+				t.Fail()
+			}
+			return nil
+		})
+
+	if err := s.CompleteIdempotentRequest(context.Background(), data); err != nil {
+		t.Fail()
+	}
+}
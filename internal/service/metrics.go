@@ -0,0 +1,89 @@
+package service
+
+import (
+	"context"
+	"github.com/lazylex/watch-store-store/internal/domain/aggregates/reservation"
+	"github.com/lazylex/watch-store-store/internal/domain/value_objects/channel"
+	"github.com/lazylex/watch-store-store/internal/dto"
+	"github.com/lazylex/watch-store-store/internal/logger"
+	"log/slog"
+	"time"
+)
+
+// observeSale передаёт в метрики выручку и количество проданных по чеку товаров, а также платежи по чеку. Вызывается
+// после фиксации транзакции продажи.
+func (s *Service) observeSale(ch channel.Channel, check *dto.Receipt) {
+	if s.Metrics == nil || s.Metrics.Service == nil {
+		return
+	}
+
+	var units uint
+	for _, p := range check.Products {
+		units += p.Amount
+	}
+	s.Metrics.Service.SalesAdd(ch, check.Total, units)
+
+	for i := range check.Payments {
+		s.Metrics.Service.PaymentsAdd(check.Payments[i].Method, check.Payments[i].Net())
+	}
+}
+
+// saleChannel возвращает канал продаж выполняемого заказа, находившегося в состоянии state. Заказ, оформленный на
+// кассе, относится к продажам на кассе, заказ, отложенный в магазине или собранный для самовывоза, - к самовывозу,
+// остальные заказы - к интернет-продажам.
+func saleChannel(state reservation.State) channel.Channel {
+	switch {
+	case state == reservation.NewForCashRegister:
+		return channel.Register
+	case state == reservation.NewForLocalCustomer || state == reservation.ReadyForPickup:
+		return channel.LocalPickup
+	default:
+		return channel.Internet
+	}
+}
+
+// RefreshGauges обновляет метрики открытых заказов и зарезервированного товара, а если включён сбор метрики
+// количества товаров (StockLevelMetrics), то и её.
+func (s *Service) RefreshGauges(ctx context.Context) error {
+	if s.Metrics == nil || s.Metrics.Service == nil {
+		return nil
+	}
+
+	reservations, err := s.Repository.ReadOpenReservationsByState(ctx)
+	if err != nil {
+		return err
+	}
+	s.Metrics.Service.OpenReservationsSet(reservations)
+
+	if !s.StockLevelMetrics {
+		return nil
+	}
+
+	stock, err := s.Repository.ReadStockAmounts(ctx)
+	if err != nil {
+		return err
+	}
+	s.Metrics.Service.StockLevelsSet(stock)
+
+	return nil
+}
+
+// WatchGauges при запуске и далее с периодичностью interval обновляет метрики, рассчитываемые по данным хранилища
+// (см. RefreshGauges). Предназначена для запуска в отдельной горутине.
+func (s *Service) WatchGauges(interval time.Duration) {
+	log := slog.With(slog.String(logger.OPLabel, "service.WatchGauges"))
+	if interval <= 0 {
+		log.Error("gauges refresh interval must be positive")
+		return
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		if err := s.RefreshGauges(context.Background()); err != nil {
+			log.Error("failed to refresh gauges: " + err.Error())
+		}
+		<-ticker.C
+	}
+}
@@ -0,0 +1,209 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"github.com/golang/mock/gomock"
+	"github.com/lazylex/watch-store-store/internal/domain/aggregates/receipt"
+	"github.com/lazylex/watch-store-store/internal/domain/aggregates/reservation"
+	"github.com/lazylex/watch-store-store/internal/domain/value_objects/channel"
+	"github.com/lazylex/watch-store-store/internal/domain/value_objects/payment"
+	"github.com/lazylex/watch-store-store/internal/dto"
+	"github.com/lazylex/watch-store-store/internal/metrics"
+	mockService "github.com/lazylex/watch-store-store/internal/ports/metrics/service/mocks"
+	"github.com/lazylex/watch-store-store/internal/ports/repository"
+	mockrepository "github.com/lazylex/watch-store-store/internal/ports/repository/mocks"
+	"testing"
+	"time"
+)
+
+func TestService_MakeSaleObservesRegisterSales(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+
+	mockRepo := mockrepository.NewMockInterface(ctrl)
+	mockServiceMetrics := mockService.NewMockMetricsInterface(ctrl)
+	data := dto.CashRegisterProducts{CashRegister: 1, PaymentMethod: payment.Cash,
+		Products: []dto.ArticlePriceAmount{{Article: "test-9", Price: 410, Amount: 10}}}
+	s := Service{Repository: mockRepo, Metrics: &metrics.Metrics{Service: mockServiceMetrics}}
+	ctx := context.WithValue(context.Background(), mockrepository.ExecuteKey{}, "✅")
+	expectNoSerials(mockRepo, ctx)
+
+	expectCashRegister(mockRepo, ctx, 1)
+	mockRepo.EXPECT().ReadOpenShift(ctx, &dto.CashRegister{CashRegister: 1}).Times(1).Return(
+		dto.Shift{ID: 7, CashRegister: 1}, nil)
+	mockRepo.EXPECT().ReadStockAmount(ctx, &dto.Article{Article: "test-9"}).Times(1).Return(uint(12), nil)
+	mockRepo.EXPECT().UpdateStockAmount(ctx, &dto.ArticleAmount{Article: "test-9", Amount: 2}).Times(1).Return(nil)
+	mockRepo.EXPECT().ReadStockLocations(ctx, &dto.Article{Article: "test-9"}).Times(1).Return(nil, nil)
+	expectNoTaxRates(mockRepo, ctx)
+	expectNoWarranties(mockRepo, ctx)
+	mockRepo.EXPECT().CreateReceipt(ctx, gomock.Any()).Times(1).Return(receipt.ID(1), nil)
+	mockServiceMetrics.EXPECT().SalesAdd(channel.Register, float64(4100), uint(10)).Times(1)
+	mockServiceMetrics.EXPECT().PaymentsAdd(payment.Cash, float64(4100)).Times(1)
+
+	if _, err := s.MakeSale(ctx, data); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestService_MakeSaleObservesSplitPayments(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+
+	mockRepo := mockrepository.NewMockInterface(ctrl)
+	mockServiceMetrics := mockService.NewMockMetricsInterface(ctrl)
+	data := dto.CashRegisterProducts{CashRegister: 1,
+		Payments: []dto.Payment{{Method: payment.Card, Amount: 100}, {Method: payment.Cash, Amount: 350}},
+		Products: []dto.ArticlePriceAmount{{Article: "test-9", Price: 410, Amount: 1}}}
+	s := Service{Repository: mockRepo, Metrics: &metrics.Metrics{Service: mockServiceMetrics}}
+	ctx := context.WithValue(context.Background(), mockrepository.ExecuteKey{}, "✅")
+	expectNoSerials(mockRepo, ctx)
+
+	expectCashRegister(mockRepo, ctx, 1)
+	mockRepo.EXPECT().ReadOpenShift(ctx, &dto.CashRegister{CashRegister: 1}).Times(1).Return(
+		dto.Shift{ID: 7, CashRegister: 1}, nil)
+	mockRepo.EXPECT().ReadStockAmount(ctx, &dto.Article{Article: "test-9"}).Times(1).Return(uint(12), nil)
+	mockRepo.EXPECT().UpdateStockAmount(ctx, &dto.ArticleAmount{Article: "test-9", Amount: 11}).Times(1).Return(nil)
+	mockRepo.EXPECT().ReadStockLocations(ctx, &dto.Article{Article: "test-9"}).Times(1).Return(nil, nil)
+	expectNoTaxRates(mockRepo, ctx)
+	expectNoWarranties(mockRepo, ctx)
+	mockRepo.EXPECT().CreateReceipt(ctx, gomock.Any()).Times(1).Return(receipt.ID(1), nil)
+	mockServiceMetrics.EXPECT().SalesAdd(channel.Register, float64(410), uint(1)).Times(1)
+	mockServiceMetrics.EXPECT().PaymentsAdd(payment.Card, float64(100)).Times(1)
+	mockServiceMetrics.EXPECT().PaymentsAdd(payment.Cash, float64(310)).Times(1)
+
+	if _, err := s.MakeSale(ctx, data); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestService_MakeSaleErrNoSalesObserved(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+
+	mockRepo := mockrepository.NewMockInterface(ctrl)
+	mockServiceMetrics := mockService.NewMockMetricsInterface(ctrl)
+	data := dto.CashRegisterProducts{CashRegister: 1, PaymentMethod: payment.Cash,
+		Products: []dto.ArticlePriceAmount{{Article: "test-9", Price: 410, Amount: 10}}}
+	s := Service{Repository: mockRepo, Metrics: &metrics.Metrics{Service: mockServiceMetrics}}
+	ctx := context.WithValue(context.Background(), mockrepository.ExecuteKey{}, "✅")
+
+	expectCashRegister(mockRepo, ctx, 1)
+	mockRepo.EXPECT().ReadOpenShift(ctx, &dto.CashRegister{CashRegister: 1}).Times(1).Return(
+		dto.Shift{ID: 7, CashRegister: 1}, nil)
+	mockRepo.EXPECT().ReadStockAmount(ctx, &dto.Article{Article: "test-9"}).Times(1).Return(uint(5), nil)
+	mockServiceMetrics.EXPECT().SalesAdd(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+	if _, err := s.MakeSale(ctx, data); err == nil {
+		t.Fail()
+	}
+}
+
+func TestService_FinishOrderObservesLocalPickupSales(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+
+	mockRepo := mockrepository.NewMockInterface(ctrl)
+	mockServiceMetrics := mockService.NewMockMetricsInterface(ctrl)
+	s := Service{Repository: mockRepo, Metrics: &metrics.Metrics{Service: mockServiceMetrics}}
+	ctx := context.WithValue(context.Background(), mockrepository.ExecuteKey{}, "✅")
+	expectNoSerials(mockRepo, ctx)
+	data := dto.NumberPaymentMethod{OrderNumber: 11, PaymentMethod: payment.Card}
+	resData := dto.NumberDateStateProducts{
+		Products:    []dto.ArticlePriceAmount{{Article: "test-9", Price: 100, Amount: 3}},
+		OrderNumber: 11,
+		Date:        time.Time{},
+		State:       reservation.ReadyForPickup,
+	}
+
+	mockRepo.EXPECT().ReadReservation(ctx, &dto.Number{OrderNumber: data.OrderNumber}).Times(1).Return(resData, nil)
+	mockRepo.EXPECT().ReadReservationCustomer(ctx, &dto.Number{OrderNumber: data.OrderNumber}).Times(1).Return(
+		dto.ReservationCustomer{}, repository.ErrNoRecord)
+	mockRepo.EXPECT().CreateReservationTransition(ctx, gomock.Any()).Times(1).Return(nil)
+	expectNoTaxRates(mockRepo, ctx)
+	expectNoWarranties(mockRepo, ctx)
+	mockRepo.EXPECT().CreateReceipt(ctx, gomock.Any()).Times(1).Return(receipt.ID(1), nil)
+	mockRepo.EXPECT().UpdateReservation(ctx, gomock.Any()).Times(1).Return(nil)
+	mockServiceMetrics.EXPECT().SalesAdd(channel.LocalPickup, float64(300), uint(3)).Times(1)
+	mockServiceMetrics.EXPECT().PaymentsAdd(payment.Card, float64(300)).Times(1)
+
+	if _, err := s.FinishOrder(ctx, data); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestSaleChannel(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		testName string
+		state    reservation.State
+		expected channel.Channel
+	}{
+		{"cash register", reservation.NewForCashRegister, channel.Register},
+		{"local customer", reservation.NewForLocalCustomer, channel.LocalPickup},
+		{"ready for pickup", reservation.ReadyForPickup, channel.LocalPickup},
+		{"internet customer", reservation.NewForInternetCustomer, channel.Internet},
+		{"shipped", reservation.Shipped, channel.Internet},
+	}
+
+	for _, tt := range tests {
+		if ch := saleChannel(tt.state); ch != tt.expected {
+			t.Errorf("%s: expected %s, got %s", tt.testName, tt.expected, ch)
+		}
+	}
+}
+
+func TestService_RefreshGaugesWithoutStockLevel(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+
+	mockRepo := mockrepository.NewMockInterface(ctrl)
+	mockServiceMetrics := mockService.NewMockMetricsInterface(ctrl)
+	s := Service{Repository: mockRepo, Metrics: &metrics.Metrics{Service: mockServiceMetrics}}
+	reservations := []dto.StateOrdersUnits{{State: reservation.Shipped, Orders: 2, Units: 5}}
+
+	mockRepo.EXPECT().ReadOpenReservationsByState(gomock.Any()).Times(1).Return(reservations, nil)
+	mockServiceMetrics.EXPECT().OpenReservationsSet(reservations).Times(1)
+	mockRepo.EXPECT().ReadStockAmounts(gomock.Any()).Times(0)
+	mockServiceMetrics.EXPECT().StockLevelsSet(gomock.Any()).Times(0)
+
+	if err := s.RefreshGauges(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestService_RefreshGaugesWithStockLevel(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+
+	mockRepo := mockrepository.NewMockInterface(ctrl)
+	mockServiceMetrics := mockService.NewMockMetricsInterface(ctrl)
+	s := Service{Repository: mockRepo, Metrics: &metrics.Metrics{Service: mockServiceMetrics}, StockLevelMetrics: true}
+	stock := []dto.ArticleAmount{{Article: "test-9", Amount: 4}}
+
+	mockRepo.EXPECT().ReadOpenReservationsByState(gomock.Any()).Times(1).Return(nil, nil)
+	mockServiceMetrics.EXPECT().OpenReservationsSet(gomock.Any()).Times(1)
+	mockRepo.EXPECT().ReadStockAmounts(gomock.Any()).Times(1).Return(stock, nil)
+	mockServiceMetrics.EXPECT().StockLevelsSet(stock).Times(1)
+
+	if err := s.RefreshGauges(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestService_RefreshGaugesErrRead(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+
+	mockRepo := mockrepository.NewMockInterface(ctrl)
+	mockServiceMetrics := mockService.NewMockMetricsInterface(ctrl)
+	s := Service{Repository: mockRepo, Metrics: &metrics.Metrics{Service: mockServiceMetrics}, StockLevelMetrics: true}
+
+	mockRepo.EXPECT().ReadOpenReservationsByState(gomock.Any()).Times(1).Return(nil, errors.New(""))
+	mockServiceMetrics.EXPECT().OpenReservationsSet(gomock.Any()).Times(0)
+
+	if err := s.RefreshGauges(context.Background()); err == nil {
+		t.Fail()
+	}
+}
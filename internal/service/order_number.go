@@ -0,0 +1,67 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/lazylex/watch-store-store/internal/domain/aggregates/reservation"
+	"github.com/lazylex/watch-store-store/internal/dto"
+	"github.com/lazylex/watch-store-store/internal/logger"
+	"github.com/lazylex/watch-store-store/internal/ports/repository"
+	"github.com/lazylex/watch-store-store/internal/ports/service"
+	"log/slog"
+)
+
+// orderNumberAttempts количество попыток выделить номер заказа, не совпадающий с номерами, переданными клиентами.
+const orderNumberAttempts = 10
+
+// allocateOrderNumber выделяет из последовательности в БД номер заказа через интернет с префиксом экземпляра
+// приложения. Номера, уже занятые заказами с переданными клиентами номерами или кассами, пропускаются.
+func (s *Service) allocateOrderNumber(ctx context.Context) (reservation.OrderNumber, error) {
+	for range orderNumberAttempts {
+		sequence, err := s.Repository.NextOrderNumber(ctx)
+		if err != nil {
+			return 0, err
+		}
+		number, err := reservation.NewOrderNumber(s.OrderNumberPrefix, sequence)
+		if err != nil {
+			return 0, err
+		}
+
+		err = s.checkOrderNumberFree(ctx, number)
+		if errors.Is(err, service.ErrOrderNumberTaken) {
+			continue
+		}
+		if err != nil {
+			return 0, err
+		}
+
+		logger.LogWithCtxData(ctx, slog.With(logger.OPLabel, "service.allocateOrderNumber")).Info(
+			fmt.Sprintf("allocated order number %d", number))
+		return number, nil
+	}
+
+	return 0, service.ErrNoFreeOrderNumber
+}
+
+// checkOrderNumberFree возвращает service.ErrOrderNumberTaken, если заказ с переданным номером уже существует или
+// номер принадлежит зарегистрированной кассе.
+func (s *Service) checkOrderNumberFree(ctx context.Context, number reservation.OrderNumber) error {
+	_, err := s.Repository.ReadReservation(ctx, &dto.Number{OrderNumber: number})
+	switch {
+	case err == nil:
+		return service.ErrOrderNumberTaken
+	case !errors.Is(err, repository.ErrNoRecord):
+		return err
+	}
+
+	_, err = s.Repository.ReadCashRegister(ctx, &dto.CashRegister{CashRegister: number})
+	switch {
+	case err == nil:
+		return service.ErrOrderNumberTaken
+	case errors.Is(err, repository.ErrNoRecord):
+		return nil
+	default:
+		return err
+	}
+}
@@ -0,0 +1,108 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"github.com/golang/mock/gomock"
+	"github.com/lazylex/watch-store-store/internal/domain/aggregates/reservation"
+	"github.com/lazylex/watch-store-store/internal/dto"
+	"github.com/lazylex/watch-store-store/internal/metrics"
+	mockService "github.com/lazylex/watch-store-store/internal/ports/metrics/service/mocks"
+	"github.com/lazylex/watch-store-store/internal/ports/repository"
+	mockrepository "github.com/lazylex/watch-store-store/internal/ports/repository/mocks"
+	"github.com/lazylex/watch-store-store/internal/ports/service"
+	"testing"
+	"time"
+)
+
+func TestService_MakeReservationAllocatesOrderNumber(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+
+	mockRepo := mockrepository.NewMockInterface(ctrl)
+	mockServiceMetrics := mockService.NewMockMetricsInterface(ctrl)
+	data := dto.NumberDateStateProducts{
+		Products: []dto.ArticlePriceAmount{{Article: "test-9", Amount: 1, Price: 698}},
+		Date:     time.Now(),
+		State:    reservation.NewForInternetCustomer,
+	}
+	s := Service{Repository: mockRepo, Metrics: &metrics.Metrics{Service: mockServiceMetrics}, OrderNumberPrefix: 3}
+
+	ctx := context.WithValue(context.Background(), mockrepository.ExecuteKey{}, "✅")
+	expectNoSerials(mockRepo, ctx)
+	gomock.InOrder(
+		mockRepo.EXPECT().NextOrderNumber(ctx).Times(1).Return(int64(41), nil),
+		mockRepo.EXPECT().ReadReservation(ctx, &dto.Number{OrderNumber: 3_000_000_041}).Times(1).Return(
+			dto.NumberDateStateProducts{OrderNumber: 3_000_000_041}, nil),
+		mockRepo.EXPECT().NextOrderNumber(ctx).Times(1).Return(int64(42), nil),
+		mockRepo.EXPECT().ReadReservation(ctx, &dto.Number{OrderNumber: 3_000_000_042}).Times(1).Return(
+			dto.NumberDateStateProducts{}, repository.ErrNoRecord),
+		mockRepo.EXPECT().ReadCashRegister(ctx, &dto.CashRegister{CashRegister: 3_000_000_042}).Times(1).Return(
+			dto.CashRegisterRecord{}, repository.ErrNoRecord),
+	)
+	mockRepo.EXPECT().ReadStockAmount(ctx, &dto.Article{Article: "test-9"}).Times(1).Return(uint(5), nil)
+	mockRepo.EXPECT().UpdateStockAmount(ctx, gomock.Any()).Times(1).Return(nil)
+	mockRepo.EXPECT().ReadStockLocations(ctx, gomock.Any()).Times(1).Return(nil, nil)
+	mockRepo.EXPECT().CreateReservation(ctx, gomock.Any()).Times(1).DoAndReturn(
+		func(_ context.Context, r *dto.NumberDateStateProducts) error {
+			if r.OrderNumber != 3_000_000_042 {
+				t.Errorf("reservation saved with number %d", r.OrderNumber)
+			}
+			return nil
+		})
+	mockRepo.EXPECT().CreateReservationTransition(ctx, gomock.Any()).Times(1).Return(nil)
+	mockServiceMetrics.EXPECT().PlacedInternetOrdersInc().Times(1)
+
+	result, err := s.MakeReservation(ctx, data)
+	if err != nil || result.OrderNumber != 3_000_000_042 || result.PickupCode != "" {
+		t.Errorf("expected number %d, got %d, %v", 3_000_000_042, result.OrderNumber, err)
+	}
+}
+
+func TestService_MakeReservationNoFreeOrderNumber(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+
+	mockRepo := mockrepository.NewMockInterface(ctrl)
+	data := dto.NumberDateStateProducts{
+		Products: []dto.ArticlePriceAmount{{Article: "test-9", Amount: 1, Price: 698}},
+		Date:     time.Now(),
+		State:    reservation.NewForLocalCustomer,
+	}
+	s := Service{Repository: mockRepo}
+
+	ctx := context.WithValue(context.Background(), mockrepository.ExecuteKey{}, "✅")
+	mockRepo.EXPECT().NextOrderNumber(ctx).Times(orderNumberAttempts).Return(int64(11), nil)
+	mockRepo.EXPECT().ReadReservation(ctx, gomock.Any()).Times(orderNumberAttempts).Return(
+		dto.NumberDateStateProducts{OrderNumber: 11}, nil)
+	mockRepo.EXPECT().ReadStockAmount(gomock.Any(), gomock.Any()).Times(0)
+	mockRepo.EXPECT().CreateReservation(gomock.Any(), gomock.Any()).Times(0)
+
+	if _, err := s.MakeReservation(ctx, data); !errors.Is(err, service.ErrNoFreeOrderNumber) {
+		t.Errorf("expected %v, got %v", service.ErrNoFreeOrderNumber, err)
+	}
+}
+
+func TestService_MakeReservationOrderNumberTaken(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+
+	mockRepo := mockrepository.NewMockInterface(ctrl)
+	data := dto.NumberDateStateProducts{
+		Products:    []dto.ArticlePriceAmount{{Article: "test-9", Amount: 1, Price: 698}},
+		OrderNumber: 466,
+		Date:        time.Now(),
+		State:       reservation.NewForInternetCustomer,
+	}
+	s := Service{Repository: mockRepo}
+
+	ctx := context.WithValue(context.Background(), mockrepository.ExecuteKey{}, "✅")
+	mockRepo.EXPECT().NextOrderNumber(gomock.Any()).Times(0)
+	mockRepo.EXPECT().ReadReservation(ctx, &dto.Number{OrderNumber: 466}).Times(1).Return(
+		dto.NumberDateStateProducts{OrderNumber: 466}, nil)
+	mockRepo.EXPECT().CreateReservation(gomock.Any(), gomock.Any()).Times(0)
+
+	if _, err := s.MakeReservation(ctx, data); !errors.Is(err, service.ErrOrderNumberTaken) {
+		t.Errorf("expected %v, got %v", service.ErrOrderNumberTaken, err)
+	}
+}
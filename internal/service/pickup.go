@@ -0,0 +1,81 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/lazylex/watch-store-store/internal/domain/aggregates/reservation"
+	"github.com/lazylex/watch-store-store/internal/domain/value_objects/pickup"
+	"github.com/lazylex/watch-store-store/internal/dto"
+	"github.com/lazylex/watch-store-store/internal/logger"
+	"github.com/lazylex/watch-store-store/internal/ports/repository"
+	"github.com/lazylex/watch-store-store/internal/ports/service"
+	"log/slog"
+)
+
+// OpenReservationsByPhone возвращает невыполненные и неотменённые заказы покупателя с переданным номером телефона
+// вместе с данными покупателя. Номер телефона может быть передан в любом формате (с пробелами, скобками и дефисами).
+func (s *Service) OpenReservationsByPhone(ctx context.Context, data dto.Phone) ([]dto.NumberDateStateProducts,
+	error) {
+	if err := data.Validate(); err != nil {
+		return nil, err
+	}
+	data.Phone = data.Phone.Normalized()
+
+	result, err := s.Repository.ReadOpenReservationsByPhone(ctx, &data)
+	if err != nil {
+		return nil, err
+	}
+
+	logger.LogWithCtxData(ctx, slog.With(logger.OPLabel, "service.OpenReservationsByPhone")).Info(
+		fmt.Sprintf("requested open orders of phone %s, %d found", data.Phone.Redacted(), len(result)))
+
+	return result, nil
+}
+
+// isPickupState возвращает true, если заказ в состоянии state выдаётся покупателю в магазине.
+func isPickupState(state reservation.State) bool {
+	return state == reservation.NewForLocalCustomer || state == reservation.ReadyForPickup
+}
+
+// pickupCodeSalt возвращает соль, с которой вычисляется хэш кода получения заказа с номером number.
+func pickupCodeSalt(number reservation.OrderNumber) string {
+	return numberKey(number)
+}
+
+// saveReservationCustomer сохраняет данные покупателя и хэш кода получения заказа, если они есть.
+func (s *Service) saveReservationCustomer(ctx context.Context, data *dto.NumberDateStateProducts,
+	code pickup.Code) error {
+	if data.Customer == nil && code == "" {
+		return nil
+	}
+
+	record := dto.ReservationCustomer{OrderNumber: data.OrderNumber, Customer: data.Customer}
+	if code != "" {
+		record.PickupCodeHash = code.Hash(pickupCodeSalt(data.OrderNumber))
+	}
+
+	return s.Repository.CreateReservationCustomer(ctx, &record)
+}
+
+// checkPickupCode проверяет код получения заказа. Если хэш кода для заказа не сохранялся (заказ оформлен до появления
+// кодов), проверка не выполняется. При несовпадении кода возвращается service.ErrPickupCodeMismatch.
+func (s *Service) checkPickupCode(ctx context.Context, number reservation.OrderNumber, code pickup.Code) error {
+	customer, err := s.Repository.ReadReservationCustomer(ctx, &dto.Number{OrderNumber: number})
+	switch {
+	case errors.Is(err, repository.ErrNoRecord):
+		return nil
+	case err != nil:
+		return err
+	case customer.PickupCodeHash == "":
+		return nil
+	}
+
+	if !code.Matches(customer.PickupCodeHash, pickupCodeSalt(number)) {
+		logger.LogWithCtxData(ctx, slog.With(logger.OPLabel, "service.checkPickupCode")).Warn(
+			fmt.Sprintf("wrong pickup code for order %d", number))
+		return service.ErrPickupCodeMismatch
+	}
+
+	return nil
+}
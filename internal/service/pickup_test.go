@@ -0,0 +1,173 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"github.com/golang/mock/gomock"
+	"github.com/lazylex/watch-store-store/internal/domain/aggregates/receipt"
+	"github.com/lazylex/watch-store-store/internal/domain/aggregates/reservation"
+	"github.com/lazylex/watch-store-store/internal/domain/value_objects/pickup"
+	"github.com/lazylex/watch-store-store/internal/dto"
+	"github.com/lazylex/watch-store-store/internal/dto/validators"
+	"github.com/lazylex/watch-store-store/internal/metrics"
+	mockMetrics "github.com/lazylex/watch-store-store/internal/ports/metrics/service/mocks"
+	"github.com/lazylex/watch-store-store/internal/ports/repository"
+	mockrepository "github.com/lazylex/watch-store-store/internal/ports/repository/mocks"
+	"github.com/lazylex/watch-store-store/internal/ports/service"
+	"testing"
+	"time"
+)
+
+func TestService_MakeReservationSavesNormalizedCustomer(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+
+	mockRepo := mockrepository.NewMockInterface(ctrl)
+	mockServiceMetrics := mockMetrics.NewMockMetricsInterface(ctrl)
+	s := Service{Repository: mockRepo, Metrics: &metrics.Metrics{Service: mockServiceMetrics}}
+	ctx := context.WithValue(context.Background(), mockrepository.ExecuteKey{}, "✅")
+	expectNoSerials(mockRepo, ctx)
+	customer := &dto.Customer{Name: " Иван Петров ", Phone: "+7 (912) 345-67-89", Email: "Ivan@Example.com"}
+	data := dto.NumberDateStateProducts{
+		Products: []dto.ArticlePriceAmount{{Article: "test-9", Amount: 1, Price: 698}},
+		Date:     time.Now(),
+		State:    reservation.NewForInternetCustomer,
+		Customer: customer,
+	}
+
+	mockRepo.EXPECT().NextOrderNumber(ctx).Times(1).Return(int64(5), nil)
+	mockRepo.EXPECT().ReadReservation(ctx, gomock.Any()).Times(1).Return(dto.NumberDateStateProducts{},
+		repository.ErrNoRecord)
+	mockRepo.EXPECT().ReadCashRegister(ctx, gomock.Any()).Times(1).Return(dto.CashRegisterRecord{},
+		repository.ErrNoRecord)
+	mockRepo.EXPECT().ReadStockAmount(ctx, gomock.Any()).Times(1).Return(uint(5), nil)
+	mockRepo.EXPECT().UpdateStockAmount(ctx, gomock.Any()).Times(1).Return(nil)
+	mockRepo.EXPECT().ReadStockLocations(ctx, gomock.Any()).Times(1).Return(nil, nil)
+	mockRepo.EXPECT().CreateReservation(ctx, gomock.Any()).Times(1).Return(nil)
+	mockRepo.EXPECT().CreateReservationTransition(ctx, gomock.Any()).Times(1).Return(nil)
+	mockRepo.EXPECT().CreateReservationCustomer(ctx, gomock.Any()).Times(1).DoAndReturn(
+		func(_ context.Context, record *dto.ReservationCustomer) error {
+			if record.Customer == nil || record.Customer.Phone != "79123456789" ||
+				record.Customer.Name != "Иван Петров" || record.PickupCodeHash != "" {
+				t.Errorf("unexpected customer record %+v", record)
+			}
+			return nil
+		})
+	mockServiceMetrics.EXPECT().PlacedInternetOrdersInc().Times(1)
+
+	result, err := s.MakeReservation(ctx, data)
+	if err != nil || result.PickupCode != "" {
+		t.Errorf("unexpected result %+v, %v", result, err)
+	}
+	if customer.Phone != "+7 (912) 345-67-89" {
+		t.Error("customer of caller was modified")
+	}
+}
+
+func TestService_FinishOrderPickupCode(t *testing.T) {
+	t.Parallel()
+	code := pickup.Code("402913")
+	hash := code.Hash(pickupCodeSalt(13))
+
+	tests := []struct {
+		testName string
+		code     pickup.Code
+		err      error
+	}{
+		{"wrong code", "111111", service.ErrPickupCodeMismatch},
+		{"no code", "", service.ErrPickupCodeMismatch},
+		{"right code", code, nil},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.testName, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+
+			mockRepo := mockrepository.NewMockInterface(ctrl)
+			mockServiceMetrics := mockMetrics.NewMockMetricsInterface(ctrl)
+			s := Service{Repository: mockRepo, Metrics: &metrics.Metrics{Service: mockServiceMetrics}}
+			ctx := context.WithValue(context.Background(), mockrepository.ExecuteKey{}, "✅")
+			data := dto.NumberPaymentMethod{OrderNumber: 13, PaymentMethod: "cash", PickupCode: tt.code}
+
+			mockRepo.EXPECT().ReadReservation(ctx, &dto.Number{OrderNumber: 13}).Times(1).Return(
+				dto.NumberDateStateProducts{OrderNumber: 13, State: reservation.ReadyForPickup,
+					Products: []dto.ArticlePriceAmount{{Article: "test-9", Price: 100, Amount: 1}}}, nil)
+			mockRepo.EXPECT().ReadReservationCustomer(ctx, &dto.Number{OrderNumber: 13}).Times(1).Return(
+				dto.ReservationCustomer{OrderNumber: 13, PickupCodeHash: hash}, nil)
+			if tt.err == nil {
+				mockRepo.EXPECT().CreateReservationTransition(ctx, gomock.Any()).Times(1).Return(nil)
+				expectNoTaxRates(mockRepo, ctx)
+				expectNoWarranties(mockRepo, ctx)
+				mockRepo.EXPECT().CreateReceipt(ctx, gomock.Any()).Times(1).Return(receipt.ID(1), nil)
+				expectNoSerials(mockRepo, ctx)
+				mockRepo.EXPECT().UpdateReservation(ctx, gomock.Any()).Times(1).Return(nil)
+				mockServiceMetrics.EXPECT().SalesAdd(gomock.Any(), gomock.Any(), gomock.Any()).Times(1)
+				mockServiceMetrics.EXPECT().PaymentsAdd(gomock.Any(), gomock.Any()).Times(1)
+			}
+
+			if _, err := s.FinishOrder(ctx, data); !errors.Is(err, tt.err) {
+				t.Errorf("expected %v, got %v", tt.err, err)
+			}
+		})
+	}
+}
+
+func TestService_FinishOrderInternetWithoutPickupCode(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+
+	mockRepo := mockrepository.NewMockInterface(ctrl)
+	mockServiceMetrics := mockMetrics.NewMockMetricsInterface(ctrl)
+	s := Service{Repository: mockRepo, Metrics: &metrics.Metrics{Service: mockServiceMetrics}}
+	ctx := context.WithValue(context.Background(), mockrepository.ExecuteKey{}, "✅")
+	expectNoSerials(mockRepo, ctx)
+
+	mockRepo.EXPECT().ReadReservation(ctx, &dto.Number{OrderNumber: 14}).Times(1).Return(
+		dto.NumberDateStateProducts{OrderNumber: 14, State: reservation.Shipped,
+			Products: []dto.ArticlePriceAmount{{Article: "test-9", Price: 100, Amount: 1}}}, nil)
+	mockRepo.EXPECT().ReadReservationCustomer(gomock.Any(), gomock.Any()).Times(0)
+	mockRepo.EXPECT().CreateReservationTransition(ctx, gomock.Any()).Times(1).Return(nil)
+	expectNoTaxRates(mockRepo, ctx)
+	expectNoWarranties(mockRepo, ctx)
+	mockRepo.EXPECT().CreateReceipt(ctx, gomock.Any()).Times(1).Return(receipt.ID(2), nil)
+	mockRepo.EXPECT().UpdateReservation(ctx, gomock.Any()).Times(1).Return(nil)
+	mockServiceMetrics.EXPECT().SalesAdd(gomock.Any(), gomock.Any(), gomock.Any()).Times(1)
+	mockServiceMetrics.EXPECT().PaymentsAdd(gomock.Any(), gomock.Any()).Times(1)
+
+	if _, err := s.FinishOrder(ctx, dto.NumberPaymentMethod{OrderNumber: 14}); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestService_OpenReservationsByPhone(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+
+	mockRepo := mockrepository.NewMockInterface(ctrl)
+	s := Service{Repository: mockRepo}
+	expected := []dto.NumberDateStateProducts{{OrderNumber: 13, State: reservation.ReadyForPickup}}
+
+	mockRepo.EXPECT().ReadOpenReservationsByPhone(gomock.Any(), &dto.Phone{Phone: "79123456789"}).Times(1).Return(
+		expected, nil)
+
+	result, err := s.OpenReservationsByPhone(context.Background(), dto.Phone{Phone: "+7 (912) 345-67-89"})
+	if err != nil || len(result) != 1 || result[0].OrderNumber != 13 {
+		t.Errorf("unexpected result %v, %v", result, err)
+	}
+}
+
+func TestService_OpenReservationsByPhoneIncorrect(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+
+	mockRepo := mockrepository.NewMockInterface(ctrl)
+	s := Service{Repository: mockRepo}
+	mockRepo.EXPECT().ReadOpenReservationsByPhone(gomock.Any(), gomock.Any()).Times(0)
+
+	if _, err := s.OpenReservationsByPhone(context.Background(), dto.Phone{Phone: "12-34"}); !errors.Is(err,
+		validators.ErrIncorrectPhone) {
+		t.Errorf("unexpected error %v", err)
+	}
+}
@@ -0,0 +1,144 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"github.com/lazylex/watch-store-store/internal/domain/aggregates/refund"
+	"github.com/lazylex/watch-store-store/internal/domain/event"
+	"github.com/lazylex/watch-store-store/internal/domain/value_objects/article"
+	"github.com/lazylex/watch-store-store/internal/domain/value_objects/payment"
+	"github.com/lazylex/watch-store-store/internal/domain/value_objects/serial"
+	"github.com/lazylex/watch-store-store/internal/dto"
+	"github.com/lazylex/watch-store-store/internal/logger"
+	"github.com/lazylex/watch-store-store/internal/ports/service"
+	"log/slog"
+	"time"
+)
+
+// ReturnSale оформляет возврат товаров, проданных по чеку. Возвращаемое количество не может превышать проданное с
+// учётом уже оформленных по чеку возвратов. Товары возвращаются в продажу, деньги возвращаются по цене из чека тем же
+// способом, которым чек был оплачен (по чеку, оплаченному несколькими способами, - наличными). Возврат относится к
+// открытой на кассе смене. Для товаров, учитываемых по серийным номерам, передаются номера возвращаемых экземпляров,
+// проданных по этому чеку. Гарантии на возвращённый товар аннулируются.
+func (s *Service) ReturnSale(ctx context.Context, data dto.Refund) (refund.ID, error) {
+	var id refund.ID
+	var returned dto.RefundRecord
+
+	if err := data.Validate(); err != nil {
+		return 0, err
+	}
+
+	err := s.Repository.WithinTransaction(ctx, func(txCtx context.Context) error {
+		var check dto.Receipt
+		var refunded []dto.ArticleAmount
+		var inStock uint
+		var picked []dto.SerialRecord
+
+		shiftID, err := s.openShiftID(txCtx, data.CashRegister)
+		if err != nil {
+			return err
+		}
+
+		receiptID := dto.ReceiptID{ID: data.ReceiptID}
+		if check, err = s.Repository.ReadReceipt(txCtx, &receiptID); err != nil {
+			return err
+		}
+		if refunded, err = s.Repository.ReadRefundedProducts(txCtx, &receiptID); err != nil {
+			return err
+		}
+
+		sold := make(map[article.Article]dto.ArticlePriceAmount)
+		for _, p := range check.Products {
+			sold[p.Article] = addReceiptLine(sold[p.Article], p)
+		}
+		for _, p := range refunded {
+			if product, ok := sold[p.Article]; ok {
+				product.Amount -= min(product.Amount, p.Amount)
+				sold[p.Article] = product
+			}
+		}
+
+		record := dto.RefundRecord{
+			ReceiptID:     data.ReceiptID,
+			ShiftID:       shiftID,
+			CashRegister:  data.CashRegister,
+			PaymentMethod: check.PaymentMethod,
+			Date:          time.Now(),
+		}
+		if record.PaymentMethod == payment.Mixed {
+			record.PaymentMethod = payment.Cash
+		}
+
+		for _, p := range data.Products {
+			product, ok := sold[p.Article]
+			if !ok {
+				return service.ErrProductNotInReceipt
+			}
+			if p.Amount > product.Amount {
+				return service.ErrRefundExceedsSold
+			}
+
+			if inStock, err = s.Repository.ReadStockAmount(txCtx, &dto.Article{Article: p.Article}); err != nil {
+				return err
+			}
+			if err = s.Repository.UpdateStockAmount(txCtx,
+				&dto.ArticleAmount{Article: p.Article, Amount: inStock + p.Amount}); err != nil {
+				return err
+			}
+
+			record.Products = append(record.Products,
+				dto.ArticlePriceAmount{Article: p.Article, Price: product.Price, Amount: p.Amount})
+			record.Total += product.Price * float64(p.Amount)
+		}
+
+		amounts := make(map[article.Article]uint, len(data.Products))
+		for _, p := range data.Products {
+			amounts[p.Article] = p.Amount
+		}
+		if picked, err = s.pickSerials(txCtx, amounts, data.Serials, func(r dto.SerialRecord) bool {
+			return r.State == serial.Sold && r.ReceiptID == data.ReceiptID
+		}); err != nil {
+			return err
+		}
+
+		if id, err = s.Repository.CreateRefund(txCtx, &record); err != nil {
+			return err
+		}
+		record.ID = id
+
+		if err = s.moveSerials(txCtx, picked, dto.SerialEvent{State: serial.InStock, ReceiptID: data.ReceiptID,
+			RefundID: id}); err != nil {
+			return err
+		}
+		if err = s.voidWarranties(txCtx, data.ReceiptID, data.Products, picked, record.Date); err != nil {
+			return err
+		}
+		returned = record
+
+		logger.LogWithCtxData(txCtx, slog.With(logger.OPLabel, "service.ReturnSale")).Info(
+			fmt.Sprintf("refund %d on receipt %d completed, total %.2f", id, data.ReceiptID, record.Total))
+
+		return nil
+	})
+
+	if err != nil {
+		return 0, err
+	}
+
+	s.emit(ctx, event.SaleReturned, numberKey(data.ReceiptID), returned)
+	return id, nil
+}
+
+// addReceiptLine добавляет к проданному по чеку товару ещё одну строку чека с тем же артикулом (такие строки могут быть
+// в чеках, сохранённых до запрета повторяющихся товаров в продаже). Цена объединённой строки - средняя цена единицы
+// товара, поэтому сумма возврата всего проданного товара совпадает с его суммой в чеке.
+func addReceiptLine(product, line dto.ArticlePriceAmount) dto.ArticlePriceAmount {
+	if product.Amount == 0 {
+		return line
+	}
+
+	amount := product.Amount + line.Amount
+	product.Price = (product.Price*float64(product.Amount) + line.Price*float64(line.Amount)) / float64(amount)
+	product.Amount = amount
+	return product
+}
@@ -0,0 +1,166 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"github.com/golang/mock/gomock"
+	"github.com/lazylex/watch-store-store/internal/domain/aggregates/refund"
+	"github.com/lazylex/watch-store-store/internal/domain/value_objects/payment"
+	"github.com/lazylex/watch-store-store/internal/dto"
+	"github.com/lazylex/watch-store-store/internal/ports/repository"
+	mockrepository "github.com/lazylex/watch-store-store/internal/ports/repository/mocks"
+	"github.com/lazylex/watch-store-store/internal/ports/service"
+	"testing"
+)
+
+func TestService_ReturnSaleIncorrectDTO(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+
+	mockRepo := mockrepository.NewMockInterface(ctrl)
+	s := Service{Repository: mockRepo}
+
+	_, err := s.ReturnSale(context.Background(), dto.Refund{ReceiptID: 1, CashRegister: 1})
+	if err == nil {
+		t.Fail()
+	}
+}
+
+func TestService_ReturnSaleNoOpenShift(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+
+	mockRepo := mockrepository.NewMockInterface(ctrl)
+	s := Service{Repository: mockRepo}
+	ctx := context.WithValue(context.Background(), mockrepository.ExecuteKey{}, "✅")
+	data := dto.Refund{ReceiptID: 1, CashRegister: 1, Products: []dto.ArticleAmount{{Article: "test-9", Amount: 1}}}
+
+	expectCashRegister(mockRepo, ctx, 1)
+	mockRepo.EXPECT().ReadOpenShift(ctx, &dto.CashRegister{CashRegister: 1}).Times(1).Return(dto.Shift{},
+		repository.ErrNoRecord)
+
+	_, err := s.ReturnSale(ctx, data)
+	if !errors.Is(err, service.ErrNoOpenShift) {
+		t.Fail()
+	}
+}
+
+func TestService_ReturnSaleSuccess(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+
+	mockRepo := mockrepository.NewMockInterface(ctrl)
+	s := Service{Repository: mockRepo}
+	ctx := context.WithValue(context.Background(), mockrepository.ExecuteKey{}, "✅")
+	expectNoSerials(mockRepo, ctx)
+	expectNoWarranties(mockRepo, ctx)
+	data := dto.Refund{ReceiptID: 1, CashRegister: 1, Products: []dto.ArticleAmount{{Article: "test-9", Amount: 1}}}
+	receiptID := dto.ReceiptID{ID: 1}
+
+	expectCashRegister(mockRepo, ctx, 1)
+	mockRepo.EXPECT().ReadOpenShift(ctx, &dto.CashRegister{CashRegister: 1}).Times(1).Return(
+		dto.Shift{ID: 5, CashRegister: 1}, nil)
+	mockRepo.EXPECT().ReadReceipt(ctx, &receiptID).Times(1).Return(dto.Receipt{ID: 1, PaymentMethod: payment.Card,
+		Products: []dto.ArticlePriceAmount{{Article: "test-9", Price: 100, Amount: 3}}}, nil)
+	mockRepo.EXPECT().ReadRefundedProducts(ctx, &receiptID).Times(1).Return(
+		[]dto.ArticleAmount{{Article: "test-9", Amount: 2}}, nil)
+	mockRepo.EXPECT().ReadStockAmount(ctx, &dto.Article{Article: "test-9"}).Times(1).Return(uint(4), nil)
+	mockRepo.EXPECT().UpdateStockAmount(ctx, &dto.ArticleAmount{Article: "test-9", Amount: 5}).Times(1).Return(nil)
+	mockRepo.EXPECT().CreateRefund(ctx, gomock.Any()).Times(1).DoAndReturn(
+		func(_ context.Context, r *dto.RefundRecord) (refund.ID, error) {
+			if r.ShiftID != 5 || r.Total != 100 || r.PaymentMethod != payment.Card || len(r.Products) != 1 {
+				t.Fail()
+			}
+			return refund.ID(9), nil
+		})
+
+	id, err := s.ReturnSale(ctx, data)
+	if err != nil || id != 9 {
+		t.Fail()
+	}
+}
+
+func TestService_ReturnSaleDuplicateReceiptLines(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+
+	mockRepo := mockrepository.NewMockInterface(ctrl)
+	s := Service{Repository: mockRepo}
+	ctx := context.WithValue(context.Background(), mockrepository.ExecuteKey{}, "✅")
+	expectNoSerials(mockRepo, ctx)
+	expectNoWarranties(mockRepo, ctx)
+	data := dto.Refund{ReceiptID: 1, CashRegister: 1, Products: []dto.ArticleAmount{{Article: "test-9", Amount: 3}}}
+	receiptID := dto.ReceiptID{ID: 1}
+
+	// в чеке две строки одного товара - возвращается товар обеих строк
+	expectCashRegister(mockRepo, ctx, 1)
+	mockRepo.EXPECT().ReadOpenShift(ctx, &dto.CashRegister{CashRegister: 1}).Times(1).Return(
+		dto.Shift{ID: 5, CashRegister: 1}, nil)
+	mockRepo.EXPECT().ReadReceipt(ctx, &receiptID).Times(1).Return(dto.Receipt{ID: 1, PaymentMethod: payment.Card,
+		Products: []dto.ArticlePriceAmount{
+			{Article: "test-9", Price: 100, Amount: 1},
+			{Article: "test-9", Price: 130, Amount: 2},
+		}}, nil)
+	mockRepo.EXPECT().ReadRefundedProducts(ctx, &receiptID).Times(1).Return(nil, nil)
+	mockRepo.EXPECT().ReadStockAmount(ctx, &dto.Article{Article: "test-9"}).Times(1).Return(uint(0), nil)
+	mockRepo.EXPECT().UpdateStockAmount(ctx, &dto.ArticleAmount{Article: "test-9", Amount: 3}).Times(1).Return(nil)
+	mockRepo.EXPECT().CreateRefund(ctx, gomock.Any()).Times(1).DoAndReturn(
+		func(_ context.Context, r *dto.RefundRecord) (refund.ID, error) {
+			if r.Total != 360 || len(r.Products) != 1 || r.Products[0].Amount != 3 {
+				t.Errorf("unexpected refund %+v", r)
+			}
+			return refund.ID(9), nil
+		})
+
+	if _, err := s.ReturnSale(ctx, data); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestService_ReturnSaleExceedsSold(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+
+	mockRepo := mockrepository.NewMockInterface(ctrl)
+	s := Service{Repository: mockRepo}
+	ctx := context.WithValue(context.Background(), mockrepository.ExecuteKey{}, "✅")
+	data := dto.Refund{ReceiptID: 1, CashRegister: 1, Products: []dto.ArticleAmount{{Article: "test-9", Amount: 2}}}
+	receiptID := dto.ReceiptID{ID: 1}
+
+	expectCashRegister(mockRepo, ctx, 1)
+	mockRepo.EXPECT().ReadOpenShift(ctx, &dto.CashRegister{CashRegister: 1}).Times(1).Return(
+		dto.Shift{ID: 5, CashRegister: 1}, nil)
+	mockRepo.EXPECT().ReadReceipt(ctx, &receiptID).Times(1).Return(dto.Receipt{ID: 1, PaymentMethod: payment.Cash,
+		Products: []dto.ArticlePriceAmount{{Article: "test-9", Price: 100, Amount: 3}}}, nil)
+	mockRepo.EXPECT().ReadRefundedProducts(ctx, &receiptID).Times(1).Return(
+		[]dto.ArticleAmount{{Article: "test-9", Amount: 2}}, nil)
+	mockRepo.EXPECT().CreateRefund(gomock.Any(), gomock.Any()).Times(0)
+
+	_, err := s.ReturnSale(ctx, data)
+	if !errors.Is(err, service.ErrRefundExceedsSold) {
+		t.Fail()
+	}
+}
+
+func TestService_ReturnSaleProductNotInReceipt(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+
+	mockRepo := mockrepository.NewMockInterface(ctrl)
+	s := Service{Repository: mockRepo}
+	ctx := context.WithValue(context.Background(), mockrepository.ExecuteKey{}, "✅")
+	data := dto.Refund{ReceiptID: 1, CashRegister: 1, Products: []dto.ArticleAmount{{Article: "test-8", Amount: 1}}}
+	receiptID := dto.ReceiptID{ID: 1}
+
+	expectCashRegister(mockRepo, ctx, 1)
+	mockRepo.EXPECT().ReadOpenShift(ctx, &dto.CashRegister{CashRegister: 1}).Times(1).Return(
+		dto.Shift{ID: 5, CashRegister: 1}, nil)
+	mockRepo.EXPECT().ReadReceipt(ctx, &receiptID).Times(1).Return(dto.Receipt{ID: 1, PaymentMethod: payment.Cash,
+		Products: []dto.ArticlePriceAmount{{Article: "test-9", Price: 100, Amount: 3}}}, nil)
+	mockRepo.EXPECT().ReadRefundedProducts(ctx, &receiptID).Times(1).Return(nil, nil)
+
+	_, err := s.ReturnSale(ctx, data)
+	if !errors.Is(err, service.ErrProductNotInReceipt) {
+		t.Fail()
+	}
+}
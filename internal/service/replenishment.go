@@ -0,0 +1,143 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"github.com/lazylex/watch-store-store/internal/domain/event"
+	"github.com/lazylex/watch-store-store/internal/dto"
+	"github.com/lazylex/watch-store-store/internal/logger"
+	"log/slog"
+	"math"
+	"sort"
+	"time"
+)
+
+// withDefaults возвращает параметры, в которых нулевые значения (кроме страхового запаса) заменены значениями по
+// умолчанию.
+func (d ReplenishmentDefaults) withDefaults() ReplenishmentDefaults {
+	if d.WindowDays == 0 {
+		d.WindowDays = defaultReplenishment.WindowDays
+	}
+	if d.LeadTimeDays == 0 {
+		d.LeadTimeDays = defaultReplenishment.LeadTimeDays
+	}
+	if d.CoverDays == 0 {
+		d.CoverDays = defaultReplenishment.CoverDays
+	}
+	return d
+}
+
+// SetReplenishmentSetting сохраняет срок поставки и страховой запас товара, находящегося в продаже.
+func (s *Service) SetReplenishmentSetting(ctx context.Context, data dto.ReplenishmentSetting) error {
+	if err := data.Validate(); err != nil {
+		return err
+	}
+	if _, err := s.Stock(ctx, dto.Article{Article: data.Article}); err != nil {
+		return err
+	}
+
+	if err := s.Repository.UpsertReplenishmentSetting(ctx, &data); err != nil {
+		return err
+	}
+
+	logger.LogWithCtxData(ctx, slog.With(logger.OPLabel, "service.SetReplenishmentSetting")).Info(
+		fmt.Sprintf("replenishment setting of article %s: lead time %d days, safety stock %d",
+			data.Article, data.LeadTimeDays, data.SafetyStock))
+	s.emit(ctx, event.ReplenishmentSettingChanged, string(data.Article), data)
+
+	return nil
+}
+
+// ReplenishmentSetting возвращает сохранённые срок поставки и страховой запас товара.
+func (s *Service) ReplenishmentSetting(ctx context.Context, data dto.Article) (dto.ReplenishmentSetting, error) {
+	if err := data.Validate(); err != nil {
+		return dto.ReplenishmentSetting{}, err
+	}
+
+	return s.Repository.ReadReplenishmentSetting(ctx, &data)
+}
+
+// ReorderSuggestions возвращает список товаров, которые необходимо заказать, упорядоченный по возрастанию количества
+// дней, на которые хватит товара (товары без продаж - в конце списка). Скорость продаж рассчитывается по продажам за
+// последние data.WindowDays дней (при нулевом значении - за период из параметров сервиса). Товар предлагается к
+// заказу, если его остаток не превышает точки заказа - продаж за срок поставки плюс страховой запас. Количество к заказу
+// доводит остаток до продаж за срок поставки и CoverDays дней плюс страховой запас.
+func (s *Service) ReorderSuggestions(ctx context.Context, data dto.WindowDays) ([]dto.ReorderSuggestion, error) {
+	defaults := s.Replenishment.withDefaults()
+	if data.WindowDays == 0 {
+		data.WindowDays = defaults.WindowDays
+	}
+	if err := data.Validate(); err != nil {
+		return nil, err
+	}
+
+	since := time.Now().AddDate(0, 0, -int(data.WindowDays))
+	records, err := s.Repository.ReadStockSoldSince(ctx, since)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]dto.ReorderSuggestion, 0)
+	for _, record := range records {
+		if suggestion, ok := suggestReorder(record, data.WindowDays, defaults); ok {
+			result = append(result, suggestion)
+		}
+	}
+
+	sort.SliceStable(result, func(i, j int) bool {
+		switch {
+		case result[i].DaysOfCover == nil:
+			return false
+		case result[j].DaysOfCover == nil:
+			return true
+		}
+		return *result[i].DaysOfCover < *result[j].DaysOfCover
+	})
+
+	logger.LogWithCtxData(ctx, slog.With(logger.OPLabel, "service.ReorderSuggestions")).Info(
+		fmt.Sprintf("%d articles suggested to reorder by sales for %d days", len(result), data.WindowDays))
+
+	return result, nil
+}
+
+// suggestReorder рассчитывает предложение о заказе товара. Возвращает false, если заказывать товар не нужно.
+func suggestReorder(record dto.ArticleStockSold, windowDays uint, defaults ReplenishmentDefaults) (
+	dto.ReorderSuggestion, bool) {
+	setting := dto.ReplenishmentSetting{LeadTimeDays: defaults.LeadTimeDays, SafetyStock: defaults.SafetyStock}
+	if record.Setting != nil {
+		setting = *record.Setting
+	}
+
+	velocity := float64(record.Sold) / float64(windowDays)
+	reorderPoint := velocity*float64(setting.LeadTimeDays) + float64(setting.SafetyStock)
+	if reorderPoint == 0 || float64(record.InStock) > reorderPoint {
+		return dto.ReorderSuggestion{}, false
+	}
+
+	target := velocity*float64(setting.LeadTimeDays+defaults.CoverDays) + float64(setting.SafetyStock)
+	quantity := math.Ceil(target - float64(record.InStock))
+	if quantity <= 0 {
+		return dto.ReorderSuggestion{}, false
+	}
+
+	suggestion := dto.ReorderSuggestion{
+		Article:      record.Article,
+		InStock:      record.InStock,
+		Velocity:     round(velocity),
+		LeadTimeDays: setting.LeadTimeDays,
+		SafetyStock:  setting.SafetyStock,
+		ReorderPoint: round(reorderPoint),
+		Quantity:     uint(quantity),
+	}
+	if velocity > 0 {
+		daysOfCover := round(float64(record.InStock) / velocity)
+		suggestion.DaysOfCover = &daysOfCover
+	}
+
+	return suggestion, true
+}
+
+// round округляет значение до сотых.
+func round(value float64) float64 {
+	return math.Round(value*100) / 100
+}
@@ -0,0 +1,102 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"github.com/golang/mock/gomock"
+	"github.com/lazylex/watch-store-store/internal/dto"
+	"github.com/lazylex/watch-store-store/internal/dto/validators"
+	"github.com/lazylex/watch-store-store/internal/ports/repository"
+	mockrepository "github.com/lazylex/watch-store-store/internal/ports/repository/mocks"
+	"testing"
+	"time"
+)
+
+func TestService_ReorderSuggestions(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+
+	mockRepo := mockrepository.NewMockInterface(ctrl)
+	s := Service{Repository: mockRepo, Replenishment: ReplenishmentDefaults{WindowDays: 10, LeadTimeDays: 5,
+		CoverDays: 10}}
+
+	mockRepo.EXPECT().ReadStockSoldSince(gomock.Any(), gomock.Any()).Times(1).DoAndReturn(
+		func(_ context.Context, since time.Time) ([]dto.ArticleStockSold, error) {
+			if time.Since(since) < 10*24*time.Hour-time.Minute || time.Since(since) > 10*24*time.Hour+time.Minute {
+				t.Fail()
+			}
+			return []dto.ArticleStockSold{
+				// 2 в день, точка заказа 10, остаток 4 - заказать 2*(5+10)-4=26
+				{Article: "fast", InStock: 4, Sold: 20},
+				// 1 в день, точка заказа 2*1+3=5, остаток 5 - заказать 1*(2+10)+3-5=10
+				{Article: "custom", InStock: 5, Sold: 10,
+					Setting: &dto.ReplenishmentSetting{Article: "custom", LeadTimeDays: 2, SafetyStock: 3}},
+				// 0.5 в день, точка заказа 2.5, остаток 30 - не заказывать
+				{Article: "slow", InStock: 30, Sold: 5},
+				// без продаж и без страхового запаса - не заказывать
+				{Article: "unsold", InStock: 0, Sold: 0},
+				// без продаж, но остаток ниже страхового запаса - заказать до страхового запаса
+				{Article: "safety", InStock: 1, Sold: 0,
+					Setting: &dto.ReplenishmentSetting{Article: "safety", LeadTimeDays: 3, SafetyStock: 4}},
+			}, nil
+		})
+
+	result, err := s.ReorderSuggestions(context.Background(), dto.WindowDays{})
+	if err != nil || len(result) != 3 {
+		t.Fatal(result, err)
+	}
+	if result[0].Article != "fast" || result[0].Quantity != 26 || *result[0].DaysOfCover != 2 {
+		t.Errorf("unexpected %+v", result[0])
+	}
+	if result[1].Article != "custom" || result[1].Quantity != 10 || result[1].ReorderPoint != 5 {
+		t.Errorf("unexpected %+v", result[1])
+	}
+	if result[2].Article != "safety" || result[2].Quantity != 3 || result[2].DaysOfCover != nil {
+		t.Errorf("unexpected %+v", result[2])
+	}
+}
+
+func TestService_ReorderSuggestionsIncorrectWindow(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+
+	mockRepo := mockrepository.NewMockInterface(ctrl)
+	s := Service{Repository: mockRepo}
+
+	mockRepo.EXPECT().ReadStockSoldSince(gomock.Any(), gomock.Any()).Times(0)
+
+	_, err := s.ReorderSuggestions(context.Background(), dto.WindowDays{WindowDays: validators.MaxDays + 1})
+	if !errors.Is(err, validators.ErrIncorrectWindow) {
+		t.Fail()
+	}
+}
+
+func TestService_SetReplenishmentSettingNoStock(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+
+	mockRepo := mockrepository.NewMockInterface(ctrl)
+	s := Service{Repository: mockRepo}
+	data := dto.ReplenishmentSetting{Article: "CA-F91W", LeadTimeDays: 7}
+
+	mockRepo.EXPECT().ReadStock(gomock.Any(), gomock.Any()).Times(1).Return(dto.ArticlePriceNameAmount{},
+		repository.ErrNoRecord)
+	mockRepo.EXPECT().UpsertReplenishmentSetting(gomock.Any(), gomock.Any()).Times(0)
+
+	if err := s.SetReplenishmentSetting(context.Background(), data); !errors.Is(err, repository.ErrNoRecord) {
+		t.Fail()
+	}
+}
+
+func TestNewWithOptionalOptions(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+
+	s := New(withMockRepo(mockrepository.NewMockInterface(ctrl)), WithMetrics(nil), WithAdjustmentReasons(nil),
+		WithReplenishment(ReplenishmentDefaults{WindowDays: 56}))
+
+	if s.Replenishment.WindowDays != 56 || s.Replenishment.LeadTimeDays != defaultReplenishment.LeadTimeDays ||
+		len(s.AdjustmentReasons) == 0 {
+		t.Fail()
+	}
+}
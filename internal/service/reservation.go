@@ -0,0 +1,117 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"github.com/lazylex/watch-store-store/internal/domain/aggregates/reservation"
+	"github.com/lazylex/watch-store-store/internal/domain/event"
+	"github.com/lazylex/watch-store-store/internal/dto"
+	"github.com/lazylex/watch-store-store/internal/logger"
+	"github.com/lazylex/watch-store-store/internal/ports/service"
+	"log/slog"
+	"time"
+)
+
+// systemActor инициатор операций, выполняемых не по запросу пользователя (например, по сообщению из Kafka).
+const systemActor = "system"
+
+// actor возвращает инициатора операции, сохранённого в контексте, или systemActor, если он не определён.
+func actor(ctx context.Context) string {
+	if a, ok := ctx.Value(logger.Actor).(string); ok && a != "" {
+		return a
+	}
+	return systemActor
+}
+
+// MarkReadyForPickup помечает заказ, как собранный и ожидающий покупателя в магазине.
+func (s *Service) MarkReadyForPickup(ctx context.Context, data dto.Number) error {
+	if err := s.changeReservationState(ctx, data, reservation.ReadyForPickup); err != nil {
+		return err
+	}
+
+	logger.LogWithCtxData(ctx, slog.With(logger.OPLabel, "service.MarkReadyForPickup")).Info(
+		fmt.Sprintf("order %d is ready for pickup", data.OrderNumber))
+	s.emit(ctx, event.OrderReadyForPickup, numberKey(data.OrderNumber), data)
+	return nil
+}
+
+// ShipOrder помечает заказ, как переданный в доставку.
+func (s *Service) ShipOrder(ctx context.Context, data dto.Number) error {
+	if err := s.changeReservationState(ctx, data, reservation.Shipped); err != nil {
+		return err
+	}
+
+	logger.LogWithCtxData(ctx, slog.With(logger.OPLabel, "service.ShipOrder")).Info(
+		fmt.Sprintf("order %d shipped", data.OrderNumber))
+	s.emit(ctx, event.OrderShipped, numberKey(data.OrderNumber), data)
+	return nil
+}
+
+// ReservationHistory возвращает историю смены состояний заказа в хронологическом порядке.
+func (s *Service) ReservationHistory(ctx context.Context, data dto.Number) ([]dto.ReservationTransition, error) {
+	if err := data.Validate(); err != nil {
+		return nil, err
+	}
+
+	result, err := s.Repository.ReadReservationTransitions(ctx, &data)
+	if err != nil {
+		return nil, err
+	}
+
+	logger.LogWithCtxData(ctx, slog.With(logger.OPLabel, "service.ReservationHistory")).Info(
+		fmt.Sprintf("requested history of order %d", data.OrderNumber))
+
+	return result, nil
+}
+
+// changeReservationState переводит бронирование в состояние to без изменения зарезервированных товаров.
+func (s *Service) changeReservationState(ctx context.Context, data dto.Number, to reservation.State) error {
+	if err := data.Validate(); err != nil {
+		return err
+	}
+
+	return s.Repository.WithinTransaction(ctx, func(txCtx context.Context) error {
+		res, err := s.Repository.ReadReservation(txCtx, &data)
+		if err != nil {
+			return err
+		}
+
+		if err = s.transitReservation(txCtx, &res, to); err != nil {
+			return err
+		}
+
+		return s.Repository.UpdateReservation(txCtx, &dto.NumberDateStateProducts{
+			Products:    res.Products,
+			OrderNumber: data.OrderNumber,
+			Date:        time.Now(),
+			State:       to,
+		})
+	})
+}
+
+// transitReservation проверяет допустимость перехода бронирования res в состояние to и сохраняет переход в истории.
+// Сохранение нового состояния самого бронирования остаётся за вызывающим кодом. Для уже выполненного или отменённого
+// заказа возвращаемая ошибка соответствует также service.ErrAlreadyProcessed.
+func (s *Service) transitReservation(ctx context.Context, res *dto.NumberDateStateProducts, to reservation.State) error {
+	aggregate := reservation.Reservation{OrderNumber: res.OrderNumber, State: res.State}
+	if err := aggregate.TransitTo(to); err != nil {
+		if res.State.IsFinal() {
+			return fmt.Errorf("%w: %w", service.ErrAlreadyProcessed, err)
+		}
+		return err
+	}
+
+	return s.createReservationTransition(ctx, res.OrderNumber, res.State, to)
+}
+
+// createReservationTransition сохраняет в истории переход бронирования из состояния from в состояние to.
+func (s *Service) createReservationTransition(ctx context.Context, number reservation.OrderNumber, from,
+	to reservation.State) error {
+	return s.Repository.CreateReservationTransition(ctx, &dto.ReservationTransition{
+		OrderNumber: number,
+		From:        from,
+		To:          to,
+		Actor:       actor(ctx),
+		Date:        time.Now(),
+	})
+}
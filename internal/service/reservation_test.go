@@ -0,0 +1,111 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"github.com/golang/mock/gomock"
+	"github.com/lazylex/watch-store-store/internal/domain/aggregates/reservation"
+	"github.com/lazylex/watch-store-store/internal/dto"
+	"github.com/lazylex/watch-store-store/internal/logger"
+	mockrepository "github.com/lazylex/watch-store-store/internal/ports/repository/mocks"
+	"github.com/lazylex/watch-store-store/internal/ports/service"
+	"testing"
+	"time"
+)
+
+func TestService_MarkReadyForPickupSuccess(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+
+	mockRepo := mockrepository.NewMockInterface(ctrl)
+	s := Service{Repository: mockRepo}
+	data := dto.Number{OrderNumber: 150}
+	ctx := context.WithValue(context.WithValue(context.Background(), mockrepository.ExecuteKey{}, "✅"),
+		logger.Actor, "ivanova")
+	products := []dto.ArticlePriceAmount{{Article: "test-9", Amount: 1, Price: 698}}
+
+	mockRepo.EXPECT().ReadReservation(ctx, &data).Times(1).Return(dto.NumberDateStateProducts{
+		Products: products, OrderNumber: data.OrderNumber, Date: time.Now(), State: reservation.NewForLocalCustomer,
+	}, nil)
+	mockRepo.EXPECT().CreateReservationTransition(ctx, gomock.Any()).Times(1).DoAndReturn(
+		func(_ context.Context, record *dto.ReservationTransition) error {
+			if record.From != reservation.NewForLocalCustomer || record.To != reservation.ReadyForPickup ||
+				record.Actor != "ivanova" || record.OrderNumber != data.OrderNumber {
+				t.Fail()
+			}
+			return nil
+		})
+	mockRepo.EXPECT().UpdateReservation(ctx, gomock.Any()).Times(1).DoAndReturn(
+		func(_ context.Context, record *dto.NumberDateStateProducts) error {
+			if record.State != reservation.ReadyForPickup || len(record.Products) != len(products) {
+				t.Fail()
+			}
+			return nil
+		})
+
+	if err := s.MarkReadyForPickup(ctx, data); err != nil {
+		t.Fail()
+	}
+}
+
+func TestService_ShipOrderIllegalTransition(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+
+	mockRepo := mockrepository.NewMockInterface(ctrl)
+	s := Service{Repository: mockRepo}
+	data := dto.Number{OrderNumber: 150}
+	ctx := context.WithValue(context.Background(), mockrepository.ExecuteKey{}, "✅")
+
+	mockRepo.EXPECT().ReadReservation(ctx, &data).Times(1).Return(dto.NumberDateStateProducts{
+		Products:    []dto.ArticlePriceAmount{{Article: "test-9", Amount: 1, Price: 698}},
+		OrderNumber: data.OrderNumber, State: reservation.NewForLocalCustomer,
+	}, nil)
+	mockRepo.EXPECT().CreateReservationTransition(gomock.Any(), gomock.Any()).Times(0)
+	mockRepo.EXPECT().UpdateReservation(gomock.Any(), gomock.Any()).Times(0)
+
+	err := s.ShipOrder(ctx, data)
+	if !errors.Is(err, reservation.ErrIllegalTransition) || errors.Is(err, service.ErrAlreadyProcessed) {
+		t.Fail()
+	}
+}
+
+func TestService_ShipOrderAlreadyCancelled(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+
+	mockRepo := mockrepository.NewMockInterface(ctrl)
+	s := Service{Repository: mockRepo}
+	data := dto.Number{OrderNumber: 150}
+	ctx := context.WithValue(context.Background(), mockrepository.ExecuteKey{}, "✅")
+
+	mockRepo.EXPECT().ReadReservation(ctx, &data).Times(1).Return(dto.NumberDateStateProducts{
+		Products:    []dto.ArticlePriceAmount{{Article: "test-9", Amount: 1, Price: 698}},
+		OrderNumber: data.OrderNumber, State: reservation.Cancel,
+	}, nil)
+
+	err := s.ShipOrder(ctx, data)
+	if !errors.Is(err, reservation.ErrIllegalTransition) || !errors.Is(err, service.ErrAlreadyProcessed) {
+		t.Fail()
+	}
+}
+
+func TestService_ReservationHistory(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+
+	mockRepo := mockrepository.NewMockInterface(ctrl)
+	s := Service{Repository: mockRepo}
+	data := dto.Number{OrderNumber: 150}
+	history := []dto.ReservationTransition{
+		{OrderNumber: 150, To: reservation.NewForInternetCustomer, Actor: systemActor},
+		{OrderNumber: 150, From: reservation.NewForInternetCustomer, To: reservation.Shipped, Actor: "petrov"},
+	}
+
+	mockRepo.EXPECT().ReadReservationTransitions(context.Background(), &data).Times(1).Return(history, nil)
+
+	result, err := s.ReservationHistory(context.Background(), data)
+	if err != nil || len(result) != 2 {
+		t.Fail()
+	}
+}
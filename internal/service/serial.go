@@ -0,0 +1,313 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/lazylex/watch-store-store/internal/domain/event"
+	"github.com/lazylex/watch-store-store/internal/domain/value_objects/article"
+	"github.com/lazylex/watch-store-store/internal/domain/value_objects/serial"
+	"github.com/lazylex/watch-store-store/internal/dto"
+	"github.com/lazylex/watch-store-store/internal/logger"
+	"github.com/lazylex/watch-store-store/internal/ports/repository"
+	"github.com/lazylex/watch-store-store/internal/ports/service"
+	"log/slog"
+	"time"
+)
+
+// SetSerialTracking включает или отключает учёт товара, находящегося в продаже, по серийным номерам. При включении
+// учёта регистрируются переданные серийные номера имеющихся в продаже экземпляров, после чего количество доступных
+// экземпляров должно совпасть с количеством товара, иначе возвращается service.ErrSerialsDontMatchStock. Впервые
+// включить учёт можно только для товара, не зарезервированного под невыполненные заказы. При отключении учёта
+// доступные экземпляры списываются (см. serial.WrittenOff).
+func (s *Service) SetSerialTracking(ctx context.Context, data dto.SerialTracking) error {
+	if err := data.Validate(); err != nil {
+		return err
+	}
+	if _, err := s.Stock(ctx, dto.Article{Article: data.Article}); err != nil {
+		return err
+	}
+
+	a := dto.Article{Article: data.Article}
+	err := s.Repository.WithinTransaction(ctx, func(txCtx context.Context) error {
+		if !data.Tracked {
+			available, err := s.Repository.ReadAvailableSerials(txCtx, &a)
+			if err != nil {
+				return err
+			}
+			if err = s.moveSerials(txCtx, available, dto.SerialEvent{State: serial.WrittenOff}); err != nil {
+				return err
+			}
+			return s.Repository.DeleteSerialTracking(txCtx, &a)
+		}
+
+		tracked, err := s.trackedArticles(txCtx, []article.Article{data.Article})
+		if err != nil {
+			return err
+		}
+		if _, ok := tracked[data.Article]; !ok {
+			var reserved uint
+			if reserved, err = s.Repository.ReadReservedAmount(txCtx, &a); err != nil {
+				return err
+			}
+			if reserved > 0 {
+				return service.ErrArticleReserved
+			}
+		}
+
+		if err = s.registerSerials(txCtx, data.Article, data.Serials, dto.SerialEvent{}); err != nil {
+			return err
+		}
+
+		var amount uint
+		var available []dto.SerialRecord
+		if available, err = s.Repository.ReadAvailableSerials(txCtx, &a); err != nil {
+			return err
+		}
+		if amount, err = s.Repository.ReadStockAmount(txCtx, &a); err != nil {
+			return err
+		}
+		if uint(len(available)) != amount {
+			return service.ErrSerialsDontMatchStock
+		}
+
+		return s.Repository.CreateSerialTracking(txCtx, &a, time.Now())
+	})
+	if err != nil {
+		return err
+	}
+
+	logger.LogWithCtxData(ctx, slog.With(logger.OPLabel, "service.SetSerialTracking")).Info(
+		fmt.Sprintf("serial tracking of article %s set to %t, %d serials registered", data.Article, data.Tracked,
+			len(data.Serials)))
+	s.emit(ctx, event.SerialTrackingChanged, string(data.Article), data)
+
+	return nil
+}
+
+// AvailableSerials возвращает серийные номера доступных для продажи экземпляров товара.
+func (s *Service) AvailableSerials(ctx context.Context, data dto.Article) ([]serial.Number, error) {
+	if err := data.Validate(); err != nil {
+		return nil, err
+	}
+
+	records, err := s.Repository.ReadAvailableSerials(ctx, &data)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]serial.Number, len(records))
+	for i, record := range records {
+		result[i] = record.Serial
+	}
+
+	return result, nil
+}
+
+// SerialHistory возвращает текущее состояние экземпляра товара с серийным номером и историю его событий: регистрацию,
+// резервирование, продажу, возврат и списание. Если серийный номер не зарегистрирован, возвращается
+// repository.ErrNoRecord.
+func (s *Service) SerialHistory(ctx context.Context, data dto.Serial) (dto.SerialHistory, error) {
+	if err := data.Validate(); err != nil {
+		return dto.SerialHistory{}, err
+	}
+
+	records, err := s.Repository.ReadSerials(ctx, []serial.Number{data.Serial})
+	if err != nil {
+		return dto.SerialHistory{}, err
+	}
+	if len(records) == 0 {
+		return dto.SerialHistory{}, repository.ErrNoRecord
+	}
+
+	result := dto.SerialHistory{SerialRecord: records[0]}
+	if result.Events, err = s.Repository.ReadSerialEvents(ctx, &data); err != nil {
+		return dto.SerialHistory{}, err
+	}
+
+	logger.LogWithCtxData(ctx, slog.With(logger.OPLabel, "service.SerialHistory")).Info(
+		fmt.Sprintf("requested history of serial %s", data.Serial))
+
+	return result, nil
+}
+
+// trackedArticles возвращает множество тех из переданных артикулов, товары с которыми учитываются по серийным номерам.
+func (s *Service) trackedArticles(ctx context.Context, articles []article.Article) (map[article.Article]struct{},
+	error) {
+	tracked, err := s.Repository.ReadSerialTrackedArticles(ctx, articles)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make(map[article.Article]struct{}, len(tracked))
+	for _, a := range tracked {
+		result[a] = struct{}{}
+	}
+
+	return result, nil
+}
+
+// checkNotSerialTracked возвращает service.ErrSerialTracked, если товар учитывается по серийным номерам. Количество
+// такого товара изменяется только вместе с состоянием его экземпляров.
+func (s *Service) checkNotSerialTracked(ctx context.Context, a article.Article) error {
+	tracked, err := s.trackedArticles(ctx, []article.Article{a})
+	if err != nil {
+		return err
+	}
+	if _, ok := tracked[a]; ok {
+		return service.ErrSerialTracked
+	}
+
+	return nil
+}
+
+// registerSerials регистрирует доступные для продажи экземпляры товара с переданными серийными номерами. Списанные
+// экземпляры того же товара возвращаются в продажу, для номеров, зарегистрированных иначе, возвращается
+// service.ErrSerialRegistered. Событие e дополняется данными каждого экземпляра и сохраняется в истории.
+func (s *Service) registerSerials(ctx context.Context, a article.Article, numbers []serial.Number,
+	e dto.SerialEvent) error {
+	if len(numbers) == 0 {
+		return nil
+	}
+
+	existing, err := s.Repository.ReadSerials(ctx, numbers)
+	if err != nil {
+		return err
+	}
+
+	registered := make(map[serial.Number]struct{}, len(existing))
+	for _, record := range existing {
+		if record.Article != a || record.State != serial.WrittenOff {
+			return service.ErrSerialRegistered
+		}
+		registered[record.Serial] = struct{}{}
+	}
+
+	var created []dto.SerialRecord
+	for _, n := range numbers {
+		if _, ok := registered[n]; !ok {
+			created = append(created, dto.SerialRecord{Serial: n, Article: a, State: serial.InStock})
+		}
+	}
+
+	err = s.Repository.CreateSerials(ctx, created)
+	// одновременная регистрация того же номера параллельным запросом
+	if errors.Is(err, repository.ErrDuplicate) {
+		return service.ErrSerialRegistered
+	}
+	if err != nil {
+		return err
+	}
+
+	e.State = serial.InStock
+	if err = s.moveSerials(ctx, existing, e); err != nil {
+		return err
+	}
+
+	return s.Repository.CreateSerialEvents(ctx, serialEvents(created, e))
+}
+
+// pickSerials проверяет, что переданные серийные номера соответствуют товарам: каждый номер зарегистрирован и его
+// экземпляр удовлетворяет условию fits, а для каждого учитываемого по серийным номерам товара передано ровно amounts
+// номеров его экземпляров. Номера товаров, не учитываемых по серийным номерам, не принимаются. Возвращаются записи о
+// выбранных экземплярах.
+func (s *Service) pickSerials(ctx context.Context, amounts map[article.Article]uint, numbers []serial.Number,
+	fits func(dto.SerialRecord) bool) ([]dto.SerialRecord, error) {
+	articles := make([]article.Article, 0, len(amounts))
+	for a := range amounts {
+		articles = append(articles, a)
+	}
+
+	tracked, err := s.trackedArticles(ctx, articles)
+	if err != nil {
+		return nil, err
+	}
+	if len(tracked) == 0 && len(numbers) == 0 {
+		return nil, nil
+	}
+
+	records, err := s.Repository.ReadSerials(ctx, numbers)
+	if err != nil {
+		return nil, err
+	}
+	if len(records) != len(numbers) {
+		return nil, service.ErrSerialUnavailable
+	}
+
+	picked := make(map[article.Article]uint)
+	for _, record := range records {
+		if _, ok := tracked[record.Article]; !ok {
+			return nil, service.ErrSerialsDontMatch
+		}
+		if !fits(record) {
+			return nil, service.ErrSerialUnavailable
+		}
+		picked[record.Article]++
+	}
+
+	for a := range tracked {
+		if picked[a] != amounts[a] {
+			return nil, service.ErrSerialsDontMatch
+		}
+	}
+
+	return records, nil
+}
+
+// moveSerials переводит экземпляры товаров в состояние e.State и сохраняет в истории событие e, дополненное данными
+// каждого экземпляра. Номер заказа сохраняется только у зарезервированных экземпляров, идентификатор чека - только у
+// проданных.
+func (s *Service) moveSerials(ctx context.Context, records []dto.SerialRecord, e dto.SerialEvent) error {
+	if len(records) == 0 {
+		return nil
+	}
+
+	moved := make([]dto.SerialRecord, len(records))
+	for i, record := range records {
+		record.State, record.OrderNumber, record.ReceiptID = e.State, 0, 0
+		switch e.State {
+		case serial.Reserved:
+			record.OrderNumber = e.OrderNumber
+		case serial.Sold:
+			record.ReceiptID = e.ReceiptID
+		}
+		moved[i] = record
+	}
+
+	if err := s.Repository.UpdateSerials(ctx, moved); err != nil {
+		return err
+	}
+
+	return s.Repository.CreateSerialEvents(ctx, serialEvents(moved, e))
+}
+
+// serialEvents возвращает для каждого экземпляра товара копию события e с серийным номером и артикулом экземпляра.
+// Если дата события не задана, используется текущая.
+func serialEvents(records []dto.SerialRecord, e dto.SerialEvent) []dto.SerialEvent {
+	if e.Date.IsZero() {
+		e.Date = time.Now()
+	}
+
+	result := make([]dto.SerialEvent, len(records))
+	for i, record := range records {
+		e.Serial, e.Article = record.Serial, record.Article
+		result[i] = e
+	}
+
+	return result
+}
+
+// isAvailableSerial возвращает true, если экземпляр товара доступен для продажи.
+func isAvailableSerial(record dto.SerialRecord) bool {
+	return record.State == serial.InStock
+}
+
+// productAmounts возвращает суммарное количество каждого из товаров.
+func productAmounts(products []dto.ArticlePriceAmount) map[article.Article]uint {
+	result := make(map[article.Article]uint, len(products))
+	for _, p := range products {
+		result[p.Article] += p.Amount
+	}
+
+	return result
+}
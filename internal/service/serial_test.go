@@ -0,0 +1,292 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"github.com/golang/mock/gomock"
+	"github.com/lazylex/watch-store-store/internal/domain/aggregates/receipt"
+	"github.com/lazylex/watch-store-store/internal/domain/aggregates/reservation"
+	"github.com/lazylex/watch-store-store/internal/domain/value_objects/article"
+	"github.com/lazylex/watch-store-store/internal/domain/value_objects/payment"
+	"github.com/lazylex/watch-store-store/internal/domain/value_objects/serial"
+	"github.com/lazylex/watch-store-store/internal/dto"
+	"github.com/lazylex/watch-store-store/internal/metrics"
+	mockMetrics "github.com/lazylex/watch-store-store/internal/ports/metrics/service/mocks"
+	"github.com/lazylex/watch-store-store/internal/ports/repository"
+	mockrepository "github.com/lazylex/watch-store-store/internal/ports/repository/mocks"
+	"github.com/lazylex/watch-store-store/internal/ports/service"
+	"testing"
+)
+
+// expectNoSerials ожидает проверки учёта по серийным номерам, не находящие ни учитываемых товаров, ни
+// зарезервированных под заказы экземпляров.
+func expectNoSerials(mockRepo *mockrepository.MockInterface, ctx context.Context) {
+	mockRepo.EXPECT().ReadSerialTrackedArticles(ctx, gomock.Any()).AnyTimes().Return(nil, nil)
+	mockRepo.EXPECT().ReadSerialsByOrder(ctx, gomock.Any()).AnyTimes().Return(nil, nil)
+}
+
+func TestService_SetSerialTrackingEnable(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+
+	mockRepo := mockrepository.NewMockInterface(ctrl)
+	s := Service{Repository: mockRepo}
+	ctx := context.WithValue(context.Background(), mockrepository.ExecuteKey{}, "✅")
+	a := &dto.Article{Article: "test-9"}
+	data := dto.SerialTracking{Article: "test-9", Tracked: true, Serials: []serial.Number{"A1", "A2"}}
+
+	mockRepo.EXPECT().ReadStock(ctx, a).Times(1).Return(dto.ArticlePriceNameAmount{Article: "test-9", Amount: 2}, nil)
+	mockRepo.EXPECT().ReadSerialTrackedArticles(ctx, []article.Article{"test-9"}).Times(1).Return(nil, nil)
+	mockRepo.EXPECT().ReadReservedAmount(ctx, a).Times(1).Return(uint(0), nil)
+	mockRepo.EXPECT().ReadSerials(ctx, data.Serials).Times(1).Return(nil, nil)
+	mockRepo.EXPECT().CreateSerials(ctx, []dto.SerialRecord{
+		{Serial: "A1", Article: "test-9", State: serial.InStock},
+		{Serial: "A2", Article: "test-9", State: serial.InStock}}).Times(1).Return(nil)
+	mockRepo.EXPECT().CreateSerialEvents(ctx, gomock.Len(2)).Times(1).Return(nil)
+	mockRepo.EXPECT().ReadAvailableSerials(ctx, a).Times(1).Return(make([]dto.SerialRecord, 2), nil)
+	mockRepo.EXPECT().ReadStockAmount(ctx, a).Times(1).Return(uint(2), nil)
+	mockRepo.EXPECT().CreateSerialTracking(ctx, a, gomock.Any()).Times(1).Return(nil)
+
+	if err := s.SetSerialTracking(ctx, data); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestService_SetSerialTrackingSerialsDontMatchStock(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+
+	mockRepo := mockrepository.NewMockInterface(ctrl)
+	s := Service{Repository: mockRepo}
+	ctx := context.WithValue(context.Background(), mockrepository.ExecuteKey{}, "✅")
+	a := &dto.Article{Article: "test-9"}
+
+	mockRepo.EXPECT().ReadStock(ctx, a).Times(1).Return(dto.ArticlePriceNameAmount{Article: "test-9", Amount: 3}, nil)
+	mockRepo.EXPECT().ReadSerialTrackedArticles(ctx, gomock.Any()).Times(1).Return(nil, nil)
+	mockRepo.EXPECT().ReadReservedAmount(ctx, a).Times(1).Return(uint(0), nil)
+	mockRepo.EXPECT().ReadAvailableSerials(ctx, a).Times(1).Return(nil, nil)
+	mockRepo.EXPECT().ReadStockAmount(ctx, a).Times(1).Return(uint(3), nil)
+
+	err := s.SetSerialTracking(ctx, dto.SerialTracking{Article: "test-9", Tracked: true})
+	if !errors.Is(err, service.ErrSerialsDontMatchStock) {
+		t.Fatalf("expected %v, got %v", service.ErrSerialsDontMatchStock, err)
+	}
+}
+
+func TestService_SetSerialTrackingReservedArticle(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+
+	mockRepo := mockrepository.NewMockInterface(ctrl)
+	s := Service{Repository: mockRepo}
+	ctx := context.WithValue(context.Background(), mockrepository.ExecuteKey{}, "✅")
+	a := &dto.Article{Article: "test-9"}
+
+	mockRepo.EXPECT().ReadStock(ctx, a).Times(1).Return(dto.ArticlePriceNameAmount{Article: "test-9"}, nil)
+	mockRepo.EXPECT().ReadSerialTrackedArticles(ctx, gomock.Any()).Times(1).Return(nil, nil)
+	mockRepo.EXPECT().ReadReservedAmount(ctx, a).Times(1).Return(uint(1), nil)
+
+	err := s.SetSerialTracking(ctx, dto.SerialTracking{Article: "test-9", Tracked: true})
+	if !errors.Is(err, service.ErrArticleReserved) {
+		t.Fatalf("expected %v, got %v", service.ErrArticleReserved, err)
+	}
+}
+
+func TestService_SetSerialTrackingDisableWritesOff(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+
+	mockRepo := mockrepository.NewMockInterface(ctrl)
+	s := Service{Repository: mockRepo}
+	ctx := context.WithValue(context.Background(), mockrepository.ExecuteKey{}, "✅")
+	a := &dto.Article{Article: "test-9"}
+
+	mockRepo.EXPECT().ReadStock(ctx, a).Times(1).Return(dto.ArticlePriceNameAmount{Article: "test-9"}, nil)
+	mockRepo.EXPECT().ReadAvailableSerials(ctx, a).Times(1).Return(
+		[]dto.SerialRecord{{Serial: "A1", Article: "test-9", State: serial.InStock}}, nil)
+	mockRepo.EXPECT().UpdateSerials(ctx, []dto.SerialRecord{{Serial: "A1", Article: "test-9",
+		State: serial.WrittenOff}}).Times(1).Return(nil)
+	mockRepo.EXPECT().CreateSerialEvents(ctx, gomock.Len(1)).Times(1).Return(nil)
+	mockRepo.EXPECT().DeleteSerialTracking(ctx, a).Times(1).Return(nil)
+
+	if err := s.SetSerialTracking(ctx, dto.SerialTracking{Article: "test-9"}); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestService_MakeSaleSellsSerials(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+
+	mockRepo := mockrepository.NewMockInterface(ctrl)
+	data := dto.CashRegisterProducts{CashRegister: 1, PaymentMethod: payment.Cash, Serials: []serial.Number{"A1"},
+		Products: []dto.ArticlePriceAmount{{Article: "test-9", Price: 410, Amount: 1}}}
+	s := Service{Repository: mockRepo}
+	ctx := context.WithValue(context.Background(), mockrepository.ExecuteKey{}, "✅")
+
+	expectCashRegister(mockRepo, ctx, 1)
+	mockRepo.EXPECT().ReadOpenShift(ctx, &dto.CashRegister{CashRegister: 1}).Times(1).Return(
+		dto.Shift{ID: 7, CashRegister: 1}, nil)
+	mockRepo.EXPECT().ReadStockAmount(ctx, &dto.Article{Article: "test-9"}).Times(1).Return(uint(2), nil)
+	mockRepo.EXPECT().UpdateStockAmount(ctx, &dto.ArticleAmount{Article: "test-9", Amount: 1}).Times(1).Return(nil)
+	mockRepo.EXPECT().ReadStockLocations(ctx, &dto.Article{Article: "test-9"}).Times(1).Return(nil, nil)
+	mockRepo.EXPECT().ReadSerialTrackedArticles(ctx, []article.Article{"test-9"}).Times(1).Return(
+		[]article.Article{"test-9"}, nil)
+	mockRepo.EXPECT().ReadSerials(ctx, data.Serials).Times(1).Return(
+		[]dto.SerialRecord{{Serial: "A1", Article: "test-9", State: serial.InStock}}, nil)
+	expectNoTaxRates(mockRepo, ctx)
+	expectNoWarranties(mockRepo, ctx)
+	mockRepo.EXPECT().CreateReceipt(ctx, gomock.Any()).Times(1).Return(receipt.ID(5), nil)
+	mockRepo.EXPECT().UpdateSerials(ctx, []dto.SerialRecord{{Serial: "A1", Article: "test-9", State: serial.Sold,
+		ReceiptID: 5}}).Times(1).Return(nil)
+	mockRepo.EXPECT().CreateSerialEvents(ctx, gomock.Any()).Times(1).DoAndReturn(
+		func(_ context.Context, events []dto.SerialEvent) error {
+			if len(events) != 1 || events[0].Serial != "A1" || events[0].ReceiptID != 5 {
+				t.Errorf("unexpected events %+v", events)
+			}
+			return nil
+		})
+
+	if _, err := s.MakeSale(ctx, data); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestService_MakeSaleSerialsErrors(t *testing.T) {
+	t.Parallel()
+	products := []dto.ArticlePriceAmount{{Article: "test-9", Price: 410, Amount: 1}}
+
+	tests := []struct {
+		testName string
+		serials  []serial.Number
+		records  []dto.SerialRecord
+		err      error
+	}{
+		{"no serials for tracked article", nil, nil, service.ErrSerialsDontMatch},
+		{"unknown serial", []serial.Number{"A1"}, nil, service.ErrSerialUnavailable},
+		{"sold serial", []serial.Number{"A1"},
+			[]dto.SerialRecord{{Serial: "A1", Article: "test-9", State: serial.Sold}}, service.ErrSerialUnavailable},
+		{"serial of another article", []serial.Number{"A1"},
+			[]dto.SerialRecord{{Serial: "A1", Article: "test-1", State: serial.InStock}}, service.ErrSerialsDontMatch},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.testName, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+
+			mockRepo := mockrepository.NewMockInterface(ctrl)
+			s := Service{Repository: mockRepo}
+			ctx := context.WithValue(context.Background(), mockrepository.ExecuteKey{}, "✅")
+
+			expectCashRegister(mockRepo, ctx, 1)
+			mockRepo.EXPECT().ReadOpenShift(ctx, gomock.Any()).Times(1).Return(dto.Shift{ID: 7}, nil)
+			mockRepo.EXPECT().ReadStockAmount(ctx, gomock.Any()).Times(1).Return(uint(2), nil)
+			mockRepo.EXPECT().UpdateStockAmount(ctx, gomock.Any()).Times(1).Return(nil)
+			mockRepo.EXPECT().ReadStockLocations(ctx, gomock.Any()).Times(1).Return(nil, nil)
+			mockRepo.EXPECT().ReadSerialTrackedArticles(ctx, gomock.Any()).Times(1).Return(
+				[]article.Article{"test-9"}, nil)
+			mockRepo.EXPECT().ReadSerials(ctx, gomock.Any()).Times(1).Return(tt.records, nil)
+
+			_, err := s.MakeSale(ctx, dto.CashRegisterProducts{CashRegister: 1, PaymentMethod: payment.Cash,
+				Products: products, Serials: tt.serials})
+			if !errors.Is(err, tt.err) {
+				t.Fatalf("expected %v, got %v", tt.err, err)
+			}
+		})
+	}
+}
+
+func TestService_CancelReservationReleasesSerials(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+
+	mockRepo := mockrepository.NewMockInterface(ctrl)
+	mockServiceMetrics := mockMetrics.NewMockMetricsInterface(ctrl)
+	s := Service{Repository: mockRepo, Metrics: &metrics.Metrics{Service: mockServiceMetrics}}
+	ctx := context.WithValue(context.Background(), mockrepository.ExecuteKey{}, "✅")
+	number := &dto.Number{OrderNumber: 13}
+
+	mockRepo.EXPECT().ReadReservation(ctx, number).Times(1).Return(dto.NumberDateStateProducts{OrderNumber: 13,
+		State:    reservation.NewForInternetCustomer,
+		Products: []dto.ArticlePriceAmount{{Article: "test-9", Price: 100, Amount: 1}}}, nil)
+	mockRepo.EXPECT().CreateReservationTransition(ctx, gomock.Any()).Times(1).Return(nil)
+	mockRepo.EXPECT().ReadSerialsByOrder(ctx, number).Times(1).Return([]dto.SerialRecord{{Serial: "A1",
+		Article: "test-9", State: serial.Reserved, OrderNumber: 13}}, nil)
+	mockRepo.EXPECT().UpdateSerials(ctx, []dto.SerialRecord{{Serial: "A1", Article: "test-9",
+		State: serial.InStock}}).Times(1).Return(nil)
+	mockRepo.EXPECT().CreateSerialEvents(ctx, gomock.Len(1)).Times(1).Return(nil)
+	mockRepo.EXPECT().ReadStockAmount(ctx, &dto.Article{Article: "test-9"}).Times(1).Return(uint(0), nil)
+	mockRepo.EXPECT().UpdateStockAmount(ctx, &dto.ArticleAmount{Article: "test-9", Amount: 1}).Times(1).Return(nil)
+	mockRepo.EXPECT().UpdateReservation(ctx, gomock.Any()).Times(1).Return(nil)
+	mockServiceMetrics.EXPECT().CancelOrdersInc().Times(1)
+
+	if err := s.CancelReservation(ctx, *number); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestService_ChangeAmountInStockSerialTracked(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+
+	mockRepo := mockrepository.NewMockInterface(ctrl)
+	s := Service{Repository: mockRepo}
+	ctx := context.WithValue(context.Background(), mockrepository.ExecuteKey{}, "✅")
+
+	mockRepo.EXPECT().ReadSerialTrackedArticles(ctx, []article.Article{"test-9"}).Times(1).Return(
+		[]article.Article{"test-9"}, nil)
+
+	err := s.ChangeAmountInStock(ctx, dto.ArticleAmount{Article: "test-9", Amount: 3})
+	if !errors.Is(err, service.ErrSerialTracked) {
+		t.Fatalf("expected %v, got %v", service.ErrSerialTracked, err)
+	}
+}
+
+func TestService_ReceiveGoodsRegistersSerials(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+
+	mockRepo := mockrepository.NewMockInterface(ctrl)
+	s := Service{Repository: mockRepo}
+	ctx := context.WithValue(context.Background(), mockrepository.ExecuteKey{}, "✅")
+	data := dto.GoodsReceipt{DocumentNumber: "TN-1", Supplier: "casio", Lines: []dto.GoodsReceiptLine{
+		{Article: "test-9", Cost: 100, Amount: 1, Serials: []serial.Number{"A1"}}}}
+
+	mockRepo.EXPECT().ReadGoodsReceipt(ctx, gomock.Any()).Times(1).Return(dto.GoodsReceipt{}, repository.ErrNoRecord)
+	mockRepo.EXPECT().ReadSerialTrackedArticles(ctx, []article.Article{"test-9"}).Times(1).Return(
+		[]article.Article{"test-9"}, nil)
+	mockRepo.EXPECT().ReadStockAmount(ctx, &dto.Article{Article: "test-9"}).Times(1).Return(uint(2), nil)
+	mockRepo.EXPECT().UpdateStockAmount(ctx, &dto.ArticleAmount{Article: "test-9", Amount: 3}).Times(1).Return(nil)
+	mockRepo.EXPECT().ReadSerials(ctx, []serial.Number{"A1"}).Times(1).Return(nil, nil)
+	mockRepo.EXPECT().CreateSerials(ctx, []dto.SerialRecord{{Serial: "A1", Article: "test-9",
+		State: serial.InStock}}).Times(1).Return(nil)
+	mockRepo.EXPECT().CreateSerialEvents(ctx, gomock.Any()).Times(1).DoAndReturn(
+		func(_ context.Context, events []dto.SerialEvent) error {
+			if len(events) != 1 || events[0].DocumentNumber != "TN-1" || events[0].State != serial.InStock {
+				t.Errorf("unexpected events %+v", events)
+			}
+			return nil
+		})
+	mockRepo.EXPECT().CreateGoodsReceipt(ctx, gomock.Any()).Times(1).Return(nil)
+
+	if received, err := s.ReceiveGoods(ctx, data); err != nil || !received {
+		t.Fatalf("expected received goods, got %t, %v", received, err)
+	}
+}
+
+func TestService_SerialHistoryUnknownSerial(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+
+	mockRepo := mockrepository.NewMockInterface(ctrl)
+	s := Service{Repository: mockRepo}
+	ctx := context.Background()
+
+	mockRepo.EXPECT().ReadSerials(ctx, []serial.Number{"A1"}).Times(1).Return(nil, nil)
+
+	if _, err := s.SerialHistory(ctx, dto.Serial{Serial: "A1"}); !errors.Is(err, repository.ErrNoRecord) {
+		t.Fatalf("expected %v, got %v", repository.ErrNoRecord, err)
+	}
+}
@@ -3,13 +3,24 @@ package service
 import (
 	"context"
 	"fmt"
+	"github.com/lazylex/watch-store-store/internal/domain/aggregates/receipt"
 	"github.com/lazylex/watch-store-store/internal/domain/aggregates/reservation"
+	"github.com/lazylex/watch-store-store/internal/domain/aggregates/shift"
+	"github.com/lazylex/watch-store-store/internal/domain/event"
+	"github.com/lazylex/watch-store-store/internal/domain/value_objects/adjustment"
 	"github.com/lazylex/watch-store-store/internal/domain/value_objects/article"
+	"github.com/lazylex/watch-store-store/internal/domain/value_objects/channel"
+	"github.com/lazylex/watch-store-store/internal/domain/value_objects/location"
+	"github.com/lazylex/watch-store-store/internal/domain/value_objects/payment"
+	"github.com/lazylex/watch-store-store/internal/domain/value_objects/pickup"
+	"github.com/lazylex/watch-store-store/internal/domain/value_objects/serial"
 	"github.com/lazylex/watch-store-store/internal/dto"
+	"github.com/lazylex/watch-store-store/internal/dto/validators"
 	"github.com/lazylex/watch-store-store/internal/helpers/constants/prefixes"
 	"github.com/lazylex/watch-store-store/internal/helpers/constants/various"
 	"github.com/lazylex/watch-store-store/internal/logger"
 	"github.com/lazylex/watch-store-store/internal/metrics"
+	"github.com/lazylex/watch-store-store/internal/ports/events"
 	"github.com/lazylex/watch-store-store/internal/ports/repository"
 	"github.com/lazylex/watch-store-store/internal/ports/service"
 	standartLog "log"
@@ -21,8 +32,37 @@ type Service struct {
 	Repository    repository.Interface
 	SQLRepository repository.SQLDBInterface
 	Metrics       *metrics.Metrics
+	// AdjustmentReasons допустимые коды причин относительной корректировки количества товара
+	AdjustmentReasons []adjustment.Reason
+	// Replenishment параметры расчёта предложений о заказе товаров
+	Replenishment ReplenishmentDefaults
+	// Instance название экземпляра приложения (магазина)
+	Instance string
+	// Events получатель доменных событий. Если не задан, события не публикуются
+	Events events.Interface
+	// StockLevelMetrics нужно ли передавать в метрики количество каждого товара
+	StockLevelMetrics bool
+	// OrderNumberPrefix префикс выделяемых сервисом номеров заказов. Если равен 0, номера выделяются без префикса
+	OrderNumberPrefix uint
+
+	// optionalOptions количество применённых необязательных опций
+	optionalOptions int
 }
 
+// ReplenishmentDefaults параметры расчёта предложений о заказе товаров. WindowDays - количество последних дней, продажи
+// за которые учитываются при расчёте скорости продаж, LeadTimeDays и SafetyStock - срок поставки и страховой запас для
+// товаров без собственных параметров пополнения, CoverDays - на сколько дней продаж после поставки должно хватить
+// заказанного товара.
+type ReplenishmentDefaults struct {
+	WindowDays   uint
+	LeadTimeDays uint
+	SafetyStock  uint
+	CoverDays    uint
+}
+
+// defaultReplenishment параметры расчёта предложений о заказе, используемые для незаданных (нулевых) значений.
+var defaultReplenishment = ReplenishmentDefaults{WindowDays: 28, LeadTimeDays: 7, CoverDays: 14}
+
 type Option func(*Service)
 
 // WithMetrics служит для внедрения в сервис уже инициализированных метрик для Prometheus.
@@ -32,8 +72,64 @@ func WithMetrics(metrics *metrics.Metrics) Option {
 	}
 }
 
+// WithAdjustmentReasons задаёт список допустимых кодов причин корректировки количества товара. Если список пуст,
+// используются коды adjustment.DefaultReasons.
+func WithAdjustmentReasons(reasons []string) Option {
+	return func(s *Service) {
+		s.optionalOptions++
+		s.AdjustmentReasons = make([]adjustment.Reason, 0, len(reasons))
+		for _, r := range reasons {
+			s.AdjustmentReasons = append(s.AdjustmentReasons, adjustment.Reason(r))
+		}
+	}
+}
+
+// WithReplenishment задаёт параметры расчёта предложений о заказе товаров. Нулевые значения (кроме страхового запаса)
+// заменяются значениями по умолчанию.
+func WithReplenishment(defaults ReplenishmentDefaults) Option {
+	return func(s *Service) {
+		s.optionalOptions++
+		s.Replenishment = defaults
+	}
+}
+
+// WithInstance задаёт название экземпляра приложения (магазина), используемое при перемещении товара между магазинами.
+func WithInstance(instance string) Option {
+	return func(s *Service) {
+		s.optionalOptions++
+		s.Instance = instance
+	}
+}
+
+// WithEvents задаёт получателя доменных событий, возникающих при изменении данных сервисом.
+func WithEvents(publisher events.Interface) Option {
+	return func(s *Service) {
+		s.optionalOptions++
+		s.Events = publisher
+	}
+}
+
+// WithStockLevelMetrics включает передачу в метрики доступного для продажи количества каждого товара. Количество
+// меток метрики равно количеству товаров в ассортименте, поэтому по умолчанию она не собирается.
+func WithStockLevelMetrics(enabled bool) Option {
+	return func(s *Service) {
+		s.optionalOptions++
+		s.StockLevelMetrics = enabled
+	}
+}
+
+// WithOrderNumberPrefix задаёт префикс выделяемых сервисом номеров заказов, позволяющий избежать совпадения номеров
+// заказов разных магазинов. Префикс не может быть больше reservation.MaxOrderNumberPrefix.
+func WithOrderNumberPrefix(prefix uint) Option {
+	return func(s *Service) {
+		s.optionalOptions++
+		s.OrderNumberPrefix = prefix
+	}
+}
+
 // New создаёт сервис. В качестве параметров передаются функции, инициализирующие в сервисе репозиторий с интерфейсом
-// repository.Interface и структура работы с логами *slog.Logger.
+// repository.Interface и метрики. Обязательными являются опции, инициализирующие репозиторий и метрики (метрики могут
+// быть инициализированы значением nil), остальные опции - необязательные.
 func New(options ...Option) *Service {
 	requiredOptions, initializedOptions := 2, 0
 
@@ -43,6 +139,17 @@ func New(options ...Option) *Service {
 		initializedOptions++
 	}
 
+	// необязательные опции не учитываются при проверке количества инициализированных обязательных опций
+	initializedOptions -= s.optionalOptions
+	if len(s.AdjustmentReasons) == 0 {
+		s.AdjustmentReasons = adjustment.DefaultReasons()
+	}
+	s.Replenishment = s.Replenishment.withDefaults()
+
+	if s.OrderNumberPrefix > reservation.MaxOrderNumberPrefix {
+		standartLog.Fatal(prefixes.ServicePrefix + reservation.ErrOrderNumberPrefix.Error())
+	}
+
 	if initializedOptions != requiredOptions {
 		standartLog.Fatal(prefixes.ServicePrefix +
 			fmt.Sprintf("need to initialize %d options, not %d", requiredOptions, initializedOptions))
@@ -65,6 +172,7 @@ func (s *Service) ChangePriceInStock(ctx context.Context, data dto.ArticlePrice)
 	if err == nil {
 		logger.LogWithCtxData(ctx, slog.With(logger.OPLabel, "service.ChangePriceInStock")).Info(
 			fmt.Sprintf("change price to %.2f in stock record with article %s", data.Price, data.Article))
+		s.emit(ctx, event.StockPriceChanged, string(data.Article), data)
 	}
 	return err
 }
@@ -97,21 +205,34 @@ func (s *Service) AddProductToStock(ctx context.Context, data dto.ArticlePriceNa
 
 	logger.LogWithCtxData(ctx, slog.With(logger.OPLabel, "service.AddProductToStock")).Info(
 		fmt.Sprintf("add to stock record with article %s, price %.2f", data.Article, data.Price))
+	s.emit(ctx, event.StockAdded, string(data.Article), data)
 	return nil
 }
 
-// ChangeAmountInStock изменяет доступное для продажи количество товара.
+// ChangeAmountInStock изменяет доступное для продажи количество товара. Количество товара, учитываемого по серийным
+// номерам, изменяется только вместе с состоянием его экземпляров, поэтому для него возвращается
+// service.ErrSerialTracked.
 func (s *Service) ChangeAmountInStock(ctx context.Context, data dto.ArticleAmount) error {
 	if err := data.Validate(); err != nil {
 		return err
 	}
 
-	if err := s.Repository.UpdateStockAmount(ctx, &data); err != nil {
+	err := s.Repository.WithinTransaction(ctx, func(txCtx context.Context) error {
+		if err := s.checkNotSerialTracked(txCtx, data.Article); err != nil {
+			return err
+		}
+		if err := s.Repository.UpdateStockAmount(txCtx, &data); err != nil {
+			return err
+		}
+		return s.withdrawFromLocations(txCtx, data.Article, data.Amount, 0, location.Default)
+	})
+	if err != nil {
 		return err
 	}
 
 	logger.LogWithCtxData(ctx, slog.With(logger.OPLabel, "service.ChangeAmountInStock")).Info(
 		fmt.Sprintf("amount udpaded to %d in stock record with article %s", data.Amount, data.Article))
+	s.emit(ctx, event.StockAmountChanged, string(data.Article), data)
 	return nil
 }
 
@@ -131,17 +252,56 @@ func (s *Service) AmountInStock(ctx context.Context, data dto.Article) (uint, er
 
 // MakeReservation производит резервирование товара для покупателя. Резервирование проводится как для бронирования
 // через интернет, так и во время нахождения товара на кассе (в ожидании оплаты локальным покупателем). В таком случае
-// в качестве номера заказа передаётся номер кассы.
-func (s *Service) MakeReservation(ctx context.Context, data dto.NumberDateStateProducts) error {
+// в качестве номера заказа передаётся номер зарегистрированной и включённой кассы. Товар резервируется в первую очередь
+// с указанного в заказе места хранения (для заказа на кассе по умолчанию - с места хранения кассы), недостающее
+// количество - с остальных мест. Если номер заказа не кассы не передан, он выделяется сервисом, иначе проверяется, что
+// заказа или кассы с таким номером ещё нет. Выделение и проверка номера выполняются в транзакции бронирования. Для
+// заказов покупателей в магазине генерируется код получения заказа, хэш которого сохраняется вместе с данными
+// покупателя. Для товаров, учитываемых по серийным номерам, передаются номера резервируемых экземпляров. Возвращается
+// номер заказа и код его получения.
+func (s *Service) MakeReservation(ctx context.Context, data dto.NumberDateStateProducts) (dto.NumberPickupCode,
+	error) {
 	var err error
 	var available uint
+	var code pickup.Code
+	var picked []dto.SerialRecord
 	newAmountInStock := make(map[article.Article]uint)
 
 	if err = data.Validate(); err != nil {
-		return err
+		return dto.NumberPickupCode{}, err
 	}
 
-	return s.Repository.WithinTransaction(ctx, func(txCtx context.Context) error {
+	if data.Customer != nil {
+		customer := *data.Customer
+		customer.Normalize()
+		data.Customer = &customer
+	}
+
+	if data.State == reservation.NewForLocalCustomer {
+		if code, err = pickup.NewCode(); err != nil {
+			return dto.NumberPickupCode{}, err
+		}
+	}
+
+	err = s.Repository.WithinTransaction(ctx, func(txCtx context.Context) error {
+		switch {
+		case data.State == reservation.NewForCashRegister:
+			var cashRegister dto.CashRegisterRecord
+			if cashRegister, err = s.useCashRegister(txCtx, data.OrderNumber); err != nil {
+				return err
+			}
+			if data.Location == "" {
+				data.Location = cashRegister.Location
+			}
+		case data.NeedsOrderNumber():
+			if data.OrderNumber, err = s.allocateOrderNumber(txCtx); err != nil {
+				return err
+			}
+		default:
+			if err = s.checkOrderNumberFree(txCtx, data.OrderNumber); err != nil {
+				return err
+			}
+		}
 		for _, p := range data.Products {
 			if available, err = s.Repository.ReadStockAmount(txCtx,
 				&dto.Article{Article: p.Article}); err != nil {
@@ -152,6 +312,10 @@ func (s *Service) MakeReservation(ctx context.Context, data dto.NumberDateStateP
 			}
 			newAmountInStock[p.Article] = available - p.Amount
 		}
+		if picked, err = s.pickSerials(txCtx, productAmounts(data.Products), data.Serials,
+			isAvailableSerial); err != nil {
+			return err
+		}
 		for _, p := range data.Products {
 			err = s.Repository.UpdateStockAmount(
 				txCtx,
@@ -163,10 +327,24 @@ func (s *Service) MakeReservation(ctx context.Context, data dto.NumberDateStateP
 			if err != nil {
 				return err
 			}
+			if err = s.withdrawFromLocations(txCtx, p.Article, newAmountInStock[p.Article], p.Amount,
+				data.Location); err != nil {
+				return err
+			}
 		}
 		if err = s.Repository.CreateReservation(txCtx, &data); err != nil {
 			return err
 		}
+		if err = s.moveSerials(txCtx, picked, dto.SerialEvent{State: serial.Reserved,
+			OrderNumber: data.OrderNumber}); err != nil {
+			return err
+		}
+		if err = s.createReservationTransition(txCtx, data.OrderNumber, 0, data.State); err != nil {
+			return err
+		}
+		if err = s.saveReservationCustomer(txCtx, &data, code); err != nil {
+			return err
+		}
 
 		if data.State == reservation.NewForInternetCustomer {
 			s.Metrics.Service.PlacedInternetOrdersInc()
@@ -180,22 +358,44 @@ func (s *Service) MakeReservation(ctx context.Context, data dto.NumberDateStateP
 			fmt.Sprintf("succesfully saved order %d", data.OrderNumber))
 		return nil
 	})
+	if err != nil {
+		return dto.NumberPickupCode{}, err
+	}
+
+	// события публикуются в брокер сообщений в открытом виде, поэтому данные покупателя в событие не попадают (код
+	// получения заказа и его хэш не входят в заказ)
+	made := data
+	made.Customer = nil
+	s.emit(ctx, event.ReservationMade, numberKey(data.OrderNumber), made)
+	return dto.NumberPickupCode{OrderNumber: data.OrderNumber, PickupCode: code}, nil
 }
 
-// CancelReservation снимает бронь с товара/ов.
+// CancelReservation снимает бронь с товара/ов. Отменить можно любой ещё не выполненный заказ, в том числе переданный в
+// доставку (если покупатель от него отказался и товар вернулся в магазин). Зарезервированные под заказ экземпляры
+// товаров с серийными номерами снова становятся доступными для продажи.
 func (s *Service) CancelReservation(ctx context.Context, data dto.Number) error {
 	if err := data.Validate(); err != nil {
 		return err
 	}
 
-	return s.Repository.WithinTransaction(ctx, func(txCtx context.Context) error {
+	var cancelled dto.NumberDateStateProducts
+	err := s.Repository.WithinTransaction(ctx, func(txCtx context.Context) error {
 		res, err := s.Repository.ReadReservation(txCtx, &data)
 		if err != nil {
 			return err
 		}
 
-		if !res.IsNew() {
-			return service.ErrAlreadyProcessed
+		if err = s.transitReservation(txCtx, &res, reservation.Cancel); err != nil {
+			return err
+		}
+
+		var reserved []dto.SerialRecord
+		if reserved, err = s.Repository.ReadSerialsByOrder(txCtx, &data); err != nil {
+			return err
+		}
+		if err = s.moveSerials(txCtx, reserved, dto.SerialEvent{State: serial.InStock,
+			OrderNumber: data.OrderNumber}); err != nil {
+			return err
 		}
 
 		for _, p := range res.Products {
@@ -210,16 +410,18 @@ func (s *Service) CancelReservation(ctx context.Context, data dto.Number) error
 
 		}
 
-		if data.OrderNumber <= reservation.MaxCashRegisterNumber {
-			return s.Repository.DeleteReservation(txCtx, &dto.Number{OrderNumber: data.OrderNumber})
-		}
-
-		err = s.Repository.UpdateReservation(txCtx, &dto.NumberDateStateProducts{
+		cancelled = dto.NumberDateStateProducts{
 			Products:    res.Products,
 			OrderNumber: data.OrderNumber,
 			Date:        time.Now(),
 			State:       reservation.Cancel,
-		})
+		}
+
+		if res.State == reservation.NewForCashRegister {
+			return s.Repository.DeleteReservation(txCtx, &dto.Number{OrderNumber: data.OrderNumber})
+		}
+
+		err = s.Repository.UpdateReservation(txCtx, &cancelled)
 
 		if err == nil {
 			s.Metrics.Service.CancelOrdersInc()
@@ -227,21 +429,42 @@ func (s *Service) CancelReservation(ctx context.Context, data dto.Number) error
 
 		return err
 	})
+	if err != nil {
+		return err
+	}
+
+	s.emit(ctx, event.ReservationCancelled, numberKey(data.OrderNumber), cancelled)
+	return nil
 }
 
-// MakeSale уменьшает количества доступного для продажи товара и производит запись в статистику продаж.
-func (s *Service) MakeSale(ctx context.Context, data []dto.ArticlePriceAmount) error {
-	for _, p := range data {
-		if err := p.Validate(); err != nil {
-			return err
-		}
+// MakeSale уменьшает количества доступного для продажи товара и производит запись в статистику продаж. Проданные
+// товары объединяются в чек, идентификатор которого возвращается. Сумма переданных платежей должна покрывать сумму
+// чека, сдача выдаётся только с наличных. Для товаров, учитываемых по серийным номерам, передаются номера продаваемых
+// экземпляров. На товары с гарантийным сроком выдаются гарантии, привязанные к чеку и телефону покупателя, если он
+// передан.
+func (s *Service) MakeSale(ctx context.Context, data dto.CashRegisterProducts) (receipt.ID, error) {
+	if err := data.Validate(); err != nil {
+		return 0, err
 	}
 
 	var err error
 	var available uint
+	var id receipt.ID
+	var picked []dto.SerialRecord
+	sold := dto.Receipt{CashRegister: data.CashRegister, Products: data.Products}
 
-	return s.Repository.WithinTransaction(ctx, func(txCtx context.Context) error {
-		for _, p := range data {
+	sold.CalculateTotal()
+	if err = sold.ApplyPayments(data.PaymentMethod, data.Payments); err != nil {
+		return 0, err
+	}
+
+	err = s.Repository.WithinTransaction(ctx, func(txCtx context.Context) error {
+		var shiftID shift.ID
+		if shiftID, err = s.openShiftID(txCtx, data.CashRegister); err != nil {
+			return err
+		}
+
+		for _, p := range data.Products {
 			if available, err = s.Repository.ReadStockAmount(txCtx, &dto.Article{Article: p.Article}); err != nil {
 				return err
 			}
@@ -256,53 +479,121 @@ func (s *Service) MakeSale(ctx context.Context, data []dto.ArticlePriceAmount) e
 			); err != nil {
 				return err
 			}
-		}
-
-		for _, p := range data {
-			if err = s.Repository.CreateSoldRecord(txCtx, &dto.ArticlePriceAmountDate{
-				Article: p.Article, Price: p.Price, Amount: p.Amount, Date: time.Now(),
-			}); err != nil {
+			if err = s.withdrawFromLocations(txCtx, p.Article, available-p.Amount, p.Amount,
+				location.Default); err != nil {
 				return err
 			}
 		}
 
+		if picked, err = s.pickSerials(txCtx, productAmounts(data.Products), data.Serials,
+			isAvailableSerial); err != nil {
+			return err
+		}
+
+		sold.ShiftID = shiftID
+		if id, err = s.createReceipt(txCtx, &sold); err != nil {
+			return err
+		}
+		sold.ID = id
+
+		if err = s.moveSerials(txCtx, picked, dto.SerialEvent{State: serial.Sold, ReceiptID: id}); err != nil {
+			return err
+		}
+		if err = s.issueWarranties(txCtx, &sold, picked, data.Phone.Normalized()); err != nil {
+			return err
+		}
+
 		logger.LogWithCtxData(txCtx, slog.With(logger.OPLabel, "service.MakeSale")).Info(
-			"sale completed successfully")
+			fmt.Sprintf("sale completed successfully, receipt %d", id))
 
 		return nil
 	})
+
+	if err != nil {
+		return 0, err
+	}
+
+	s.observeSale(channel.Register, &sold)
+	s.emit(ctx, event.SaleMade, numberKey(id), sold)
+	return id, nil
 }
 
-// FinishOrder помечает заказ, как выполненный. Данные о содержащихся в заказе товарах переносятся в статистику продаж.
-func (s *Service) FinishOrder(ctx context.Context, data dto.Number) error {
+// FinishOrder помечает заказ, как выполненный. Данные о содержащихся в заказе товарах переносятся в статистику продаж
+// и объединяются в чек, идентификатор которого возвращается. Заказ, оформленный на кассе, относится к открытой на ней
+// смене и требует указания способа оплаты или платежей. Заказ покупателя в магазине выдаётся только по коду получения
+// заказа, выданному при резервировании. Заказ интернет-магазина без указанного способа оплаты и платежей считается
+// оплаченным через интернет. Зарезервированные под заказ экземпляры товаров с серийными номерами продаются по чеку.
+// На товары с гарантийным сроком выдаются гарантии, привязанные к чеку и телефону покупателя заказа.
+func (s *Service) FinishOrder(ctx context.Context, data dto.NumberPaymentMethod) (receipt.ID, error) {
 	if err := data.Validate(); err != nil {
-		return err
+		return 0, err
 	}
 
-	return s.Repository.WithinTransaction(ctx, func(txCtx context.Context) error {
+	var id receipt.ID
+	var check dto.Receipt
+	var ch channel.Channel
 
-		res, err := s.Repository.ReadReservation(txCtx, &data)
+	err := s.Repository.WithinTransaction(ctx, func(txCtx context.Context) error {
+
+		number := dto.Number{OrderNumber: data.OrderNumber}
+		res, err := s.Repository.ReadReservation(txCtx, &number)
 		if err != nil {
 			return err
 		}
 
-		if !res.IsNew() {
-			return service.ErrAlreadyProcessed
+		if isPickupState(res.State) {
+			if err = s.checkPickupCode(txCtx, data.OrderNumber, data.PickupCode); err != nil {
+				return err
+			}
 		}
 
-		for _, p := range res.Products {
-			if err = s.Repository.CreateSoldRecord(txCtx, &dto.ArticlePriceAmountDate{
-				Article: p.Article,
-				Price:   p.Price,
-				Amount:  p.Amount,
-				Date:    time.Now(),
-			}); err != nil {
+		if err = s.transitReservation(txCtx, &res, reservation.Finished); err != nil {
+			return err
+		}
+		ch = saleChannel(res.State)
+		forCashRegister := res.State == reservation.NewForCashRegister
+
+		check = dto.Receipt{Products: res.Products}
+		method := data.PaymentMethod
+		if forCashRegister {
+			if method == "" && len(data.Payments) == 0 {
+				return validators.ErrIncorrectPaymentMethod
+			}
+			check.CashRegister = data.OrderNumber
+			if check.ShiftID, err = s.openShiftID(txCtx, data.OrderNumber); err != nil {
 				return err
 			}
+		} else {
+			check.OrderNumber = data.OrderNumber
+			if method == "" && len(data.Payments) == 0 {
+				method = payment.Online
+			}
+		}
+
+		check.CalculateTotal()
+		if err = check.ApplyPayments(method, data.Payments); err != nil {
+			return err
+		}
+
+		if id, err = s.createReceipt(txCtx, &check); err != nil {
+			return err
+		}
+		check.ID = id
+
+		var reserved []dto.SerialRecord
+		if reserved, err = s.Repository.ReadSerialsByOrder(txCtx, &number); err != nil {
+			return err
+		}
+		if err = s.moveSerials(txCtx, reserved, dto.SerialEvent{State: serial.Sold, OrderNumber: data.OrderNumber,
+			ReceiptID: id}); err != nil {
+			return err
+		}
+		if err = s.issueWarranties(txCtx, &check, reserved, ""); err != nil {
+			return err
 		}
 
-		if data.OrderNumber <= reservation.MaxCashRegisterNumber {
-			return s.Repository.DeleteReservation(txCtx, &data)
+		if forCashRegister {
+			return s.Repository.DeleteReservation(txCtx, &number)
 		}
 
 		return s.Repository.UpdateReservation(txCtx, &dto.NumberDateStateProducts{
@@ -312,6 +603,42 @@ func (s *Service) FinishOrder(ctx context.Context, data dto.Number) error {
 			State:       reservation.Finished,
 		})
 	})
+
+	if err != nil {
+		return 0, err
+	}
+
+	s.observeSale(ch, &check)
+	s.emit(ctx, event.OrderFinished, numberKey(data.OrderNumber), check)
+	return id, nil
+}
+
+// createReceipt проставляет дату продажи, рассчитывает НДС по действующим на неё ставкам и сохраняет чек вместе с
+// записями о проданных товарах и платежах в хранилище. Итоговая сумма и платежи чека должны быть рассчитаны заранее.
+func (s *Service) createReceipt(ctx context.Context, data *dto.Receipt) (receipt.ID, error) {
+	data.Date = time.Now()
+	if err := s.applyTaxes(ctx, data); err != nil {
+		return 0, err
+	}
+
+	return s.Repository.CreateReceipt(ctx, data)
+}
+
+// Receipt возвращает чек с переданным идентификатором вместе с проданными по нему товарами.
+func (s *Service) Receipt(ctx context.Context, data dto.ReceiptID) (dto.Receipt, error) {
+	if err := data.Validate(); err != nil {
+		return dto.Receipt{}, err
+	}
+
+	result, err := s.Repository.ReadReceipt(ctx, &data)
+	if err != nil {
+		return dto.Receipt{}, err
+	}
+
+	logger.LogWithCtxData(ctx, slog.With(logger.OPLabel, "service.Receipt")).Info(
+		fmt.Sprintf("requested receipt %d", data.ID))
+
+	return result, nil
 }
 
 // TotalSold возвращает количество проданного товара с переданным артикулом за весь период.
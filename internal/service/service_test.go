@@ -4,8 +4,11 @@ import (
 	"context"
 	"errors"
 	"github.com/golang/mock/gomock"
+	"github.com/lazylex/watch-store-store/internal/domain/aggregates/receipt"
 	"github.com/lazylex/watch-store-store/internal/domain/aggregates/reservation"
+	"github.com/lazylex/watch-store-store/internal/domain/value_objects/payment"
 	"github.com/lazylex/watch-store-store/internal/dto"
+	"github.com/lazylex/watch-store-store/internal/dto/validators"
 	"github.com/lazylex/watch-store-store/internal/metrics"
 	mockService "github.com/lazylex/watch-store-store/internal/ports/metrics/service/mocks"
 	"github.com/lazylex/watch-store-store/internal/ports/repository"
@@ -207,10 +210,13 @@ func TestService_ChangeAmountInStockCorrectDTO(t *testing.T) {
 	mockRepo := mockrepository.NewMockInterface(ctrl)
 	data := dto.ArticleAmount{Article: "test-9", Amount: 10}
 	s := Service{Repository: mockRepo}
+	ctx := context.WithValue(context.Background(), mockrepository.ExecuteKey{}, "✅")
+	expectNoSerials(mockRepo, ctx)
 
-	mockRepo.EXPECT().UpdateStockAmount(context.Background(), &data).Times(1).Return(nil)
+	mockRepo.EXPECT().UpdateStockAmount(ctx, &data).Times(1).Return(nil)
+	mockRepo.EXPECT().ReadStockLocations(ctx, &dto.Article{Article: "test-9"}).Times(1).Return(nil, nil)
 
-	err := s.ChangeAmountInStock(context.Background(), data)
+	err := s.ChangeAmountInStock(ctx, data)
 	if err != nil {
 		t.Fail()
 	}
@@ -239,10 +245,12 @@ func TestService_ChangeAmountInStockNoRecord(t *testing.T) {
 	mockRepo := mockrepository.NewMockInterface(ctrl)
 	data := dto.ArticleAmount{Article: "test-9", Amount: 10}
 	s := Service{Repository: mockRepo}
+	ctx := context.WithValue(context.Background(), mockrepository.ExecuteKey{}, "✅")
+	expectNoSerials(mockRepo, ctx)
 
-	mockRepo.EXPECT().UpdateStockAmount(context.Background(), &data).Times(1).Return(repository.ErrNoRecord)
+	mockRepo.EXPECT().UpdateStockAmount(ctx, &data).Times(1).Return(repository.ErrNoRecord)
 
-	err := s.ChangeAmountInStock(context.Background(), data)
+	err := s.ChangeAmountInStock(ctx, data)
 	if !errors.Is(err, repository.ErrNoRecord) {
 		t.Fail()
 	}
@@ -408,7 +416,7 @@ func TestService_MakeReservationIncorrectDTO(t *testing.T) {
 	mockRepo := mockrepository.NewMockInterface(ctrl)
 	data := dto.NumberDateStateProducts{
 		Products:    []dto.ArticlePriceAmount{{Article: "test-9", Amount: 1, Price: 698}},
-		OrderNumber: reservation.MaxCashRegisterNumber + 1,
+		OrderNumber: -1,
 		Date:        time.Now(),
 		State:       reservation.NewForCashRegister,
 	}
@@ -416,7 +424,7 @@ func TestService_MakeReservationIncorrectDTO(t *testing.T) {
 
 	mockRepo.EXPECT().WithinTransaction(context.Background(), gomock.Any()).Times(0)
 
-	err := s.MakeReservation(context.Background(), data)
+	_, err := s.MakeReservation(context.Background(), data)
 	if err == nil {
 		t.Fail()
 	}
@@ -429,19 +437,23 @@ func TestService_MakeReservationSuccess(t *testing.T) {
 	mockRepo := mockrepository.NewMockInterface(ctrl)
 	data := dto.NumberDateStateProducts{
 		Products:    []dto.ArticlePriceAmount{{Article: "test-9", Amount: 1, Price: 698}},
-		OrderNumber: reservation.MaxCashRegisterNumber,
+		OrderNumber: 10,
 		Date:        time.Now(),
 		State:       reservation.NewForCashRegister,
 	}
 	s := Service{Repository: mockRepo}
 
 	ctx := context.WithValue(context.Background(), mockrepository.ExecuteKey{}, "✅")
+	expectNoSerials(mockRepo, ctx)
+	expectCashRegister(mockRepo, ctx, data.OrderNumber)
 	mockRepo.EXPECT().ReadStockAmount(ctx, &dto.Article{Article: "test-9"}).Times(1).Return(uint(5), nil)
 	mockRepo.EXPECT().UpdateStockAmount(ctx,
 		&dto.ArticleAmount{Article: "test-9", Amount: uint(4)}).Times(1).Return(nil)
+	mockRepo.EXPECT().ReadStockLocations(ctx, &dto.Article{Article: "test-9"}).Times(1).Return(nil, nil)
 	mockRepo.EXPECT().CreateReservation(ctx, &data).Times(1).Return(nil)
+	mockRepo.EXPECT().CreateReservationTransition(ctx, gomock.Any()).Times(1).Return(nil)
 
-	err := s.MakeReservation(ctx, data)
+	_, err := s.MakeReservation(ctx, data)
 	if err != nil {
 		t.Fail()
 	}
@@ -455,7 +467,7 @@ func TestService_MakeReservationForInternetSuccess(t *testing.T) {
 	mockServiceMetrics := mockService.NewMockMetricsInterface(ctrl)
 	data := dto.NumberDateStateProducts{
 		Products:    []dto.ArticlePriceAmount{{Article: "test-9", Amount: 1, Price: 698}},
-		OrderNumber: reservation.MaxCashRegisterNumber + 1,
+		OrderNumber: 11,
 		Date:        time.Now(),
 		State:       reservation.NewForInternetCustomer,
 	}
@@ -463,13 +475,19 @@ func TestService_MakeReservationForInternetSuccess(t *testing.T) {
 		Metrics: &metrics.Metrics{Service: mockServiceMetrics}}
 
 	ctx := context.WithValue(context.Background(), mockrepository.ExecuteKey{}, "✅")
+	expectNoSerials(mockRepo, ctx)
+	mockRepo.EXPECT().ReadReservation(ctx, &dto.Number{OrderNumber: data.OrderNumber}).Times(1).Return(
+		dto.NumberDateStateProducts{}, repository.ErrNoRecord)
+	expectNoCashRegister(mockRepo, ctx, data.OrderNumber)
 	mockRepo.EXPECT().ReadStockAmount(ctx, &dto.Article{Article: "test-9"}).Times(1).Return(uint(5), nil)
 	mockRepo.EXPECT().UpdateStockAmount(ctx,
 		&dto.ArticleAmount{Article: "test-9", Amount: uint(4)}).Times(1).Return(nil)
+	mockRepo.EXPECT().ReadStockLocations(ctx, &dto.Article{Article: "test-9"}).Times(1).Return(nil, nil)
 	mockRepo.EXPECT().CreateReservation(ctx, &data).Times(1).Return(nil)
+	mockRepo.EXPECT().CreateReservationTransition(ctx, gomock.Any()).Times(1).Return(nil)
 	mockServiceMetrics.EXPECT().PlacedInternetOrdersInc().Times(1)
 
-	err := s.MakeReservation(ctx, data)
+	_, err := s.MakeReservation(ctx, data)
 	if err != nil {
 		t.Fail()
 	}
@@ -483,7 +501,7 @@ func TestService_MakeReservationForLocalSuccess(t *testing.T) {
 	mockServiceMetrics := mockService.NewMockMetricsInterface(ctrl)
 	data := dto.NumberDateStateProducts{
 		Products:    []dto.ArticlePriceAmount{{Article: "test-9", Amount: 1, Price: 698}},
-		OrderNumber: reservation.MaxCashRegisterNumber + 1,
+		OrderNumber: 11,
 		Date:        time.Now(),
 		State:       reservation.NewForLocalCustomer,
 	}
@@ -491,15 +509,28 @@ func TestService_MakeReservationForLocalSuccess(t *testing.T) {
 		Metrics: &metrics.Metrics{Service: mockServiceMetrics}}
 
 	ctx := context.WithValue(context.Background(), mockrepository.ExecuteKey{}, "✅")
+	expectNoSerials(mockRepo, ctx)
+	mockRepo.EXPECT().ReadReservation(ctx, &dto.Number{OrderNumber: data.OrderNumber}).Times(1).Return(
+		dto.NumberDateStateProducts{}, repository.ErrNoRecord)
+	expectNoCashRegister(mockRepo, ctx, data.OrderNumber)
 	mockRepo.EXPECT().ReadStockAmount(ctx, &dto.Article{Article: "test-9"}).Times(1).Return(uint(5), nil)
 	mockRepo.EXPECT().UpdateStockAmount(ctx,
 		&dto.ArticleAmount{Article: "test-9", Amount: uint(4)}).Times(1).Return(nil)
+	mockRepo.EXPECT().ReadStockLocations(ctx, &dto.Article{Article: "test-9"}).Times(1).Return(nil, nil)
 	mockRepo.EXPECT().CreateReservation(ctx, &data).Times(1).Return(nil)
+	mockRepo.EXPECT().CreateReservationTransition(ctx, gomock.Any()).Times(1).Return(nil)
+	var saved dto.ReservationCustomer
+	mockRepo.EXPECT().CreateReservationCustomer(ctx, gomock.Any()).Times(1).DoAndReturn(
+		func(_ context.Context, record *dto.ReservationCustomer) error {
+			saved = *record
+			return nil
+		})
 	mockServiceMetrics.EXPECT().PlacedLocalOrdersInc().Times(1)
 
-	err := s.MakeReservation(ctx, data)
-	if err != nil {
-		t.Fail()
+	result, err := s.MakeReservation(ctx, data)
+	if err != nil || !result.PickupCode.IsValid() || saved.OrderNumber != data.OrderNumber || saved.Customer != nil ||
+		!result.PickupCode.Matches(saved.PickupCodeHash, pickupCodeSalt(data.OrderNumber)) {
+		t.Errorf("unexpected result %d, saved %+v, %v", result.OrderNumber, saved, err)
 	}
 }
 
@@ -510,17 +541,18 @@ func TestService_MakeReservationErrAmount(t *testing.T) {
 	mockRepo := mockrepository.NewMockInterface(ctrl)
 	data := dto.NumberDateStateProducts{
 		Products:    []dto.ArticlePriceAmount{{Article: "test-9", Amount: 1, Price: 698}},
-		OrderNumber: reservation.MaxCashRegisterNumber,
+		OrderNumber: 10,
 		Date:        time.Now(),
 		State:       reservation.NewForCashRegister,
 	}
 	s := Service{Repository: mockRepo}
 
 	ctx := context.WithValue(context.Background(), mockrepository.ExecuteKey{}, "✅")
+	expectCashRegister(mockRepo, ctx, data.OrderNumber)
 	mockRepo.EXPECT().ReadStockAmount(ctx, &dto.Article{Article: "test-9"}).Times(1).Return(
 		uint(0), errors.New(""))
 
-	err := s.MakeReservation(ctx, data)
+	_, err := s.MakeReservation(ctx, data)
 	if err == nil {
 		t.Fail()
 	}
@@ -533,16 +565,17 @@ func TestService_MakeReservationNoEnough(t *testing.T) {
 	mockRepo := mockrepository.NewMockInterface(ctrl)
 	data := dto.NumberDateStateProducts{
 		Products:    []dto.ArticlePriceAmount{{Article: "test-9", Amount: 2, Price: 698}},
-		OrderNumber: reservation.MaxCashRegisterNumber,
+		OrderNumber: 10,
 		Date:        time.Now(),
 		State:       reservation.NewForCashRegister,
 	}
 	s := Service{Repository: mockRepo}
 
 	ctx := context.WithValue(context.Background(), mockrepository.ExecuteKey{}, "✅")
+	expectCashRegister(mockRepo, ctx, data.OrderNumber)
 	mockRepo.EXPECT().ReadStockAmount(ctx, &dto.Article{Article: "test-9"}).Times(1).Return(uint(1), nil)
 
-	err := s.MakeReservation(ctx, data)
+	_, err := s.MakeReservation(ctx, data)
 	if !errors.Is(err, service.ErrNoEnoughItemsToReserve) {
 		t.Fail()
 	}
@@ -555,18 +588,20 @@ func TestService_MakeReservationErrUpdate(t *testing.T) {
 	mockRepo := mockrepository.NewMockInterface(ctrl)
 	data := dto.NumberDateStateProducts{
 		Products:    []dto.ArticlePriceAmount{{Article: "test-9", Amount: 1, Price: 698}},
-		OrderNumber: reservation.MaxCashRegisterNumber,
+		OrderNumber: 10,
 		Date:        time.Now(),
 		State:       reservation.NewForCashRegister,
 	}
 	s := Service{Repository: mockRepo}
 
 	ctx := context.WithValue(context.Background(), mockrepository.ExecuteKey{}, "✅")
+	expectNoSerials(mockRepo, ctx)
+	expectCashRegister(mockRepo, ctx, data.OrderNumber)
 	mockRepo.EXPECT().ReadStockAmount(ctx, &dto.Article{Article: "test-9"}).Times(1).Return(uint(5), nil)
 	mockRepo.EXPECT().UpdateStockAmount(ctx,
 		&dto.ArticleAmount{Article: "test-9", Amount: uint(4)}).Times(1).Return(errors.New(""))
 
-	err := s.MakeReservation(ctx, data)
+	_, err := s.MakeReservation(ctx, data)
 	if err == nil {
 		t.Fail()
 	}
@@ -579,19 +614,22 @@ func TestService_MakeReservationErrCreate(t *testing.T) {
 	mockRepo := mockrepository.NewMockInterface(ctrl)
 	data := dto.NumberDateStateProducts{
 		Products:    []dto.ArticlePriceAmount{{Article: "test-9", Amount: 1, Price: 698}},
-		OrderNumber: reservation.MaxCashRegisterNumber,
+		OrderNumber: 10,
 		Date:        time.Now(),
 		State:       reservation.NewForCashRegister,
 	}
 	s := Service{Repository: mockRepo}
 
 	ctx := context.WithValue(context.Background(), mockrepository.ExecuteKey{}, "✅")
+	expectNoSerials(mockRepo, ctx)
+	expectCashRegister(mockRepo, ctx, data.OrderNumber)
 	mockRepo.EXPECT().ReadStockAmount(ctx, &dto.Article{Article: "test-9"}).Times(1).Return(uint(5), nil)
 	mockRepo.EXPECT().UpdateStockAmount(ctx,
 		&dto.ArticleAmount{Article: "test-9", Amount: uint(4)}).Times(1).Return(nil)
+	mockRepo.EXPECT().ReadStockLocations(ctx, &dto.Article{Article: "test-9"}).Times(1).Return(nil, nil)
 	mockRepo.EXPECT().CreateReservation(ctx, &data).Times(1).Return(errors.New(""))
 
-	err := s.MakeReservation(ctx, data)
+	_, err := s.MakeReservation(ctx, data)
 	if err == nil {
 		t.Fail()
 	}
@@ -622,6 +660,7 @@ func TestService_CancelReservationCashRegister(t *testing.T) {
 	s := Service{Repository: mockRepo}
 
 	ctx := context.WithValue(context.Background(), mockrepository.ExecuteKey{}, "✅")
+	expectNoSerials(mockRepo, ctx)
 
 	mockRepo.EXPECT().ReadReservation(ctx, &data).Times(1).Return(
 		dto.NumberDateStateProducts{
@@ -630,6 +669,7 @@ func TestService_CancelReservationCashRegister(t *testing.T) {
 			Date:        time.Now(),
 			State:       reservation.NewForCashRegister,
 		}, nil)
+	mockRepo.EXPECT().CreateReservationTransition(ctx, gomock.Any()).Times(1).Return(nil)
 	mockRepo.EXPECT().ReadStockAmount(ctx, &dto.Article{Article: "test-9"}).Times(1).Return(uint(5), nil)
 	mockRepo.EXPECT().UpdateStockAmount(ctx,
 		&dto.ArticleAmount{Article: "test-9", Amount: uint(6)}).Times(1).Return(nil)
@@ -692,6 +732,7 @@ func TestService_CancelReservationErrReadStockAmount(t *testing.T) {
 	s := Service{Repository: mockRepo}
 
 	ctx := context.WithValue(context.Background(), mockrepository.ExecuteKey{}, "✅")
+	expectNoSerials(mockRepo, ctx)
 
 	mockRepo.EXPECT().ReadReservation(ctx, &data).Times(1).Return(
 		dto.NumberDateStateProducts{
@@ -700,6 +741,7 @@ func TestService_CancelReservationErrReadStockAmount(t *testing.T) {
 			Date:        time.Now(),
 			State:       reservation.NewForCashRegister,
 		}, nil)
+	mockRepo.EXPECT().CreateReservationTransition(ctx, gomock.Any()).Times(1).Return(nil)
 	mockRepo.EXPECT().ReadStockAmount(ctx, &dto.Article{Article: "test-9"}).Times(1).Return(
 		uint(0), repository.ErrNoRecord)
 
@@ -718,6 +760,7 @@ func TestService_CancelReservationErrUpdateAmount(t *testing.T) {
 	s := Service{Repository: mockRepo}
 
 	ctx := context.WithValue(context.Background(), mockrepository.ExecuteKey{}, "✅")
+	expectNoSerials(mockRepo, ctx)
 
 	mockRepo.EXPECT().ReadReservation(ctx, &data).Times(1).Return(
 		dto.NumberDateStateProducts{
@@ -726,6 +769,7 @@ func TestService_CancelReservationErrUpdateAmount(t *testing.T) {
 			Date:        time.Now(),
 			State:       reservation.NewForCashRegister,
 		}, nil)
+	mockRepo.EXPECT().CreateReservationTransition(ctx, gomock.Any()).Times(1).Return(nil)
 	mockRepo.EXPECT().ReadStockAmount(ctx, &dto.Article{Article: "test-9"}).Times(1).Return(uint(5), nil)
 	mockRepo.EXPECT().UpdateStockAmount(ctx,
 		&dto.ArticleAmount{Article: "test-9", Amount: uint(6)}).Times(1).Return(repository.ErrTimeout)
@@ -747,10 +791,12 @@ func TestService_CancelReservationInternet(t *testing.T) {
 		Metrics: &metrics.Metrics{HTTP: nil, Service: mockServiceMetrics}}
 
 	ctx := context.WithValue(context.Background(), mockrepository.ExecuteKey{}, "✅")
+	expectNoSerials(mockRepo, ctx)
 	resData := dto.NumberDateStateProducts{Products: []dto.ArticlePriceAmount{{Article: "test-9", Amount: 1, Price: 698}},
 		OrderNumber: 555, Date: time.Now(), State: reservation.NewForInternetCustomer,
 	}
 	mockRepo.EXPECT().ReadReservation(ctx, &data).Times(1).Return(resData, nil)
+	mockRepo.EXPECT().CreateReservationTransition(ctx, gomock.Any()).Times(1).Return(nil)
 	mockRepo.EXPECT().ReadStockAmount(ctx, &dto.Article{Article: "test-9"}).Times(1).Return(uint(5), nil)
 	mockRepo.EXPECT().UpdateStockAmount(ctx,
 		&dto.ArticleAmount{Article: "test-9", Amount: uint(6)}).Times(1).Return(nil)
@@ -772,9 +818,10 @@ func TestService_MakeSaleErrDTO(t *testing.T) {
 	ctrl := gomock.NewController(t)
 
 	mockRepo := mockrepository.NewMockInterface(ctrl)
-	data := []dto.ArticlePriceAmount{{Article: "test-9.9999", Price: 410, Amount: 10}}
+	data := dto.CashRegisterProducts{CashRegister: 1, PaymentMethod: payment.Cash,
+		Products: []dto.ArticlePriceAmount{{Article: "test-9.9999", Price: 410, Amount: 10}}}
 	s := Service{Repository: mockRepo}
-	err := s.MakeSale(context.Background(), data)
+	_, err := s.MakeSale(context.Background(), data)
 	if err == nil {
 		t.Fail()
 	}
@@ -785,34 +832,103 @@ func TestService_MakeSaleSuccess(t *testing.T) {
 	ctrl := gomock.NewController(t)
 
 	mockRepo := mockrepository.NewMockInterface(ctrl)
-	data := []dto.ArticlePriceAmount{{Article: "test-9", Price: 410, Amount: 10}}
+	data := dto.CashRegisterProducts{CashRegister: 1, PaymentMethod: payment.Cash,
+		Products: []dto.ArticlePriceAmount{{Article: "test-9", Price: 410, Amount: 10}}}
 	s := Service{Repository: mockRepo}
 	ctx := context.WithValue(context.Background(), mockrepository.ExecuteKey{}, "✅")
+	expectNoSerials(mockRepo, ctx)
 
+	expectCashRegister(mockRepo, ctx, 1)
+	mockRepo.EXPECT().ReadOpenShift(ctx, &dto.CashRegister{CashRegister: 1}).Times(1).Return(
+		dto.Shift{ID: 7, CashRegister: 1}, nil)
 	mockRepo.EXPECT().ReadStockAmount(ctx, &dto.Article{Article: "test-9"}).Times(1).Return(uint(12), nil)
 	mockRepo.EXPECT().UpdateStockAmount(ctx, &dto.ArticleAmount{Article: "test-9", Amount: 2}).Times(1).Return(nil)
-	mockRepo.EXPECT().CreateSoldRecord(ctx, gomock.Any()).Times(1).Return(nil)
+	mockRepo.EXPECT().ReadStockLocations(ctx, &dto.Article{Article: "test-9"}).Times(1).Return(nil, nil)
+	expectNoTaxRates(mockRepo, ctx)
+	expectNoWarranties(mockRepo, ctx)
+	mockRepo.EXPECT().CreateReceipt(ctx, gomock.Any()).Times(1).Return(receipt.ID(1), nil)
 
-	err := s.MakeSale(ctx, data)
+	_, err := s.MakeSale(ctx, data)
 	if err != nil {
 		t.Fail()
 	}
 }
 
-func TestService_MakeSaleErrCreateSoldRecord(t *testing.T) {
+func TestService_MakeSalePaymentsDontMatchTotal(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+
+	mockRepo := mockrepository.NewMockInterface(ctrl)
+	data := dto.CashRegisterProducts{CashRegister: 1,
+		Payments: []dto.Payment{{Method: payment.Card, Amount: 4000}, {Method: payment.Voucher, Amount: 50}},
+		Products: []dto.ArticlePriceAmount{{Article: "test-9", Price: 410, Amount: 10}}}
+	s := Service{Repository: mockRepo}
+	ctx := context.WithValue(context.Background(), mockrepository.ExecuteKey{}, "✅")
+
+	mockRepo.EXPECT().WithinTransaction(gomock.Any(), gomock.Any()).Times(0)
+	mockRepo.EXPECT().CreateReceipt(gomock.Any(), gomock.Any()).Times(0)
+
+	if _, err := s.MakeSale(ctx, data); !errors.Is(err, validators.ErrPaymentsDontMatchTotal) {
+		t.Fail()
+	}
+}
+
+func TestService_MakeSaleSavesPaymentsWithChange(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+
+	mockRepo := mockrepository.NewMockInterface(ctrl)
+	data := dto.CashRegisterProducts{CashRegister: 1,
+		Payments: []dto.Payment{{Method: payment.Voucher, Amount: 4000}, {Method: payment.Cash, Amount: 500}},
+		Products: []dto.ArticlePriceAmount{{Article: "test-9", Price: 410, Amount: 10}}}
+	s := Service{Repository: mockRepo}
+	ctx := context.WithValue(context.Background(), mockrepository.ExecuteKey{}, "✅")
+	expectNoSerials(mockRepo, ctx)
+
+	expectCashRegister(mockRepo, ctx, 1)
+	mockRepo.EXPECT().ReadOpenShift(ctx, &dto.CashRegister{CashRegister: 1}).Times(1).Return(
+		dto.Shift{ID: 7, CashRegister: 1}, nil)
+	mockRepo.EXPECT().ReadStockAmount(ctx, &dto.Article{Article: "test-9"}).Times(1).Return(uint(12), nil)
+	mockRepo.EXPECT().UpdateStockAmount(ctx, &dto.ArticleAmount{Article: "test-9", Amount: 2}).Times(1).Return(nil)
+	mockRepo.EXPECT().ReadStockLocations(ctx, &dto.Article{Article: "test-9"}).Times(1).Return(nil, nil)
+	expectNoTaxRates(mockRepo, ctx)
+	expectNoWarranties(mockRepo, ctx)
+	mockRepo.EXPECT().CreateReceipt(ctx, gomock.Any()).Times(1).DoAndReturn(
+		func(_ context.Context, r *dto.Receipt) (receipt.ID, error) {
+			if r.ShiftID != 7 || r.PaymentMethod != payment.Mixed || len(r.Payments) != 2 ||
+				r.Payments[1].Change != 400 {
+				t.Errorf("unexpected receipt %+v", r)
+			}
+			return receipt.ID(1), nil
+		})
+
+	if _, err := s.MakeSale(ctx, data); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestService_MakeSaleErrCreateReceipt(t *testing.T) {
 	t.Parallel()
 	ctrl := gomock.NewController(t)
 
 	mockRepo := mockrepository.NewMockInterface(ctrl)
-	data := []dto.ArticlePriceAmount{{Article: "test-9", Price: 410, Amount: 10}}
+	data := dto.CashRegisterProducts{CashRegister: 1, PaymentMethod: payment.Cash,
+		Products: []dto.ArticlePriceAmount{{Article: "test-9", Price: 410, Amount: 10}}}
 	s := Service{Repository: mockRepo}
 	ctx := context.WithValue(context.Background(), mockrepository.ExecuteKey{}, "✅")
+	expectNoSerials(mockRepo, ctx)
 
+	expectCashRegister(mockRepo, ctx, 1)
+	mockRepo.EXPECT().ReadOpenShift(ctx, &dto.CashRegister{CashRegister: 1}).Times(1).Return(
+		dto.Shift{ID: 7, CashRegister: 1}, nil)
 	mockRepo.EXPECT().ReadStockAmount(ctx, &dto.Article{Article: "test-9"}).Times(1).Return(uint(12), nil)
 	mockRepo.EXPECT().UpdateStockAmount(ctx, &dto.ArticleAmount{Article: "test-9", Amount: 2}).Times(1).Return(nil)
-	mockRepo.EXPECT().CreateSoldRecord(ctx, gomock.Any()).Times(1).Return(repository.ErrTimeout)
+	mockRepo.EXPECT().ReadStockLocations(ctx, &dto.Article{Article: "test-9"}).Times(1).Return(nil, nil)
+	expectNoTaxRates(mockRepo, ctx)
+	expectNoWarranties(mockRepo, ctx)
+	mockRepo.EXPECT().CreateReceipt(ctx, gomock.Any()).Times(1).Return(receipt.ID(0), repository.ErrTimeout)
 
-	err := s.MakeSale(ctx, data)
+	_, err := s.MakeSale(ctx, data)
 	if !errors.Is(err, repository.ErrTimeout) {
 		t.Fail()
 	}
@@ -823,15 +939,19 @@ func TestService_MakeSaleErrUpdateStock(t *testing.T) {
 	ctrl := gomock.NewController(t)
 
 	mockRepo := mockrepository.NewMockInterface(ctrl)
-	data := []dto.ArticlePriceAmount{{Article: "test-9", Price: 410, Amount: 10}}
+	data := dto.CashRegisterProducts{CashRegister: 1, PaymentMethod: payment.Cash,
+		Products: []dto.ArticlePriceAmount{{Article: "test-9", Price: 410, Amount: 10}}}
 	s := Service{Repository: mockRepo}
 	ctx := context.WithValue(context.Background(), mockrepository.ExecuteKey{}, "✅")
 
+	expectCashRegister(mockRepo, ctx, 1)
+	mockRepo.EXPECT().ReadOpenShift(ctx, &dto.CashRegister{CashRegister: 1}).Times(1).Return(
+		dto.Shift{ID: 7, CashRegister: 1}, nil)
 	mockRepo.EXPECT().ReadStockAmount(ctx, &dto.Article{Article: "test-9"}).Times(1).Return(uint(12), nil)
 	mockRepo.EXPECT().UpdateStockAmount(ctx, &dto.ArticleAmount{Article: "test-9", Amount: 2}).Times(
 		1).Return(repository.ErrTimeout)
 
-	err := s.MakeSale(ctx, data)
+	_, err := s.MakeSale(ctx, data)
 	if !errors.Is(err, repository.ErrTimeout) {
 		t.Fail()
 	}
@@ -842,13 +962,17 @@ func TestService_MakeSaleErrNoEnoughItemsInStock(t *testing.T) {
 	ctrl := gomock.NewController(t)
 
 	mockRepo := mockrepository.NewMockInterface(ctrl)
-	data := []dto.ArticlePriceAmount{{Article: "test-9", Price: 410, Amount: 10}}
+	data := dto.CashRegisterProducts{CashRegister: 1, PaymentMethod: payment.Cash,
+		Products: []dto.ArticlePriceAmount{{Article: "test-9", Price: 410, Amount: 10}}}
 	s := Service{Repository: mockRepo}
 	ctx := context.WithValue(context.Background(), mockrepository.ExecuteKey{}, "✅")
 
+	expectCashRegister(mockRepo, ctx, 1)
+	mockRepo.EXPECT().ReadOpenShift(ctx, &dto.CashRegister{CashRegister: 1}).Times(1).Return(
+		dto.Shift{ID: 7, CashRegister: 1}, nil)
 	mockRepo.EXPECT().ReadStockAmount(ctx, &dto.Article{Article: "test-9"}).Times(1).Return(uint(2), nil)
 
-	err := s.MakeSale(ctx, data)
+	_, err := s.MakeSale(ctx, data)
 	if !errors.Is(err, service.ErrNoEnoughItemsInStock) {
 		t.Fail()
 	}
@@ -859,14 +983,18 @@ func TestService_MakeSaleErrReadStock(t *testing.T) {
 	ctrl := gomock.NewController(t)
 
 	mockRepo := mockrepository.NewMockInterface(ctrl)
-	data := []dto.ArticlePriceAmount{{Article: "test-9", Price: 410, Amount: 10}}
+	data := dto.CashRegisterProducts{CashRegister: 1, PaymentMethod: payment.Cash,
+		Products: []dto.ArticlePriceAmount{{Article: "test-9", Price: 410, Amount: 10}}}
 	s := Service{Repository: mockRepo}
 	ctx := context.WithValue(context.Background(), mockrepository.ExecuteKey{}, "✅")
 
+	expectCashRegister(mockRepo, ctx, 1)
+	mockRepo.EXPECT().ReadOpenShift(ctx, &dto.CashRegister{CashRegister: 1}).Times(1).Return(
+		dto.Shift{ID: 7, CashRegister: 1}, nil)
 	mockRepo.EXPECT().ReadStockAmount(ctx, &dto.Article{Article: "test-9"}).Times(1).Return(uint(2),
 		repository.ErrTimeout)
 
-	err := s.MakeSale(ctx, data)
+	_, err := s.MakeSale(ctx, data)
 	if !errors.Is(err, repository.ErrTimeout) {
 		t.Fail()
 	}
@@ -879,7 +1007,7 @@ func TestService_FinishOrderIncorrectDTO(t *testing.T) {
 	mockRepo := mockrepository.NewMockInterface(ctrl)
 	s := Service{Repository: mockRepo}
 
-	err := s.FinishOrder(context.Background(), dto.Number{OrderNumber: 0})
+	_, err := s.FinishOrder(context.Background(), dto.NumberPaymentMethod{OrderNumber: 0})
 	if err == nil {
 		t.Fail()
 	}
@@ -892,19 +1020,26 @@ func TestService_FinishOrderLocal(t *testing.T) {
 	mockRepo := mockrepository.NewMockInterface(ctrl)
 	s := Service{Repository: mockRepo}
 	ctx := context.WithValue(context.Background(), mockrepository.ExecuteKey{}, "✅")
-	data := dto.Number{OrderNumber: reservation.MaxCashRegisterNumber}
+	expectNoSerials(mockRepo, ctx)
+	data := dto.NumberPaymentMethod{OrderNumber: 10, PaymentMethod: payment.Card}
 	resData := dto.NumberDateStateProducts{
 		Products:    []dto.ArticlePriceAmount{{Article: "test-9", Price: 100, Amount: 1}},
-		OrderNumber: reservation.MaxCashRegisterNumber,
+		OrderNumber: 10,
 		Date:        time.Time{},
 		State:       reservation.NewForCashRegister,
 	}
 
-	mockRepo.EXPECT().ReadReservation(ctx, &data).Times(1).Return(resData, nil)
-	mockRepo.EXPECT().CreateSoldRecord(ctx, gomock.Any()).Times(1).Return(nil)
-	mockRepo.EXPECT().DeleteReservation(ctx, &data).Times(1).Return(nil)
+	mockRepo.EXPECT().ReadReservation(ctx, &dto.Number{OrderNumber: data.OrderNumber}).Times(1).Return(resData, nil)
+	mockRepo.EXPECT().CreateReservationTransition(ctx, gomock.Any()).Times(1).Return(nil)
+	expectCashRegister(mockRepo, ctx, data.OrderNumber)
+	mockRepo.EXPECT().ReadOpenShift(ctx, &dto.CashRegister{CashRegister: data.OrderNumber}).Times(1).Return(
+		dto.Shift{ID: 7, CashRegister: data.OrderNumber}, nil)
+	expectNoTaxRates(mockRepo, ctx)
+	expectNoWarranties(mockRepo, ctx)
+	mockRepo.EXPECT().CreateReceipt(ctx, gomock.Any()).Times(1).Return(receipt.ID(1), nil)
+	mockRepo.EXPECT().DeleteReservation(ctx, &dto.Number{OrderNumber: data.OrderNumber}).Times(1).Return(nil)
 
-	err := s.FinishOrder(ctx, data)
+	_, err := s.FinishOrder(ctx, data)
 	if err != nil {
 		t.Fail()
 	}
@@ -917,43 +1052,50 @@ func TestService_FinishOrderInternet(t *testing.T) {
 	mockRepo := mockrepository.NewMockInterface(ctrl)
 	s := Service{Repository: mockRepo}
 	ctx := context.WithValue(context.Background(), mockrepository.ExecuteKey{}, "✅")
-	data := dto.Number{OrderNumber: reservation.MaxCashRegisterNumber + 1}
+	expectNoSerials(mockRepo, ctx)
+	data := dto.NumberPaymentMethod{OrderNumber: 11, PaymentMethod: payment.Card}
 	resData := dto.NumberDateStateProducts{
 		Products:    []dto.ArticlePriceAmount{{Article: "test-9", Price: 100, Amount: 1}},
-		OrderNumber: reservation.MaxCashRegisterNumber + 1,
+		OrderNumber: 11,
 		Date:        time.Time{},
 		State:       reservation.NewForInternetCustomer,
 	}
 
-	mockRepo.EXPECT().ReadReservation(ctx, &data).Times(1).Return(resData, nil)
-	mockRepo.EXPECT().CreateSoldRecord(ctx, gomock.Any()).Times(1).Return(nil)
+	mockRepo.EXPECT().ReadReservation(ctx, &dto.Number{OrderNumber: data.OrderNumber}).Times(1).Return(resData, nil)
+	mockRepo.EXPECT().CreateReservationTransition(ctx, gomock.Any()).Times(1).Return(nil)
+	expectNoTaxRates(mockRepo, ctx)
+	expectNoWarranties(mockRepo, ctx)
+	mockRepo.EXPECT().CreateReceipt(ctx, gomock.Any()).Times(1).Return(receipt.ID(1), nil)
 	mockRepo.EXPECT().UpdateReservation(ctx, gomock.Any()).Times(1).Return(nil)
 
-	err := s.FinishOrder(ctx, data)
+	_, err := s.FinishOrder(ctx, data)
 	if err != nil {
 		t.Fail()
 	}
 }
 
-func TestService_FinishOrderErrCreateSoldRecord(t *testing.T) {
+func TestService_FinishOrderErrCreateReceipt(t *testing.T) {
 	t.Parallel()
 	ctrl := gomock.NewController(t)
 
 	mockRepo := mockrepository.NewMockInterface(ctrl)
 	s := Service{Repository: mockRepo}
 	ctx := context.WithValue(context.Background(), mockrepository.ExecuteKey{}, "✅")
-	data := dto.Number{OrderNumber: reservation.MaxCashRegisterNumber + 1}
+	data := dto.NumberPaymentMethod{OrderNumber: 11, PaymentMethod: payment.Card}
 	resData := dto.NumberDateStateProducts{
 		Products:    []dto.ArticlePriceAmount{{Article: "test-9", Price: 100, Amount: 1}},
-		OrderNumber: reservation.MaxCashRegisterNumber + 1,
+		OrderNumber: 11,
 		Date:        time.Time{},
 		State:       reservation.NewForInternetCustomer,
 	}
 
-	mockRepo.EXPECT().ReadReservation(ctx, &data).Times(1).Return(resData, nil)
-	mockRepo.EXPECT().CreateSoldRecord(ctx, gomock.Any()).Times(1).Return(repository.ErrTimeout)
+	mockRepo.EXPECT().ReadReservation(ctx, &dto.Number{OrderNumber: data.OrderNumber}).Times(1).Return(resData, nil)
+	mockRepo.EXPECT().CreateReservationTransition(ctx, gomock.Any()).Times(1).Return(nil)
+	expectNoTaxRates(mockRepo, ctx)
+	expectNoWarranties(mockRepo, ctx)
+	mockRepo.EXPECT().CreateReceipt(ctx, gomock.Any()).Times(1).Return(receipt.ID(0), repository.ErrTimeout)
 
-	err := s.FinishOrder(ctx, data)
+	_, err := s.FinishOrder(ctx, data)
 	if !errors.Is(err, repository.ErrTimeout) {
 		t.Fail()
 	}
@@ -966,17 +1108,17 @@ func TestService_FinishOrderErrAlreadyProcessed(t *testing.T) {
 	mockRepo := mockrepository.NewMockInterface(ctrl)
 	s := Service{Repository: mockRepo}
 	ctx := context.WithValue(context.Background(), mockrepository.ExecuteKey{}, "✅")
-	data := dto.Number{OrderNumber: reservation.MaxCashRegisterNumber + 1}
+	data := dto.NumberPaymentMethod{OrderNumber: 11, PaymentMethod: payment.Card}
 	resData := dto.NumberDateStateProducts{
 		Products:    []dto.ArticlePriceAmount{{Article: "test-9", Price: 100, Amount: 1}},
-		OrderNumber: reservation.MaxCashRegisterNumber + 1,
+		OrderNumber: 11,
 		Date:        time.Time{},
 		State:       reservation.Finished,
 	}
 
-	mockRepo.EXPECT().ReadReservation(ctx, &data).Times(1).Return(resData, nil)
+	mockRepo.EXPECT().ReadReservation(ctx, &dto.Number{OrderNumber: data.OrderNumber}).Times(1).Return(resData, nil)
 
-	err := s.FinishOrder(ctx, data)
+	_, err := s.FinishOrder(ctx, data)
 	if !errors.Is(err, service.ErrAlreadyProcessed) {
 		t.Fail()
 	}
@@ -989,18 +1131,134 @@ func TestService_FinishOrderErrReadReservation(t *testing.T) {
 	mockRepo := mockrepository.NewMockInterface(ctrl)
 	s := Service{Repository: mockRepo}
 	ctx := context.WithValue(context.Background(), mockrepository.ExecuteKey{}, "✅")
-	data := dto.Number{OrderNumber: reservation.MaxCashRegisterNumber + 1}
+	data := dto.NumberPaymentMethod{OrderNumber: 11, PaymentMethod: payment.Card}
 	resData := dto.NumberDateStateProducts{
 		Products:    []dto.ArticlePriceAmount{{Article: "test-9", Price: 100, Amount: 1}},
-		OrderNumber: reservation.MaxCashRegisterNumber + 1,
+		OrderNumber: 11,
 		Date:        time.Time{},
 		State:       reservation.NewForInternetCustomer,
 	}
 
-	mockRepo.EXPECT().ReadReservation(ctx, &data).Times(1).Return(resData, repository.ErrTimeout)
+	mockRepo.EXPECT().ReadReservation(ctx, &dto.Number{OrderNumber: data.OrderNumber}).Times(1).Return(resData, repository.ErrTimeout)
 
-	err := s.FinishOrder(ctx, data)
+	_, err := s.FinishOrder(ctx, data)
 	if !errors.Is(err, repository.ErrTimeout) {
 		t.Fail()
 	}
 }
+
+func TestService_MakeSaleReceiptData(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+
+	mockRepo := mockrepository.NewMockInterface(ctrl)
+	data := dto.CashRegisterProducts{CashRegister: 3, PaymentMethod: payment.Cash,
+		Products: []dto.ArticlePriceAmount{{Article: "test-9", Price: 410, Amount: 10}}}
+	s := Service{Repository: mockRepo}
+	ctx := context.WithValue(context.Background(), mockrepository.ExecuteKey{}, "✅")
+	expectNoSerials(mockRepo, ctx)
+
+	expectCashRegister(mockRepo, ctx, 3)
+	mockRepo.EXPECT().ReadOpenShift(ctx, &dto.CashRegister{CashRegister: 3}).Times(1).Return(
+		dto.Shift{ID: 7, CashRegister: 3}, nil)
+	mockRepo.EXPECT().ReadStockAmount(ctx, &dto.Article{Article: "test-9"}).Times(1).Return(uint(12), nil)
+	mockRepo.EXPECT().UpdateStockAmount(ctx, &dto.ArticleAmount{Article: "test-9", Amount: 2}).Times(1).Return(nil)
+	mockRepo.EXPECT().ReadStockLocations(ctx, &dto.Article{Article: "test-9"}).Times(1).Return(nil, nil)
+	expectNoTaxRates(mockRepo, ctx)
+	expectNoWarranties(mockRepo, ctx)
+	mockRepo.EXPECT().CreateReceipt(ctx, gomock.Any()).Times(1).DoAndReturn(
+		func(_ context.Context, r *dto.Receipt) (receipt.ID, error) {
+			if r.CashRegister != 3 || r.OrderNumber != 0 || r.Total != 4100 || r.Date.IsZero() || r.ShiftID != 7 ||
+				r.PaymentMethod != payment.Cash {
+				t.Fail()
+			}
+			return receipt.ID(77), nil
+		})
+
+	id, err := s.MakeSale(ctx, data)
+	if err != nil || id != 77 {
+		t.Fail()
+	}
+}
+
+func TestService_FinishOrderInternetReceiptData(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+
+	mockRepo := mockrepository.NewMockInterface(ctrl)
+	s := Service{Repository: mockRepo}
+	ctx := context.WithValue(context.Background(), mockrepository.ExecuteKey{}, "✅")
+	expectNoSerials(mockRepo, ctx)
+	data := dto.NumberPaymentMethod{OrderNumber: 11, PaymentMethod: payment.Card}
+	resData := dto.NumberDateStateProducts{
+		Products:    []dto.ArticlePriceAmount{{Article: "test-9", Price: 100, Amount: 2}},
+		OrderNumber: 11,
+		State:       reservation.NewForInternetCustomer,
+	}
+
+	mockRepo.EXPECT().ReadReservation(ctx, &dto.Number{OrderNumber: data.OrderNumber}).Times(1).Return(resData, nil)
+	mockRepo.EXPECT().CreateReservationTransition(ctx, gomock.Any()).Times(1).Return(nil)
+	expectNoTaxRates(mockRepo, ctx)
+	expectNoWarranties(mockRepo, ctx)
+	mockRepo.EXPECT().CreateReceipt(ctx, gomock.Any()).Times(1).DoAndReturn(
+		func(_ context.Context, r *dto.Receipt) (receipt.ID, error) {
+			if r.CashRegister != 0 || r.OrderNumber != data.OrderNumber || r.Total != 200 {
+				t.Fail()
+			}
+			return receipt.ID(78), nil
+		})
+	mockRepo.EXPECT().UpdateReservation(ctx, gomock.Any()).Times(1).Return(nil)
+
+	id, err := s.FinishOrder(ctx, data)
+	if err != nil || id != 78 {
+		t.Fail()
+	}
+}
+
+func TestService_ReceiptIncorrectDTO(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+
+	mockRepo := mockrepository.NewMockInterface(ctrl)
+	s := Service{Repository: mockRepo}
+
+	mockRepo.EXPECT().ReadReceipt(gomock.Any(), gomock.Any()).Times(0)
+
+	_, err := s.Receipt(context.Background(), dto.ReceiptID{ID: 0})
+	if err == nil {
+		t.Fail()
+	}
+}
+
+func TestService_ReceiptSuccess(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+
+	mockRepo := mockrepository.NewMockInterface(ctrl)
+	s := Service{Repository: mockRepo}
+	data := dto.ReceiptID{ID: 5}
+
+	mockRepo.EXPECT().ReadReceipt(context.Background(), &data).Times(1).Return(dto.Receipt{ID: 5, CashRegister: 1,
+		Total: 100, Products: []dto.ArticlePriceAmount{{Article: "test-9", Price: 100, Amount: 1}}}, nil)
+
+	result, err := s.Receipt(context.Background(), data)
+	if err != nil || result.ID != 5 {
+		t.Fail()
+	}
+}
+
+func TestService_ReceiptNoRecord(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+
+	mockRepo := mockrepository.NewMockInterface(ctrl)
+	s := Service{Repository: mockRepo}
+	data := dto.ReceiptID{ID: 5}
+
+	mockRepo.EXPECT().ReadReceipt(context.Background(), &data).Times(1).Return(dto.Receipt{}, repository.ErrNoRecord)
+
+	_, err := s.Receipt(context.Background(), data)
+	if !errors.Is(err, repository.ErrNoRecord) {
+		t.Fail()
+	}
+}
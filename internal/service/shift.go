@@ -0,0 +1,154 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	rs "github.com/lazylex/watch-store-store/internal/domain/aggregates/reservation"
+	"github.com/lazylex/watch-store-store/internal/domain/aggregates/shift"
+	"github.com/lazylex/watch-store-store/internal/domain/event"
+	"github.com/lazylex/watch-store-store/internal/dto"
+	"github.com/lazylex/watch-store-store/internal/logger"
+	"github.com/lazylex/watch-store-store/internal/ports/repository"
+	"github.com/lazylex/watch-store-store/internal/ports/service"
+	"log/slog"
+	"time"
+)
+
+// OpenShift открывает кассовую смену и возвращает её идентификатор. Смена открывается только на зарегистрированной и
+// включённой кассе, при этом на кассе может быть открыта только одна смена.
+func (s *Service) OpenShift(ctx context.Context, data dto.Shift) (shift.ID, error) {
+	var id shift.ID
+
+	if err := data.Validate(); err != nil {
+		return 0, err
+	}
+
+	err := s.Repository.WithinTransaction(ctx, func(txCtx context.Context) error {
+		if _, err := s.useCashRegister(txCtx, data.CashRegister); err != nil {
+			return err
+		}
+
+		_, err := s.Repository.ReadOpenShift(txCtx, &dto.CashRegister{CashRegister: data.CashRegister})
+		if err == nil {
+			return service.ErrShiftAlreadyOpen
+		}
+		if !errors.Is(err, repository.ErrNoRecord) {
+			return err
+		}
+
+		data.OpenedAt = time.Now()
+		data.ClosedAt = time.Time{}
+		if id, err = s.Repository.CreateShift(txCtx, &data); err != nil {
+			if errors.Is(err, repository.ErrDuplicate) {
+				return service.ErrShiftAlreadyOpen
+			}
+			return err
+		}
+		data.ID = id
+
+		logger.LogWithCtxData(txCtx, slog.With(logger.OPLabel, "service.OpenShift")).Info(
+			fmt.Sprintf("shift %d opened on cash register %d by cashier %s", id, data.CashRegister, data.CashierID))
+
+		return nil
+	})
+
+	if err != nil {
+		return 0, err
+	}
+
+	s.emit(ctx, event.ShiftOpened, numberKey(data.CashRegister), data)
+	return id, nil
+}
+
+// CloseShift закрывает открытую на кассе смену. По чекам, платежам и возвратам смены формируется Z-отчёт, который
+// сохраняется и возвращается.
+func (s *Service) CloseShift(ctx context.Context, data dto.CashRegister) (dto.ZReport, error) {
+	var report dto.ZReport
+
+	if err := data.Validate(); err != nil {
+		return dto.ZReport{}, err
+	}
+
+	err := s.Repository.WithinTransaction(ctx, func(txCtx context.Context) error {
+		var sales, refunds []dto.PaymentCountTotal
+		var receipts uint
+
+		current, err := s.Repository.ReadOpenShift(txCtx, &data)
+		if err != nil {
+			if errors.Is(err, repository.ErrNoRecord) {
+				return service.ErrNoOpenShift
+			}
+			return err
+		}
+
+		id := dto.ShiftID{ID: current.ID}
+		if sales, err = s.Repository.ReadShiftSales(txCtx, &id); err != nil {
+			return err
+		}
+		if receipts, err = s.Repository.ReadShiftReceiptsCount(txCtx, &id); err != nil {
+			return err
+		}
+		if refunds, err = s.Repository.ReadShiftRefunds(txCtx, &id); err != nil {
+			return err
+		}
+
+		current.ClosedAt = time.Now()
+		if err = s.Repository.CloseShift(txCtx, &current); err != nil {
+			return err
+		}
+
+		report.Fill(current, receipts, sales, refunds)
+		if err = s.Repository.CreateZReport(txCtx, &report); err != nil {
+			return err
+		}
+
+		logger.LogWithCtxData(txCtx, slog.With(logger.OPLabel, "service.CloseShift")).Info(
+			fmt.Sprintf("shift %d closed on cash register %d, sales total %.2f, refunds total %.2f",
+				current.ID, current.CashRegister, report.SalesTotal, report.RefundsTotal))
+
+		return nil
+	})
+
+	if err != nil {
+		return dto.ZReport{}, err
+	}
+
+	s.emit(ctx, event.ShiftClosed, numberKey(data.CashRegister), report)
+	return report, nil
+}
+
+// ZReport возвращает Z-отчёт закрытой смены.
+func (s *Service) ZReport(ctx context.Context, data dto.ShiftID) (dto.ZReport, error) {
+	if err := data.Validate(); err != nil {
+		return dto.ZReport{}, err
+	}
+
+	report, err := s.Repository.ReadZReport(ctx, &data)
+	if err != nil {
+		return dto.ZReport{}, err
+	}
+
+	logger.LogWithCtxData(ctx, slog.With(logger.OPLabel, "service.ZReport")).Info(
+		fmt.Sprintf("requested z-report of shift %d", data.ID))
+
+	return report, nil
+}
+
+// openShiftID возвращает идентификатор открытой на кассе смены. Касса должна быть зарегистрирована и включена. Если
+// смена не открыта, возвращается service.ErrNoOpenShift.
+func (s *Service) openShiftID(ctx context.Context, cashRegister rs.OrderNumber) (shift.ID, error) {
+	if _, err := s.useCashRegister(ctx, cashRegister); err != nil {
+		return 0, err
+	}
+
+	current, err := s.Repository.ReadOpenShift(ctx, &dto.CashRegister{CashRegister: cashRegister})
+	if err != nil {
+		if errors.Is(err, repository.ErrNoRecord) {
+			return 0, service.ErrNoOpenShift
+		}
+		return 0, err
+	}
+
+	return current.ID, nil
+}
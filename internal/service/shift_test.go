@@ -0,0 +1,171 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"github.com/golang/mock/gomock"
+	"github.com/lazylex/watch-store-store/internal/domain/aggregates/shift"
+	"github.com/lazylex/watch-store-store/internal/domain/value_objects/payment"
+	"github.com/lazylex/watch-store-store/internal/dto"
+	"github.com/lazylex/watch-store-store/internal/ports/repository"
+	mockrepository "github.com/lazylex/watch-store-store/internal/ports/repository/mocks"
+	"github.com/lazylex/watch-store-store/internal/ports/service"
+	"testing"
+)
+
+func TestService_OpenShiftIncorrectDTO(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+
+	mockRepo := mockrepository.NewMockInterface(ctrl)
+	s := Service{Repository: mockRepo}
+
+	_, err := s.OpenShift(context.Background(), dto.Shift{CashRegister: 1, CashierID: ""})
+	if err == nil {
+		t.Fail()
+	}
+}
+
+func TestService_OpenShiftSuccess(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+
+	mockRepo := mockrepository.NewMockInterface(ctrl)
+	s := Service{Repository: mockRepo}
+	ctx := context.WithValue(context.Background(), mockrepository.ExecuteKey{}, "✅")
+	data := dto.Shift{CashRegister: 1, CashierID: "ivanova", OpeningFloat: 5000}
+
+	expectCashRegister(mockRepo, ctx, 1)
+	mockRepo.EXPECT().ReadOpenShift(ctx, &dto.CashRegister{CashRegister: 1}).Times(1).Return(dto.Shift{},
+		repository.ErrNoRecord)
+	mockRepo.EXPECT().CreateShift(ctx, gomock.Any()).Times(1).DoAndReturn(
+		func(_ context.Context, sh *dto.Shift) (shift.ID, error) {
+			if sh.OpenedAt.IsZero() || sh.CashierID != "ivanova" {
+				t.Fail()
+			}
+			return shift.ID(3), nil
+		})
+
+	id, err := s.OpenShift(ctx, data)
+	if err != nil || id != 3 {
+		t.Fail()
+	}
+}
+
+func TestService_OpenShiftAlreadyOpen(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+
+	mockRepo := mockrepository.NewMockInterface(ctrl)
+	s := Service{Repository: mockRepo}
+	ctx := context.WithValue(context.Background(), mockrepository.ExecuteKey{}, "✅")
+	data := dto.Shift{CashRegister: 1, CashierID: "ivanova"}
+
+	expectCashRegister(mockRepo, ctx, 1)
+	mockRepo.EXPECT().ReadOpenShift(ctx, &dto.CashRegister{CashRegister: 1}).Times(1).Return(
+		dto.Shift{ID: 2, CashRegister: 1}, nil)
+	mockRepo.EXPECT().CreateShift(gomock.Any(), gomock.Any()).Times(0)
+
+	_, err := s.OpenShift(ctx, data)
+	if !errors.Is(err, service.ErrShiftAlreadyOpen) {
+		t.Fail()
+	}
+}
+
+func TestService_OpenShiftDuplicate(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+
+	mockRepo := mockrepository.NewMockInterface(ctrl)
+	s := Service{Repository: mockRepo}
+	ctx := context.WithValue(context.Background(), mockrepository.ExecuteKey{}, "✅")
+	data := dto.Shift{CashRegister: 1, CashierID: "ivanova"}
+
+	expectCashRegister(mockRepo, ctx, 1)
+	mockRepo.EXPECT().ReadOpenShift(ctx, &dto.CashRegister{CashRegister: 1}).Times(1).Return(dto.Shift{},
+		repository.ErrNoRecord)
+	mockRepo.EXPECT().CreateShift(ctx, gomock.Any()).Times(1).Return(shift.ID(0), repository.ErrDuplicate)
+
+	_, err := s.OpenShift(ctx, data)
+	if !errors.Is(err, service.ErrShiftAlreadyOpen) {
+		t.Fail()
+	}
+}
+
+func TestService_CloseShiftNoOpenShift(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+
+	mockRepo := mockrepository.NewMockInterface(ctrl)
+	s := Service{Repository: mockRepo}
+	ctx := context.WithValue(context.Background(), mockrepository.ExecuteKey{}, "✅")
+	data := dto.CashRegister{CashRegister: 1}
+
+	mockRepo.EXPECT().ReadOpenShift(ctx, &data).Times(1).Return(dto.Shift{}, repository.ErrNoRecord)
+
+	_, err := s.CloseShift(ctx, data)
+	if !errors.Is(err, service.ErrNoOpenShift) {
+		t.Fail()
+	}
+}
+
+func TestService_CloseShiftSuccess(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+
+	mockRepo := mockrepository.NewMockInterface(ctrl)
+	s := Service{Repository: mockRepo}
+	ctx := context.WithValue(context.Background(), mockrepository.ExecuteKey{}, "✅")
+	data := dto.CashRegister{CashRegister: 1}
+	id := dto.ShiftID{ID: 4}
+
+	mockRepo.EXPECT().ReadOpenShift(ctx, &data).Times(1).Return(
+		dto.Shift{ID: 4, CashRegister: 1, CashierID: "ivanova", OpeningFloat: 1000}, nil)
+	mockRepo.EXPECT().ReadShiftSales(ctx, &id).Times(1).Return([]dto.PaymentCountTotal{
+		{Method: payment.Cash, Count: 2, Total: 300},
+		{Method: payment.Card, Count: 1, Total: 500},
+	}, nil)
+	mockRepo.EXPECT().ReadShiftReceiptsCount(ctx, &id).Times(1).Return(uint(2), nil)
+	mockRepo.EXPECT().ReadShiftRefunds(ctx, &id).Times(1).Return([]dto.PaymentCountTotal{
+		{Method: payment.Cash, Count: 1, Total: 100},
+	}, nil)
+	mockRepo.EXPECT().CloseShift(ctx, gomock.Any()).Times(1).Return(nil)
+	mockRepo.EXPECT().CreateZReport(ctx, gomock.Any()).Times(1).Return(nil)
+
+	report, err := s.CloseShift(ctx, data)
+	if err != nil || report.ShiftID != 4 || report.SalesCount != 2 || report.SalesTotal != 800 ||
+		report.RefundsTotal != 100 || report.CashInDrawer != 1200 || report.ClosedAt.IsZero() {
+		t.Fail()
+	}
+}
+
+func TestService_ZReportIncorrectDTO(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+
+	mockRepo := mockrepository.NewMockInterface(ctrl)
+	s := Service{Repository: mockRepo}
+
+	mockRepo.EXPECT().ReadZReport(gomock.Any(), gomock.Any()).Times(0)
+
+	_, err := s.ZReport(context.Background(), dto.ShiftID{ID: 0})
+	if err == nil {
+		t.Fail()
+	}
+}
+
+func TestService_ZReportNoRecord(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+
+	mockRepo := mockrepository.NewMockInterface(ctrl)
+	s := Service{Repository: mockRepo}
+	data := dto.ShiftID{ID: 4}
+
+	mockRepo.EXPECT().ReadZReport(context.Background(), &data).Times(1).Return(dto.ZReport{}, repository.ErrNoRecord)
+
+	_, err := s.ZReport(context.Background(), data)
+	if !errors.Is(err, repository.ErrNoRecord) {
+		t.Fail()
+	}
+}
@@ -0,0 +1,69 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"github.com/lazylex/watch-store-store/internal/domain/event"
+	"github.com/lazylex/watch-store-store/internal/domain/value_objects/location"
+	"github.com/lazylex/watch-store-store/internal/dto"
+	"github.com/lazylex/watch-store-store/internal/dto/validators"
+	"github.com/lazylex/watch-store-store/internal/logger"
+	"github.com/lazylex/watch-store-store/internal/ports/service"
+	"log/slog"
+	"time"
+)
+
+// AdjustAmountInStock изменяет количество товара на величину Delta с указанием кода причины, который должен входить
+// в список допустимых. Чтение текущего количества, его изменение и запись в журнал корректировок выполняются в одной
+// транзакции. Если после корректировки количество станет отрицательным, возвращается ErrAdjustmentBelowZero. При
+// успехе возвращается новое количество товара. Количество товара, учитываемого по серийным номерам, не корректируется
+// (возвращается service.ErrSerialTracked).
+func (s *Service) AdjustAmountInStock(ctx context.Context, data dto.ArticleDeltaReason) (uint, error) {
+	if err := data.Validate(); err != nil {
+		return 0, err
+	}
+	if err := validators.AdjustmentReason(data.Reason, s.AdjustmentReasons); err != nil {
+		return 0, err
+	}
+
+	var newAmount uint
+	err := s.Repository.WithinTransaction(ctx, func(txCtx context.Context) error {
+		if err := s.checkNotSerialTracked(txCtx, data.Article); err != nil {
+			return err
+		}
+
+		amount, err := s.Repository.ReadStockAmount(txCtx, &dto.Article{Article: data.Article})
+		if err != nil {
+			return err
+		}
+
+		if int(amount)+data.Delta < 0 {
+			return service.ErrAdjustmentBelowZero
+		}
+		newAmount = uint(int(amount) + data.Delta)
+
+		if err = s.Repository.UpdateStockAmount(txCtx,
+			&dto.ArticleAmount{Article: data.Article, Amount: newAmount}); err != nil {
+			return err
+		}
+		if data.Delta < 0 {
+			if err = s.withdrawFromLocations(txCtx, data.Article, newAmount, 0, location.Default); err != nil {
+				return err
+			}
+		}
+
+		return s.Repository.CreateStockAdjustment(txCtx,
+			&dto.StockAdjustment{ArticleDeltaReason: data, AmountAfter: newAmount, Date: time.Now()})
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	logger.LogWithCtxData(ctx, slog.With(logger.OPLabel, "service.AdjustAmountInStock")).Info(
+		fmt.Sprintf("amount of article %s adjusted by %d (%s), now %d", data.Article, data.Delta, data.Reason,
+			newAmount))
+	s.emit(ctx, event.StockAdjusted, string(data.Article),
+		dto.StockAdjustment{ArticleDeltaReason: data, AmountAfter: newAmount})
+
+	return newAmount, nil
+}
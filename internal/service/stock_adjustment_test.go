@@ -0,0 +1,87 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"github.com/golang/mock/gomock"
+	"github.com/lazylex/watch-store-store/internal/domain/value_objects/adjustment"
+	"github.com/lazylex/watch-store-store/internal/dto"
+	"github.com/lazylex/watch-store-store/internal/dto/validators"
+	mockrepository "github.com/lazylex/watch-store-store/internal/ports/repository/mocks"
+	"github.com/lazylex/watch-store-store/internal/ports/service"
+	"testing"
+)
+
+func TestService_AdjustAmountInStockUnknownReason(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+
+	mockRepo := mockrepository.NewMockInterface(ctrl)
+	s := Service{Repository: mockRepo, AdjustmentReasons: []adjustment.Reason{adjustment.Damaged}}
+
+	_, err := s.AdjustAmountInStock(context.Background(),
+		dto.ArticleDeltaReason{Article: "test-1", Delta: 2, Reason: adjustment.Found})
+	if !errors.Is(err, validators.ErrIncorrectAdjustmentReason) {
+		t.Fail()
+	}
+}
+
+func TestService_AdjustAmountInStockSuccess(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+
+	mockRepo := mockrepository.NewMockInterface(ctrl)
+	s := Service{Repository: mockRepo, AdjustmentReasons: adjustment.DefaultReasons()}
+	ctx := context.WithValue(context.Background(), mockrepository.ExecuteKey{}, "✅")
+	expectNoSerials(mockRepo, ctx)
+	data := dto.ArticleDeltaReason{Article: "test-1", Delta: -1, Reason: adjustment.Damaged}
+
+	mockRepo.EXPECT().ReadStockAmount(ctx, &dto.Article{Article: "test-1"}).Times(1).Return(uint(3), nil)
+	mockRepo.EXPECT().UpdateStockAmount(ctx, &dto.ArticleAmount{Article: "test-1", Amount: 2}).Times(1).Return(nil)
+	mockRepo.EXPECT().ReadStockLocations(ctx, &dto.Article{Article: "test-1"}).Times(1).Return(nil, nil)
+	mockRepo.EXPECT().CreateStockAdjustment(ctx, gomock.Any()).Times(1).DoAndReturn(
+		func(_ context.Context, record *dto.StockAdjustment) error {
+			if record.AmountAfter != 2 || record.ArticleDeltaReason != data {
+				t.Fail()
+			}
+			return nil
+		})
+
+	amount, err := s.AdjustAmountInStock(ctx, data)
+	if err != nil || amount != 2 {
+		t.Fail()
+	}
+}
+
+func TestService_AdjustAmountInStockBelowZero(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+
+	mockRepo := mockrepository.NewMockInterface(ctrl)
+	s := Service{Repository: mockRepo, AdjustmentReasons: adjustment.DefaultReasons()}
+	ctx := context.WithValue(context.Background(), mockrepository.ExecuteKey{}, "✅")
+	expectNoSerials(mockRepo, ctx)
+
+	mockRepo.EXPECT().ReadStockAmount(ctx, &dto.Article{Article: "test-1"}).Times(1).Return(uint(1), nil)
+	mockRepo.EXPECT().UpdateStockAmount(gomock.Any(), gomock.Any()).Times(0)
+	mockRepo.EXPECT().CreateStockAdjustment(gomock.Any(), gomock.Any()).Times(0)
+
+	_, err := s.AdjustAmountInStock(ctx, dto.ArticleDeltaReason{Article: "test-1", Delta: -2, Reason: adjustment.Lost})
+	if !errors.Is(err, service.ErrAdjustmentBelowZero) {
+		t.Fail()
+	}
+}
+
+func TestNewWithAdjustmentReasons(t *testing.T) {
+	t.Parallel()
+
+	s := New(withMockRepo(nil), WithMetrics(nil), WithAdjustmentReasons([]string{"broken"}))
+	if len(s.AdjustmentReasons) != 1 || s.AdjustmentReasons[0] != "broken" {
+		t.Fail()
+	}
+
+	s = New(withMockRepo(nil), WithMetrics(nil), WithAdjustmentReasons(nil))
+	if len(s.AdjustmentReasons) != len(adjustment.DefaultReasons()) {
+		t.Fail()
+	}
+}
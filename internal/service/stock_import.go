@@ -0,0 +1,154 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"github.com/lazylex/watch-store-store/internal/domain/event"
+	"github.com/lazylex/watch-store-store/internal/domain/value_objects/article"
+	"github.com/lazylex/watch-store-store/internal/domain/value_objects/conflict"
+	"github.com/lazylex/watch-store-store/internal/domain/value_objects/location"
+	"github.com/lazylex/watch-store-store/internal/dto"
+	"github.com/lazylex/watch-store-store/internal/dto/validators"
+	"github.com/lazylex/watch-store-store/internal/logger"
+	"github.com/lazylex/watch-store-store/internal/ports/service"
+	"log/slog"
+)
+
+// ImportStock загружает товары в ассортимент и возвращает отчёт о загрузке. Каждая строка проверяется валидатором
+// записи о товаре, повторное появление артикула в загрузке считается ошибкой. Товары, уже имеющиеся в ассортименте,
+// обрабатываются в соответствии с data.Conflict. Если data.ChunkSize равен 0, загрузка применяется в одной транзакции
+// и только при отсутствии ошибочных строк. Иначе ошибочные строки пропускаются, а остальные применяются частями по
+// data.ChunkSize строк в отдельных транзакциях - строки части, которую не удалось применить, отмечаются в отчёте, как
+// ошибочные, и загрузка продолжается (отчёт считается применённым, если применена хотя бы одна часть). При data.DryRun
+// загрузка только проверяется.
+func (s *Service) ImportStock(ctx context.Context, data dto.StockImport) (dto.StockImportReport, error) {
+	if err := data.Validate(); err != nil {
+		return dto.StockImportReport{}, err
+	}
+
+	stock, err := s.Repository.ReadStockAmounts(ctx)
+	if err != nil {
+		return dto.StockImportReport{}, err
+	}
+	existing := make(map[article.Article]struct{}, len(stock))
+	for _, record := range stock {
+		existing[record.Article] = struct{}{}
+	}
+
+	report := dto.StockImportReport{DryRun: data.DryRun, Rows: make([]dto.StockImportRowResult, len(data.Rows))}
+	seen := make(map[article.Article]struct{}, len(data.Rows))
+	var planned []int
+
+	for i := range data.Rows {
+		row := &data.Rows[i]
+		result := &report.Rows[i]
+		*result = dto.StockImportRowResult{Line: row.Line, Article: row.Record.Article}
+
+		err = row.Err
+		if err == nil {
+			err = row.Record.Validate()
+		}
+		if _, ok := seen[row.Record.Article]; err == nil && ok {
+			err = validators.ErrDuplicateArticleInImport
+		}
+		if err != nil {
+			result.Status, result.Error = dto.ImportFailed, err.Error()
+			continue
+		}
+		seen[row.Record.Article] = struct{}{}
+
+		if _, ok := existing[row.Record.Article]; !ok {
+			result.Status = dto.ImportCreated
+			planned = append(planned, i)
+			continue
+		}
+		switch data.Conflict {
+		case conflict.Reject:
+			result.Status, result.Error = dto.ImportFailed, service.ErrStockExists.Error()
+		case conflict.Skip:
+			result.Status = dto.ImportSkipped
+		case conflict.Update:
+			result.Status = dto.ImportUpdated
+			planned = append(planned, i)
+		}
+	}
+
+	report.Count()
+	if data.DryRun || (data.ChunkSize == 0 && report.Failed > 0) {
+		return report, nil
+	}
+
+	applied := len(planned) == 0
+	size := int(data.ChunkSize)
+	if size == 0 {
+		size = len(planned)
+	}
+	for start := 0; start < len(planned); start += size {
+		chunk := planned[start:min(start+size, len(planned))]
+
+		err = s.Repository.WithinTransaction(ctx, func(txCtx context.Context) error {
+			for _, i := range chunk {
+				if err := s.importStockRecord(txCtx, &data.Rows[i].Record, report.Rows[i].Status); err != nil {
+					return fmt.Errorf("%w: %s", err, data.Rows[i].Record.Article)
+				}
+			}
+			return nil
+		})
+		if err != nil {
+			if data.ChunkSize == 0 {
+				return dto.StockImportReport{}, err
+			}
+			for _, i := range chunk {
+				report.Rows[i].Status = dto.ImportFailed
+				report.Rows[i].Error = fmt.Errorf("%w: %w", service.ErrImportChunkNotApplied, err).Error()
+			}
+			continue
+		}
+
+		applied = true
+		for _, i := range chunk {
+			t := event.StockAdded
+			if report.Rows[i].Status == dto.ImportUpdated {
+				t = event.StockReplaced
+			}
+			s.emit(ctx, t, string(data.Rows[i].Record.Article), data.Rows[i].Record)
+		}
+	}
+
+	report.Applied = applied
+	report.Count()
+
+	logger.LogWithCtxData(ctx, slog.With(logger.OPLabel, "service.ImportStock")).Info(
+		fmt.Sprintf("stock import applied: %d created, %d updated, %d skipped, %d failed", report.Created,
+			report.Updated, report.Skipped, report.Failed))
+
+	return report, nil
+}
+
+// importStockRecord сохраняет загружаемую запись о товаре: добавляет товар в ассортимент или заменяет данные
+// имеющегося товара. При уменьшении количества имеющегося товара согласуются количества на местах хранения. Количество
+// товара, учитываемого по серийным номерам, не заменяется (возвращается service.ErrSerialTracked).
+func (s *Service) importStockRecord(ctx context.Context, data *dto.ArticlePriceNameAmount,
+	status dto.ImportStatus) error {
+	if status == dto.ImportCreated {
+		return s.Repository.CreateStock(ctx, data)
+	}
+
+	amount, err := s.Repository.ReadStockAmount(ctx, &dto.Article{Article: data.Article})
+	if err != nil {
+		return err
+	}
+	if data.Amount != amount {
+		if err = s.checkNotSerialTracked(ctx, data.Article); err != nil {
+			return err
+		}
+	}
+	if err = s.Repository.UpdateStock(ctx, data); err != nil {
+		return err
+	}
+	if data.Amount < amount {
+		return s.withdrawFromLocations(ctx, data.Article, data.Amount, 0, location.Default)
+	}
+
+	return nil
+}
@@ -0,0 +1,176 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"github.com/golang/mock/gomock"
+	"github.com/lazylex/watch-store-store/internal/domain/value_objects/conflict"
+	"github.com/lazylex/watch-store-store/internal/dto"
+	"github.com/lazylex/watch-store-store/internal/dto/validators"
+	mockrepository "github.com/lazylex/watch-store-store/internal/ports/repository/mocks"
+	"github.com/lazylex/watch-store-store/internal/ports/service"
+	"strings"
+	"testing"
+)
+
+// importRows строки загрузки: новый товар, имеющийся товар, некорректная строка и повтор нового товара.
+func importRows() []dto.StockImportRow {
+	return []dto.StockImportRow{
+		{Line: 2, Record: dto.ArticlePriceNameAmount{Article: "CA-F91W", Name: "CASIO F-91W", Price: 3490, Amount: 5}},
+		{Line: 3, Record: dto.ArticlePriceNameAmount{Article: "CA-A158", Name: "CASIO A158", Price: 4990, Amount: 2}},
+		{Line: 4, Record: dto.ArticlePriceNameAmount{Article: "CA-W800", Name: "CASIO W-800", Price: -1, Amount: 1}},
+		{Line: 5, Record: dto.ArticlePriceNameAmount{Article: "CA-F91W", Name: "CASIO F-91W", Price: 3490, Amount: 1}},
+	}
+}
+
+func TestService_ImportStockDryRun(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+
+	mockRepo := mockrepository.NewMockInterface(ctrl)
+	s := Service{Repository: mockRepo}
+	data := dto.StockImport{Rows: importRows(), Conflict: conflict.Reject, DryRun: true}
+
+	mockRepo.EXPECT().ReadStockAmounts(gomock.Any()).Times(1).Return(
+		[]dto.ArticleAmount{{Article: "CA-A158", Amount: 7}}, nil)
+	mockRepo.EXPECT().WithinTransaction(gomock.Any(), gomock.Any()).Times(0)
+
+	report, err := s.ImportStock(context.Background(), data)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if report.Applied || !report.DryRun || report.Total != 4 || report.Created != 1 || report.Failed != 3 {
+		t.Errorf("unexpected report %+v", report)
+	}
+	expected := []error{nil, service.ErrStockExists, validators.ErrNegativePrice, validators.ErrDuplicateArticleInImport}
+	for i, e := range expected {
+		if e != nil && report.Rows[i].Error != e.Error() {
+			t.Errorf("row %d: expected error %q, got %q", i, e, report.Rows[i].Error)
+		}
+	}
+}
+
+func TestService_ImportStockAtomicNotAppliedWithErrors(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+
+	mockRepo := mockrepository.NewMockInterface(ctrl)
+	s := Service{Repository: mockRepo}
+	data := dto.StockImport{Rows: importRows(), Conflict: conflict.Update}
+
+	mockRepo.EXPECT().ReadStockAmounts(gomock.Any()).Times(1).Return(nil, nil)
+	mockRepo.EXPECT().CreateStock(gomock.Any(), gomock.Any()).Times(0)
+
+	report, err := s.ImportStock(context.Background(), data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if report.Applied || report.Failed != 2 {
+		t.Errorf("unexpected report %+v", report)
+	}
+}
+
+func TestService_ImportStockAtomicUpsert(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+
+	mockRepo := mockrepository.NewMockInterface(ctrl)
+	s := Service{Repository: mockRepo}
+	rows := importRows()[:2]
+	data := dto.StockImport{Rows: rows, Conflict: conflict.Update}
+	ctx := context.WithValue(context.Background(), mockrepository.ExecuteKey{}, "✅")
+	expectNoSerials(mockRepo, ctx)
+
+	mockRepo.EXPECT().ReadStockAmounts(ctx).Times(1).Return([]dto.ArticleAmount{{Article: "CA-A158", Amount: 7}}, nil)
+	mockRepo.EXPECT().CreateStock(ctx, &rows[0].Record).Times(1).Return(nil)
+	mockRepo.EXPECT().ReadStockAmount(ctx, &dto.Article{Article: "CA-A158"}).Times(1).Return(uint(7), nil)
+	mockRepo.EXPECT().UpdateStock(ctx, &rows[1].Record).Times(1).Return(nil)
+	mockRepo.EXPECT().ReadStockLocations(ctx, &dto.Article{Article: "CA-A158"}).Times(1).Return(nil, nil)
+
+	report, err := s.ImportStock(ctx, data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !report.Applied || report.Created != 1 || report.Updated != 1 || report.Failed != 0 {
+		t.Errorf("unexpected report %+v", report)
+	}
+}
+
+func TestService_ImportStockAtomicErrCreate(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+
+	mockRepo := mockrepository.NewMockInterface(ctrl)
+	s := Service{Repository: mockRepo}
+	data := dto.StockImport{Rows: importRows()[:1], Conflict: conflict.Reject}
+	ctx := context.WithValue(context.Background(), mockrepository.ExecuteKey{}, "✅")
+
+	mockRepo.EXPECT().ReadStockAmounts(ctx).Times(1).Return(nil, nil)
+	mockRepo.EXPECT().CreateStock(ctx, gomock.Any()).Times(1).Return(errors.New("db error"))
+
+	if _, err := s.ImportStock(ctx, data); err == nil {
+		t.Fail()
+	}
+}
+
+func TestService_ImportStockChunked(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+
+	mockRepo := mockrepository.NewMockInterface(ctrl)
+	s := Service{Repository: mockRepo}
+	data := dto.StockImport{Rows: importRows(), Conflict: conflict.Skip, ChunkSize: 1}
+	ctx := context.WithValue(context.Background(), mockrepository.ExecuteKey{}, "✅")
+
+	mockRepo.EXPECT().ReadStockAmounts(ctx).Times(1).Return(nil, nil)
+	mockRepo.EXPECT().CreateStock(ctx, &data.Rows[0].Record).Times(1).Return(errors.New("db error"))
+	mockRepo.EXPECT().CreateStock(ctx, &data.Rows[1].Record).Times(1).Return(nil)
+
+	report, err := s.ImportStock(ctx, data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !report.Applied || report.Created != 1 || report.Failed != 3 {
+		t.Errorf("unexpected report %+v", report)
+	}
+	if !strings.HasPrefix(report.Rows[0].Error, service.ErrImportChunkNotApplied.Error()) {
+		t.Errorf("unexpected error of failed chunk: %q", report.Rows[0].Error)
+	}
+}
+
+func TestService_ImportStockSkipExisting(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+
+	mockRepo := mockrepository.NewMockInterface(ctrl)
+	s := Service{Repository: mockRepo}
+	data := dto.StockImport{Rows: importRows()[1:2], Conflict: conflict.Skip}
+	ctx := context.WithValue(context.Background(), mockrepository.ExecuteKey{}, "✅")
+
+	mockRepo.EXPECT().ReadStockAmounts(ctx).Times(1).Return([]dto.ArticleAmount{{Article: "CA-A158"}}, nil)
+	mockRepo.EXPECT().UpdateStock(gomock.Any(), gomock.Any()).Times(0)
+
+	report, err := s.ImportStock(ctx, data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !report.Applied || report.Skipped != 1 {
+		t.Errorf("unexpected report %+v", report)
+	}
+}
+
+func TestService_ImportStockIncorrectDTO(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+
+	mockRepo := mockrepository.NewMockInterface(ctrl)
+	s := Service{Repository: mockRepo}
+
+	mockRepo.EXPECT().ReadStockAmounts(gomock.Any()).Times(0)
+
+	if _, err := s.ImportStock(context.Background(), dto.StockImport{Conflict: conflict.Skip}); !errors.Is(err,
+		validators.ErrNoImportRows) {
+		t.Fail()
+	}
+}
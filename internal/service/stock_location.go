@@ -0,0 +1,162 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"github.com/lazylex/watch-store-store/internal/domain/event"
+	"github.com/lazylex/watch-store-store/internal/domain/value_objects/article"
+	"github.com/lazylex/watch-store-store/internal/domain/value_objects/location"
+	"github.com/lazylex/watch-store-store/internal/dto"
+	"github.com/lazylex/watch-store-store/internal/logger"
+	"github.com/lazylex/watch-store-store/internal/ports/service"
+	"log/slog"
+)
+
+// AmountByLocation возвращает доступное для продажи количество товара с разбивкой по всем местам хранения. Количество
+// на месте хранения по умолчанию равно разности общего количества и количеств на остальных местах.
+func (s *Service) AmountByLocation(ctx context.Context, data dto.Article) (dto.AmountLocations, error) {
+	if err := data.Validate(); err != nil {
+		return dto.AmountLocations{}, err
+	}
+
+	total, err := s.Repository.ReadStockAmount(ctx, &data)
+	if err != nil {
+		return dto.AmountLocations{}, err
+	}
+
+	amounts, err := s.locationAmounts(ctx, data.Article, total)
+	if err != nil {
+		return dto.AmountLocations{}, err
+	}
+
+	result := dto.AmountLocations{Amount: total}
+	for _, l := range location.Locations() {
+		result.Locations = append(result.Locations, dto.LocationAmount{Location: l, Amount: amounts[l]})
+	}
+
+	return result, nil
+}
+
+// TransferStock перемещает товар между местами хранения. Общее количество товара не изменяется. Если на месте, с
+// которого перемещается товар, его недостаточно, возвращается service.ErrNoEnoughItemsInPlace.
+func (s *Service) TransferStock(ctx context.Context, data dto.StockTransfer) error {
+	if err := data.Validate(); err != nil {
+		return err
+	}
+
+	err := s.Repository.WithinTransaction(ctx, func(txCtx context.Context) error {
+		total, err := s.Repository.ReadStockAmount(txCtx, &dto.Article{Article: data.Article})
+		if err != nil {
+			return err
+		}
+
+		amounts, err := s.locationAmounts(txCtx, data.Article, total)
+		if err != nil {
+			return err
+		}
+		if amounts[data.From] < data.Amount {
+			return service.ErrNoEnoughItemsInPlace
+		}
+
+		for l, amount := range map[location.Location]uint{
+			data.From: amounts[data.From] - data.Amount,
+			data.To:   amounts[data.To] + data.Amount,
+		} {
+			if l == location.Default {
+				continue
+			}
+			if err = s.Repository.UpsertStockLocationAmount(txCtx,
+				&dto.ArticleLocationAmount{Article: data.Article, Location: l, Amount: amount}); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	logger.LogWithCtxData(ctx, slog.With(logger.OPLabel, "service.TransferStock")).Info(
+		fmt.Sprintf("%d items of article %s transferred from %s to %s", data.Amount, data.Article, data.From,
+			data.To))
+	s.emit(ctx, event.StockRelocated, string(data.Article), data)
+
+	return nil
+}
+
+// withdrawFromLocations согласует количества товара на местах хранения с уменьшением его общего количества до total.
+// Сначала amount единиц списывается с предпочтительного места хранения, оставшееся - с места по умолчанию, а если на
+// нём товара недостаточно, то с остальных мест в порядке location.Locations(). Поступающий товар (при увеличении
+// общего количества) учитывается на месте по умолчанию, поэтому вызова этой функции не требует.
+func (s *Service) withdrawFromLocations(ctx context.Context, art article.Article, total, amount uint,
+	preferred location.Location) error {
+	stored, err := s.Repository.ReadStockLocations(ctx, &dto.Article{Article: art})
+	if err != nil || len(stored) == 0 {
+		return err
+	}
+
+	amounts := make(map[location.Location]uint, len(stored))
+	var sum uint
+	for _, record := range stored {
+		amounts[record.Location] = record.Amount
+		sum += record.Amount
+	}
+	changed := make(map[location.Location]struct{})
+
+	if preferred != "" && preferred != location.Default && amounts[preferred] > 0 {
+		taken := min(amounts[preferred], amount)
+		amounts[preferred] -= taken
+		sum -= taken
+		changed[preferred] = struct{}{}
+	}
+
+	for _, l := range location.Locations() {
+		if sum <= total {
+			break
+		}
+		if l == location.Default || amounts[l] == 0 {
+			continue
+		}
+		taken := min(amounts[l], sum-total)
+		amounts[l] -= taken
+		sum -= taken
+		changed[l] = struct{}{}
+	}
+
+	for _, l := range location.Locations() {
+		if _, ok := changed[l]; !ok {
+			continue
+		}
+		if err = s.Repository.UpsertStockLocationAmount(ctx,
+			&dto.ArticleLocationAmount{Article: art, Location: l, Amount: amounts[l]}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// locationAmounts возвращает количества товара на местах хранения при общем количестве total.
+func (s *Service) locationAmounts(ctx context.Context, art article.Article, total uint) (
+	map[location.Location]uint, error) {
+	stored, err := s.Repository.ReadStockLocations(ctx, &dto.Article{Article: art})
+	if err != nil {
+		return nil, err
+	}
+
+	result := make(map[location.Location]uint, len(location.Locations()))
+	var sum uint
+	for _, record := range stored {
+		if record.Location == location.Default {
+			continue
+		}
+		result[record.Location] = record.Amount
+		sum += record.Amount
+	}
+	if sum < total {
+		result[location.Default] = total - sum
+	}
+
+	return result, nil
+}
@@ -0,0 +1,127 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"github.com/golang/mock/gomock"
+	"github.com/lazylex/watch-store-store/internal/domain/aggregates/reservation"
+	"github.com/lazylex/watch-store-store/internal/domain/value_objects/location"
+	"github.com/lazylex/watch-store-store/internal/dto"
+	mockrepository "github.com/lazylex/watch-store-store/internal/ports/repository/mocks"
+	"github.com/lazylex/watch-store-store/internal/ports/service"
+	"testing"
+)
+
+func TestService_AmountByLocation(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+
+	mockRepo := mockrepository.NewMockInterface(ctrl)
+	s := Service{Repository: mockRepo}
+
+	mockRepo.EXPECT().ReadStockAmount(gomock.Any(), &dto.Article{Article: "test-1"}).Times(1).Return(uint(10), nil)
+	mockRepo.EXPECT().ReadStockLocations(gomock.Any(), &dto.Article{Article: "test-1"}).Times(1).Return(
+		[]dto.LocationAmount{{Location: location.BackRoom, Amount: 6}, {Location: location.RepairDesk, Amount: 1}},
+		nil)
+
+	result, err := s.AmountByLocation(context.Background(), dto.Article{Article: "test-1"})
+	if err != nil || result.Amount != 10 || len(result.Locations) != 3 {
+		t.Fatal(result, err)
+	}
+	if result.Locations[0] != (dto.LocationAmount{Location: location.Showcase, Amount: 3}) ||
+		result.Locations[1] != (dto.LocationAmount{Location: location.BackRoom, Amount: 6}) ||
+		result.Locations[2] != (dto.LocationAmount{Location: location.RepairDesk, Amount: 1}) {
+		t.Errorf("unexpected %+v", result.Locations)
+	}
+}
+
+func TestService_TransferStock(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+
+	mockRepo := mockrepository.NewMockInterface(ctrl)
+	s := Service{Repository: mockRepo}
+	ctx := context.WithValue(context.Background(), mockrepository.ExecuteKey{}, "✅")
+
+	mockRepo.EXPECT().ReadStockAmount(ctx, &dto.Article{Article: "test-1"}).Times(1).Return(uint(10), nil)
+	mockRepo.EXPECT().ReadStockLocations(ctx, &dto.Article{Article: "test-1"}).Times(1).Return(
+		[]dto.LocationAmount{{Location: location.BackRoom, Amount: 6}}, nil)
+	mockRepo.EXPECT().UpsertStockLocationAmount(ctx, &dto.ArticleLocationAmount{Article: "test-1",
+		Location: location.BackRoom, Amount: 2}).Times(1).Return(nil)
+
+	err := s.TransferStock(ctx, dto.StockTransfer{Article: "test-1", From: location.BackRoom, To: location.Showcase,
+		Amount: 4})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestService_TransferStockNoEnoughItems(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+
+	mockRepo := mockrepository.NewMockInterface(ctrl)
+	s := Service{Repository: mockRepo}
+	ctx := context.WithValue(context.Background(), mockrepository.ExecuteKey{}, "✅")
+
+	mockRepo.EXPECT().ReadStockAmount(ctx, &dto.Article{Article: "test-1"}).Times(1).Return(uint(10), nil)
+	mockRepo.EXPECT().ReadStockLocations(ctx, &dto.Article{Article: "test-1"}).Times(1).Return(
+		[]dto.LocationAmount{{Location: location.BackRoom, Amount: 6}}, nil)
+	mockRepo.EXPECT().UpsertStockLocationAmount(ctx, gomock.Any()).Times(0)
+
+	err := s.TransferStock(ctx, dto.StockTransfer{Article: "test-1", From: location.Showcase,
+		To: location.RepairDesk, Amount: 5})
+	if !errors.Is(err, service.ErrNoEnoughItemsInPlace) {
+		t.Fatal(err)
+	}
+}
+
+func TestService_MakeReservationFromPreferredLocation(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+
+	mockRepo := mockrepository.NewMockInterface(ctrl)
+	data := dto.NumberDateStateProducts{
+		Products:    []dto.ArticlePriceAmount{{Article: "test-1", Amount: 5, Price: 698}},
+		OrderNumber: 10,
+		State:       reservation.NewForCashRegister,
+		Location:    location.BackRoom,
+	}
+	s := Service{Repository: mockRepo}
+	ctx := context.WithValue(context.Background(), mockrepository.ExecuteKey{}, "✅")
+	expectNoSerials(mockRepo, ctx)
+	expectCashRegister(mockRepo, ctx, data.OrderNumber)
+
+	// на складе 3 из 10: резервируются все 3 со склада и 2 с витрины
+	mockRepo.EXPECT().ReadStockAmount(ctx, &dto.Article{Article: "test-1"}).Times(1).Return(uint(10), nil)
+	mockRepo.EXPECT().UpdateStockAmount(ctx, &dto.ArticleAmount{Article: "test-1", Amount: 5}).Times(1).Return(nil)
+	mockRepo.EXPECT().ReadStockLocations(ctx, &dto.Article{Article: "test-1"}).Times(1).Return(
+		[]dto.LocationAmount{{Location: location.BackRoom, Amount: 3}}, nil)
+	mockRepo.EXPECT().UpsertStockLocationAmount(ctx, &dto.ArticleLocationAmount{Article: "test-1",
+		Location: location.BackRoom, Amount: 0}).Times(1).Return(nil)
+	mockRepo.EXPECT().CreateReservation(ctx, &data).Times(1).Return(nil)
+	mockRepo.EXPECT().CreateReservationTransition(ctx, gomock.Any()).Times(1).Return(nil)
+
+	if _, err := s.MakeReservation(ctx, data); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestService_WithdrawFromLocationsWhenShowcaseIsShort(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+
+	mockRepo := mockrepository.NewMockInterface(ctrl)
+	s := Service{Repository: mockRepo}
+
+	// было 10: 2 на витрине, 5 на складе, 3 на стойке ремонта. Продано 4 с витрины: 2 с витрины и 2 со склада
+	mockRepo.EXPECT().ReadStockLocations(gomock.Any(), &dto.Article{Article: "test-1"}).Times(1).Return(
+		[]dto.LocationAmount{{Location: location.RepairDesk, Amount: 3}, {Location: location.BackRoom, Amount: 5}},
+		nil)
+	mockRepo.EXPECT().UpsertStockLocationAmount(gomock.Any(), &dto.ArticleLocationAmount{Article: "test-1",
+		Location: location.BackRoom, Amount: 3}).Times(1).Return(nil)
+
+	if err := s.withdrawFromLocations(context.Background(), "test-1", 6, 4, location.Default); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,386 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/lazylex/watch-store-store/internal/domain/aggregates/transfer"
+	"github.com/lazylex/watch-store-store/internal/domain/event"
+	"github.com/lazylex/watch-store-store/internal/domain/value_objects/article"
+	"github.com/lazylex/watch-store-store/internal/domain/value_objects/location"
+	"github.com/lazylex/watch-store-store/internal/dto"
+	"github.com/lazylex/watch-store-store/internal/dto/validators"
+	"github.com/lazylex/watch-store-store/internal/logger"
+	"github.com/lazylex/watch-store-store/internal/ports/repository"
+	"github.com/lazylex/watch-store-store/internal/ports/service"
+	"log/slog"
+	"time"
+)
+
+// CreateOutboundTransfer отправляет товар в другой магазин: уменьшает количество отправляемых товаров и сохраняет
+// исходящее перемещение в состоянии transfer.InTransit. Сообщение о перемещении отправляется получателю отдельно (см.
+// TransfersToNotify). Если какого-либо товара недостаточно, перемещение не создаётся и возвращается
+// service.ErrNoEnoughItemsToSend. Товары, учитываемые по серийным номерам, не перемещаются (возвращается
+// service.ErrSerialTracked). При успехе возвращается идентификатор перемещения.
+func (s *Service) CreateOutboundTransfer(ctx context.Context, data dto.OutboundTransfer) (transfer.ID, error) {
+	if err := data.Validate(); err != nil {
+		return 0, err
+	}
+	if data.Target == s.Instance {
+		return 0, service.ErrTransferToItself
+	}
+
+	var id transfer.ID
+	var record dto.Transfer
+	err := s.Repository.WithinTransaction(ctx, func(txCtx context.Context) error {
+		now := time.Now()
+		record = dto.Transfer{Direction: transfer.Outbound, Counterpart: data.Target, State: transfer.InTransit,
+			CreatedAt: now, UpdatedAt: now}
+
+		for _, item := range data.Items {
+			if err := s.checkNotSerialTracked(txCtx, item.Article); err != nil {
+				return err
+			}
+			stock, err := s.Repository.ReadStock(txCtx, &dto.Article{Article: item.Article})
+			if err != nil {
+				return err
+			}
+			if stock.Amount < item.Amount {
+				return service.ErrNoEnoughItemsToSend
+			}
+
+			newAmount := stock.Amount - item.Amount
+			if err = s.Repository.UpdateStockAmount(txCtx,
+				&dto.ArticleAmount{Article: item.Article, Amount: newAmount}); err != nil {
+				return err
+			}
+			if err = s.withdrawFromLocations(txCtx, item.Article, newAmount, 0, location.Default); err != nil {
+				return err
+			}
+
+			record.Items = append(record.Items, dto.TransferItem{Article: item.Article, Name: stock.Name,
+				Price: stock.Price, Amount: item.Amount})
+		}
+
+		var err error
+		id, err = s.Repository.CreateTransfer(txCtx, &record)
+		record.ID = id
+		return err
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	logger.LogWithCtxData(ctx, slog.With(logger.OPLabel, "service.CreateOutboundTransfer")).Info(
+		fmt.Sprintf("transfer %d to %s created, %d items", id, data.Target, len(data.Items)))
+	s.emit(ctx, event.TransferSent, numberKey(id), record)
+
+	return id, nil
+}
+
+// RegisterInboundTransfer сохраняет поступившее от другого магазина перемещение в состоянии transfer.InTransit.
+// Остатки при этом не изменяются - товар вносится в них при подтверждении приёмки (см. ConfirmInboundTransfer).
+// Сохранение идемпотентно по отправителю и идентификатору перемещения у отправителя - если перемещение уже сохранено,
+// возвращается false.
+func (s *Service) RegisterInboundTransfer(ctx context.Context, data dto.Transfer) (bool, error) {
+	if err := data.Validate(); err != nil {
+		return false, err
+	}
+	if data.Counterpart == s.Instance {
+		return false, service.ErrTransferToItself
+	}
+
+	now := time.Now()
+	record := dto.Transfer{Direction: transfer.Inbound, Counterpart: data.Counterpart, SourceID: data.SourceID,
+		State: transfer.InTransit, CreatedAt: now, UpdatedAt: now, Notified: true}
+	for _, item := range data.Items {
+		item.Received = 0
+		record.Items = append(record.Items, item)
+	}
+
+	log := logger.LogWithCtxData(ctx, slog.With(logger.OPLabel, "service.RegisterInboundTransfer"))
+
+	id, err := s.Repository.CreateTransfer(ctx, &record)
+	if errors.Is(err, repository.ErrDuplicate) {
+		log.Info(fmt.Sprintf("transfer %d from %s already registered", data.SourceID, data.Counterpart))
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+
+	log.Info(fmt.Sprintf("transfer %d from %s registered as %d", data.SourceID, data.Counterpart, id))
+	record.ID = id
+	s.emit(ctx, event.TransferRegistered, numberKey(id), record)
+
+	return true, nil
+}
+
+// ConfirmInboundTransfer подтверждает приёмку входящего перемещения: увеличивает количество принятых товаров (создаёт
+// записи о товарах, отсутствующих в ассортименте) и переводит перемещение в состояние transfer.Received или, если
+// принятое количество отличается от отправленного, transfer.Discrepancy. Сообщение о приёмке отправляется отправителю
+// отдельно (см. TransfersToNotify). Товары, учитываемые по серийным номерам, принимаются только поставкой (см.
+// ReceiveGoods), для них возвращается service.ErrSerialTracked.
+func (s *Service) ConfirmInboundTransfer(ctx context.Context, data dto.TransferConfirmation) (dto.Transfer, error) {
+	if err := data.Validate(); err != nil {
+		return dto.Transfer{}, err
+	}
+
+	var result dto.Transfer
+	err := s.Repository.WithinTransaction(ctx, func(txCtx context.Context) error {
+		var err error
+		if result, err = s.Repository.ReadTransfer(txCtx, &dto.TransferID{ID: data.ID}); err != nil {
+			return err
+		}
+		if result.Direction != transfer.Inbound {
+			return service.ErrWrongTransferDirection
+		}
+		if result.State != transfer.InTransit {
+			return service.ErrTransferNotInTransit
+		}
+		if err = setReceivedAmounts(&result, data.Items); err != nil {
+			return err
+		}
+
+		for _, item := range result.Items {
+			if item.Received == 0 {
+				continue
+			}
+			if err = s.checkNotSerialTracked(txCtx, item.Article); err != nil {
+				return err
+			}
+
+			var amount uint
+			amount, err = s.Repository.ReadStockAmount(txCtx, &dto.Article{Article: item.Article})
+			switch {
+			case err == nil:
+				err = s.Repository.UpdateStockAmount(txCtx,
+					&dto.ArticleAmount{Article: item.Article, Amount: amount + item.Received})
+			case errors.Is(err, repository.ErrNoRecord):
+				record := item.NewStockRecord()
+				if err = record.Validate(); err != nil {
+					return err
+				}
+				err = s.Repository.CreateStock(txCtx, &record)
+			}
+			if err != nil {
+				return err
+			}
+		}
+
+		result.State = transferStateAfterReceipt(&result)
+		result.Notified = false
+		result.UpdatedAt = time.Now()
+
+		return s.Repository.UpdateTransfer(txCtx, &result)
+	})
+	if err != nil {
+		return dto.Transfer{}, err
+	}
+
+	logger.LogWithCtxData(ctx, slog.With(logger.OPLabel, "service.ConfirmInboundTransfer")).Info(
+		fmt.Sprintf("transfer %d from %s received, state %d", result.ID, result.Counterpart, result.State))
+	s.emit(ctx, event.TransferReceived, numberKey(result.ID), result)
+
+	return result, nil
+}
+
+// CompleteOutboundTransfer сохраняет поступившее от получателя принятое количество товаров исходящего перемещения
+// data.ID и переводит его в состояние transfer.Received или transfer.Discrepancy. Остатки отправителя при этом не
+// изменяются. Сохранение идемпотентно - если перемещение уже не находится в пути, возвращается false.
+func (s *Service) CompleteOutboundTransfer(ctx context.Context, data dto.Transfer) (bool, error) {
+	if err := validators.TransferID(data.ID); err != nil {
+		return false, err
+	}
+	if err := validators.Instance(data.Counterpart); err != nil {
+		return false, err
+	}
+
+	received := make([]dto.ArticleAmount, 0, len(data.Items))
+	for _, item := range data.Items {
+		if err := validators.Article(item.Article); err != nil {
+			return false, err
+		}
+		received = append(received, dto.ArticleAmount{Article: item.Article, Amount: item.Received})
+	}
+
+	var result dto.Transfer
+	completed := false
+	err := s.Repository.WithinTransaction(ctx, func(txCtx context.Context) error {
+		var err error
+		if result, err = s.Repository.ReadTransfer(txCtx, &dto.TransferID{ID: data.ID}); err != nil {
+			return err
+		}
+		if result.Direction != transfer.Outbound {
+			return service.ErrWrongTransferDirection
+		}
+		if result.Counterpart != data.Counterpart {
+			return service.ErrTransferCounterpart
+		}
+		if result.State != transfer.InTransit {
+			return nil
+		}
+		if err = setReceivedAmounts(&result, received); err != nil {
+			return err
+		}
+
+		result.State = transferStateAfterReceipt(&result)
+		result.Notified = true
+		result.UpdatedAt = time.Now()
+		if err = s.Repository.UpdateTransfer(txCtx, &result); err != nil {
+			return err
+		}
+
+		completed = true
+		return nil
+	})
+	if err != nil {
+		return false, err
+	}
+
+	log := logger.LogWithCtxData(ctx, slog.With(logger.OPLabel, "service.CompleteOutboundTransfer"))
+	if completed {
+		log.Info(fmt.Sprintf("transfer %d received by %s, state %d", result.ID, result.Counterpart, result.State))
+		s.emit(ctx, event.TransferCompleted, numberKey(result.ID), result)
+	} else {
+		log.Info(fmt.Sprintf("receipt of transfer %d already saved", result.ID))
+	}
+
+	return completed, nil
+}
+
+// ResolveTransfer урегулирует расхождение исходящего перемещения, находящегося в состоянии transfer.Discrepancy.
+// Если data.Restock, то недостача (отправленное, но не принятое получателем количество) возвращается в остатки, иначе
+// считается списанной. Излишек, принятый получателем, остатки отправителя не изменяет. Перемещение переводится в
+// состояние transfer.Resolved.
+func (s *Service) ResolveTransfer(ctx context.Context, data dto.TransferResolution) (dto.Transfer, error) {
+	if err := data.Validate(); err != nil {
+		return dto.Transfer{}, err
+	}
+
+	var result dto.Transfer
+	err := s.Repository.WithinTransaction(ctx, func(txCtx context.Context) error {
+		var err error
+		if result, err = s.Repository.ReadTransfer(txCtx, &dto.TransferID{ID: data.ID}); err != nil {
+			return err
+		}
+		if result.Direction != transfer.Outbound {
+			return service.ErrWrongTransferDirection
+		}
+		if result.State != transfer.Discrepancy {
+			return service.ErrNoTransferDiscrepancy
+		}
+
+		if data.Restock {
+			for _, item := range result.Items {
+				if item.Received >= item.Amount {
+					continue
+				}
+				if err = s.checkNotSerialTracked(txCtx, item.Article); err != nil {
+					return err
+				}
+
+				var amount uint
+				if amount, err = s.Repository.ReadStockAmount(txCtx,
+					&dto.Article{Article: item.Article}); err != nil {
+					return err
+				}
+				if err = s.Repository.UpdateStockAmount(txCtx, &dto.ArticleAmount{Article: item.Article,
+					Amount: amount + item.Amount - item.Received}); err != nil {
+					return err
+				}
+			}
+		}
+
+		result.State = transfer.Resolved
+		result.UpdatedAt = time.Now()
+
+		return s.Repository.UpdateTransfer(txCtx, &result)
+	})
+	if err != nil {
+		return dto.Transfer{}, err
+	}
+
+	logger.LogWithCtxData(ctx, slog.With(logger.OPLabel, "service.ResolveTransfer")).Info(
+		fmt.Sprintf("discrepancy of transfer %d resolved, restock: %t", result.ID, data.Restock))
+	s.emit(ctx, event.TransferResolved, numberKey(result.ID), result)
+
+	return result, nil
+}
+
+// Transfer возвращает перемещение товара между магазинами вместе с перемещаемыми товарами.
+func (s *Service) Transfer(ctx context.Context, data dto.TransferID) (dto.Transfer, error) {
+	if err := data.Validate(); err != nil {
+		return dto.Transfer{}, err
+	}
+
+	result, err := s.Repository.ReadTransfer(ctx, &data)
+	if err != nil {
+		return dto.Transfer{}, err
+	}
+
+	logger.LogWithCtxData(ctx, slog.With(logger.OPLabel, "service.Transfer")).Info(
+		fmt.Sprintf("requested transfer %d", data.ID))
+
+	return result, nil
+}
+
+// Transfers возвращает удовлетворяющие фильтру перемещения товара между магазинами без перемещаемых товаров.
+func (s *Service) Transfers(ctx context.Context, data dto.TransferFilter) ([]dto.Transfer, error) {
+	if err := data.Validate(); err != nil {
+		return nil, err
+	}
+
+	result, err := s.Repository.ReadTransfers(ctx, &data)
+	if err != nil {
+		return nil, err
+	}
+
+	logger.LogWithCtxData(ctx, slog.With(logger.OPLabel, "service.Transfers")).Info(
+		fmt.Sprintf("requested %d transfers", len(result)))
+
+	return result, nil
+}
+
+// TransfersToNotify возвращает перемещения, о которых необходимо отправить сообщение второй стороне перемещения:
+// созданные исходящие перемещения и принятые входящие.
+func (s *Service) TransfersToNotify(ctx context.Context) ([]dto.Transfer, error) {
+	return s.Repository.ReadUnnotifiedTransfers(ctx)
+}
+
+// MarkTransferNotified отмечает, что второй стороне перемещения отправлено сообщение о нём.
+func (s *Service) MarkTransferNotified(ctx context.Context, data dto.TransferID) error {
+	if err := data.Validate(); err != nil {
+		return err
+	}
+
+	return s.Repository.UpdateTransferNotified(ctx, &data)
+}
+
+// setReceivedAmounts записывает в товары перемещения принятое количество. Товары перемещения, отсутствующие в
+// received, считаются не поступившими. Если в received есть товар, не входящий в перемещение, возвращается
+// service.ErrArticleNotInTransfer.
+func setReceivedAmounts(data *dto.Transfer, received []dto.ArticleAmount) error {
+	amounts := make(map[article.Article]uint, len(received))
+	for _, r := range received {
+		amounts[r.Article] = r.Amount
+	}
+
+	for i := range data.Items {
+		data.Items[i].Received = amounts[data.Items[i].Article]
+		delete(amounts, data.Items[i].Article)
+	}
+	if len(amounts) > 0 {
+		return service.ErrArticleNotInTransfer
+	}
+
+	return nil
+}
+
+// transferStateAfterReceipt возвращает состояние перемещения после приёмки товара получателем.
+func transferStateAfterReceipt(data *dto.Transfer) transfer.State {
+	if data.HasDiscrepancy() {
+		return transfer.Discrepancy
+	}
+	return transfer.Received
+}
@@ -0,0 +1,268 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"github.com/golang/mock/gomock"
+	"github.com/lazylex/watch-store-store/internal/domain/aggregates/transfer"
+	"github.com/lazylex/watch-store-store/internal/dto"
+	"github.com/lazylex/watch-store-store/internal/ports/repository"
+	mockrepository "github.com/lazylex/watch-store-store/internal/ports/repository/mocks"
+	"github.com/lazylex/watch-store-store/internal/ports/service"
+	"testing"
+)
+
+func TestService_CreateOutboundTransfer(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+
+	mockRepo := mockrepository.NewMockInterface(ctrl)
+	s := Service{Repository: mockRepo, Instance: "store-1"}
+	ctx := context.WithValue(context.Background(), mockrepository.ExecuteKey{}, "✅")
+	expectNoSerials(mockRepo, ctx)
+
+	mockRepo.EXPECT().ReadStock(ctx, &dto.Article{Article: "test-1"}).Times(1).Return(
+		dto.ArticlePriceNameAmount{Article: "test-1", Name: "watch", Price: 100, Amount: 5}, nil)
+	mockRepo.EXPECT().UpdateStockAmount(ctx, &dto.ArticleAmount{Article: "test-1", Amount: 2}).Times(1).Return(nil)
+	mockRepo.EXPECT().ReadStockLocations(ctx, &dto.Article{Article: "test-1"}).Times(1).Return(nil, nil)
+	mockRepo.EXPECT().CreateTransfer(ctx, gomock.Any()).Times(1).DoAndReturn(
+		func(_ context.Context, data *dto.Transfer) (transfer.ID, error) {
+			if data.Direction != transfer.Outbound || data.Counterpart != "store-2" ||
+				data.State != transfer.InTransit || data.Notified || len(data.Items) != 1 ||
+				data.Items[0] != (dto.TransferItem{Article: "test-1", Name: "watch", Price: 100, Amount: 3}) {
+				t.Errorf("unexpected transfer %+v", data)
+			}
+			return 7, nil
+		})
+
+	id, err := s.CreateOutboundTransfer(ctx,
+		dto.OutboundTransfer{Target: "store-2", Items: []dto.ArticleAmount{{Article: "test-1", Amount: 3}}})
+	if err != nil || id != 7 {
+		t.Fatal(id, err)
+	}
+}
+
+func TestService_CreateOutboundTransferNoEnoughItems(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+
+	mockRepo := mockrepository.NewMockInterface(ctrl)
+	s := Service{Repository: mockRepo, Instance: "store-1"}
+	ctx := context.WithValue(context.Background(), mockrepository.ExecuteKey{}, "✅")
+	expectNoSerials(mockRepo, ctx)
+
+	mockRepo.EXPECT().ReadStock(ctx, &dto.Article{Article: "test-1"}).Times(1).Return(
+		dto.ArticlePriceNameAmount{Article: "test-1", Amount: 2}, nil)
+	mockRepo.EXPECT().UpdateStockAmount(ctx, gomock.Any()).Times(0)
+	mockRepo.EXPECT().CreateTransfer(ctx, gomock.Any()).Times(0)
+
+	_, err := s.CreateOutboundTransfer(ctx,
+		dto.OutboundTransfer{Target: "store-2", Items: []dto.ArticleAmount{{Article: "test-1", Amount: 3}}})
+	if !errors.Is(err, service.ErrNoEnoughItemsToSend) {
+		t.Fatal(err)
+	}
+}
+
+func TestService_CreateOutboundTransferToItself(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+
+	mockRepo := mockrepository.NewMockInterface(ctrl)
+	s := Service{Repository: mockRepo, Instance: "store-1"}
+
+	_, err := s.CreateOutboundTransfer(context.Background(),
+		dto.OutboundTransfer{Target: "store-1", Items: []dto.ArticleAmount{{Article: "test-1", Amount: 1}}})
+	if !errors.Is(err, service.ErrTransferToItself) {
+		t.Fatal(err)
+	}
+}
+
+func TestService_RegisterInboundTransferAlreadyRegistered(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+
+	mockRepo := mockrepository.NewMockInterface(ctrl)
+	s := Service{Repository: mockRepo, Instance: "store-2"}
+
+	mockRepo.EXPECT().CreateTransfer(gomock.Any(), gomock.Any()).Times(1).Return(transfer.ID(0),
+		repository.ErrDuplicate)
+
+	registered, err := s.RegisterInboundTransfer(context.Background(), dto.Transfer{Counterpart: "store-1",
+		SourceID: 7, Items: []dto.TransferItem{{Article: "test-1", Amount: 3}}})
+	if err != nil || registered {
+		t.Fatal(registered, err)
+	}
+}
+
+func TestService_ConfirmInboundTransferWithDiscrepancy(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+
+	mockRepo := mockrepository.NewMockInterface(ctrl)
+	s := Service{Repository: mockRepo, Instance: "store-2"}
+	ctx := context.WithValue(context.Background(), mockrepository.ExecuteKey{}, "✅")
+	expectNoSerials(mockRepo, ctx)
+
+	mockRepo.EXPECT().ReadTransfer(ctx, &dto.TransferID{ID: 3}).Times(1).Return(dto.Transfer{ID: 3,
+		Direction: transfer.Inbound, Counterpart: "store-1", SourceID: 7, State: transfer.InTransit, Notified: true,
+		Items: []dto.TransferItem{
+			{Article: "test-1", Name: "watch", Price: 100, Amount: 2},
+			{Article: "test-2", Name: "strap", Price: 10, Amount: 1},
+		}}, nil)
+	mockRepo.EXPECT().ReadStockAmount(ctx, &dto.Article{Article: "test-1"}).Times(1).Return(uint(0),
+		repository.ErrNoRecord)
+	mockRepo.EXPECT().CreateStock(ctx,
+		&dto.ArticlePriceNameAmount{Article: "test-1", Name: "watch", Price: 100, Amount: 2}).Times(1).Return(nil)
+	mockRepo.EXPECT().ReadStockAmount(ctx, &dto.Article{Article: "test-2"}).Times(0)
+	mockRepo.EXPECT().UpdateTransfer(ctx, gomock.Any()).Times(1).Return(nil)
+
+	result, err := s.ConfirmInboundTransfer(ctx,
+		dto.TransferConfirmation{ID: 3, Items: []dto.ArticleAmount{{Article: "test-1", Amount: 2}}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result.State != transfer.Discrepancy || result.Notified || result.Items[0].Received != 2 ||
+		result.Items[1].Received != 0 {
+		t.Errorf("unexpected transfer %+v", result)
+	}
+}
+
+func TestService_ConfirmInboundTransferArticleNotInTransfer(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+
+	mockRepo := mockrepository.NewMockInterface(ctrl)
+	s := Service{Repository: mockRepo, Instance: "store-2"}
+	ctx := context.WithValue(context.Background(), mockrepository.ExecuteKey{}, "✅")
+
+	mockRepo.EXPECT().ReadTransfer(ctx, &dto.TransferID{ID: 3}).Times(1).Return(dto.Transfer{ID: 3,
+		Direction: transfer.Inbound, State: transfer.InTransit,
+		Items: []dto.TransferItem{{Article: "test-1", Amount: 2}}}, nil)
+	mockRepo.EXPECT().UpdateTransfer(ctx, gomock.Any()).Times(0)
+
+	_, err := s.ConfirmInboundTransfer(ctx,
+		dto.TransferConfirmation{ID: 3, Items: []dto.ArticleAmount{{Article: "test-3", Amount: 2}}})
+	if !errors.Is(err, service.ErrArticleNotInTransfer) {
+		t.Fatal(err)
+	}
+}
+
+func TestService_ConfirmInboundTransferWrongDirection(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+
+	mockRepo := mockrepository.NewMockInterface(ctrl)
+	s := Service{Repository: mockRepo, Instance: "store-1"}
+	ctx := context.WithValue(context.Background(), mockrepository.ExecuteKey{}, "✅")
+
+	mockRepo.EXPECT().ReadTransfer(ctx, &dto.TransferID{ID: 7}).Times(1).Return(dto.Transfer{ID: 7,
+		Direction: transfer.Outbound, State: transfer.InTransit}, nil)
+
+	_, err := s.ConfirmInboundTransfer(ctx, dto.TransferConfirmation{ID: 7})
+	if !errors.Is(err, service.ErrWrongTransferDirection) {
+		t.Fatal(err)
+	}
+}
+
+func TestService_CompleteOutboundTransfer(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+
+	mockRepo := mockrepository.NewMockInterface(ctrl)
+	s := Service{Repository: mockRepo, Instance: "store-1"}
+	ctx := context.WithValue(context.Background(), mockrepository.ExecuteKey{}, "✅")
+
+	mockRepo.EXPECT().ReadTransfer(ctx, &dto.TransferID{ID: 7}).Times(1).Return(dto.Transfer{ID: 7,
+		Direction: transfer.Outbound, Counterpart: "store-2", State: transfer.InTransit,
+		Items: []dto.TransferItem{{Article: "test-1", Amount: 2}}}, nil)
+	mockRepo.EXPECT().UpdateTransfer(ctx, gomock.Any()).Times(1).DoAndReturn(
+		func(_ context.Context, data *dto.Transfer) error {
+			if data.State != transfer.Received || !data.Notified || data.Items[0].Received != 2 {
+				t.Errorf("unexpected transfer %+v", data)
+			}
+			return nil
+		})
+
+	completed, err := s.CompleteOutboundTransfer(ctx, dto.Transfer{ID: 7, Counterpart: "store-2",
+		Items: []dto.TransferItem{{Article: "test-1", Amount: 2, Received: 2}}})
+	if err != nil || !completed {
+		t.Fatal(completed, err)
+	}
+}
+
+func TestService_CompleteOutboundTransferAlreadyCompleted(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+
+	mockRepo := mockrepository.NewMockInterface(ctrl)
+	s := Service{Repository: mockRepo, Instance: "store-1"}
+	ctx := context.WithValue(context.Background(), mockrepository.ExecuteKey{}, "✅")
+
+	mockRepo.EXPECT().ReadTransfer(ctx, &dto.TransferID{ID: 7}).Times(1).Return(dto.Transfer{ID: 7,
+		Direction: transfer.Outbound, Counterpart: "store-2", State: transfer.Received}, nil)
+	mockRepo.EXPECT().UpdateTransfer(ctx, gomock.Any()).Times(0)
+
+	completed, err := s.CompleteOutboundTransfer(ctx, dto.Transfer{ID: 7, Counterpart: "store-2"})
+	if err != nil || completed {
+		t.Fatal(completed, err)
+	}
+}
+
+func TestService_CompleteOutboundTransferFromAnotherStore(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+
+	mockRepo := mockrepository.NewMockInterface(ctrl)
+	s := Service{Repository: mockRepo, Instance: "store-1"}
+	ctx := context.WithValue(context.Background(), mockrepository.ExecuteKey{}, "✅")
+
+	mockRepo.EXPECT().ReadTransfer(ctx, &dto.TransferID{ID: 7}).Times(1).Return(dto.Transfer{ID: 7,
+		Direction: transfer.Outbound, Counterpart: "store-2", State: transfer.InTransit}, nil)
+
+	_, err := s.CompleteOutboundTransfer(ctx, dto.Transfer{ID: 7, Counterpart: "store-3"})
+	if !errors.Is(err, service.ErrTransferCounterpart) {
+		t.Fatal(err)
+	}
+}
+
+func TestService_ResolveTransferWithRestock(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+
+	mockRepo := mockrepository.NewMockInterface(ctrl)
+	s := Service{Repository: mockRepo, Instance: "store-1"}
+	ctx := context.WithValue(context.Background(), mockrepository.ExecuteKey{}, "✅")
+	expectNoSerials(mockRepo, ctx)
+
+	mockRepo.EXPECT().ReadTransfer(ctx, &dto.TransferID{ID: 7}).Times(1).Return(dto.Transfer{ID: 7,
+		Direction: transfer.Outbound, Counterpart: "store-2", State: transfer.Discrepancy, Notified: true,
+		Items: []dto.TransferItem{{Article: "test-1", Amount: 3, Received: 1},
+			{Article: "test-2", Amount: 1, Received: 2}}}, nil)
+	mockRepo.EXPECT().ReadStockAmount(ctx, &dto.Article{Article: "test-1"}).Times(1).Return(uint(4), nil)
+	mockRepo.EXPECT().UpdateStockAmount(ctx, &dto.ArticleAmount{Article: "test-1", Amount: 6}).Times(1).Return(nil)
+	mockRepo.EXPECT().ReadStockAmount(ctx, &dto.Article{Article: "test-2"}).Times(0)
+	mockRepo.EXPECT().UpdateTransfer(ctx, gomock.Any()).Times(1).Return(nil)
+
+	result, err := s.ResolveTransfer(ctx, dto.TransferResolution{ID: 7, Restock: true})
+	if err != nil || result.State != transfer.Resolved {
+		t.Fatal(result, err)
+	}
+}
+
+func TestService_ResolveTransferWithoutDiscrepancy(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+
+	mockRepo := mockrepository.NewMockInterface(ctrl)
+	s := Service{Repository: mockRepo, Instance: "store-1"}
+	ctx := context.WithValue(context.Background(), mockrepository.ExecuteKey{}, "✅")
+
+	mockRepo.EXPECT().ReadTransfer(ctx, &dto.TransferID{ID: 7}).Times(1).Return(dto.Transfer{ID: 7,
+		Direction: transfer.Outbound, State: transfer.Received}, nil)
+	mockRepo.EXPECT().UpdateTransfer(ctx, gomock.Any()).Times(0)
+
+	_, err := s.ResolveTransfer(ctx, dto.TransferResolution{ID: 7})
+	if !errors.Is(err, service.ErrNoTransferDiscrepancy) {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,255 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"github.com/lazylex/watch-store-store/internal/domain/aggregates/stocktake"
+	"github.com/lazylex/watch-store-store/internal/domain/event"
+	"github.com/lazylex/watch-store-store/internal/domain/value_objects/article"
+	"github.com/lazylex/watch-store-store/internal/domain/value_objects/location"
+	"github.com/lazylex/watch-store-store/internal/dto"
+	"github.com/lazylex/watch-store-store/internal/logger"
+	"github.com/lazylex/watch-store-store/internal/ports/service"
+	"log/slog"
+	"time"
+)
+
+// StartStocktake начинает инвентаризацию переданных товаров (или всего ассортимента, если список товаров пуст).
+// Количество товаров в магазине по учёту на момент начала инвентаризации запоминается, чтобы при расчёте расхождений
+// учесть изменения остатков, прошедшие во время подсчёта. Зарезервированный товар, ещё находящийся в магазине,
+// подсчитывается вместе с доступным к продаже, поэтому входит в учётное количество.
+func (s *Service) StartStocktake(ctx context.Context, data dto.StocktakeArticles) (stocktake.ID, error) {
+	var id stocktake.ID
+	var record dto.Stocktake
+
+	if err := data.Validate(); err != nil {
+		return 0, err
+	}
+
+	err := s.Repository.WithinTransaction(ctx, func(txCtx context.Context) error {
+		var err error
+		var amounts []dto.ArticleAmount
+
+		if len(data.Articles) == 0 {
+			if amounts, err = s.Repository.ReadStockAmounts(txCtx); err != nil {
+				return err
+			}
+		} else {
+			for _, a := range data.Articles {
+				var amount uint
+				if amount, err = s.Repository.ReadStockAmount(txCtx, &dto.Article{Article: a}); err != nil {
+					return err
+				}
+				amounts = append(amounts, dto.ArticleAmount{Article: a, Amount: amount})
+			}
+		}
+
+		var reserved map[article.Article]uint
+		if reserved, err = s.reservedInStore(txCtx); err != nil {
+			return err
+		}
+
+		record = dto.Stocktake{State: stocktake.InProgress, StartedAt: time.Now()}
+		for _, a := range amounts {
+			record.Items = append(record.Items, dto.StocktakeItem{
+				Article:      a.Article,
+				SystemAmount: a.Amount + reserved[a.Article],
+				Reserved:     reserved[a.Article],
+			})
+		}
+
+		if id, err = s.Repository.CreateStocktake(txCtx, &record); err != nil {
+			return err
+		}
+		record.ID = id
+
+		logger.LogWithCtxData(txCtx, slog.With(logger.OPLabel, "service.StartStocktake")).Info(
+			fmt.Sprintf("stocktake %d started for %d articles", id, len(record.Items)))
+
+		return nil
+	})
+
+	if err != nil {
+		return 0, err
+	}
+
+	s.emit(ctx, event.StocktakeStarted, numberKey(id), record)
+	return id, nil
+}
+
+// CountStocktake добавляет к инвентаризации очередную порцию подсчитанного товара. Подсчитывать можно только товары,
+// включённые в инвентаризацию при её начале.
+func (s *Service) CountStocktake(ctx context.Context, data dto.StocktakeCounts) error {
+	if err := data.Validate(); err != nil {
+		return err
+	}
+
+	err := s.Repository.WithinTransaction(ctx, func(txCtx context.Context) error {
+		current, err := s.Repository.ReadStocktake(txCtx, &dto.StocktakeID{ID: data.ID})
+		if err != nil {
+			return err
+		}
+
+		if current.State != stocktake.InProgress {
+			return service.ErrStocktakeApplied
+		}
+
+		included := make(map[article.Article]struct{}, len(current.Items))
+		for _, item := range current.Items {
+			included[item.Article] = struct{}{}
+		}
+		for _, c := range data.Counts {
+			if _, ok := included[c.Article]; !ok {
+				return service.ErrArticleNotInStocktake
+			}
+		}
+
+		if err = s.Repository.UpdateStocktakeCounts(txCtx, &data); err != nil {
+			return err
+		}
+
+		logger.LogWithCtxData(txCtx, slog.With(logger.OPLabel, "service.CountStocktake")).Info(
+			fmt.Sprintf("added counts of %d articles to stocktake %d", len(data.Counts), data.ID))
+
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	s.emit(ctx, event.StocktakeCounted, numberKey(data.ID), data)
+	return nil
+}
+
+// StocktakeDiscrepancies возвращает расхождения подсчитанного количества товаров с учётным. Для незавершённой
+// инвентаризации расхождения рассчитываются на текущий момент, для применённой - возвращается сохранённый отчёт.
+func (s *Service) StocktakeDiscrepancies(ctx context.Context, data dto.StocktakeID) (dto.Stocktake, error) {
+	if err := data.Validate(); err != nil {
+		return dto.Stocktake{}, err
+	}
+
+	result, err := s.Repository.ReadStocktake(ctx, &data)
+	if err != nil {
+		return dto.Stocktake{}, err
+	}
+
+	if result.State == stocktake.InProgress {
+		if err = s.calculateDiscrepancies(ctx, &result); err != nil {
+			return dto.Stocktake{}, err
+		}
+	}
+
+	logger.LogWithCtxData(ctx, slog.With(logger.OPLabel, "service.StocktakeDiscrepancies")).Info(
+		fmt.Sprintf("requested discrepancies of stocktake %d", data.ID))
+
+	return result, nil
+}
+
+// ApplyStocktake завершает инвентаризацию. Количество подсчитанных товаров в учёте корректируется на величину
+// расхождения (а не заменяется подсчитанным), поэтому изменения остатков во время подсчёта не теряются. Отчёт о
+// расхождениях сохраняется и возвращается. Если найдено расхождение по товару, учитываемому по серийным номерам,
+// инвентаризация не завершается и возвращается service.ErrSerialTracked.
+func (s *Service) ApplyStocktake(ctx context.Context, data dto.StocktakeID) (dto.Stocktake, error) {
+	var result dto.Stocktake
+
+	if err := data.Validate(); err != nil {
+		return dto.Stocktake{}, err
+	}
+
+	err := s.Repository.WithinTransaction(ctx, func(txCtx context.Context) error {
+		var err error
+		var amount uint
+
+		if result, err = s.Repository.ReadStocktake(txCtx, &data); err != nil {
+			return err
+		}
+
+		if result.State != stocktake.InProgress {
+			return service.ErrStocktakeApplied
+		}
+
+		result.AppliedAt = time.Now()
+		if err = s.calculateDiscrepancies(txCtx, &result); err != nil {
+			return err
+		}
+
+		for _, item := range result.Items {
+			if item.Difference == 0 {
+				continue
+			}
+			if err = s.checkNotSerialTracked(txCtx, item.Article); err != nil {
+				return err
+			}
+
+			if amount, err = s.Repository.ReadStockAmount(txCtx, &dto.Article{Article: item.Article}); err != nil {
+				return err
+			}
+			newAmount := uint(max(int(amount)+item.Difference, 0))
+			if err = s.Repository.UpdateStockAmount(txCtx, &dto.ArticleAmount{
+				Article: item.Article,
+				Amount:  newAmount,
+			}); err != nil {
+				return err
+			}
+			if item.Difference < 0 {
+				if err = s.withdrawFromLocations(txCtx, item.Article, newAmount, 0, location.Default); err != nil {
+					return err
+				}
+			}
+		}
+
+		result.State = stocktake.Applied
+		if err = s.Repository.UpdateStocktake(txCtx, &result); err != nil {
+			return err
+		}
+
+		logger.LogWithCtxData(txCtx, slog.With(logger.OPLabel, "service.ApplyStocktake")).Info(
+			fmt.Sprintf("stocktake %d applied", data.ID))
+
+		return nil
+	})
+
+	if err != nil {
+		return dto.Stocktake{}, err
+	}
+
+	s.emit(ctx, event.StocktakeApplied, numberKey(data.ID), result)
+	return result, nil
+}
+
+// calculateDiscrepancies рассчитывает расхождения по товарам инвентаризации. Изменение количества товара в магазине
+// во время подсчёта определяется как разница текущего количества (доступного к продаже вместе с зарезервированным под
+// заказы, товар которых ещё находится в магазине) и количества на момент начала инвентаризации, поэтому учитываются
+// все изменения остатков, а не только продажи и возвраты.
+func (s *Service) calculateDiscrepancies(ctx context.Context, data *dto.Stocktake) error {
+	reserved, err := s.reservedInStore(ctx)
+	if err != nil {
+		return err
+	}
+
+	for i := range data.Items {
+		var amount uint
+		if amount, err = s.Repository.ReadStockAmount(ctx, &dto.Article{Article: data.Items[i].Article}); err != nil {
+			return err
+		}
+		data.Items[i].Movement = int(amount+reserved[data.Items[i].Article]) - int(data.Items[i].SystemAmount)
+		data.Items[i].CalculateDiscrepancy()
+	}
+
+	return nil
+}
+
+// reservedInStore возвращает количество товаров, зарезервированных под заказы, товар которых ещё находится в магазине.
+func (s *Service) reservedInStore(ctx context.Context) (map[article.Article]uint, error) {
+	amounts, err := s.Repository.ReadReservedInStoreAmounts(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make(map[article.Article]uint, len(amounts))
+	for _, a := range amounts {
+		result[a.Article] = a.Amount
+	}
+
+	return result, nil
+}
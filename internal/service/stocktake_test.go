@@ -0,0 +1,245 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"github.com/golang/mock/gomock"
+	"github.com/lazylex/watch-store-store/internal/domain/aggregates/stocktake"
+	"github.com/lazylex/watch-store-store/internal/domain/value_objects/article"
+	"github.com/lazylex/watch-store-store/internal/dto"
+	"github.com/lazylex/watch-store-store/internal/ports/repository"
+	mockrepository "github.com/lazylex/watch-store-store/internal/ports/repository/mocks"
+	"github.com/lazylex/watch-store-store/internal/ports/service"
+	"testing"
+	"time"
+)
+
+func TestService_StartStocktakeAllArticles(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+
+	mockRepo := mockrepository.NewMockInterface(ctrl)
+	s := Service{Repository: mockRepo}
+	ctx := context.WithValue(context.Background(), mockrepository.ExecuteKey{}, "✅")
+
+	mockRepo.EXPECT().ReadStockAmounts(ctx).Times(1).Return([]dto.ArticleAmount{
+		{Article: "test-1", Amount: 3},
+		{Article: "test-2", Amount: 0},
+	}, nil)
+	mockRepo.EXPECT().ReadReservedInStoreAmounts(ctx).Times(1).Return([]dto.ArticleAmount{
+		{Article: "test-1", Amount: 2},
+	}, nil)
+	mockRepo.EXPECT().CreateStocktake(ctx, gomock.Any()).Times(1).DoAndReturn(
+		func(_ context.Context, st *dto.Stocktake) (stocktake.ID, error) {
+			if st.State != stocktake.InProgress || st.StartedAt.IsZero() || len(st.Items) != 2 ||
+				st.Items[0].SystemAmount != 5 || st.Items[0].Reserved != 2 || st.Items[1].SystemAmount != 0 {
+				t.Fail()
+			}
+			return stocktake.ID(7), nil
+		})
+
+	id, err := s.StartStocktake(ctx, dto.StocktakeArticles{})
+	if err != nil || id != 7 {
+		t.Fail()
+	}
+}
+
+func TestService_StartStocktakeUnknownArticle(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+
+	mockRepo := mockrepository.NewMockInterface(ctrl)
+	s := Service{Repository: mockRepo}
+	ctx := context.WithValue(context.Background(), mockrepository.ExecuteKey{}, "✅")
+
+	mockRepo.EXPECT().ReadStockAmount(ctx, &dto.Article{Article: "test-1"}).Times(1).Return(uint(0),
+		repository.ErrNoRecord)
+	mockRepo.EXPECT().CreateStocktake(gomock.Any(), gomock.Any()).Times(0)
+
+	_, err := s.StartStocktake(ctx, dto.StocktakeArticles{Articles: []article.Article{"test-1"}})
+	if !errors.Is(err, repository.ErrNoRecord) {
+		t.Fail()
+	}
+}
+
+func TestService_CountStocktakeArticleNotIncluded(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+
+	mockRepo := mockrepository.NewMockInterface(ctrl)
+	s := Service{Repository: mockRepo}
+	ctx := context.WithValue(context.Background(), mockrepository.ExecuteKey{}, "✅")
+	data := dto.StocktakeCounts{ID: 7, Counts: []dto.ArticleAmount{{Article: "test-2", Amount: 1}}}
+
+	mockRepo.EXPECT().ReadStocktake(ctx, &dto.StocktakeID{ID: 7}).Times(1).Return(dto.Stocktake{ID: 7,
+		State: stocktake.InProgress, Items: []dto.StocktakeItem{{Article: "test-1", SystemAmount: 3}}}, nil)
+	mockRepo.EXPECT().UpdateStocktakeCounts(gomock.Any(), gomock.Any()).Times(0)
+
+	err := s.CountStocktake(ctx, data)
+	if !errors.Is(err, service.ErrArticleNotInStocktake) {
+		t.Fail()
+	}
+}
+
+func TestService_CountStocktakeApplied(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+
+	mockRepo := mockrepository.NewMockInterface(ctrl)
+	s := Service{Repository: mockRepo}
+	ctx := context.WithValue(context.Background(), mockrepository.ExecuteKey{}, "✅")
+	data := dto.StocktakeCounts{ID: 7, Counts: []dto.ArticleAmount{{Article: "test-1", Amount: 1}}}
+
+	mockRepo.EXPECT().ReadStocktake(ctx, &dto.StocktakeID{ID: 7}).Times(1).Return(dto.Stocktake{ID: 7,
+		State: stocktake.Applied, Items: []dto.StocktakeItem{{Article: "test-1", SystemAmount: 3}}}, nil)
+
+	err := s.CountStocktake(ctx, data)
+	if !errors.Is(err, service.ErrStocktakeApplied) {
+		t.Fail()
+	}
+}
+
+func TestService_CountStocktakeSuccess(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+
+	mockRepo := mockrepository.NewMockInterface(ctrl)
+	s := Service{Repository: mockRepo}
+	ctx := context.WithValue(context.Background(), mockrepository.ExecuteKey{}, "✅")
+	data := dto.StocktakeCounts{ID: 7, Counts: []dto.ArticleAmount{{Article: "test-1", Amount: 2}}}
+
+	mockRepo.EXPECT().ReadStocktake(ctx, &dto.StocktakeID{ID: 7}).Times(1).Return(dto.Stocktake{ID: 7,
+		State: stocktake.InProgress, Items: []dto.StocktakeItem{{Article: "test-1", SystemAmount: 3}}}, nil)
+	mockRepo.EXPECT().UpdateStocktakeCounts(ctx, &data).Times(1).Return(nil)
+
+	if err := s.CountStocktake(ctx, data); err != nil {
+		t.Fail()
+	}
+}
+
+func TestService_ApplyStocktakeSuccess(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+
+	mockRepo := mockrepository.NewMockInterface(ctrl)
+	s := Service{Repository: mockRepo}
+	ctx := context.WithValue(context.Background(), mockrepository.ExecuteKey{}, "✅")
+	expectNoSerials(mockRepo, ctx)
+	data := dto.StocktakeID{ID: 7}
+
+	mockRepo.EXPECT().ReadStocktake(ctx, &data).Times(1).Return(dto.Stocktake{
+		ID:        7,
+		State:     stocktake.InProgress,
+		StartedAt: time.Now().Add(-time.Hour),
+		Items: []dto.StocktakeItem{
+			{Article: "test-1", SystemAmount: 10, Counted: 7, IsCounted: true},
+			{Article: "test-2", SystemAmount: 4},
+		},
+	}, nil)
+	// во время подсчёта продано 2 товара test-1, ожидается 8, подсчитано 7 - недостача одного товара
+	mockRepo.EXPECT().ReadReservedInStoreAmounts(ctx).Times(1).Return(nil, nil)
+	mockRepo.EXPECT().ReadStockAmount(ctx, &dto.Article{Article: "test-1"}).Times(2).Return(uint(8), nil)
+	mockRepo.EXPECT().ReadStockAmount(ctx, &dto.Article{Article: "test-2"}).Times(1).Return(uint(4), nil)
+	mockRepo.EXPECT().UpdateStockAmount(ctx, &dto.ArticleAmount{Article: "test-1", Amount: 7}).Times(1).Return(nil)
+	mockRepo.EXPECT().ReadStockLocations(ctx, &dto.Article{Article: "test-1"}).Times(1).Return(nil, nil)
+	mockRepo.EXPECT().UpdateStocktake(ctx, gomock.Any()).Times(1).Return(nil)
+
+	result, err := s.ApplyStocktake(ctx, data)
+	if err != nil || result.State != stocktake.Applied || result.Items[0].Difference != -1 ||
+		result.Items[0].Movement != -2 || result.Items[1].Difference != 0 {
+		t.Fail()
+	}
+}
+
+func TestService_ApplyStocktakeOpenReservation(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+
+	mockRepo := mockrepository.NewMockInterface(ctrl)
+	s := Service{Repository: mockRepo}
+	ctx := context.WithValue(context.Background(), mockrepository.ExecuteKey{}, "✅")
+	data := dto.StocktakeID{ID: 7}
+
+	// 3 товара из 10 зарезервированы, но лежат на полке и подсчитаны вместе с остальными - расхождения нет
+	mockRepo.EXPECT().ReadStocktake(ctx, &data).Times(1).Return(dto.Stocktake{
+		ID:        7,
+		State:     stocktake.InProgress,
+		StartedAt: time.Now().Add(-time.Hour),
+		Items: []dto.StocktakeItem{
+			{Article: "test-1", SystemAmount: 10, Reserved: 3, Counted: 10, IsCounted: true},
+		},
+	}, nil)
+	mockRepo.EXPECT().ReadReservedInStoreAmounts(ctx).Times(1).Return([]dto.ArticleAmount{
+		{Article: "test-1", Amount: 3},
+	}, nil)
+	mockRepo.EXPECT().ReadStockAmount(ctx, &dto.Article{Article: "test-1"}).Times(1).Return(uint(7), nil)
+	mockRepo.EXPECT().UpdateStockAmount(gomock.Any(), gomock.Any()).Times(0)
+	mockRepo.EXPECT().UpdateStocktake(ctx, gomock.Any()).Times(1).Return(nil)
+
+	result, err := s.ApplyStocktake(ctx, data)
+	if err != nil || result.Items[0].Expected != 10 || result.Items[0].Difference != 0 {
+		t.Fail()
+	}
+}
+
+func TestService_StocktakeDiscrepanciesGoodsReceived(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+
+	mockRepo := mockrepository.NewMockInterface(ctrl)
+	s := Service{Repository: mockRepo}
+	data := dto.StocktakeID{ID: 7}
+
+	// во время подсчёта принята поставка 5 товаров test-1, подсчитано 9 - расхождения нет
+	mockRepo.EXPECT().ReadStocktake(context.Background(), &data).Times(1).Return(dto.Stocktake{
+		ID:        7,
+		State:     stocktake.InProgress,
+		StartedAt: time.Now().Add(-time.Hour),
+		Items:     []dto.StocktakeItem{{Article: "test-1", SystemAmount: 4, Counted: 9, IsCounted: true}},
+	}, nil)
+	mockRepo.EXPECT().ReadReservedInStoreAmounts(context.Background()).Times(1).Return(nil, nil)
+	mockRepo.EXPECT().ReadStockAmount(context.Background(), &dto.Article{Article: "test-1"}).Times(1).Return(
+		uint(9), nil)
+
+	result, err := s.StocktakeDiscrepancies(context.Background(), data)
+	if err != nil || result.Items[0].Movement != 5 || result.Items[0].Expected != 9 ||
+		result.Items[0].Difference != 0 {
+		t.Fail()
+	}
+}
+
+func TestService_ApplyStocktakeAlreadyApplied(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+
+	mockRepo := mockrepository.NewMockInterface(ctrl)
+	s := Service{Repository: mockRepo}
+	ctx := context.WithValue(context.Background(), mockrepository.ExecuteKey{}, "✅")
+	data := dto.StocktakeID{ID: 7}
+
+	mockRepo.EXPECT().ReadStocktake(ctx, &data).Times(1).Return(dto.Stocktake{ID: 7, State: stocktake.Applied}, nil)
+	mockRepo.EXPECT().UpdateStocktake(gomock.Any(), gomock.Any()).Times(0)
+
+	_, err := s.ApplyStocktake(ctx, data)
+	if !errors.Is(err, service.ErrStocktakeApplied) {
+		t.Fail()
+	}
+}
+
+func TestService_StocktakeDiscrepanciesApplied(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+
+	mockRepo := mockrepository.NewMockInterface(ctrl)
+	s := Service{Repository: mockRepo}
+	data := dto.StocktakeID{ID: 7}
+
+	mockRepo.EXPECT().ReadStocktake(context.Background(), &data).Times(1).Return(dto.Stocktake{ID: 7,
+		State: stocktake.Applied, Items: []dto.StocktakeItem{{Article: "test-1", Difference: -1}}}, nil)
+	mockRepo.EXPECT().ReadStockAmount(gomock.Any(), gomock.Any()).Times(0)
+
+	result, err := s.StocktakeDiscrepancies(context.Background(), data)
+	if err != nil || result.Items[0].Difference != -1 {
+		t.Fail()
+	}
+}
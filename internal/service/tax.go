@@ -0,0 +1,119 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"github.com/lazylex/watch-store-store/internal/domain/event"
+	"github.com/lazylex/watch-store-store/internal/domain/value_objects/article"
+	"github.com/lazylex/watch-store-store/internal/domain/value_objects/tax"
+	"github.com/lazylex/watch-store-store/internal/dto"
+	"github.com/lazylex/watch-store-store/internal/logger"
+	"github.com/lazylex/watch-store-store/internal/ports/service"
+	"log/slog"
+	"time"
+)
+
+// SetTaxRate сохраняет ставку НДС налоговой категории товаров, действующую с момента data.EffectiveFrom до начала
+// действия следующей ставки категории. Ставка, действующая с того же момента, заменяется. Ставки, действующие с
+// прошедшего момента, не пересчитывают налог уже проданных товаров.
+func (s *Service) SetTaxRate(ctx context.Context, data dto.TaxRate) error {
+	if err := data.Validate(); err != nil {
+		return err
+	}
+
+	if err := s.Repository.UpsertTaxRate(ctx, &data); err != nil {
+		return err
+	}
+
+	logger.LogWithCtxData(ctx, slog.With(logger.OPLabel, "service.SetTaxRate")).Info(
+		fmt.Sprintf("tax rate of category %s set to %.2f%% from %s", data.Category, data.Rate,
+			data.EffectiveFrom.Format(time.RFC3339)))
+	s.emit(ctx, event.TaxRateSet, string(data.Category), data)
+
+	return nil
+}
+
+// TaxRates возвращает все ставки НДС, упорядоченные по налоговой категории и дате начала действия.
+func (s *Service) TaxRates(ctx context.Context) ([]dto.TaxRate, error) {
+	return s.Repository.ReadTaxRates(ctx)
+}
+
+// SetArticleTaxCategory сохраняет налоговую категорию товара, находящегося в продаже. Для категории, отличной от
+// tax.Default, должна быть сохранена хотя бы одна ставка, иначе возвращается service.ErrUnknownTaxCategory.
+func (s *Service) SetArticleTaxCategory(ctx context.Context, data dto.ArticleTaxCategory) error {
+	if err := data.Validate(); err != nil {
+		return err
+	}
+	if _, err := s.Stock(ctx, dto.Article{Article: data.Article}); err != nil {
+		return err
+	}
+
+	if data.Category != tax.Default {
+		if err := s.checkTaxCategory(ctx, data.Category); err != nil {
+			return err
+		}
+	}
+
+	if err := s.Repository.UpsertArticleTaxCategory(ctx, &data); err != nil {
+		return err
+	}
+
+	logger.LogWithCtxData(ctx, slog.With(logger.OPLabel, "service.SetArticleTaxCategory")).Info(
+		fmt.Sprintf("tax category of article %s set to %s", data.Article, data.Category))
+	s.emit(ctx, event.ArticleTaxCategoryChanged, string(data.Article), data)
+
+	return nil
+}
+
+// ArticleTaxRate возвращает налоговую категорию товара, находящегося в продаже, и действующую в текущий момент ставку
+// НДС этой категории.
+func (s *Service) ArticleTaxRate(ctx context.Context, data dto.Article) (dto.ArticleTaxRate, error) {
+	if err := data.Validate(); err != nil {
+		return dto.ArticleTaxRate{}, err
+	}
+	if _, err := s.Stock(ctx, data); err != nil {
+		return dto.ArticleTaxRate{}, err
+	}
+
+	rates, err := s.Repository.ReadArticlesTaxRates(ctx, []article.Article{data.Article}, time.Now())
+	if err != nil {
+		return dto.ArticleTaxRate{}, err
+	}
+	if len(rates) == 0 {
+		return dto.ArticleTaxRate{Article: data.Article, Category: tax.Default}, nil
+	}
+
+	return rates[0], nil
+}
+
+// checkTaxCategory возвращает service.ErrUnknownTaxCategory, если для налоговой категории не сохранено ни одной ставки.
+func (s *Service) checkTaxCategory(ctx context.Context, category tax.Category) error {
+	rates, err := s.Repository.ReadTaxRates(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, rate := range rates {
+		if rate.Category == category {
+			return nil
+		}
+	}
+
+	return service.ErrUnknownTaxCategory
+}
+
+// applyTaxes рассчитывает включённый в стоимость строк чека НДС по ставкам, действующим на дату продажи.
+func (s *Service) applyTaxes(ctx context.Context, data *dto.Receipt) error {
+	articles := make([]article.Article, len(data.Products))
+	for i, p := range data.Products {
+		articles[i] = p.Article
+	}
+
+	rates, err := s.Repository.ReadArticlesTaxRates(ctx, articles, data.Date)
+	if err != nil {
+		return err
+	}
+	data.ApplyTaxes(rates)
+
+	return nil
+}
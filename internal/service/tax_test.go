@@ -0,0 +1,144 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"github.com/golang/mock/gomock"
+	"github.com/lazylex/watch-store-store/internal/domain/aggregates/receipt"
+	"github.com/lazylex/watch-store-store/internal/domain/value_objects/article"
+	"github.com/lazylex/watch-store-store/internal/domain/value_objects/payment"
+	"github.com/lazylex/watch-store-store/internal/domain/value_objects/tax"
+	"github.com/lazylex/watch-store-store/internal/dto"
+	"github.com/lazylex/watch-store-store/internal/ports/repository"
+	mockrepository "github.com/lazylex/watch-store-store/internal/ports/repository/mocks"
+	"github.com/lazylex/watch-store-store/internal/ports/service"
+	"testing"
+	"time"
+)
+
+// expectNoTaxRates добавляет ожидание чтения ставок НДС товаров чека, для которых налоговые категории и ставки не
+// заданы.
+func expectNoTaxRates(mockRepo *mockrepository.MockInterface, ctx context.Context) {
+	mockRepo.EXPECT().ReadArticlesTaxRates(ctx, gomock.Any(), gomock.Any()).Times(1).Return(nil, nil)
+}
+
+func TestService_SetTaxRate(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+
+	mockRepo := mockrepository.NewMockInterface(ctrl)
+	s := Service{Repository: mockRepo}
+	data := dto.TaxRate{Category: "reduced", Rate: 10, EffectiveFrom: time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)}
+
+	mockRepo.EXPECT().UpsertTaxRate(gomock.Any(), &data).Times(1).Return(nil)
+
+	if err := s.SetTaxRate(context.Background(), data); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestService_SetTaxRateErrDTO(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+
+	mockRepo := mockrepository.NewMockInterface(ctrl)
+	s := Service{Repository: mockRepo}
+
+	mockRepo.EXPECT().UpsertTaxRate(gomock.Any(), gomock.Any()).Times(0)
+
+	if err := s.SetTaxRate(context.Background(), dto.TaxRate{Category: "reduced", Rate: 10}); err == nil {
+		t.Fail()
+	}
+}
+
+func TestService_SetArticleTaxCategory(t *testing.T) {
+	t.Parallel()
+	rates := []dto.TaxRate{{Category: "standard", Rate: 20, EffectiveFrom: time.Now().AddDate(-1, 0, 0)}}
+
+	tests := []struct {
+		testName string
+		category tax.Category
+		err      error
+	}{
+		{"category with rates", "standard", nil},
+		{"default category", tax.Default, nil},
+		{"category without rates", "reduced", service.ErrUnknownTaxCategory},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.testName, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockRepo := mockrepository.NewMockInterface(ctrl)
+			s := Service{Repository: mockRepo}
+			ctx := context.Background()
+			data := dto.ArticleTaxCategory{Article: "test-9", Category: tt.category}
+
+			mockRepo.EXPECT().ReadStock(ctx, &dto.Article{Article: "test-9"}).Times(1).Return(
+				dto.ArticlePriceNameAmount{Article: "test-9"}, nil)
+			if tt.category != tax.Default {
+				mockRepo.EXPECT().ReadTaxRates(ctx).Times(1).Return(rates, nil)
+			}
+			if tt.err == nil {
+				mockRepo.EXPECT().UpsertArticleTaxCategory(ctx, &data).Times(1).Return(nil)
+			}
+
+			if err := s.SetArticleTaxCategory(ctx, data); !errors.Is(err, tt.err) {
+				t.Errorf("expected %v, got %v", tt.err, err)
+			}
+		})
+	}
+}
+
+func TestService_ArticleTaxRateNotInStock(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+
+	mockRepo := mockrepository.NewMockInterface(ctrl)
+	s := Service{Repository: mockRepo}
+
+	mockRepo.EXPECT().ReadStock(gomock.Any(), &dto.Article{Article: "test-9"}).Times(1).Return(
+		dto.ArticlePriceNameAmount{}, repository.ErrNoRecord)
+	mockRepo.EXPECT().ReadArticlesTaxRates(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+	if _, err := s.ArticleTaxRate(context.Background(), dto.Article{Article: "test-9"}); !errors.Is(err,
+		repository.ErrNoRecord) {
+		t.Fail()
+	}
+}
+
+func TestService_MakeSaleSavesTaxes(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+
+	mockRepo := mockrepository.NewMockInterface(ctrl)
+	data := dto.CashRegisterProducts{CashRegister: 1, PaymentMethod: payment.Card,
+		Products: []dto.ArticlePriceAmount{{Article: "test-9", Price: 1200, Amount: 2},
+			{Article: "test-10", Price: 550, Amount: 1}}}
+	s := Service{Repository: mockRepo}
+	ctx := context.WithValue(context.Background(), mockrepository.ExecuteKey{}, "✅")
+	expectNoSerials(mockRepo, ctx)
+	expectNoWarranties(mockRepo, ctx)
+
+	expectCashRegister(mockRepo, ctx, 1)
+	mockRepo.EXPECT().ReadOpenShift(ctx, &dto.CashRegister{CashRegister: 1}).Times(1).Return(
+		dto.Shift{ID: 7, CashRegister: 1}, nil)
+	for _, a := range []article.Article{"test-9", "test-10"} {
+		mockRepo.EXPECT().ReadStockAmount(ctx, &dto.Article{Article: a}).Times(1).Return(uint(5), nil)
+		mockRepo.EXPECT().UpdateStockAmount(ctx, gomock.Any()).Times(1).Return(nil)
+		mockRepo.EXPECT().ReadStockLocations(ctx, &dto.Article{Article: a}).Times(1).Return(nil, nil)
+	}
+	mockRepo.EXPECT().ReadArticlesTaxRates(ctx, []article.Article{"test-9", "test-10"}, gomock.Any()).Times(1).Return(
+		[]dto.ArticleTaxRate{{Article: "test-9", Category: "standard", Rate: 20}}, nil)
+	mockRepo.EXPECT().CreateReceipt(ctx, gomock.Any()).Times(1).DoAndReturn(
+		func(_ context.Context, r *dto.Receipt) (receipt.ID, error) {
+			if r.Tax != 400 || len(r.Taxes) != 2 || r.Taxes[0].Net != 2000 || r.Taxes[1].Category != tax.Default ||
+				r.Taxes[1].Net != 550 {
+				t.Errorf("unexpected taxes %+v", r.Taxes)
+			}
+			return receipt.ID(1), nil
+		})
+
+	if _, err := s.MakeSale(ctx, data); err != nil {
+		t.Fatal(err)
+	}
+}
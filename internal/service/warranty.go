@@ -0,0 +1,376 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/lazylex/watch-store-store/internal/domain/aggregates/receipt"
+	"github.com/lazylex/watch-store-store/internal/domain/aggregates/warranty"
+	"github.com/lazylex/watch-store-store/internal/domain/event"
+	"github.com/lazylex/watch-store-store/internal/domain/value_objects/article"
+	"github.com/lazylex/watch-store-store/internal/domain/value_objects/phone"
+	"github.com/lazylex/watch-store-store/internal/domain/value_objects/serial"
+	"github.com/lazylex/watch-store-store/internal/dto"
+	"github.com/lazylex/watch-store-store/internal/logger"
+	"github.com/lazylex/watch-store-store/internal/ports/repository"
+	"github.com/lazylex/watch-store-store/internal/ports/service"
+	"log/slog"
+	"time"
+)
+
+// SetWarrantyTerm сохраняет гарантийный срок товара, находящегося в продаже. При нулевом сроке гарантия на товар
+// больше не выдаётся. Изменение срока не затрагивает уже выданные гарантии.
+func (s *Service) SetWarrantyTerm(ctx context.Context, data dto.WarrantyTerm) error {
+	if err := data.Validate(); err != nil {
+		return err
+	}
+	if _, err := s.Stock(ctx, dto.Article{Article: data.Article}); err != nil {
+		return err
+	}
+
+	var err error
+	if data.Months == 0 {
+		err = s.Repository.DeleteWarrantyTerm(ctx, &dto.Article{Article: data.Article})
+	} else {
+		err = s.Repository.UpsertWarrantyTerm(ctx, &data)
+	}
+	if err != nil {
+		return err
+	}
+
+	logger.LogWithCtxData(ctx, slog.With(logger.OPLabel, "service.SetWarrantyTerm")).Info(
+		fmt.Sprintf("warranty term of article %s set to %d months", data.Article, data.Months))
+	s.emit(ctx, event.WarrantyTermChanged, string(data.Article), data)
+
+	return nil
+}
+
+// WarrantyTerm возвращает гарантийный срок товара, находящегося в продаже. Если срок не сохранён, он равен нулю.
+func (s *Service) WarrantyTerm(ctx context.Context, data dto.Article) (dto.WarrantyTerm, error) {
+	if err := data.Validate(); err != nil {
+		return dto.WarrantyTerm{}, err
+	}
+	if _, err := s.Stock(ctx, data); err != nil {
+		return dto.WarrantyTerm{}, err
+	}
+
+	terms, err := s.Repository.ReadWarrantyTerms(ctx, []article.Article{data.Article})
+	if err != nil {
+		return dto.WarrantyTerm{}, err
+	}
+	if len(terms) == 0 {
+		return dto.WarrantyTerm{Article: data.Article}, nil
+	}
+
+	return terms[0], nil
+}
+
+// WarrantiesByReceipt возвращает гарантии, выданные по чеку.
+func (s *Service) WarrantiesByReceipt(ctx context.Context, data dto.ReceiptID) ([]dto.Warranty, error) {
+	if err := data.Validate(); err != nil {
+		return nil, err
+	}
+
+	result, err := s.Repository.ReadWarrantiesByReceipt(ctx, &data)
+	if err != nil {
+		return nil, err
+	}
+
+	logger.LogWithCtxData(ctx, slog.With(logger.OPLabel, "service.WarrantiesByReceipt")).Info(
+		fmt.Sprintf("requested warranties of receipt %d, %d found", data.ID, len(result)))
+
+	return result, nil
+}
+
+// WarrantiesBySerial возвращает в хронологическом порядке гарантии на экземпляр товара с серийным номером. Если
+// экземпляр продавался повторно после возврата, гарантий несколько.
+func (s *Service) WarrantiesBySerial(ctx context.Context, data dto.Serial) ([]dto.Warranty, error) {
+	if err := data.Validate(); err != nil {
+		return nil, err
+	}
+
+	result, err := s.Repository.ReadWarrantiesBySerial(ctx, &data)
+	if err != nil {
+		return nil, err
+	}
+
+	logger.LogWithCtxData(ctx, slog.With(logger.OPLabel, "service.WarrantiesBySerial")).Info(
+		fmt.Sprintf("requested warranties of serial %s, %d found", data.Serial, len(result)))
+
+	return result, nil
+}
+
+// WarrantiesByPhone возвращает в хронологическом порядке гарантии, выданные покупателю с переданным номером телефона
+// (номер сравнивается в нормализованном виде).
+func (s *Service) WarrantiesByPhone(ctx context.Context, data dto.Phone) ([]dto.Warranty, error) {
+	if err := data.Validate(); err != nil {
+		return nil, err
+	}
+	data.Phone = data.Phone.Normalized()
+
+	result, err := s.Repository.ReadWarrantiesByPhone(ctx, &data)
+	if err != nil {
+		return nil, err
+	}
+
+	logger.LogWithCtxData(ctx, slog.With(logger.OPLabel, "service.WarrantiesByPhone")).Info(
+		fmt.Sprintf("requested warranties of phone %s, %d found", data.Phone.Redacted(), len(result)))
+
+	return result, nil
+}
+
+// RegisterWarrantyClaim регистрирует гарантийное обращение в состоянии warranty.Registered и возвращает присвоенный
+// ему идентификатор. Если гарантии нет, возвращается repository.ErrNoRecord, если она аннулирована из-за возврата
+// товара - service.ErrWarrantyVoid, если её срок истёк - service.ErrWarrantyExpired. Пока по гарантии есть незакрытое
+// обращение, новое не регистрируется (service.ErrWarrantyClaimOpen).
+func (s *Service) RegisterWarrantyClaim(ctx context.Context, data dto.WarrantyIDDescription) (warranty.ClaimID,
+	error) {
+	if err := data.Validate(); err != nil {
+		return 0, err
+	}
+	data.Normalize()
+
+	var id warranty.ClaimID
+	claim := dto.WarrantyClaim{WarrantyID: data.WarrantyID, Description: data.Description, Status: warranty.Registered,
+		CreatedAt: time.Now()}
+
+	err := s.Repository.WithinTransaction(ctx, func(txCtx context.Context) error {
+		number := dto.WarrantyID{ID: data.WarrantyID}
+		w, err := s.Repository.ReadWarranty(txCtx, &number)
+		if err != nil {
+			return err
+		}
+		if w.IsVoid() {
+			return service.ErrWarrantyVoid
+		}
+		if !w.IsActive(claim.CreatedAt) {
+			return service.ErrWarrantyExpired
+		}
+
+		var claims []dto.WarrantyClaim
+		if claims, err = s.Repository.ReadWarrantyClaims(txCtx, &number); err != nil {
+			return err
+		}
+		for _, c := range claims {
+			if !c.Status.IsFinal() {
+				return service.ErrWarrantyClaimOpen
+			}
+		}
+
+		if id, err = s.Repository.CreateWarrantyClaim(txCtx, &claim); err != nil {
+			return err
+		}
+
+		return s.Repository.CreateWarrantyClaimTransition(txCtx, &dto.WarrantyClaimTransition{ClaimID: id,
+			To: warranty.Registered, Actor: actor(txCtx), Date: claim.CreatedAt})
+	})
+	if err != nil {
+		return 0, err
+	}
+	claim.ID = id
+
+	logger.LogWithCtxData(ctx, slog.With(logger.OPLabel, "service.RegisterWarrantyClaim")).Info(
+		fmt.Sprintf("claim %d registered for warranty %d", id, data.WarrantyID))
+	s.emit(ctx, event.WarrantyClaimRegistered, numberKey(data.WarrantyID), claim)
+
+	return id, nil
+}
+
+// ChangeWarrantyClaimStatus переводит гарантийное обращение в новое состояние и сохраняет переход в истории. Если
+// переход недопустим, возвращается ошибка, соответствующая warranty.ErrIllegalTransition, а для закрытого обращения -
+// также service.ErrAlreadyProcessed.
+func (s *Service) ChangeWarrantyClaimStatus(ctx context.Context, data dto.ClaimIDStatus) error {
+	if err := data.Validate(); err != nil {
+		return err
+	}
+
+	var transition dto.WarrantyClaimTransition
+	var warrantyID warranty.ID
+
+	err := s.Repository.WithinTransaction(ctx, func(txCtx context.Context) error {
+		claim, err := s.Repository.ReadWarrantyClaim(txCtx, &dto.WarrantyClaimID{ID: data.ClaimID})
+		if err != nil {
+			return err
+		}
+		warrantyID = claim.WarrantyID
+
+		aggregate := warranty.Claim{ID: claim.ID, Status: claim.Status}
+		if err = aggregate.TransitTo(data.Status); err != nil {
+			if claim.Status.IsFinal() {
+				return fmt.Errorf("%w: %w", service.ErrAlreadyProcessed, err)
+			}
+			return err
+		}
+
+		if err = s.Repository.UpdateWarrantyClaimStatus(txCtx, &data); err != nil {
+			return err
+		}
+
+		transition = dto.WarrantyClaimTransition{ClaimID: claim.ID, From: claim.Status, To: data.Status,
+			Actor: actor(txCtx), Date: time.Now()}
+		return s.Repository.CreateWarrantyClaimTransition(txCtx, &transition)
+	})
+	if err != nil {
+		return err
+	}
+
+	logger.LogWithCtxData(ctx, slog.With(logger.OPLabel, "service.ChangeWarrantyClaimStatus")).Info(
+		fmt.Sprintf("claim %d moved from %s to %s", data.ClaimID, transition.From, transition.To))
+	s.emit(ctx, event.WarrantyClaimStatusChanged, numberKey(warrantyID), transition)
+
+	return nil
+}
+
+// WarrantyClaims возвращает в хронологическом порядке гарантийные обращения по гарантии вместе с историей смены их
+// состояний. Если гарантии нет, возвращается repository.ErrNoRecord.
+func (s *Service) WarrantyClaims(ctx context.Context, data dto.WarrantyID) ([]dto.WarrantyClaim, error) {
+	if err := data.Validate(); err != nil {
+		return nil, err
+	}
+
+	if _, err := s.Repository.ReadWarranty(ctx, &data); err != nil {
+		return nil, err
+	}
+
+	result, err := s.Repository.ReadWarrantyClaims(ctx, &data)
+	if err != nil {
+		return nil, err
+	}
+
+	transitions, err := s.Repository.ReadWarrantyClaimTransitions(ctx, &data)
+	if err != nil {
+		return nil, err
+	}
+
+	history := make(map[warranty.ClaimID][]dto.WarrantyClaimTransition, len(result))
+	for _, t := range transitions {
+		history[t.ClaimID] = append(history[t.ClaimID], t)
+	}
+	for i := range result {
+		result[i].History = history[result[i].ID]
+	}
+
+	logger.LogWithCtxData(ctx, slog.With(logger.OPLabel, "service.WarrantyClaims")).Info(
+		fmt.Sprintf("requested claims of warranty %d, %d found", data.ID, len(result)))
+
+	return result, nil
+}
+
+// issueWarranties выдаёт гарантии на проданные по чеку товары, для которых сохранён гарантийный срок. На каждый
+// проданный экземпляр товара из sold выдаётся отдельная гарантия с его серийным номером, на остальное количество
+// строки чека - одна гарантия. Телефон покупателя заказа берётся из сохранённых с заказом данных, для продажи на кассе
+// используется переданный customerPhone.
+func (s *Service) issueWarranties(ctx context.Context, check *dto.Receipt, sold []dto.SerialRecord,
+	customerPhone phone.Phone) error {
+	articles := make([]article.Article, len(check.Products))
+	for i, p := range check.Products {
+		articles[i] = p.Article
+	}
+
+	terms, err := s.Repository.ReadWarrantyTerms(ctx, articles)
+	if err != nil || len(terms) == 0 {
+		return err
+	}
+
+	if check.OrderNumber != 0 {
+		if customerPhone, err = s.orderCustomerPhone(ctx, check); err != nil {
+			return err
+		}
+	}
+
+	months := make(map[article.Article]uint, len(terms))
+	for _, t := range terms {
+		months[t.Article] = t.Months
+	}
+
+	serials := make(map[article.Article][]serial.Number)
+	for _, record := range sold {
+		serials[record.Article] = append(serials[record.Article], record.Serial)
+	}
+
+	var issued []dto.Warranty
+	for _, p := range check.Products {
+		m, ok := months[p.Article]
+		if !ok {
+			continue
+		}
+
+		w := dto.Warranty{Article: p.Article, ReceiptID: check.ID, OrderNumber: check.OrderNumber,
+			Phone: customerPhone, SoldAt: check.Date, ExpiresAt: warranty.Expiration(check.Date, m)}
+
+		amount := p.Amount
+		for amount > 0 && len(serials[p.Article]) > 0 {
+			w.Serial, w.Amount = serials[p.Article][0], 1
+			issued = append(issued, w)
+			serials[p.Article] = serials[p.Article][1:]
+			amount--
+		}
+		if amount > 0 {
+			w.Serial, w.Amount = "", amount
+			issued = append(issued, w)
+		}
+	}
+
+	return s.Repository.CreateWarranties(ctx, issued)
+}
+
+// orderCustomerPhone возвращает телефон покупателя, сохранённый с заказом, по которому выписан чек. Если данные
+// покупателя не сохранялись, возвращается пустой номер.
+func (s *Service) orderCustomerPhone(ctx context.Context, check *dto.Receipt) (phone.Phone, error) {
+	customer, err := s.Repository.ReadReservationCustomer(ctx, &dto.Number{OrderNumber: check.OrderNumber})
+	switch {
+	case errors.Is(err, repository.ErrNoRecord):
+		return "", nil
+	case err != nil:
+		return "", err
+	case customer.Customer == nil:
+		return "", nil
+	}
+
+	return customer.Customer.Phone, nil
+}
+
+// voidWarranties аннулирует гарантии на товар products, возвращённый по чеку receiptID. Гарантии на возвращённые
+// экземпляры с серийными номерами returned аннулируются целиком, гарантии на остальной товар - на возвращённое
+// количество. Если возвращено всё количество товара гарантии, она аннулируется целиком в момент date.
+func (s *Service) voidWarranties(ctx context.Context, receiptID receipt.ID, products []dto.ArticleAmount,
+	returned []dto.SerialRecord, date time.Time) error {
+	warranties, err := s.Repository.ReadWarrantiesByReceipt(ctx, &dto.ReceiptID{ID: receiptID})
+	if err != nil || len(warranties) == 0 {
+		return err
+	}
+
+	amounts := make(map[article.Article]uint, len(products))
+	for _, p := range products {
+		amounts[p.Article] = p.Amount
+	}
+	serials := make(map[serial.Number]struct{}, len(returned))
+	for _, r := range returned {
+		serials[r.Serial] = struct{}{}
+	}
+
+	// сначала аннулируются гарантии на возвращённые экземпляры, затем на товар без серийных номеров
+	for _, withSerial := range []bool{true, false} {
+		for i := range warranties {
+			w := &warranties[i]
+			matches := w.Serial == ""
+			if withSerial {
+				_, matches = serials[w.Serial]
+			}
+			if !matches || amounts[w.Article] == 0 {
+				continue
+			}
+
+			voided := w.Void(amounts[w.Article], date)
+			if voided == 0 {
+				continue
+			}
+			amounts[w.Article] -= voided
+			if err = s.Repository.UpdateWarrantyRefund(ctx, w); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,326 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"github.com/golang/mock/gomock"
+	"github.com/lazylex/watch-store-store/internal/domain/aggregates/receipt"
+	"github.com/lazylex/watch-store-store/internal/domain/aggregates/refund"
+	"github.com/lazylex/watch-store-store/internal/domain/aggregates/reservation"
+	"github.com/lazylex/watch-store-store/internal/domain/aggregates/warranty"
+	"github.com/lazylex/watch-store-store/internal/domain/value_objects/article"
+	"github.com/lazylex/watch-store-store/internal/domain/value_objects/payment"
+	"github.com/lazylex/watch-store-store/internal/domain/value_objects/serial"
+	"github.com/lazylex/watch-store-store/internal/dto"
+	"github.com/lazylex/watch-store-store/internal/ports/repository"
+	mockrepository "github.com/lazylex/watch-store-store/internal/ports/repository/mocks"
+	"github.com/lazylex/watch-store-store/internal/ports/service"
+	"testing"
+	"time"
+)
+
+// expectNoWarranties ожидает проверки гарантийных сроков проданных товаров и гарантий возвращаемых товаров, не
+// находящей ни одного срока и ни одной гарантии.
+func expectNoWarranties(mockRepo *mockrepository.MockInterface, ctx context.Context) {
+	mockRepo.EXPECT().ReadWarrantyTerms(ctx, gomock.Any()).AnyTimes().Return(nil, nil)
+	mockRepo.EXPECT().ReadWarrantiesByReceipt(ctx, gomock.Any()).AnyTimes().Return(nil, nil)
+}
+
+func TestService_MakeSaleIssuesWarranties(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+
+	mockRepo := mockrepository.NewMockInterface(ctrl)
+	data := dto.CashRegisterProducts{CashRegister: 1, PaymentMethod: payment.Cash, Serials: []serial.Number{"A1", "A2"},
+		Phone: "+7 (912) 345-67-89", Products: []dto.ArticlePriceAmount{{Article: "test-9", Price: 410, Amount: 2},
+			{Article: "test-10", Price: 90, Amount: 3}, {Article: "test-11", Price: 15, Amount: 1}}}
+	s := Service{Repository: mockRepo}
+	ctx := context.WithValue(context.Background(), mockrepository.ExecuteKey{}, "✅")
+
+	expectCashRegister(mockRepo, ctx, 1)
+	mockRepo.EXPECT().ReadOpenShift(ctx, &dto.CashRegister{CashRegister: 1}).Times(1).Return(
+		dto.Shift{ID: 7, CashRegister: 1}, nil)
+	for _, a := range []article.Article{"test-9", "test-10", "test-11"} {
+		mockRepo.EXPECT().ReadStockAmount(ctx, &dto.Article{Article: a}).Times(1).Return(uint(5), nil)
+		mockRepo.EXPECT().UpdateStockAmount(ctx, gomock.Any()).Times(1).Return(nil)
+		mockRepo.EXPECT().ReadStockLocations(ctx, &dto.Article{Article: a}).Times(1).Return(nil, nil)
+	}
+	mockRepo.EXPECT().ReadSerialTrackedArticles(ctx, gomock.Len(3)).Times(1).Return([]article.Article{"test-9"}, nil)
+	mockRepo.EXPECT().ReadSerials(ctx, data.Serials).Times(1).Return([]dto.SerialRecord{
+		{Serial: "A1", Article: "test-9", State: serial.InStock},
+		{Serial: "A2", Article: "test-9", State: serial.InStock}}, nil)
+	expectNoTaxRates(mockRepo, ctx)
+	mockRepo.EXPECT().CreateReceipt(ctx, gomock.Any()).Times(1).Return(receipt.ID(5), nil)
+	mockRepo.EXPECT().UpdateSerials(ctx, gomock.Len(2)).Times(1).Return(nil)
+	mockRepo.EXPECT().CreateSerialEvents(ctx, gomock.Len(2)).Times(1).Return(nil)
+	mockRepo.EXPECT().ReadWarrantyTerms(ctx, []article.Article{"test-9", "test-10", "test-11"}).Times(1).Return(
+		[]dto.WarrantyTerm{{Article: "test-9", Months: 24}, {Article: "test-10", Months: 6}}, nil)
+	mockRepo.EXPECT().CreateWarranties(ctx, gomock.Any()).Times(1).DoAndReturn(
+		func(_ context.Context, warranties []dto.Warranty) error {
+			if len(warranties) != 3 {
+				t.Fatalf("expected 3 warranties, got %+v", warranties)
+			}
+			for i, expected := range []dto.Warranty{
+				{Article: "test-9", Serial: "A1", Amount: 1},
+				{Article: "test-9", Serial: "A2", Amount: 1},
+				{Article: "test-10", Amount: 3},
+			} {
+				w := warranties[i]
+				if w.Article != expected.Article || w.Serial != expected.Serial || w.Amount != expected.Amount ||
+					w.ReceiptID != 5 || w.Phone != "79123456789" {
+					t.Errorf("unexpected warranty %+v", w)
+				}
+			}
+			if !warranties[0].ExpiresAt.Equal(warranties[0].SoldAt.AddDate(2, 0, 0)) ||
+				!warranties[2].ExpiresAt.Equal(warranties[2].SoldAt.AddDate(0, 6, 0)) {
+				t.Errorf("unexpected expiration dates %+v", warranties)
+			}
+			return nil
+		})
+
+	if _, err := s.MakeSale(ctx, data); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestService_FinishOrderIssuesWarrantyWithCustomerPhone(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+
+	mockRepo := mockrepository.NewMockInterface(ctrl)
+	s := Service{Repository: mockRepo}
+	ctx := context.WithValue(context.Background(), mockrepository.ExecuteKey{}, "✅")
+	expectNoSerials(mockRepo, ctx)
+	data := dto.NumberPaymentMethod{OrderNumber: 11, PaymentMethod: payment.Card}
+	number := &dto.Number{OrderNumber: 11}
+
+	mockRepo.EXPECT().ReadReservation(ctx, number).Times(1).Return(dto.NumberDateStateProducts{
+		Products:    []dto.ArticlePriceAmount{{Article: "test-9", Price: 100, Amount: 1}},
+		OrderNumber: 11,
+		State:       reservation.NewForInternetCustomer,
+	}, nil)
+	mockRepo.EXPECT().CreateReservationTransition(ctx, gomock.Any()).Times(1).Return(nil)
+	expectNoTaxRates(mockRepo, ctx)
+	mockRepo.EXPECT().CreateReceipt(ctx, gomock.Any()).Times(1).Return(receipt.ID(3), nil)
+	mockRepo.EXPECT().ReadWarrantyTerms(ctx, []article.Article{"test-9"}).Times(1).Return(
+		[]dto.WarrantyTerm{{Article: "test-9", Months: 12}}, nil)
+	mockRepo.EXPECT().ReadReservationCustomer(ctx, number).Times(1).Return(dto.ReservationCustomer{OrderNumber: 11,
+		Customer: &dto.Customer{Name: "Иван", Phone: "79123456789"}}, nil)
+	mockRepo.EXPECT().CreateWarranties(ctx, gomock.Any()).Times(1).DoAndReturn(
+		func(_ context.Context, warranties []dto.Warranty) error {
+			if len(warranties) != 1 || warranties[0].OrderNumber != 11 || warranties[0].ReceiptID != 3 ||
+				warranties[0].Phone != "79123456789" || warranties[0].Amount != 1 {
+				t.Errorf("unexpected warranties %+v", warranties)
+			}
+			return nil
+		})
+	mockRepo.EXPECT().UpdateReservation(ctx, gomock.Any()).Times(1).Return(nil)
+
+	if _, err := s.FinishOrder(ctx, data); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestService_SetWarrantyTermZeroDeletesTerm(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+
+	mockRepo := mockrepository.NewMockInterface(ctrl)
+	s := Service{Repository: mockRepo}
+	ctx := context.Background()
+	a := &dto.Article{Article: "test-9"}
+
+	mockRepo.EXPECT().ReadStock(ctx, a).Times(1).Return(dto.ArticlePriceNameAmount{Article: "test-9"}, nil)
+	mockRepo.EXPECT().DeleteWarrantyTerm(ctx, a).Times(1).Return(nil)
+
+	if err := s.SetWarrantyTerm(ctx, dto.WarrantyTerm{Article: "test-9"}); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestService_RegisterWarrantyClaim(t *testing.T) {
+	t.Parallel()
+	active := dto.Warranty{ID: 4, ExpiresAt: time.Now().Add(time.Hour)}
+
+	tests := []struct {
+		testName string
+		warranty dto.Warranty
+		readErr  error
+		claims   []dto.WarrantyClaim
+		err      error
+	}{
+		{"registered", active, nil, []dto.WarrantyClaim{{ID: 1, Status: warranty.Closed}}, nil},
+		{"unknown warranty", dto.Warranty{}, repository.ErrNoRecord, nil, repository.ErrNoRecord},
+		{"expired warranty", dto.Warranty{ID: 4, ExpiresAt: time.Now().Add(-time.Hour)}, nil, nil,
+			service.ErrWarrantyExpired},
+		{"open claim", active, nil, []dto.WarrantyClaim{{ID: 1, Status: warranty.InRepair}},
+			service.ErrWarrantyClaimOpen},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.testName, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+
+			mockRepo := mockrepository.NewMockInterface(ctrl)
+			s := Service{Repository: mockRepo}
+			ctx := context.WithValue(context.Background(), mockrepository.ExecuteKey{}, "✅")
+			number := &dto.WarrantyID{ID: 4}
+
+			mockRepo.EXPECT().ReadWarranty(ctx, number).Times(1).Return(tt.warranty, tt.readErr)
+			mockRepo.EXPECT().ReadWarrantyClaims(ctx, number).MaxTimes(1).Return(tt.claims, nil)
+			if tt.err == nil {
+				mockRepo.EXPECT().CreateWarrantyClaim(ctx, gomock.Any()).Times(1).DoAndReturn(
+					func(_ context.Context, claim *dto.WarrantyClaim) (warranty.ClaimID, error) {
+						if claim.Status != warranty.Registered || claim.Description != "Не заводится" {
+							t.Errorf("unexpected claim %+v", claim)
+						}
+						return 2, nil
+					})
+				mockRepo.EXPECT().CreateWarrantyClaimTransition(ctx, gomock.Any()).Times(1).Return(nil)
+			}
+
+			id, err := s.RegisterWarrantyClaim(ctx, dto.WarrantyIDDescription{WarrantyID: 4,
+				Description: " Не заводится "})
+			if !errors.Is(err, tt.err) || (tt.err == nil && id != 2) {
+				t.Errorf("expected %v, got %d, %v", tt.err, id, err)
+			}
+		})
+	}
+}
+
+func TestService_ChangeWarrantyClaimStatus(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		testName string
+		from     warranty.Status
+		to       warranty.Status
+		err      error
+	}{
+		{"sent to repair", warranty.Registered, warranty.InRepair, nil},
+		{"closed without decision", warranty.InRepair, warranty.Closed, warranty.ErrIllegalTransition},
+		{"closed claim", warranty.Closed, warranty.InRepair, service.ErrAlreadyProcessed},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.testName, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+
+			mockRepo := mockrepository.NewMockInterface(ctrl)
+			s := Service{Repository: mockRepo}
+			ctx := context.WithValue(context.Background(), mockrepository.ExecuteKey{}, "✅")
+			data := dto.ClaimIDStatus{ClaimID: 2, Status: tt.to}
+
+			mockRepo.EXPECT().ReadWarrantyClaim(ctx, &dto.WarrantyClaimID{ID: 2}).Times(1).Return(
+				dto.WarrantyClaim{ID: 2, WarrantyID: 4, Status: tt.from}, nil)
+			if tt.err == nil {
+				mockRepo.EXPECT().UpdateWarrantyClaimStatus(ctx, &data).Times(1).Return(nil)
+				mockRepo.EXPECT().CreateWarrantyClaimTransition(ctx, gomock.Any()).Times(1).DoAndReturn(
+					func(_ context.Context, transition *dto.WarrantyClaimTransition) error {
+						if transition.From != tt.from || transition.To != tt.to || transition.Actor != systemActor {
+							t.Errorf("unexpected transition %+v", transition)
+						}
+						return nil
+					})
+			}
+
+			if err := s.ChangeWarrantyClaimStatus(ctx, data); !errors.Is(err, tt.err) {
+				t.Errorf("expected %v, got %v", tt.err, err)
+			}
+		})
+	}
+}
+
+func TestService_WarrantyClaimsWithHistory(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+
+	mockRepo := mockrepository.NewMockInterface(ctrl)
+	s := Service{Repository: mockRepo}
+	ctx := context.Background()
+	number := &dto.WarrantyID{ID: 4}
+
+	mockRepo.EXPECT().ReadWarranty(ctx, number).Times(1).Return(dto.Warranty{ID: 4}, nil)
+	mockRepo.EXPECT().ReadWarrantyClaims(ctx, number).Times(1).Return([]dto.WarrantyClaim{
+		{ID: 1, WarrantyID: 4, Status: warranty.Closed}, {ID: 2, WarrantyID: 4, Status: warranty.Registered}}, nil)
+	mockRepo.EXPECT().ReadWarrantyClaimTransitions(ctx, number).Times(1).Return([]dto.WarrantyClaimTransition{
+		{ClaimID: 1, To: warranty.Registered},
+		{ClaimID: 1, From: warranty.Registered, To: warranty.Rejected},
+		{ClaimID: 1, From: warranty.Rejected, To: warranty.Closed},
+		{ClaimID: 2, To: warranty.Registered}}, nil)
+
+	claims, err := s.WarrantyClaims(ctx, dto.WarrantyID{ID: 4})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(claims) != 2 || len(claims[0].History) != 3 || len(claims[1].History) != 1 ||
+		claims[0].History[2].To != warranty.Closed {
+		t.Errorf("unexpected claims %+v", claims)
+	}
+}
+
+func TestService_ReturnSaleVoidsWarrantiesThenClaimRejected(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+
+	mockRepo := mockrepository.NewMockInterface(ctrl)
+	s := Service{Repository: mockRepo}
+	ctx := context.WithValue(context.Background(), mockrepository.ExecuteKey{}, "✅")
+	receiptID := dto.ReceiptID{ID: 118}
+	expires := time.Now().AddDate(1, 0, 0)
+	data := dto.Refund{ReceiptID: 118, CashRegister: 1, Serials: []serial.Number{"S1"},
+		Products: []dto.ArticleAmount{{Article: "watch", Amount: 1}, {Article: "strap", Amount: 1}}}
+
+	expectCashRegister(mockRepo, ctx, 1)
+	mockRepo.EXPECT().ReadOpenShift(ctx, &dto.CashRegister{CashRegister: 1}).Times(1).Return(
+		dto.Shift{ID: 5, CashRegister: 1}, nil)
+	mockRepo.EXPECT().ReadReceipt(ctx, &receiptID).Times(1).Return(dto.Receipt{ID: 118, PaymentMethod: payment.Card,
+		Products: []dto.ArticlePriceAmount{
+			{Article: "watch", Price: 5000, Amount: 2},
+			{Article: "strap", Price: 300, Amount: 3},
+		}}, nil)
+	mockRepo.EXPECT().ReadRefundedProducts(ctx, &receiptID).Times(1).Return(nil, nil)
+	mockRepo.EXPECT().ReadStockAmount(ctx, gomock.Any()).Times(2).Return(uint(0), nil)
+	mockRepo.EXPECT().UpdateStockAmount(ctx, gomock.Any()).Times(2).Return(nil)
+	mockRepo.EXPECT().ReadSerialTrackedArticles(ctx, gomock.Any()).Times(1).Return(
+		[]article.Article{"watch"}, nil)
+	mockRepo.EXPECT().ReadSerials(ctx, []serial.Number{"S1"}).Times(1).Return([]dto.SerialRecord{
+		{Serial: "S1", Article: "watch", State: serial.Sold, ReceiptID: 118}}, nil)
+	mockRepo.EXPECT().CreateRefund(ctx, gomock.Any()).Times(1).Return(refund.ID(9), nil)
+	mockRepo.EXPECT().UpdateSerials(ctx, gomock.Any()).Times(1).Return(nil)
+	mockRepo.EXPECT().CreateSerialEvents(ctx, gomock.Any()).Times(1).Return(nil)
+	mockRepo.EXPECT().ReadWarrantiesByReceipt(ctx, &receiptID).Times(1).Return([]dto.Warranty{
+		{ID: 31, Article: "watch", Serial: "S1", Amount: 1, ReceiptID: 118, ExpiresAt: expires},
+		{ID: 32, Article: "watch", Serial: "S2", Amount: 1, ReceiptID: 118, ExpiresAt: expires},
+		{ID: 33, Article: "strap", Amount: 3, ReceiptID: 118, ExpiresAt: expires},
+	}, nil)
+
+	voided := make(map[warranty.ID]dto.Warranty)
+	mockRepo.EXPECT().UpdateWarrantyRefund(ctx, gomock.Any()).Times(2).DoAndReturn(
+		func(_ context.Context, w *dto.Warranty) error {
+			voided[w.ID] = *w
+			return nil
+		})
+
+	if _, err := s.ReturnSale(ctx, data); err != nil {
+		t.Fatal(err)
+	}
+	if w := voided[31]; !w.IsVoid() || w.Refunded != 1 {
+		t.Errorf("warranty of returned watch not voided: %+v", w)
+	}
+	if w := voided[33]; w.IsVoid() || w.Refunded != 1 {
+		t.Errorf("unexpected warranty of partially returned straps: %+v", w)
+	}
+
+	mockRepo.EXPECT().ReadWarranty(ctx, &dto.WarrantyID{ID: 31}).Times(1).Return(voided[31], nil)
+	mockRepo.EXPECT().CreateWarrantyClaim(gomock.Any(), gomock.Any()).Times(0)
+
+	_, err := s.RegisterWarrantyClaim(ctx, dto.WarrantyIDDescription{WarrantyID: 31, Description: "Не заводится"})
+	if !errors.Is(err, service.ErrWarrantyVoid) {
+		t.Errorf("expected %v, got %v", service.ErrWarrantyVoid, err)
+	}
+}
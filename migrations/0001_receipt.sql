@@ -0,0 +1,16 @@
+-- Чеки, объединяющие проданные в рамках одной покупки товары
+CREATE TABLE IF NOT EXISTS receipt
+(
+    id            BIGINT UNSIGNED NOT NULL AUTO_INCREMENT,
+    cash_register INT             NULL,
+    order_number  INT             NULL,
+    total         DECIMAL(12, 2)  NOT NULL,
+    created_at    DATETIME        NOT NULL,
+    PRIMARY KEY (id),
+    INDEX idx_receipt_created_at (created_at)
+);
+
+ALTER TABLE sold
+    ADD COLUMN receipt_id BIGINT UNSIGNED NULL,
+    ADD INDEX idx_sold_receipt_id (receipt_id),
+    ADD CONSTRAINT fk_sold_receipt FOREIGN KEY (receipt_id) REFERENCES receipt (id);
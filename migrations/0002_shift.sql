@@ -0,0 +1,75 @@
+-- Кассовые смены. На одной кассе может быть открыта только одна смена (уникальный индекс по вычисляемому столбцу)
+CREATE TABLE IF NOT EXISTS shift
+(
+    id            BIGINT UNSIGNED NOT NULL AUTO_INCREMENT,
+    cash_register INT             NOT NULL,
+    cashier_id    VARCHAR(64)     NOT NULL,
+    opening_float DECIMAL(12, 2)  NOT NULL DEFAULT 0,
+    opened_at     DATETIME        NOT NULL,
+    closed_at     DATETIME        NULL,
+    open_register INT AS (IF(closed_at IS NULL, cash_register, NULL)) STORED,
+    PRIMARY KEY (id),
+    UNIQUE INDEX idx_shift_open_register (open_register)
+);
+
+ALTER TABLE receipt
+    ADD COLUMN shift_id       BIGINT UNSIGNED NULL AFTER cash_register,
+    ADD COLUMN payment_method VARCHAR(16)     NOT NULL DEFAULT '' AFTER order_number,
+    ADD INDEX idx_receipt_shift_id (shift_id),
+    ADD CONSTRAINT fk_receipt_shift FOREIGN KEY (shift_id) REFERENCES shift (id);
+
+-- Возвраты товаров по чекам
+CREATE TABLE IF NOT EXISTS refund
+(
+    id             BIGINT UNSIGNED NOT NULL AUTO_INCREMENT,
+    receipt_id     BIGINT UNSIGNED NOT NULL,
+    shift_id       BIGINT UNSIGNED NOT NULL,
+    cash_register  INT             NOT NULL,
+    payment_method VARCHAR(16)     NOT NULL,
+    total          DECIMAL(12, 2)  NOT NULL,
+    created_at     DATETIME        NOT NULL,
+    PRIMARY KEY (id),
+    INDEX idx_refund_receipt_id (receipt_id),
+    INDEX idx_refund_shift_id (shift_id),
+    CONSTRAINT fk_refund_receipt FOREIGN KEY (receipt_id) REFERENCES receipt (id),
+    CONSTRAINT fk_refund_shift FOREIGN KEY (shift_id) REFERENCES shift (id)
+);
+
+CREATE TABLE IF NOT EXISTS refund_item
+(
+    refund_id BIGINT UNSIGNED NOT NULL,
+    article   VARCHAR(50)     NOT NULL,
+    price     DECIMAL(12, 2)  NOT NULL,
+    amount    INT UNSIGNED    NOT NULL,
+    PRIMARY KEY (refund_id, article),
+    CONSTRAINT fk_refund_item_refund FOREIGN KEY (refund_id) REFERENCES refund (id)
+);
+
+-- Отчёты о закрытии смены (Z-отчёты)
+CREATE TABLE IF NOT EXISTS z_report
+(
+    shift_id       BIGINT UNSIGNED NOT NULL,
+    cash_register  INT             NOT NULL,
+    cashier_id     VARCHAR(64)     NOT NULL,
+    opened_at      DATETIME        NOT NULL,
+    closed_at      DATETIME        NOT NULL,
+    opening_float  DECIMAL(12, 2)  NOT NULL,
+    sales_count    INT UNSIGNED    NOT NULL,
+    sales_total    DECIMAL(12, 2)  NOT NULL,
+    refunds_count  INT UNSIGNED    NOT NULL,
+    refunds_total  DECIMAL(12, 2)  NOT NULL,
+    cash_in_drawer DECIMAL(12, 2)  NOT NULL,
+    PRIMARY KEY (shift_id),
+    CONSTRAINT fk_z_report_shift FOREIGN KEY (shift_id) REFERENCES shift (id)
+);
+
+CREATE TABLE IF NOT EXISTS z_report_payment
+(
+    shift_id       BIGINT UNSIGNED         NOT NULL,
+    operation      ENUM ('sale', 'refund') NOT NULL,
+    payment_method VARCHAR(16)             NOT NULL,
+    count          INT UNSIGNED            NOT NULL,
+    total          DECIMAL(12, 2)          NOT NULL,
+    PRIMARY KEY (shift_id, operation, payment_method),
+    CONSTRAINT fk_z_report_payment_report FOREIGN KEY (shift_id) REFERENCES z_report (shift_id)
+);
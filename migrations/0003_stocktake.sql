@@ -0,0 +1,25 @@
+-- Инвентаризации. Состояние: 1 - идёт подсчёт товара, 2 - результаты внесены в остатки
+CREATE TABLE IF NOT EXISTS stocktake
+(
+    id         BIGINT UNSIGNED  NOT NULL AUTO_INCREMENT,
+    state      TINYINT UNSIGNED NOT NULL,
+    started_at DATETIME         NOT NULL,
+    applied_at DATETIME         NULL,
+    PRIMARY KEY (id)
+);
+
+-- Подсчитываемые при инвентаризации товары. После применения инвентаризации содержат отчёт о расхождениях
+CREATE TABLE IF NOT EXISTS stocktake_item
+(
+    stocktake_id  BIGINT UNSIGNED NOT NULL,
+    article       VARCHAR(50)     NOT NULL,
+    system_amount INT UNSIGNED    NOT NULL,
+    counted       INT UNSIGNED    NOT NULL DEFAULT 0,
+    is_counted    BOOLEAN         NOT NULL DEFAULT FALSE,
+    sold          INT UNSIGNED    NOT NULL DEFAULT 0,
+    refunded      INT UNSIGNED    NOT NULL DEFAULT 0,
+    expected      INT UNSIGNED    NOT NULL DEFAULT 0,
+    difference    INT             NOT NULL DEFAULT 0,
+    PRIMARY KEY (stocktake_id, article),
+    CONSTRAINT fk_stocktake_item_stocktake FOREIGN KEY (stocktake_id) REFERENCES stocktake (id)
+);
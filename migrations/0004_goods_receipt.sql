@@ -0,0 +1,18 @@
+-- Принятые поставки. Номер сопроводительного документа уникален, что обеспечивает идемпотентность приёмки
+CREATE TABLE IF NOT EXISTS goods_receipt
+(
+    document_number VARCHAR(64)  NOT NULL,
+    supplier        VARCHAR(255) NOT NULL,
+    received_at     DATETIME     NOT NULL,
+    PRIMARY KEY (document_number)
+);
+
+CREATE TABLE IF NOT EXISTS goods_receipt_line
+(
+    document_number VARCHAR(64)    NOT NULL,
+    article         VARCHAR(50)    NOT NULL,
+    cost            DECIMAL(12, 2) NOT NULL,
+    amount          INT UNSIGNED   NOT NULL,
+    PRIMARY KEY (document_number, article),
+    CONSTRAINT fk_goods_receipt_line_receipt FOREIGN KEY (document_number) REFERENCES goods_receipt (document_number)
+);
@@ -0,0 +1,13 @@
+-- Журнал относительных корректировок количества товара с указанием причины
+CREATE TABLE IF NOT EXISTS stock_adjustment
+(
+    id           BIGINT UNSIGNED NOT NULL AUTO_INCREMENT,
+    article      VARCHAR(50)     NOT NULL,
+    delta        INT             NOT NULL,
+    reason       VARCHAR(50)     NOT NULL,
+    comment      VARCHAR(255)    NOT NULL DEFAULT '',
+    amount_after INT UNSIGNED    NOT NULL,
+    created_at   DATETIME        NOT NULL,
+    PRIMARY KEY (id),
+    INDEX idx_stock_adjustment_article (article)
+);
@@ -0,0 +1,12 @@
+-- История смены состояний бронирований. Записи хранятся и после удаления бронирования, оформленного на кассе
+CREATE TABLE IF NOT EXISTS reservation_transition
+(
+    id           BIGINT UNSIGNED  NOT NULL AUTO_INCREMENT,
+    order_number INT              NOT NULL,
+    from_state   TINYINT UNSIGNED NOT NULL,
+    to_state     TINYINT UNSIGNED NOT NULL,
+    actor        VARCHAR(255)     NOT NULL,
+    created_at   DATETIME         NOT NULL,
+    PRIMARY KEY (id),
+    INDEX idx_reservation_transition_order (order_number, created_at)
+);
@@ -0,0 +1,13 @@
+-- Результаты обработки запросов с заголовком Idempotency-Key. Записи с истёкшим сроком действия удаляются
+CREATE TABLE IF NOT EXISTS idempotency_key
+(
+    idempotency_key VARCHAR(255) NOT NULL,
+    fingerprint     CHAR(64)     NOT NULL,
+    completed       BOOLEAN      NOT NULL DEFAULT FALSE,
+    status          SMALLINT     NOT NULL DEFAULT 0,
+    content_type    VARCHAR(255) NOT NULL DEFAULT '',
+    body            MEDIUMBLOB,
+    expires_at      DATETIME     NOT NULL,
+    PRIMARY KEY (idempotency_key),
+    INDEX idx_idempotency_key_expires_at (expires_at)
+);
@@ -0,0 +1,8 @@
+-- Параметры пополнения запасов товара: срок поставки и страховой запас
+CREATE TABLE IF NOT EXISTS replenishment_setting
+(
+    article        VARCHAR(50)  NOT NULL,
+    lead_time_days INT UNSIGNED NOT NULL,
+    safety_stock   INT UNSIGNED NOT NULL DEFAULT 0,
+    PRIMARY KEY (article)
+);
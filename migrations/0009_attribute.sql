@@ -0,0 +1,23 @@
+-- Определения атрибутов товаров. Для перечислимых атрибутов допустимые значения хранятся в виде JSON-массива
+CREATE TABLE IF NOT EXISTS attribute_definition
+(
+    code        VARCHAR(50)  NOT NULL,
+    name        VARCHAR(100) NOT NULL,
+    type        VARCHAR(10)  NOT NULL,
+    enum_values TEXT         NULL,
+    PRIMARY KEY (code)
+);
+
+-- Значения атрибутов товаров. Для значений, являющихся числами, дополнительно сохраняется числовое значение, по
+-- которому выполняется поиск по диапазону
+CREATE TABLE IF NOT EXISTS article_attribute
+(
+    article      VARCHAR(50)    NOT NULL,
+    code         VARCHAR(50)    NOT NULL,
+    value        VARCHAR(255)   NOT NULL,
+    value_number DECIMAL(18, 4) NULL,
+    PRIMARY KEY (article, code),
+    INDEX idx_article_attribute_value (code, value),
+    INDEX idx_article_attribute_number (code, value_number),
+    CONSTRAINT fk_article_attribute_code FOREIGN KEY (code) REFERENCES attribute_definition (code)
+);
@@ -0,0 +1,8 @@
+-- Штрихкоды товаров. Коды UPC-A хранятся в формате EAN-13 (с ведущим нулём), у товара может быть несколько штрихкодов
+CREATE TABLE IF NOT EXISTS barcode
+(
+    barcode CHAR(13)    NOT NULL,
+    article VARCHAR(50) NOT NULL,
+    PRIMARY KEY (barcode),
+    INDEX idx_barcode_article (article)
+);
@@ -0,0 +1,10 @@
+-- Количество товара на местах хранения внутри магазина. Хранятся только места, отличные от места по умолчанию
+-- (витрины): количество на нём равно разности общего количества товара из таблицы stock и суммы количеств на остальных
+-- местах
+CREATE TABLE IF NOT EXISTS stock_location
+(
+    article  VARCHAR(50)  NOT NULL,
+    location VARCHAR(20)  NOT NULL,
+    amount   INT UNSIGNED NOT NULL,
+    PRIMARY KEY (article, location)
+);
@@ -0,0 +1,31 @@
+-- Перемещения товара между магазинами (экземплярами приложения). Направление: outbound - отправлено из этого магазина,
+-- inbound - отправлено в этот магазин. Состояние: 1 - в пути, 2 - принято, 3 - принято с расхождением,
+-- 4 - расхождение урегулировано. Для входящих перемещений source_id содержит идентификатор перемещения у отправителя,
+-- уникальный в пределах отправителя, что обеспечивает идемпотентность получения сообщений. notified - второй стороне
+-- отправлено сообщение об отправке (для исходящих) или приёмке (для входящих) товара
+CREATE TABLE IF NOT EXISTS stock_transfer
+(
+    id          BIGINT UNSIGNED  NOT NULL AUTO_INCREMENT,
+    direction   VARCHAR(10)      NOT NULL,
+    counterpart VARCHAR(100)     NOT NULL,
+    source_id   BIGINT UNSIGNED  NULL,
+    state       TINYINT UNSIGNED NOT NULL,
+    notified    BOOLEAN          NOT NULL DEFAULT FALSE,
+    created_at  DATETIME         NOT NULL,
+    updated_at  DATETIME         NOT NULL,
+    PRIMARY KEY (id),
+    UNIQUE KEY uq_stock_transfer_source (counterpart, source_id),
+    INDEX idx_stock_transfer_notified (notified)
+);
+
+CREATE TABLE IF NOT EXISTS stock_transfer_item
+(
+    transfer_id BIGINT UNSIGNED NOT NULL,
+    article     VARCHAR(50)     NOT NULL,
+    name        VARCHAR(255)    NOT NULL DEFAULT '',
+    price       DECIMAL(12, 2)  NOT NULL DEFAULT 0,
+    amount      INT UNSIGNED    NOT NULL,
+    received    INT UNSIGNED    NOT NULL DEFAULT 0,
+    PRIMARY KEY (transfer_id, article),
+    CONSTRAINT fk_stock_transfer_item_transfer FOREIGN KEY (transfer_id) REFERENCES stock_transfer (id)
+);
@@ -0,0 +1,13 @@
+-- Последовательность номеров заказов через интернет, выделяемых сервером. Таблица содержит одну строку, значение
+-- которой увеличивается при выделении номера. Начальное значение - наибольший из уже использованных номеров заказов, но
+-- не меньше наибольшего номера кассы (10)
+CREATE TABLE IF NOT EXISTS order_number_sequence
+(
+    id    TINYINT UNSIGNED NOT NULL,
+    value BIGINT UNSIGNED  NOT NULL,
+    PRIMARY KEY (id)
+);
+
+INSERT IGNORE INTO order_number_sequence (id, value)
+SELECT 1, GREATEST(10, COALESCE(MAX(order_number), 0))
+FROM on_processing;
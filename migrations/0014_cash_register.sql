@@ -0,0 +1,25 @@
+-- Реестр касс магазина. Номер кассы используется как номер заказа, оформленного на ней, поэтому номера касс не должны
+-- совпадать с номерами заказов через интернет. Пустое место хранения означает место хранения по умолчанию. Время
+-- last_seen_at обновляется при каждой операции, выполненной на кассе. Изначально зарегистрированы кассы 1-10, ранее
+-- зашитые в код
+CREATE TABLE IF NOT EXISTS cash_register
+(
+    number       INT UNSIGNED NOT NULL,
+    name         VARCHAR(100) NOT NULL,
+    location     VARCHAR(20)  NOT NULL DEFAULT '',
+    enabled      BOOLEAN      NOT NULL DEFAULT TRUE,
+    last_seen_at DATETIME     NULL,
+    PRIMARY KEY (number)
+);
+
+INSERT IGNORE INTO cash_register (number, name)
+VALUES (1, 'Касса 1'),
+       (2, 'Касса 2'),
+       (3, 'Касса 3'),
+       (4, 'Касса 4'),
+       (5, 'Касса 5'),
+       (6, 'Касса 6'),
+       (7, 'Касса 7'),
+       (8, 'Касса 8'),
+       (9, 'Касса 9'),
+       (10, 'Касса 10');
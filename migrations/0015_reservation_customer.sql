@@ -0,0 +1,12 @@
+-- Данные покупателей и хэши кодов получения заказов. Номер телефона хранится в нормализованном виде (только цифры).
+-- Для заказов, оформленных до появления таблицы, записей нет, и код получения при их выдаче не проверяется
+CREATE TABLE IF NOT EXISTS reservation_customer
+(
+    order_number     BIGINT       NOT NULL,
+    name             VARCHAR(100) NULL,
+    phone            VARCHAR(15)  NULL,
+    email            VARCHAR(254) NULL,
+    pickup_code_hash CHAR(64)     NULL,
+    PRIMARY KEY (order_number),
+    INDEX idx_reservation_customer_phone (phone)
+);
@@ -0,0 +1,18 @@
+-- Платежи, которыми оплачены чеки. Сдача выдаётся только с наличных платежей. Для чеков, сохранённых до появления
+-- таблицы, создаётся один платёж на всю сумму чека способом оплаты из чека
+CREATE TABLE IF NOT EXISTS receipt_payment
+(
+    receipt_id     BIGINT UNSIGNED NOT NULL,
+    line           INT UNSIGNED    NOT NULL,
+    payment_method VARCHAR(16)     NOT NULL,
+    amount         DECIMAL(12, 2)  NOT NULL,
+    change_given   DECIMAL(12, 2)  NOT NULL DEFAULT 0,
+    PRIMARY KEY (receipt_id, line),
+    INDEX idx_receipt_payment_method (payment_method),
+    CONSTRAINT fk_receipt_payment_receipt FOREIGN KEY (receipt_id) REFERENCES receipt (id)
+);
+
+INSERT INTO receipt_payment (receipt_id, line, payment_method, amount)
+SELECT id, 1, payment_method, total
+FROM receipt
+WHERE id NOT IN (SELECT receipt_id FROM receipt_payment);
@@ -0,0 +1,29 @@
+-- Ставки НДС налоговых категорий товаров. Ставка действует с момента effective_from до начала действия следующей ставки
+-- той же категории
+CREATE TABLE IF NOT EXISTS tax_rate
+(
+    category       VARCHAR(50)   NOT NULL,
+    effective_from DATETIME      NOT NULL,
+    rate           DECIMAL(5, 2) NOT NULL,
+    PRIMARY KEY (category, effective_from)
+);
+
+-- Налоговые категории товаров. Товары без записи относятся к категории default
+CREATE TABLE IF NOT EXISTS article_tax_category
+(
+    article  VARCHAR(50) NOT NULL,
+    category VARCHAR(50) NOT NULL,
+    PRIMARY KEY (article)
+);
+
+-- НДС, включённый в стоимость проданного товара. Для продаж, записанных до появления налогов, стоимость без налога
+-- равна стоимости продажи
+ALTER TABLE sold
+    ADD COLUMN tax_category VARCHAR(50)    NOT NULL DEFAULT 'default',
+    ADD COLUMN tax_rate     DECIMAL(5, 2)  NOT NULL DEFAULT 0,
+    ADD COLUMN net_amount   DECIMAL(12, 2) NOT NULL DEFAULT 0,
+    ADD COLUMN tax_amount   DECIMAL(12, 2) NOT NULL DEFAULT 0;
+
+UPDATE sold
+SET net_amount = price * amount
+WHERE tax_amount = 0;
@@ -0,0 +1,38 @@
+-- Товары, учитываемые по серийным номерам
+CREATE TABLE IF NOT EXISTS serial_tracked_article
+(
+    article    VARCHAR(50) NOT NULL,
+    tracked_at DATETIME    NOT NULL,
+    PRIMARY KEY (article)
+);
+
+-- Экземпляры товаров с серийными номерами. Для зарезервированного экземпляра order_number - номер заказа, для
+-- проданного receipt_id - идентификатор чека
+CREATE TABLE IF NOT EXISTS serial_number
+(
+    serial       VARCHAR(64)     NOT NULL,
+    article      VARCHAR(50)     NOT NULL,
+    state        VARCHAR(16)     NOT NULL,
+    order_number INT             NOT NULL DEFAULT 0,
+    receipt_id   BIGINT UNSIGNED NOT NULL DEFAULT 0,
+    PRIMARY KEY (serial),
+    INDEX idx_serial_number_article (article, state),
+    INDEX idx_serial_number_order (order_number, state),
+    INDEX idx_serial_number_receipt (receipt_id)
+);
+
+-- История экземпляров товаров с серийными номерами. Каждая запись ссылается на документ, вызвавший событие
+CREATE TABLE IF NOT EXISTS serial_event
+(
+    id              BIGINT UNSIGNED NOT NULL AUTO_INCREMENT,
+    serial          VARCHAR(64)     NOT NULL,
+    article         VARCHAR(50)     NOT NULL,
+    state           VARCHAR(16)     NOT NULL,
+    document_number VARCHAR(64)     NOT NULL DEFAULT '',
+    order_number    INT             NOT NULL DEFAULT 0,
+    receipt_id      BIGINT UNSIGNED NOT NULL DEFAULT 0,
+    refund_id       BIGINT UNSIGNED NOT NULL DEFAULT 0,
+    created_at      DATETIME        NOT NULL,
+    PRIMARY KEY (id),
+    INDEX idx_serial_event_serial (serial, created_at)
+);
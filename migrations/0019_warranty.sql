@@ -0,0 +1,55 @@
+-- Гарантийные сроки товаров в месяцах. На товары без записи гарантия не выдаётся
+CREATE TABLE IF NOT EXISTS warranty_term
+(
+    article VARCHAR(50)       NOT NULL,
+    months  SMALLINT UNSIGNED NOT NULL,
+    PRIMARY KEY (article)
+);
+
+-- Гарантии, выданные при продаже товаров и выполнении заказов. Гарантия на экземпляр товара с серийным номером
+-- выдаётся на одну единицу товара, на остальные товары - на всё количество строки чека. Телефон покупателя хранится в
+-- нормализованном виде (только цифры). refunded - количество возвращённого покупателем товара, гарантия на который
+-- аннулирована, voided_at - момент аннулирования гарантии на всё её количество
+CREATE TABLE IF NOT EXISTS warranty
+(
+    id           BIGINT UNSIGNED NOT NULL AUTO_INCREMENT,
+    article      VARCHAR(50)     NOT NULL,
+    serial       VARCHAR(64)     NOT NULL DEFAULT '',
+    amount       INT UNSIGNED    NOT NULL,
+    refunded     INT UNSIGNED    NOT NULL DEFAULT 0,
+    receipt_id   BIGINT UNSIGNED NOT NULL,
+    order_number INT             NOT NULL DEFAULT 0,
+    phone        VARCHAR(15)     NULL,
+    sold_at      DATETIME        NOT NULL,
+    expires_at   DATETIME        NOT NULL,
+    voided_at    DATETIME        NULL,
+    PRIMARY KEY (id),
+    INDEX idx_warranty_receipt (receipt_id),
+    INDEX idx_warranty_serial (serial),
+    INDEX idx_warranty_phone (phone)
+);
+
+-- Гарантийные обращения покупателей
+CREATE TABLE IF NOT EXISTS warranty_claim
+(
+    id          BIGINT UNSIGNED NOT NULL AUTO_INCREMENT,
+    warranty_id BIGINT UNSIGNED NOT NULL,
+    description VARCHAR(1000)   NOT NULL,
+    status      VARCHAR(16)     NOT NULL,
+    created_at  DATETIME        NOT NULL,
+    PRIMARY KEY (id),
+    INDEX idx_warranty_claim_warranty (warranty_id)
+);
+
+-- История смены состояний гарантийных обращений. Для регистрации обращения from_status пусто
+CREATE TABLE IF NOT EXISTS warranty_claim_transition
+(
+    id          BIGINT UNSIGNED NOT NULL AUTO_INCREMENT,
+    claim_id    BIGINT UNSIGNED NOT NULL,
+    from_status VARCHAR(16)     NOT NULL DEFAULT '',
+    to_status   VARCHAR(16)     NOT NULL,
+    actor       VARCHAR(255)    NOT NULL,
+    created_at  DATETIME        NOT NULL,
+    PRIMARY KEY (id),
+    INDEX idx_warranty_claim_transition_claim (claim_id, created_at)
+);
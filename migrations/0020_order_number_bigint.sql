@@ -0,0 +1,22 @@
+-- Номера заказов с префиксом экземпляра приложения (префикс, умноженный на 1 000 000 000, плюс порядковый номер)
+-- не помещаются в INT, поэтому все столбцы с номерами заказов расширяются до BIGINT
+ALTER TABLE on_processing
+    MODIFY COLUMN order_number BIGINT NOT NULL;
+
+ALTER TABLE receipt
+    MODIFY COLUMN order_number BIGINT NULL;
+
+ALTER TABLE reservation_transition
+    MODIFY COLUMN order_number BIGINT NOT NULL;
+
+ALTER TABLE reservation_customer
+    MODIFY COLUMN order_number BIGINT NOT NULL;
+
+ALTER TABLE serial_number
+    MODIFY COLUMN order_number BIGINT NOT NULL DEFAULT 0;
+
+ALTER TABLE serial_event
+    MODIFY COLUMN order_number BIGINT NOT NULL DEFAULT 0;
+
+ALTER TABLE warranty
+    MODIFY COLUMN order_number BIGINT NOT NULL DEFAULT 0;
@@ -0,0 +1,13 @@
+-- Учётное количество товара при инвентаризации включает зарезервированный товар, ещё находящийся в магазине, а
+-- ожидаемое количество рассчитывается с учётом всех изменений остатков во время подсчёта, а не только продаж и
+-- возвратов. Для сохранённых отчётов изменение остатков равно разнице возвращённого и проданного
+ALTER TABLE stocktake_item
+    ADD COLUMN reserved INT UNSIGNED NOT NULL DEFAULT 0 AFTER system_amount,
+    ADD COLUMN movement INT          NOT NULL DEFAULT 0 AFTER is_counted;
+
+UPDATE stocktake_item
+SET movement = CAST(refunded AS SIGNED) - CAST(sold AS SIGNED);
+
+ALTER TABLE stocktake_item
+    DROP COLUMN sold,
+    DROP COLUMN refunded;
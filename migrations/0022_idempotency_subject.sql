@@ -0,0 +1,6 @@
+-- Ключи идемпотентности хранятся отдельно для каждого субъекта JWT-токена (пустая строка - запросы без токена), чтобы
+-- клиенты, случайно использовавшие одинаковый ключ, не получали ответы друг друга
+ALTER TABLE idempotency_key
+    ADD COLUMN subject VARCHAR(255) NOT NULL DEFAULT '' FIRST,
+    DROP PRIMARY KEY,
+    ADD PRIMARY KEY (subject, idempotency_key);